@@ -578,8 +578,11 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// gen_txs defines the genesis transactions.
-	GenTxs        [][]byte `protobuf:"bytes,1,rep,name=gen_txs,json=genTxs,proto3" json:"gen_txs,omitempty"`
-	InitBlockHash string   `protobuf:"bytes,2,opt,name=init_block_hash,json=initBlockHash,proto3" json:"init_block_hash,omitempty"`
+	GenTxs [][]byte `protobuf:"bytes,1,rep,name=gen_txs,json=genTxs,proto3" json:"gen_txs,omitempty"`
+	// init_block_hash is the execution block hash the validator set is synced
+	// at on InitChain. If empty, the Symbiotic snapshot imported in the
+	// symStaking genesis is applied instead.
+	InitBlockHash string `protobuf:"bytes,2,opt,name=init_block_hash,json=initBlockHash,proto3" json:"init_block_hash,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -32,6 +32,8 @@ const (
 	Query_TallyResult_FullMethodName         = "/cosmos.symGov.v1.Query/TallyResult"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.symGov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.symGov.v1.Query/MessageBasedParams"
+	Query_VotingPowerSnapshot_FullMethodName = "/cosmos.symGov.v1.Query/VotingPowerSnapshot"
+	Query_RestakerVotes_FullMethodName       = "/cosmos.symGov.v1.Query/RestakerVotes"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +62,10 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VotingPowerSnapshot queries the voting power snapshot a proposal is tallied against.
+	VotingPowerSnapshot(ctx context.Context, in *QueryVotingPowerSnapshotRequest, opts ...grpc.CallOption) (*QueryVotingPowerSnapshotResponse, error)
+	// RestakerVotes queries the votes cast by Ethereum restakers on a proposal.
+	RestakerVotes(ctx context.Context, in *QueryRestakerVotesRequest, opts ...grpc.CallOption) (*QueryRestakerVotesResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +175,24 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VotingPowerSnapshot(ctx context.Context, in *QueryVotingPowerSnapshotRequest, opts ...grpc.CallOption) (*QueryVotingPowerSnapshotResponse, error) {
+	out := new(QueryVotingPowerSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_VotingPowerSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RestakerVotes(ctx context.Context, in *QueryRestakerVotesRequest, opts ...grpc.CallOption) (*QueryRestakerVotesResponse, error) {
+	out := new(QueryRestakerVotesResponse)
+	err := c.cc.Invoke(ctx, Query_RestakerVotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +219,10 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VotingPowerSnapshot queries the voting power snapshot a proposal is tallied against.
+	VotingPowerSnapshot(context.Context, *QueryVotingPowerSnapshotRequest) (*QueryVotingPowerSnapshotResponse, error)
+	// RestakerVotes queries the votes cast by Ethereum restakers on a proposal.
+	RestakerVotes(context.Context, *QueryRestakerVotesRequest) (*QueryRestakerVotesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +263,12 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) VotingPowerSnapshot(context.Context, *QueryVotingPowerSnapshotRequest) (*QueryVotingPowerSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerSnapshot not implemented")
+}
+func (UnimplementedQueryServer) RestakerVotes(context.Context, *QueryRestakerVotesRequest) (*QueryRestakerVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakerVotes not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +480,42 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPowerSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingPowerSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerSnapshot(ctx, req.(*QueryVotingPowerSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RestakerVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestakerVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RestakerVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RestakerVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestakerVotes(ctx, req.(*QueryRestakerVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +567,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VotingPowerSnapshot",
+			Handler:    _Query_VotingPowerSnapshot_Handler,
+		},
+		{
+			MethodName: "RestakerVotes",
+			Handler:    _Query_RestakerVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symGov/v1/query.proto",
@@ -31,6 +31,7 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.symGov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.symGov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.symGov.v1.Msg/SudoExec"
+	Msg_VoteRestaker_FullMethodName                 = "/cosmos.symGov.v1.Msg/VoteRestaker"
 )
 
 // MsgClient is the client API for Msg service.
@@ -60,6 +61,9 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// VoteRestaker defines a method to relay the EIP-712 signed vote of an Ethereum restaker on a
+	// specific proposal.
+	VoteRestaker(ctx context.Context, in *MsgVoteRestaker, opts ...grpc.CallOption) (*MsgVoteRestakerResponse, error)
 }
 
 type msgClient struct {
@@ -160,6 +164,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) VoteRestaker(ctx context.Context, in *MsgVoteRestaker, opts ...grpc.CallOption) (*MsgVoteRestakerResponse, error) {
+	out := new(MsgVoteRestakerResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteRestaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -187,6 +200,9 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// VoteRestaker defines a method to relay the EIP-712 signed vote of an Ethereum restaker on a
+	// specific proposal.
+	VoteRestaker(context.Context, *MsgVoteRestaker) (*MsgVoteRestakerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -224,6 +240,9 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) VoteRestaker(context.Context, *MsgVoteRestaker) (*MsgVoteRestakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRestaker not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -417,6 +436,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteRestaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteRestaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteRestaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteRestaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteRestaker(ctx, req.(*MsgVoteRestaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +501,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "VoteRestaker",
+			Handler:    _Msg_VoteRestaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symGov/v1/tx.proto",
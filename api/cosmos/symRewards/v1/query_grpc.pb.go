@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/symRewards/v1/query.proto
+
+package symRewardsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName             = "/cosmos.symRewards.v1.Query/Params"
+	Query_OperatorRewards_FullMethodName    = "/cosmos.symRewards.v1.Query/OperatorRewards"
+	Query_AllOperatorRewards_FullMethodName = "/cosmos.symRewards.v1.Query/AllOperatorRewards"
+	Query_ClaimBatch_FullMethodName         = "/cosmos.symRewards.v1.Query/ClaimBatch"
+	Query_ClaimBatches_FullMethodName       = "/cosmos.symRewards.v1.Query/ClaimBatches"
+	Query_ClaimProof_FullMethodName         = "/cosmos.symRewards.v1.Query/ClaimProof"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the rewards module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// OperatorRewards queries the rewards accrued by a validator.
+	OperatorRewards(ctx context.Context, in *QueryOperatorRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorRewardsResponse, error)
+	// AllOperatorRewards queries the rewards accrued by all the validators.
+	AllOperatorRewards(ctx context.Context, in *QueryAllOperatorRewardsRequest, opts ...grpc.CallOption) (*QueryAllOperatorRewardsResponse, error)
+	// ClaimBatch queries a batch of Ethereum reward claims by id.
+	ClaimBatch(ctx context.Context, in *QueryClaimBatchRequest, opts ...grpc.CallOption) (*QueryClaimBatchResponse, error)
+	// ClaimBatches queries the batches of Ethereum reward claims, optionally only
+	// the pending ones.
+	ClaimBatches(ctx context.Context, in *QueryClaimBatchesRequest, opts ...grpc.CallOption) (*QueryClaimBatchesResponse, error)
+	// ClaimProof queries the merkle proof of a claim of a batch.
+	ClaimProof(ctx context.Context, in *QueryClaimProofRequest, opts ...grpc.CallOption) (*QueryClaimProofResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorRewards(ctx context.Context, in *QueryOperatorRewardsRequest, opts ...grpc.CallOption) (*QueryOperatorRewardsResponse, error) {
+	out := new(QueryOperatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllOperatorRewards(ctx context.Context, in *QueryAllOperatorRewardsRequest, opts ...grpc.CallOption) (*QueryAllOperatorRewardsResponse, error) {
+	out := new(QueryAllOperatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_AllOperatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimBatch(ctx context.Context, in *QueryClaimBatchRequest, opts ...grpc.CallOption) (*QueryClaimBatchResponse, error) {
+	out := new(QueryClaimBatchResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimBatches(ctx context.Context, in *QueryClaimBatchesRequest, opts ...grpc.CallOption) (*QueryClaimBatchesResponse, error) {
+	out := new(QueryClaimBatchesResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimBatches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimProof(ctx context.Context, in *QueryClaimProofRequest, opts ...grpc.CallOption) (*QueryClaimProofResponse, error) {
+	out := new(QueryClaimProofResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params queries the parameters of the rewards module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// OperatorRewards queries the rewards accrued by a validator.
+	OperatorRewards(context.Context, *QueryOperatorRewardsRequest) (*QueryOperatorRewardsResponse, error)
+	// AllOperatorRewards queries the rewards accrued by all the validators.
+	AllOperatorRewards(context.Context, *QueryAllOperatorRewardsRequest) (*QueryAllOperatorRewardsResponse, error)
+	// ClaimBatch queries a batch of Ethereum reward claims by id.
+	ClaimBatch(context.Context, *QueryClaimBatchRequest) (*QueryClaimBatchResponse, error)
+	// ClaimBatches queries the batches of Ethereum reward claims, optionally only
+	// the pending ones.
+	ClaimBatches(context.Context, *QueryClaimBatchesRequest) (*QueryClaimBatchesResponse, error)
+	// ClaimProof queries the merkle proof of a claim of a batch.
+	ClaimProof(context.Context, *QueryClaimProofRequest) (*QueryClaimProofResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) OperatorRewards(context.Context, *QueryOperatorRewardsRequest) (*QueryOperatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorRewards not implemented")
+}
+func (UnimplementedQueryServer) AllOperatorRewards(context.Context, *QueryAllOperatorRewardsRequest) (*QueryAllOperatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllOperatorRewards not implemented")
+}
+func (UnimplementedQueryServer) ClaimBatch(context.Context, *QueryClaimBatchRequest) (*QueryClaimBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBatch not implemented")
+}
+func (UnimplementedQueryServer) ClaimBatches(context.Context, *QueryClaimBatchesRequest) (*QueryClaimBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBatches not implemented")
+}
+func (UnimplementedQueryServer) ClaimProof(context.Context, *QueryClaimProofRequest) (*QueryClaimProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimProof not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorRewards(ctx, req.(*QueryOperatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllOperatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOperatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllOperatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllOperatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllOperatorRewards(ctx, req.(*QueryAllOperatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimBatch(ctx, req.(*QueryClaimBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimBatches(ctx, req.(*QueryClaimBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimProof(ctx, req.(*QueryClaimProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symRewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OperatorRewards",
+			Handler:    _Query_OperatorRewards_Handler,
+		},
+		{
+			MethodName: "AllOperatorRewards",
+			Handler:    _Query_AllOperatorRewards_Handler,
+		},
+		{
+			MethodName: "ClaimBatch",
+			Handler:    _Query_ClaimBatch_Handler,
+		},
+		{
+			MethodName: "ClaimBatches",
+			Handler:    _Query_ClaimBatches_Handler,
+		},
+		{
+			MethodName: "ClaimProof",
+			Handler:    _Query_ClaimProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symRewards/v1/query.proto",
+}
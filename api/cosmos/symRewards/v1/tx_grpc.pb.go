@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/symRewards/v1/tx.proto
+
+package symRewardsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_WithdrawOperatorRewards_FullMethodName = "/cosmos.symRewards.v1.Msg/WithdrawOperatorRewards"
+	Msg_ConfirmClaimBatch_FullMethodName       = "/cosmos.symRewards.v1.Msg/ConfirmClaimBatch"
+	Msg_UpdateParams_FullMethodName            = "/cosmos.symRewards.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// WithdrawOperatorRewards withdraws the commission of a validator to a
+	// Cosmos address. The staker rewards are only claimed on Ethereum.
+	WithdrawOperatorRewards(ctx context.Context, in *MsgWithdrawOperatorRewards, opts ...grpc.CallOption) (*MsgWithdrawOperatorRewardsResponse, error)
+	// ConfirmClaimBatch marks a claim batch posted with the proof that its root
+	// was submitted to the rewards contract.
+	ConfirmClaimBatch(ctx context.Context, in *MsgConfirmClaimBatch, opts ...grpc.CallOption) (*MsgConfirmClaimBatchResponse, error)
+	// UpdateParams defines a governance operation for updating the x/symRewards
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WithdrawOperatorRewards(ctx context.Context, in *MsgWithdrawOperatorRewards, opts ...grpc.CallOption) (*MsgWithdrawOperatorRewardsResponse, error) {
+	out := new(MsgWithdrawOperatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawOperatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmClaimBatch(ctx context.Context, in *MsgConfirmClaimBatch, opts ...grpc.CallOption) (*MsgConfirmClaimBatchResponse, error) {
+	out := new(MsgConfirmClaimBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfirmClaimBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// WithdrawOperatorRewards withdraws the commission of a validator to a
+	// Cosmos address. The staker rewards are only claimed on Ethereum.
+	WithdrawOperatorRewards(context.Context, *MsgWithdrawOperatorRewards) (*MsgWithdrawOperatorRewardsResponse, error)
+	// ConfirmClaimBatch marks a claim batch posted with the proof that its root
+	// was submitted to the rewards contract.
+	ConfirmClaimBatch(context.Context, *MsgConfirmClaimBatch) (*MsgConfirmClaimBatchResponse, error)
+	// UpdateParams defines a governance operation for updating the x/symRewards
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) WithdrawOperatorRewards(context.Context, *MsgWithdrawOperatorRewards) (*MsgWithdrawOperatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOperatorRewards not implemented")
+}
+func (UnimplementedMsgServer) ConfirmClaimBatch(context.Context, *MsgConfirmClaimBatch) (*MsgConfirmClaimBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmClaimBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_WithdrawOperatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawOperatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawOperatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawOperatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawOperatorRewards(ctx, req.(*MsgWithdrawOperatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmClaimBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmClaimBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmClaimBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfirmClaimBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmClaimBatch(ctx, req.(*MsgConfirmClaimBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symRewards.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WithdrawOperatorRewards",
+			Handler:    _Msg_WithdrawOperatorRewards_Handler,
+		},
+		{
+			MethodName: "ConfirmClaimBatch",
+			Handler:    _Msg_ConfirmClaimBatch_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symRewards/v1/tx.proto",
+}
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.symSlash.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.symSlash.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName              = "/cosmos.symSlash.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.symSlash.v1beta1.Msg/UpdateParams"
+	Msg_SignSlashRequest_FullMethodName    = "/cosmos.symSlash.v1beta1.Msg/SignSlashRequest"
+	Msg_FulfillSlashRequest_FullMethodName = "/cosmos.symSlash.v1beta1.Msg/FulfillSlashRequest"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +36,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SignSlashRequest adds the signature of the operator of a validator to a
+	// slash request.
+	SignSlashRequest(ctx context.Context, in *MsgSignSlashRequest, opts ...grpc.CallOption) (*MsgSignSlashRequestResponse, error)
+	// FulfillSlashRequest marks a signed slash request fulfilled with the proof
+	// that its slash was executed on Ethereum.
+	FulfillSlashRequest(ctx context.Context, in *MsgFulfillSlashRequest, opts ...grpc.CallOption) (*MsgFulfillSlashRequestResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +70,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SignSlashRequest(ctx context.Context, in *MsgSignSlashRequest, opts ...grpc.CallOption) (*MsgSignSlashRequestResponse, error) {
+	out := new(MsgSignSlashRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_SignSlashRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillSlashRequest(ctx context.Context, in *MsgFulfillSlashRequest, opts ...grpc.CallOption) (*MsgFulfillSlashRequestResponse, error) {
+	out := new(MsgFulfillSlashRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_FulfillSlashRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +99,12 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SignSlashRequest adds the signature of the operator of a validator to a
+	// slash request.
+	SignSlashRequest(context.Context, *MsgSignSlashRequest) (*MsgSignSlashRequestResponse, error)
+	// FulfillSlashRequest marks a signed slash request fulfilled with the proof
+	// that its slash was executed on Ethereum.
+	FulfillSlashRequest(context.Context, *MsgFulfillSlashRequest) (*MsgFulfillSlashRequestResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +118,12 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SignSlashRequest(context.Context, *MsgSignSlashRequest) (*MsgSignSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSlashRequest not implemented")
+}
+func (UnimplementedMsgServer) FulfillSlashRequest(context.Context, *MsgFulfillSlashRequest) (*MsgFulfillSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillSlashRequest not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +173,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SignSlashRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignSlashRequest(ctx, req.(*MsgSignSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FulfillSlashRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillSlashRequest(ctx, req.(*MsgFulfillSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +224,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SignSlashRequest",
+			Handler:    _Msg_SignSlashRequest_Handler,
+		},
+		{
+			MethodName: "FulfillSlashRequest",
+			Handler:    _Msg_FulfillSlashRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/tx.proto",
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName     = "/cosmos.symStaking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName      = "/cosmos.symStaking.v1beta1.Query/Validator"
-	Query_HistoricalInfo_FullMethodName = "/cosmos.symStaking.v1beta1.Query/HistoricalInfo"
-	Query_Params_FullMethodName         = "/cosmos.symStaking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                   = "/cosmos.symStaking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                    = "/cosmos.symStaking.v1beta1.Query/Validator"
+	Query_HistoricalInfo_FullMethodName               = "/cosmos.symStaking.v1beta1.Query/HistoricalInfo"
+	Query_Params_FullMethodName                       = "/cosmos.symStaking.v1beta1.Query/Params"
+	Query_SymbioticSyncStatus_FullMethodName          = "/cosmos.symStaking.v1beta1.Query/SymbioticSyncStatus"
+	Query_SymbioticSyncHistory_FullMethodName         = "/cosmos.symStaking.v1beta1.Query/SymbioticSyncHistory"
+	Query_SymbioticPendingPowerChanges_FullMethodName = "/cosmos.symStaking.v1beta1.Query/SymbioticPendingPowerChanges"
+	Query_OperatorBinding_FullMethodName              = "/cosmos.symStaking.v1beta1.Query/OperatorBinding"
+	Query_ConsPubKeyRotations_FullMethodName          = "/cosmos.symStaking.v1beta1.Query/ConsPubKeyRotations"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +45,19 @@ type QueryClient interface {
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SymbioticSyncStatus queries the last Symbiotic sync.
+	SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the persisted Symbiotic sync records, by
+	// ascending height.
+	SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error)
+	// SymbioticPendingPowerChanges queries the validator stakes not fully
+	// applied yet because of the max_power_change_rate param.
+	SymbioticPendingPowerChanges(ctx context.Context, in *QuerySymbioticPendingPowerChangesRequest, opts ...grpc.CallOption) (*QuerySymbioticPendingPowerChangesResponse, error)
+	// OperatorBinding queries the Symbiotic operator a validator is bound to.
+	OperatorBinding(ctx context.Context, in *QueryOperatorBindingRequest, opts ...grpc.CallOption) (*QueryOperatorBindingResponse, error)
+	// ConsPubKeyRotations queries the pending and applied consensus key
+	// rotations of a validator.
+	ConsPubKeyRotations(ctx context.Context, in *QueryConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationsResponse, error)
 }
 
 type queryClient struct {
@@ -86,6 +104,51 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SymbioticSyncStatus(ctx context.Context, in *QuerySymbioticSyncStatusRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncStatusResponse, error) {
+	out := new(QuerySymbioticSyncStatusResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticSyncStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticSyncHistory(ctx context.Context, in *QuerySymbioticSyncHistoryRequest, opts ...grpc.CallOption) (*QuerySymbioticSyncHistoryResponse, error) {
+	out := new(QuerySymbioticSyncHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticSyncHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SymbioticPendingPowerChanges(ctx context.Context, in *QuerySymbioticPendingPowerChangesRequest, opts ...grpc.CallOption) (*QuerySymbioticPendingPowerChangesResponse, error) {
+	out := new(QuerySymbioticPendingPowerChangesResponse)
+	err := c.cc.Invoke(ctx, Query_SymbioticPendingPowerChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorBinding(ctx context.Context, in *QueryOperatorBindingRequest, opts ...grpc.CallOption) (*QueryOperatorBindingResponse, error) {
+	out := new(QueryOperatorBindingResponse)
+	err := c.cc.Invoke(ctx, Query_OperatorBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsPubKeyRotations(ctx context.Context, in *QueryConsPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationsResponse, error) {
+	out := new(QueryConsPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_ConsPubKeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -101,6 +164,19 @@ type QueryServer interface {
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SymbioticSyncStatus queries the last Symbiotic sync.
+	SymbioticSyncStatus(context.Context, *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error)
+	// SymbioticSyncHistory queries the persisted Symbiotic sync records, by
+	// ascending height.
+	SymbioticSyncHistory(context.Context, *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error)
+	// SymbioticPendingPowerChanges queries the validator stakes not fully
+	// applied yet because of the max_power_change_rate param.
+	SymbioticPendingPowerChanges(context.Context, *QuerySymbioticPendingPowerChangesRequest) (*QuerySymbioticPendingPowerChangesResponse, error)
+	// OperatorBinding queries the Symbiotic operator a validator is bound to.
+	OperatorBinding(context.Context, *QueryOperatorBindingRequest) (*QueryOperatorBindingResponse, error)
+	// ConsPubKeyRotations queries the pending and applied consensus key
+	// rotations of a validator.
+	ConsPubKeyRotations(context.Context, *QueryConsPubKeyRotationsRequest) (*QueryConsPubKeyRotationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -120,6 +196,21 @@ func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistorical
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) SymbioticSyncStatus(context.Context, *QuerySymbioticSyncStatusRequest) (*QuerySymbioticSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncStatus not implemented")
+}
+func (UnimplementedQueryServer) SymbioticSyncHistory(context.Context, *QuerySymbioticSyncHistoryRequest) (*QuerySymbioticSyncHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticSyncHistory not implemented")
+}
+func (UnimplementedQueryServer) SymbioticPendingPowerChanges(context.Context, *QuerySymbioticPendingPowerChangesRequest) (*QuerySymbioticPendingPowerChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbioticPendingPowerChanges not implemented")
+}
+func (UnimplementedQueryServer) OperatorBinding(context.Context, *QueryOperatorBindingRequest) (*QueryOperatorBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorBinding not implemented")
+}
+func (UnimplementedQueryServer) ConsPubKeyRotations(context.Context, *QueryConsPubKeyRotationsRequest) (*QueryConsPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsPubKeyRotations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -205,6 +296,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SymbioticSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncStatus(ctx, req.(*QuerySymbioticSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticSyncHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticSyncHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticSyncHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticSyncHistory(ctx, req.(*QuerySymbioticSyncHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SymbioticPendingPowerChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbioticPendingPowerChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbioticPendingPowerChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SymbioticPendingPowerChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbioticPendingPowerChanges(ctx, req.(*QuerySymbioticPendingPowerChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OperatorBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorBinding(ctx, req.(*QueryOperatorBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsPubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsPubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConsPubKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsPubKeyRotations(ctx, req.(*QueryConsPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +409,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SymbioticSyncStatus",
+			Handler:    _Query_SymbioticSyncStatus_Handler,
+		},
+		{
+			MethodName: "SymbioticSyncHistory",
+			Handler:    _Query_SymbioticSyncHistory_Handler,
+		},
+		{
+			MethodName: "SymbioticPendingPowerChanges",
+			Handler:    _Query_SymbioticPendingPowerChanges_Handler,
+		},
+		{
+			MethodName: "OperatorBinding",
+			Handler:    _Query_OperatorBinding_Handler,
+		},
+		{
+			MethodName: "ConsPubKeyRotations",
+			Handler:    _Query_ConsPubKeyRotations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symStaking/v1beta1/query.proto",
@@ -4510,35 +4510,33 @@ func (x *fastReflection_ValAddresses) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_unbonding_time      protoreflect.FieldDescriptor
-	fd_Params_max_validators      protoreflect.FieldDescriptor
-	fd_Params_max_entries         protoreflect.FieldDescriptor
-	fd_Params_historical_entries  protoreflect.FieldDescriptor
-	fd_Params_bond_denom          protoreflect.FieldDescriptor
-	fd_Params_min_commission_rate protoreflect.FieldDescriptor
+	md_ConsPubKeyRotation                   protoreflect.MessageDescriptor
+	fd_ConsPubKeyRotation_validator_address protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_old_cons_pubkey   protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_new_cons_pubkey   protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_height            protoreflect.FieldDescriptor
+	fd_ConsPubKeyRotation_rotated_height    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_symStaking_v1beta1_staking_proto_init()
-	md_Params = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("Params")
-	fd_Params_unbonding_time = md_Params.Fields().ByName("unbonding_time")
-	fd_Params_max_validators = md_Params.Fields().ByName("max_validators")
-	fd_Params_max_entries = md_Params.Fields().ByName("max_entries")
-	fd_Params_historical_entries = md_Params.Fields().ByName("historical_entries")
-	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
-	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	md_ConsPubKeyRotation = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("ConsPubKeyRotation")
+	fd_ConsPubKeyRotation_validator_address = md_ConsPubKeyRotation.Fields().ByName("validator_address")
+	fd_ConsPubKeyRotation_old_cons_pubkey = md_ConsPubKeyRotation.Fields().ByName("old_cons_pubkey")
+	fd_ConsPubKeyRotation_new_cons_pubkey = md_ConsPubKeyRotation.Fields().ByName("new_cons_pubkey")
+	fd_ConsPubKeyRotation_height = md_ConsPubKeyRotation.Fields().ByName("height")
+	fd_ConsPubKeyRotation_rotated_height = md_ConsPubKeyRotation.Fields().ByName("rotated_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_ConsPubKeyRotation)(nil)
 
-type fastReflection_Params Params
+type fastReflection_ConsPubKeyRotation ConsPubKeyRotation
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *ConsPubKeyRotation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotation)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *ConsPubKeyRotation) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4550,43 +4548,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_ConsPubKeyRotation_messageType fastReflection_ConsPubKeyRotation_messageType
+var _ protoreflect.MessageType = fastReflection_ConsPubKeyRotation_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_ConsPubKeyRotation_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_ConsPubKeyRotation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConsPubKeyRotation)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_ConsPubKeyRotation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotation)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_ConsPubKeyRotation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotation
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_ConsPubKeyRotation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConsPubKeyRotation
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_ConsPubKeyRotation) Type() protoreflect.MessageType {
+	return _fastReflection_ConsPubKeyRotation_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_ConsPubKeyRotation) New() protoreflect.Message {
+	return new(fastReflection_ConsPubKeyRotation)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_ConsPubKeyRotation) Interface() protoreflect.ProtoMessage {
+	return (*ConsPubKeyRotation)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4594,40 +4592,34 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.UnbondingTime != nil {
-		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-		if !f(fd_Params_unbonding_time, value) {
-			return
-		}
-	}
-	if x.MaxValidators != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxValidators)
-		if !f(fd_Params_max_validators, value) {
+func (x *fastReflection_ConsPubKeyRotation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_ConsPubKeyRotation_validator_address, value) {
 			return
 		}
 	}
-	if x.MaxEntries != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.MaxEntries)
-		if !f(fd_Params_max_entries, value) {
+	if x.OldConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotation_old_cons_pubkey, value) {
 			return
 		}
 	}
-	if x.HistoricalEntries != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.HistoricalEntries)
-		if !f(fd_Params_historical_entries, value) {
+	if x.NewConsPubkey != nil {
+		value := protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+		if !f(fd_ConsPubKeyRotation_new_cons_pubkey, value) {
 			return
 		}
 	}
-	if x.BondDenom != "" {
-		value := protoreflect.ValueOfString(x.BondDenom)
-		if !f(fd_Params_bond_denom, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ConsPubKeyRotation_height, value) {
 			return
 		}
 	}
-	if x.MinCommissionRate != "" {
-		value := protoreflect.ValueOfString(x.MinCommissionRate)
-		if !f(fd_Params_min_commission_rate, value) {
+	if x.RotatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RotatedHeight)
+		if !f(fd_ConsPubKeyRotation_rotated_height, value) {
 			return
 		}
 	}
@@ -4644,25 +4636,23 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ConsPubKeyRotation) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		return x.UnbondingTime != nil
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		return x.MaxValidators != uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		return x.MaxEntries != uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		return x.HistoricalEntries != uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		return x.BondDenom != ""
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
-		return x.MinCommissionRate != ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		return x.OldConsPubkey != nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		return x.NewConsPubkey != nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		return x.Height != int64(0)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		return x.RotatedHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4672,25 +4662,23 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ConsPubKeyRotation) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		x.UnbondingTime = nil
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		x.MaxValidators = uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		x.MaxEntries = uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		x.HistoricalEntries = uint32(0)
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		x.BondDenom = ""
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
-		x.MinCommissionRate = ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		x.OldConsPubkey = nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		x.NewConsPubkey = nil
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		x.Height = int64(0)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		x.RotatedHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4700,31 +4688,28 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		value := x.UnbondingTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		value := x.MaxValidators
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		value := x.MaxEntries
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		value := x.HistoricalEntries
-		return protoreflect.ValueOfUint32(value)
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		value := x.BondDenom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
-		value := x.MinCommissionRate
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		value := x.OldConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		value := x.NewConsPubkey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		value := x.RotatedHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4738,25 +4723,23 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ConsPubKeyRotation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		x.UnbondingTime = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		x.MaxValidators = uint32(value.Uint())
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		x.MaxEntries = uint32(value.Uint())
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		x.HistoricalEntries = uint32(value.Uint())
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		x.BondDenom = value.Interface().(string)
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
-		x.MinCommissionRate = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		x.OldConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		x.NewConsPubkey = value.Message().Interface().(*anypb.Any)
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		x.Height = value.Int()
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		x.RotatedHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4770,64 +4753,64 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		if x.UnbondingTime == nil {
-			x.UnbondingTime = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		panic(fmt.Errorf("field max_validators of message cosmos.symStaking.v1beta1.Params is not mutable"))
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		panic(fmt.Errorf("field max_entries of message cosmos.symStaking.v1beta1.Params is not mutable"))
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		panic(fmt.Errorf("field historical_entries of message cosmos.symStaking.v1beta1.Params is not mutable"))
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		panic(fmt.Errorf("field bond_denom of message cosmos.symStaking.v1beta1.Params is not mutable"))
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
-		panic(fmt.Errorf("field min_commission_rate of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		if x.OldConsPubkey == nil {
+			x.OldConsPubkey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.OldConsPubkey.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		if x.NewConsPubkey == nil {
+			x.NewConsPubkey = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.NewConsPubkey.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.symStaking.v1beta1.ConsPubKeyRotation is not mutable"))
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		panic(fmt.Errorf("field height of message cosmos.symStaking.v1beta1.ConsPubKeyRotation is not mutable"))
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		panic(fmt.Errorf("field rotated_height of message cosmos.symStaking.v1beta1.ConsPubKeyRotation is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConsPubKeyRotation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.symStaking.v1beta1.Params.max_validators":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.symStaking.v1beta1.Params.max_entries":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.symStaking.v1beta1.Params.historical_entries":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "cosmos.symStaking.v1beta1.Params.bond_denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.validator_address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.ConsPubKeyRotation.rotated_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.ConsPubKeyRotation"))
 		}
-		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.ConsPubKeyRotation does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ConsPubKeyRotation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.ConsPubKeyRotation", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4835,7 +4818,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ConsPubKeyRotation) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4846,7 +4829,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ConsPubKeyRotation) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4858,7 +4841,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_ConsPubKeyRotation) IsValid() bool {
 	return x != nil
 }
 
@@ -4868,9 +4851,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ConsPubKeyRotation) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ConsPubKeyRotation)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4882,27 +4865,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.UnbondingTime != nil {
-			l = options.Size(x.UnbondingTime)
+		l = len(x.ValidatorAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MaxValidators != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxValidators))
-		}
-		if x.MaxEntries != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxEntries))
-		}
-		if x.HistoricalEntries != 0 {
-			n += 1 + runtime.Sov(uint64(x.HistoricalEntries))
-		}
-		l = len(x.BondDenom)
-		if l > 0 {
+		if x.OldConsPubkey != nil {
+			l = options.Size(x.OldConsPubkey)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinCommissionRate)
-		if l > 0 {
+		if x.NewConsPubkey != nil {
+			l = options.Size(x.NewConsPubkey)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.RotatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RotatedHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4913,7 +4893,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ConsPubKeyRotation)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4932,37 +4912,32 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinCommissionRate) > 0 {
-			i -= len(x.MinCommissionRate)
-			copy(dAtA[i:], x.MinCommissionRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCommissionRate)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.BondDenom) > 0 {
-			i -= len(x.BondDenom)
-			copy(dAtA[i:], x.BondDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
+		if x.RotatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RotatedHeight))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x28
 		}
-		if x.HistoricalEntries != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalEntries))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.MaxEntries != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEntries))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.MaxValidators != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxValidators))
+		if x.NewConsPubkey != nil {
+			encoded, err := options.Marshal(x.NewConsPubkey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1a
 		}
-		if x.UnbondingTime != nil {
-			encoded, err := options.Marshal(x.UnbondingTime)
+		if x.OldConsPubkey != nil {
+			encoded, err := options.Marshal(x.OldConsPubkey)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4973,6 +4948,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -4986,7 +4968,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ConsPubKeyRotation)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5018,17 +5000,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5038,33 +5020,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.UnbondingTime == nil {
-					x.UnbondingTime = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 				}
-				x.MaxValidators = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5074,18 +5052,2150 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxValidators |= uint32(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.MaxEntries = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OldConsPubkey == nil {
+					x.OldConsPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OldConsPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NewConsPubkey == nil {
+					x.NewConsPubkey = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NewConsPubkey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RotatedHeight", wireType)
+				}
+				x.RotatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RotatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_unbonding_time                 protoreflect.FieldDescriptor
+	fd_Params_max_validators                 protoreflect.FieldDescriptor
+	fd_Params_max_entries                    protoreflect.FieldDescriptor
+	fd_Params_historical_entries             protoreflect.FieldDescriptor
+	fd_Params_bond_denom                     protoreflect.FieldDescriptor
+	fd_Params_min_commission_rate            protoreflect.FieldDescriptor
+	fd_Params_middleware_address             protoreflect.FieldDescriptor
+	fd_Params_symbiotic_sync_period          protoreflect.FieldDescriptor
+	fd_Params_slots_in_epoch                 protoreflect.FieldDescriptor
+	fd_Params_beacon_genesis_timestamp       protoreflect.FieldDescriptor
+	fd_Params_slot_duration                  protoreflect.FieldDescriptor
+	fd_Params_middleware_abi                 protoreflect.FieldDescriptor
+	fd_Params_auto_register_validators       protoreflect.FieldDescriptor
+	fd_Params_symbiotic_sync_history_entries protoreflect.FieldDescriptor
+	fd_Params_max_stake_staleness            protoreflect.FieldDescriptor
+	fd_Params_stale_stake_action             protoreflect.FieldDescriptor
+	fd_Params_ethereum_network               protoreflect.FieldDescriptor
+	fd_Params_max_power_change_rate          protoreflect.FieldDescriptor
+	fd_Params_require_operator_binding       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_Params = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("Params")
+	fd_Params_unbonding_time = md_Params.Fields().ByName("unbonding_time")
+	fd_Params_max_validators = md_Params.Fields().ByName("max_validators")
+	fd_Params_max_entries = md_Params.Fields().ByName("max_entries")
+	fd_Params_historical_entries = md_Params.Fields().ByName("historical_entries")
+	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
+	fd_Params_min_commission_rate = md_Params.Fields().ByName("min_commission_rate")
+	fd_Params_middleware_address = md_Params.Fields().ByName("middleware_address")
+	fd_Params_symbiotic_sync_period = md_Params.Fields().ByName("symbiotic_sync_period")
+	fd_Params_slots_in_epoch = md_Params.Fields().ByName("slots_in_epoch")
+	fd_Params_beacon_genesis_timestamp = md_Params.Fields().ByName("beacon_genesis_timestamp")
+	fd_Params_slot_duration = md_Params.Fields().ByName("slot_duration")
+	fd_Params_middleware_abi = md_Params.Fields().ByName("middleware_abi")
+	fd_Params_auto_register_validators = md_Params.Fields().ByName("auto_register_validators")
+	fd_Params_symbiotic_sync_history_entries = md_Params.Fields().ByName("symbiotic_sync_history_entries")
+	fd_Params_max_stake_staleness = md_Params.Fields().ByName("max_stake_staleness")
+	fd_Params_stale_stake_action = md_Params.Fields().ByName("stale_stake_action")
+	fd_Params_ethereum_network = md_Params.Fields().ByName("ethereum_network")
+	fd_Params_max_power_change_rate = md_Params.Fields().ByName("max_power_change_rate")
+	fd_Params_require_operator_binding = md_Params.Fields().ByName("require_operator_binding")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UnbondingTime != nil {
+		value := protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+		if !f(fd_Params_unbonding_time, value) {
+			return
+		}
+	}
+	if x.MaxValidators != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxValidators)
+		if !f(fd_Params_max_validators, value) {
+			return
+		}
+	}
+	if x.MaxEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxEntries)
+		if !f(fd_Params_max_entries, value) {
+			return
+		}
+	}
+	if x.HistoricalEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.HistoricalEntries)
+		if !f(fd_Params_historical_entries, value) {
+			return
+		}
+	}
+	if x.BondDenom != "" {
+		value := protoreflect.ValueOfString(x.BondDenom)
+		if !f(fd_Params_bond_denom, value) {
+			return
+		}
+	}
+	if x.MinCommissionRate != "" {
+		value := protoreflect.ValueOfString(x.MinCommissionRate)
+		if !f(fd_Params_min_commission_rate, value) {
+			return
+		}
+	}
+	if x.MiddlewareAddress != "" {
+		value := protoreflect.ValueOfString(x.MiddlewareAddress)
+		if !f(fd_Params_middleware_address, value) {
+			return
+		}
+	}
+	if x.SymbioticSyncPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SymbioticSyncPeriod)
+		if !f(fd_Params_symbiotic_sync_period, value) {
+			return
+		}
+	}
+	if x.SlotsInEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlotsInEpoch)
+		if !f(fd_Params_slots_in_epoch, value) {
+			return
+		}
+	}
+	if x.BeaconGenesisTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BeaconGenesisTimestamp)
+		if !f(fd_Params_beacon_genesis_timestamp, value) {
+			return
+		}
+	}
+	if x.SlotDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlotDuration)
+		if !f(fd_Params_slot_duration, value) {
+			return
+		}
+	}
+	if x.MiddlewareAbi != nil {
+		value := protoreflect.ValueOfMessage(x.MiddlewareAbi.ProtoReflect())
+		if !f(fd_Params_middleware_abi, value) {
+			return
+		}
+	}
+	if x.AutoRegisterValidators != false {
+		value := protoreflect.ValueOfBool(x.AutoRegisterValidators)
+		if !f(fd_Params_auto_register_validators, value) {
+			return
+		}
+	}
+	if x.SymbioticSyncHistoryEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SymbioticSyncHistoryEntries)
+		if !f(fd_Params_symbiotic_sync_history_entries, value) {
+			return
+		}
+	}
+	if x.MaxStakeStaleness != nil {
+		value := protoreflect.ValueOfMessage(x.MaxStakeStaleness.ProtoReflect())
+		if !f(fd_Params_max_stake_staleness, value) {
+			return
+		}
+	}
+	if x.StaleStakeAction != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.StaleStakeAction))
+		if !f(fd_Params_stale_stake_action, value) {
+			return
+		}
+	}
+	if x.EthereumNetwork != "" {
+		value := protoreflect.ValueOfString(x.EthereumNetwork)
+		if !f(fd_Params_ethereum_network, value) {
+			return
+		}
+	}
+	if x.MaxPowerChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxPowerChangeRate)
+		if !f(fd_Params_max_power_change_rate, value) {
+			return
+		}
+	}
+	if x.RequireOperatorBinding != false {
+		value := protoreflect.ValueOfBool(x.RequireOperatorBinding)
+		if !f(fd_Params_require_operator_binding, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		return x.UnbondingTime != nil
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		return x.MaxValidators != uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		return x.MaxEntries != uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		return x.HistoricalEntries != uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		return x.BondDenom != ""
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		return x.MinCommissionRate != ""
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		return x.MiddlewareAddress != ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		return x.SymbioticSyncPeriod != int64(0)
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		return x.SlotsInEpoch != int64(0)
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		return x.BeaconGenesisTimestamp != int64(0)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		return x.SlotDuration != int64(0)
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		return x.MiddlewareAbi != nil
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		return x.AutoRegisterValidators != false
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		return x.SymbioticSyncHistoryEntries != uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		return x.MaxStakeStaleness != nil
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		return x.StaleStakeAction != 0
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		return x.EthereumNetwork != ""
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		return x.MaxPowerChangeRate != ""
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		return x.RequireOperatorBinding != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		x.UnbondingTime = nil
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		x.MaxValidators = uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		x.MaxEntries = uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		x.HistoricalEntries = uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		x.BondDenom = ""
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		x.MinCommissionRate = ""
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		x.MiddlewareAddress = ""
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		x.SymbioticSyncPeriod = int64(0)
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		x.SlotsInEpoch = int64(0)
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		x.BeaconGenesisTimestamp = int64(0)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		x.SlotDuration = int64(0)
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		x.MiddlewareAbi = nil
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		x.AutoRegisterValidators = false
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		x.SymbioticSyncHistoryEntries = uint32(0)
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		x.MaxStakeStaleness = nil
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		x.StaleStakeAction = 0
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		x.EthereumNetwork = ""
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		x.MaxPowerChangeRate = ""
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		x.RequireOperatorBinding = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		value := x.UnbondingTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		value := x.MaxValidators
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		value := x.MaxEntries
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		value := x.HistoricalEntries
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		value := x.BondDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		value := x.MinCommissionRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		value := x.MiddlewareAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		value := x.SymbioticSyncPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		value := x.SlotsInEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		value := x.BeaconGenesisTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		value := x.SlotDuration
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		value := x.MiddlewareAbi
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		value := x.AutoRegisterValidators
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		value := x.SymbioticSyncHistoryEntries
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		value := x.MaxStakeStaleness
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		value := x.StaleStakeAction
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		value := x.EthereumNetwork
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		value := x.MaxPowerChangeRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		value := x.RequireOperatorBinding
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		x.UnbondingTime = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		x.MaxValidators = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		x.MaxEntries = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		x.HistoricalEntries = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		x.BondDenom = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		x.MinCommissionRate = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		x.MiddlewareAddress = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		x.SymbioticSyncPeriod = value.Int()
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		x.SlotsInEpoch = value.Int()
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		x.BeaconGenesisTimestamp = value.Int()
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		x.SlotDuration = value.Int()
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		x.MiddlewareAbi = value.Message().Interface().(*MiddlewareABI)
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		x.AutoRegisterValidators = value.Bool()
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		x.SymbioticSyncHistoryEntries = uint32(value.Uint())
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		x.MaxStakeStaleness = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		x.StaleStakeAction = (StaleStakeAction)(value.Enum())
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		x.EthereumNetwork = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		x.MaxPowerChangeRate = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		x.RequireOperatorBinding = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		if x.UnbondingTime == nil {
+			x.UnbondingTime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.UnbondingTime.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		if x.MiddlewareAbi == nil {
+			x.MiddlewareAbi = new(MiddlewareABI)
+		}
+		return protoreflect.ValueOfMessage(x.MiddlewareAbi.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		if x.MaxStakeStaleness == nil {
+			x.MaxStakeStaleness = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxStakeStaleness.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		panic(fmt.Errorf("field max_validators of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		panic(fmt.Errorf("field max_entries of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		panic(fmt.Errorf("field historical_entries of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		panic(fmt.Errorf("field bond_denom of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		panic(fmt.Errorf("field min_commission_rate of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		panic(fmt.Errorf("field middleware_address of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		panic(fmt.Errorf("field symbiotic_sync_period of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		panic(fmt.Errorf("field slots_in_epoch of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		panic(fmt.Errorf("field beacon_genesis_timestamp of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		panic(fmt.Errorf("field slot_duration of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		panic(fmt.Errorf("field auto_register_validators of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		panic(fmt.Errorf("field symbiotic_sync_history_entries of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		panic(fmt.Errorf("field stale_stake_action of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		panic(fmt.Errorf("field ethereum_network of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		panic(fmt.Errorf("field max_power_change_rate of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		panic(fmt.Errorf("field require_operator_binding of message cosmos.symStaking.v1beta1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.Params.unbonding_time":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.max_validators":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.Params.max_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.Params.historical_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.Params.bond_denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.min_commission_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.middleware_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.Params.slots_in_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.Params.beacon_genesis_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.Params.slot_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.symStaking.v1beta1.Params.middleware_abi":
+		m := new(MiddlewareABI)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.auto_register_validators":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.symStaking.v1beta1.Params.symbiotic_sync_history_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.symStaking.v1beta1.Params.max_stake_staleness":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.symStaking.v1beta1.Params.stale_stake_action":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.symStaking.v1beta1.Params.ethereum_network":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.max_power_change_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.Params.require_operator_binding":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UnbondingTime != nil {
+			l = options.Size(x.UnbondingTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxValidators))
+		}
+		if x.MaxEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEntries))
+		}
+		if x.HistoricalEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoricalEntries))
+		}
+		l = len(x.BondDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinCommissionRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MiddlewareAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SymbioticSyncPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.SymbioticSyncPeriod))
+		}
+		if x.SlotsInEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlotsInEpoch))
+		}
+		if x.BeaconGenesisTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeaconGenesisTimestamp))
+		}
+		if x.SlotDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlotDuration))
+		}
+		if x.MiddlewareAbi != nil {
+			l = options.Size(x.MiddlewareAbi)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRegisterValidators {
+			n += 2
+		}
+		if x.SymbioticSyncHistoryEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.SymbioticSyncHistoryEntries))
+		}
+		if x.MaxStakeStaleness != nil {
+			l = options.Size(x.MaxStakeStaleness)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StaleStakeAction != 0 {
+			n += 2 + runtime.Sov(uint64(x.StaleStakeAction))
+		}
+		l = len(x.EthereumNetwork)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPowerChangeRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequireOperatorBinding {
+			n += 3
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequireOperatorBinding {
+			i--
+			if x.RequireOperatorBinding {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.MaxPowerChangeRate) > 0 {
+			i -= len(x.MaxPowerChangeRate)
+			copy(dAtA[i:], x.MaxPowerChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPowerChangeRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.EthereumNetwork) > 0 {
+			i -= len(x.EthereumNetwork)
+			copy(dAtA[i:], x.EthereumNetwork)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EthereumNetwork)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.StaleStakeAction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StaleStakeAction))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxStakeStaleness != nil {
+			encoded, err := options.Marshal(x.MaxStakeStaleness)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.SymbioticSyncHistoryEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SymbioticSyncHistoryEntries))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.AutoRegisterValidators {
+			i--
+			if x.AutoRegisterValidators {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MiddlewareAbi != nil {
+			encoded, err := options.Marshal(x.MiddlewareAbi)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.SlotDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlotDuration))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.BeaconGenesisTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeaconGenesisTimestamp))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.SlotsInEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlotsInEpoch))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.SymbioticSyncPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SymbioticSyncPeriod))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.MiddlewareAddress) > 0 {
+			i -= len(x.MiddlewareAddress)
+			copy(dAtA[i:], x.MiddlewareAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MiddlewareAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MinCommissionRate) > 0 {
+			i -= len(x.MinCommissionRate)
+			copy(dAtA[i:], x.MinCommissionRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCommissionRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BondDenom) > 0 {
+			i -= len(x.BondDenom)
+			copy(dAtA[i:], x.BondDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.HistoricalEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalEntries))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEntries))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxValidators))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UnbondingTime != nil {
+			encoded, err := options.Marshal(x.UnbondingTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UnbondingTime == nil {
+					x.UnbondingTime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				}
+				x.MaxValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxValidators |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				}
+				x.MaxEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				}
+				x.HistoricalEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoricalEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MiddlewareAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncPeriod", wireType)
+				}
+				x.SymbioticSyncPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SymbioticSyncPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlotsInEpoch", wireType)
+				}
+				x.SlotsInEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlotsInEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeaconGenesisTimestamp", wireType)
+				}
+				x.BeaconGenesisTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeaconGenesisTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlotDuration", wireType)
+				}
+				x.SlotDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlotDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAbi", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MiddlewareAbi == nil {
+					x.MiddlewareAbi = &MiddlewareABI{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MiddlewareAbi); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterValidators", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRegisterValidators = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncHistoryEntries", wireType)
+				}
+				x.SymbioticSyncHistoryEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SymbioticSyncHistoryEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStakeStaleness", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxStakeStaleness == nil {
+					x.MaxStakeStaleness = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxStakeStaleness); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StaleStakeAction", wireType)
+				}
+				x.StaleStakeAction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StaleStakeAction |= StaleStakeAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EthereumNetwork", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EthereumNetwork = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPowerChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPowerChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireOperatorBinding", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireOperatorBinding = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MiddlewareABI                      protoreflect.MessageDescriptor
+	fd_MiddlewareABI_abi                  protoreflect.FieldDescriptor
+	fd_MiddlewareABI_epoch_method         protoreflect.FieldDescriptor
+	fd_MiddlewareABI_validator_set_method protoreflect.FieldDescriptor
+	fd_MiddlewareABI_stake_field          protoreflect.FieldDescriptor
+	fd_MiddlewareABI_key_field            protoreflect.FieldDescriptor
+	fd_MiddlewareABI_key_type             protoreflect.FieldDescriptor
+	fd_MiddlewareABI_operator_field       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_symStaking_v1beta1_staking_proto_init()
+	md_MiddlewareABI = File_cosmos_symStaking_v1beta1_staking_proto.Messages().ByName("MiddlewareABI")
+	fd_MiddlewareABI_abi = md_MiddlewareABI.Fields().ByName("abi")
+	fd_MiddlewareABI_epoch_method = md_MiddlewareABI.Fields().ByName("epoch_method")
+	fd_MiddlewareABI_validator_set_method = md_MiddlewareABI.Fields().ByName("validator_set_method")
+	fd_MiddlewareABI_stake_field = md_MiddlewareABI.Fields().ByName("stake_field")
+	fd_MiddlewareABI_key_field = md_MiddlewareABI.Fields().ByName("key_field")
+	fd_MiddlewareABI_key_type = md_MiddlewareABI.Fields().ByName("key_type")
+	fd_MiddlewareABI_operator_field = md_MiddlewareABI.Fields().ByName("operator_field")
+}
+
+var _ protoreflect.Message = (*fastReflection_MiddlewareABI)(nil)
+
+type fastReflection_MiddlewareABI MiddlewareABI
+
+func (x *MiddlewareABI) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MiddlewareABI)(x)
+}
+
+func (x *MiddlewareABI) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MiddlewareABI_messageType fastReflection_MiddlewareABI_messageType
+var _ protoreflect.MessageType = fastReflection_MiddlewareABI_messageType{}
+
+type fastReflection_MiddlewareABI_messageType struct{}
+
+func (x fastReflection_MiddlewareABI_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MiddlewareABI)(nil)
+}
+func (x fastReflection_MiddlewareABI_messageType) New() protoreflect.Message {
+	return new(fastReflection_MiddlewareABI)
+}
+func (x fastReflection_MiddlewareABI_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MiddlewareABI
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MiddlewareABI) Descriptor() protoreflect.MessageDescriptor {
+	return md_MiddlewareABI
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MiddlewareABI) Type() protoreflect.MessageType {
+	return _fastReflection_MiddlewareABI_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MiddlewareABI) New() protoreflect.Message {
+	return new(fastReflection_MiddlewareABI)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MiddlewareABI) Interface() protoreflect.ProtoMessage {
+	return (*MiddlewareABI)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MiddlewareABI) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Abi != "" {
+		value := protoreflect.ValueOfString(x.Abi)
+		if !f(fd_MiddlewareABI_abi, value) {
+			return
+		}
+	}
+	if x.EpochMethod != "" {
+		value := protoreflect.ValueOfString(x.EpochMethod)
+		if !f(fd_MiddlewareABI_epoch_method, value) {
+			return
+		}
+	}
+	if x.ValidatorSetMethod != "" {
+		value := protoreflect.ValueOfString(x.ValidatorSetMethod)
+		if !f(fd_MiddlewareABI_validator_set_method, value) {
+			return
+		}
+	}
+	if x.StakeField != "" {
+		value := protoreflect.ValueOfString(x.StakeField)
+		if !f(fd_MiddlewareABI_stake_field, value) {
+			return
+		}
+	}
+	if x.KeyField != "" {
+		value := protoreflect.ValueOfString(x.KeyField)
+		if !f(fd_MiddlewareABI_key_field, value) {
+			return
+		}
+	}
+	if x.KeyType != "" {
+		value := protoreflect.ValueOfString(x.KeyType)
+		if !f(fd_MiddlewareABI_key_type, value) {
+			return
+		}
+	}
+	if x.OperatorField != "" {
+		value := protoreflect.ValueOfString(x.OperatorField)
+		if !f(fd_MiddlewareABI_operator_field, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MiddlewareABI) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		return x.Abi != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		return x.EpochMethod != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		return x.ValidatorSetMethod != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		return x.StakeField != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		return x.KeyField != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		return x.KeyType != ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		return x.OperatorField != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MiddlewareABI) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		x.Abi = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		x.EpochMethod = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		x.ValidatorSetMethod = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		x.StakeField = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		x.KeyField = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		x.KeyType = ""
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		x.OperatorField = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MiddlewareABI) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		value := x.Abi
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		value := x.EpochMethod
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		value := x.ValidatorSetMethod
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		value := x.StakeField
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		value := x.KeyField
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		value := x.OperatorField
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MiddlewareABI) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		x.Abi = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		x.EpochMethod = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		x.ValidatorSetMethod = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		x.StakeField = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		x.KeyField = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		x.KeyType = value.Interface().(string)
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		x.OperatorField = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MiddlewareABI) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		panic(fmt.Errorf("field abi of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		panic(fmt.Errorf("field epoch_method of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		panic(fmt.Errorf("field validator_set_method of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		panic(fmt.Errorf("field stake_field of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		panic(fmt.Errorf("field key_field of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		panic(fmt.Errorf("field key_type of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		panic(fmt.Errorf("field operator_field of message cosmos.symStaking.v1beta1.MiddlewareABI is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MiddlewareABI) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.abi":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.epoch_method":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.validator_set_method":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.stake_field":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_field":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.key_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.symStaking.v1beta1.MiddlewareABI.operator_field":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.symStaking.v1beta1.MiddlewareABI"))
+		}
+		panic(fmt.Errorf("message cosmos.symStaking.v1beta1.MiddlewareABI does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MiddlewareABI) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.symStaking.v1beta1.MiddlewareABI", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MiddlewareABI) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MiddlewareABI) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MiddlewareABI) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MiddlewareABI) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MiddlewareABI)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Abi)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EpochMethod)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorSetMethod)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakeField)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KeyField)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KeyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OperatorField)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MiddlewareABI)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OperatorField) > 0 {
+			i -= len(x.OperatorField)
+			copy(dAtA[i:], x.OperatorField)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorField)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.KeyType) > 0 {
+			i -= len(x.KeyType)
+			copy(dAtA[i:], x.KeyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyType)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.KeyField) > 0 {
+			i -= len(x.KeyField)
+			copy(dAtA[i:], x.KeyField)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyField)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StakeField) > 0 {
+			i -= len(x.StakeField)
+			copy(dAtA[i:], x.StakeField)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakeField)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ValidatorSetMethod) > 0 {
+			i -= len(x.ValidatorSetMethod)
+			copy(dAtA[i:], x.ValidatorSetMethod)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorSetMethod)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.EpochMethod) > 0 {
+			i -= len(x.EpochMethod)
+			copy(dAtA[i:], x.EpochMethod)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpochMethod)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Abi) > 0 {
+			i -= len(x.Abi)
+			copy(dAtA[i:], x.Abi)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abi)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MiddlewareABI)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MiddlewareABI: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MiddlewareABI: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
 					if iNdEx >= l {
@@ -5093,16 +7203,125 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxEntries |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Abi = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochMethod", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochMethod = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetMethod", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorSetMethod = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakeField", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HistoricalEntries = 0
+				x.StakeField = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyField", wireType)
+				}
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5112,14 +7331,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HistoricalEntries |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyField = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5147,11 +7379,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				x.KeyType = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorField", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5179,7 +7411,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinCommissionRate = string(dAtA[iNdEx:postIndex])
+				x.OperatorField = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5287,7 +7519,7 @@ func (x *ValidatorUpdates) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValidatorUpdates) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+	mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5780,6 +8012,63 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{0}
 }
 
+// StaleStakeAction is the safety mode entered while the Symbiotic stake is
+// staler than the max_stake_staleness param.
+type StaleStakeAction int32
+
+const (
+	// STALE_STAKE_ACTION_UNSPECIFIED defines no safety mode.
+	StaleStakeAction_STALE_STAKE_ACTION_UNSPECIFIED StaleStakeAction = 0
+	// STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES freezes the validator set sent to
+	// CometBFT until a sync is applied again.
+	StaleStakeAction_STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES StaleStakeAction = 1
+	// STALE_STAKE_ACTION_HALT_CHAIN rejects every tx through the circuit breaker
+	// until a sync is applied again. Blocks keep being produced so that syncs can
+	// resume.
+	StaleStakeAction_STALE_STAKE_ACTION_HALT_CHAIN StaleStakeAction = 2
+)
+
+// Enum value maps for StaleStakeAction.
+var (
+	StaleStakeAction_name = map[int32]string{
+		0: "STALE_STAKE_ACTION_UNSPECIFIED",
+		1: "STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES",
+		2: "STALE_STAKE_ACTION_HALT_CHAIN",
+	}
+	StaleStakeAction_value = map[string]int32{
+		"STALE_STAKE_ACTION_UNSPECIFIED":            0,
+		"STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES": 1,
+		"STALE_STAKE_ACTION_HALT_CHAIN":             2,
+	}
+)
+
+func (x StaleStakeAction) Enum() *StaleStakeAction {
+	p := new(StaleStakeAction)
+	*p = x
+	return p
+}
+
+func (x StaleStakeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaleStakeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+}
+
+func (StaleStakeAction) Type() protoreflect.EnumType {
+	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1]
+}
+
+func (x StaleStakeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaleStakeAction.Descriptor instead.
+func (StaleStakeAction) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+}
+
 // Infraction indicates the infraction a validator committed.
 type Infraction int32
 
@@ -5817,11 +8106,11 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1].Descriptor()
+	return file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[2].Descriptor()
 }
 
 func (Infraction) Type() protoreflect.EnumType {
-	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[1]
+	return &file_cosmos_symStaking_v1beta1_staking_proto_enumTypes[2]
 }
 
 func (x Infraction) Number() protoreflect.EnumNumber {
@@ -5830,7 +8119,7 @@ func (x Infraction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Infraction.Descriptor instead.
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -6273,6 +8562,82 @@ func (x *ValAddresses) GetAddresses() []string {
 	return nil
 }
 
+// ConsPubKeyRotation is a rotation of the consensus public key of a validator.
+// It is pending until a Symbiotic sync reports the new key.
+type ConsPubKeyRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key replaced by the rotation.
+	OldConsPubkey *anypb.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the
+	// rotation.
+	NewConsPubkey *anypb.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height the rotation was requested at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// rotated_height is the height of the Symbiotic sync that applied the
+	// rotation, zero while it is pending.
+	RotatedHeight int64 `protobuf:"varint,5,opt,name=rotated_height,json=rotatedHeight,proto3" json:"rotated_height,omitempty"`
+}
+
+func (x *ConsPubKeyRotation) Reset() {
+	*x = ConsPubKeyRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsPubKeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsPubKeyRotation) ProtoMessage() {}
+
+// Deprecated: Use ConsPubKeyRotation.ProtoReflect.Descriptor instead.
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConsPubKeyRotation) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *ConsPubKeyRotation) GetOldConsPubkey() *anypb.Any {
+	if x != nil {
+		return x.OldConsPubkey
+	}
+	return nil
+}
+
+func (x *ConsPubKeyRotation) GetNewConsPubkey() *anypb.Any {
+	if x != nil {
+		return x.NewConsPubkey
+	}
+	return nil
+}
+
+func (x *ConsPubKeyRotation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ConsPubKeyRotation) GetRotatedHeight() int64 {
+	if x != nil {
+		return x.RotatedHeight
+	}
+	return 0
+}
+
 // Params defines the parameters for the x/symStaking module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -6291,12 +8656,57 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate string `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3" json:"min_commission_rate,omitempty"`
+	// middleware_address is the address of the Symbiotic network middleware the
+	// validator set is read from. Nodes refuse to start if their local config
+	// points to a different middleware.
+	MiddlewareAddress string `protobuf:"bytes,7,opt,name=middleware_address,json=middlewareAddress,proto3" json:"middleware_address,omitempty"`
+	// symbiotic_sync_period is the number of blocks between two validator power
+	// syncs from the middleware.
+	SymbioticSyncPeriod int64 `protobuf:"varint,8,opt,name=symbiotic_sync_period,json=symbioticSyncPeriod,proto3" json:"symbiotic_sync_period,omitempty"`
+	// slots_in_epoch is the number of beacon chain slots in an epoch.
+	SlotsInEpoch int64 `protobuf:"varint,9,opt,name=slots_in_epoch,json=slotsInEpoch,proto3" json:"slots_in_epoch,omitempty"`
+	// beacon_genesis_timestamp is the unix time of the beacon chain genesis.
+	BeaconGenesisTimestamp int64 `protobuf:"varint,10,opt,name=beacon_genesis_timestamp,json=beaconGenesisTimestamp,proto3" json:"beacon_genesis_timestamp,omitempty"`
+	// slot_duration is the duration of a beacon chain slot, in seconds.
+	SlotDuration int64 `protobuf:"varint,11,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	// middleware_abi describes how the validator set is read from the middleware.
+	// The zero value reads a SimpleMiddleware.
+	MiddlewareAbi *MiddlewareABI `protobuf:"bytes,12,opt,name=middleware_abi,json=middlewareAbi,proto3" json:"middleware_abi,omitempty"`
+	// auto_register_validators makes the middleware validator set drive the
+	// validators: operators reported with a consensus public key get a validator
+	// created without MsgCreateValidator, and validators missing from the set
+	// lose their tokens and start unbonding.
+	AutoRegisterValidators bool `protobuf:"varint,13,opt,name=auto_register_validators,json=autoRegisterValidators,proto3" json:"auto_register_validators,omitempty"`
+	// symbiotic_sync_history_entries is the number of Symbiotic sync records to
+	// persist. The last sync is always kept.
+	SymbioticSyncHistoryEntries uint32 `protobuf:"varint,14,opt,name=symbiotic_sync_history_entries,json=symbioticSyncHistoryEntries,proto3" json:"symbiotic_sync_history_entries,omitempty"`
+	// max_stake_staleness is how old the stake of the last applied Symbiotic
+	// sync may get before stale_stake_action is taken. Zero disables the check.
+	MaxStakeStaleness *durationpb.Duration `protobuf:"bytes,15,opt,name=max_stake_staleness,json=maxStakeStaleness,proto3" json:"max_stake_staleness,omitempty"`
+	// stale_stake_action is the safety mode entered while the stake is staler
+	// than max_stake_staleness.
+	StaleStakeAction StaleStakeAction `protobuf:"varint,16,opt,name=stale_stake_action,json=staleStakeAction,proto3,enum=cosmos.symStaking.v1beta1.StaleStakeAction" json:"stale_stake_action,omitempty"`
+	// ethereum_network is the Ethereum network the middleware is deployed on:
+	// mainnet, sepolia, holesky or devnet. The beacon chain params of a public
+	// network must match its profile, a devnet sets its own. Nodes refuse to
+	// start if their local config points to another network. Empty skips both
+	// checks.
+	EthereumNetwork string `protobuf:"bytes,17,opt,name=ethereum_network,json=ethereumNetwork,proto3" json:"ethereum_network,omitempty"`
+	// max_power_change_rate is the fraction of the bonded power that a single
+	// Symbiotic sync may change, counting both increases and decreases. Changes
+	// beyond it are kept pending and applied at the next syncs. Zero disables
+	// the limit.
+	MaxPowerChangeRate string `protobuf:"bytes,18,opt,name=max_power_change_rate,json=maxPowerChangeRate,proto3" json:"max_power_change_rate,omitempty"`
+	// require_operator_binding requires validators to be bound to their
+	// Symbiotic operator, through MsgCreateValidator or MsgUpdateOperatorBinding,
+	// for the middleware stake to be applied to them.
+	RequireOperatorBinding bool `protobuf:"varint,19,opt,name=require_operator_binding,json=requireOperatorBinding,proto3" json:"require_operator_binding,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6310,7 +8720,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Params) GetUnbondingTime() *durationpb.Duration {
@@ -6355,6 +8765,195 @@ func (x *Params) GetMinCommissionRate() string {
 	return ""
 }
 
+func (x *Params) GetMiddlewareAddress() string {
+	if x != nil {
+		return x.MiddlewareAddress
+	}
+	return ""
+}
+
+func (x *Params) GetSymbioticSyncPeriod() int64 {
+	if x != nil {
+		return x.SymbioticSyncPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetSlotsInEpoch() int64 {
+	if x != nil {
+		return x.SlotsInEpoch
+	}
+	return 0
+}
+
+func (x *Params) GetBeaconGenesisTimestamp() int64 {
+	if x != nil {
+		return x.BeaconGenesisTimestamp
+	}
+	return 0
+}
+
+func (x *Params) GetSlotDuration() int64 {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMiddlewareAbi() *MiddlewareABI {
+	if x != nil {
+		return x.MiddlewareAbi
+	}
+	return nil
+}
+
+func (x *Params) GetAutoRegisterValidators() bool {
+	if x != nil {
+		return x.AutoRegisterValidators
+	}
+	return false
+}
+
+func (x *Params) GetSymbioticSyncHistoryEntries() uint32 {
+	if x != nil {
+		return x.SymbioticSyncHistoryEntries
+	}
+	return 0
+}
+
+func (x *Params) GetMaxStakeStaleness() *durationpb.Duration {
+	if x != nil {
+		return x.MaxStakeStaleness
+	}
+	return nil
+}
+
+func (x *Params) GetStaleStakeAction() StaleStakeAction {
+	if x != nil {
+		return x.StaleStakeAction
+	}
+	return StaleStakeAction_STALE_STAKE_ACTION_UNSPECIFIED
+}
+
+func (x *Params) GetEthereumNetwork() string {
+	if x != nil {
+		return x.EthereumNetwork
+	}
+	return ""
+}
+
+func (x *Params) GetMaxPowerChangeRate() string {
+	if x != nil {
+		return x.MaxPowerChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetRequireOperatorBinding() bool {
+	if x != nil {
+		return x.RequireOperatorBinding
+	}
+	return false
+}
+
+// MiddlewareABI maps the methods and return values of a middleware contract to
+// the Symbiotic validator set.
+type MiddlewareABI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// abi is the JSON ABI of the middleware, it must contain the methods below.
+	Abi string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	// epoch_method is the method returning the current epoch, passed as the only
+	// argument of validator_set_method. Empty if validator_set_method takes no
+	// argument.
+	EpochMethod string `protobuf:"bytes,2,opt,name=epoch_method,json=epochMethod,proto3" json:"epoch_method,omitempty"`
+	// validator_set_method is the method whose first output is the array of
+	// validator set entries.
+	ValidatorSetMethod string `protobuf:"bytes,3,opt,name=validator_set_method,json=validatorSetMethod,proto3" json:"validator_set_method,omitempty"`
+	// stake_field is the path of the stake in an entry. Dots select tuple
+	// components and arrays along the path are summed, e.g. "vaults.stake" sums
+	// a vault breakdown.
+	StakeField string `protobuf:"bytes,4,opt,name=stake_field,json=stakeField,proto3" json:"stake_field,omitempty"`
+	// key_field is the path of the consensus key in an entry.
+	KeyField string `protobuf:"bytes,5,opt,name=key_field,json=keyField,proto3" json:"key_field,omitempty"`
+	// key_type is the type of the consensus key: "cons_address", "ed25519",
+	// "secp256k1" or "bls12_381".
+	KeyType string `protobuf:"bytes,6,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// operator_field is the path of the operator address in an entry, optional.
+	OperatorField string `protobuf:"bytes,7,opt,name=operator_field,json=operatorField,proto3" json:"operator_field,omitempty"`
+}
+
+func (x *MiddlewareABI) Reset() {
+	*x = MiddlewareABI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiddlewareABI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiddlewareABI) ProtoMessage() {}
+
+// Deprecated: Use MiddlewareABI.ProtoReflect.Descriptor instead.
+func (*MiddlewareABI) Descriptor() ([]byte, []int) {
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MiddlewareABI) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetEpochMethod() string {
+	if x != nil {
+		return x.EpochMethod
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetValidatorSetMethod() string {
+	if x != nil {
+		return x.ValidatorSetMethod
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetStakeField() string {
+	if x != nil {
+		return x.StakeField
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetKeyField() string {
+	if x != nil {
+		return x.KeyField
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *MiddlewareABI) GetOperatorField() string {
+	if x != nil {
+		return x.OperatorField
+	}
+	return ""
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 //
@@ -6370,7 +8969,7 @@ type ValidatorUpdates struct {
 func (x *ValidatorUpdates) Reset() {
 	*x = ValidatorUpdates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8]
+		mi := &file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6384,7 +8983,7 @@ func (*ValidatorUpdates) ProtoMessage() {}
 
 // Deprecated: Use ValidatorUpdates.ProtoReflect.Descriptor instead.
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ValidatorUpdates) GetUpdates() []*v11.ValidatorUpdate {
@@ -6522,7 +9121,29 @@ var file_cosmos_symStaking_v1beta1_staking_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x9f, 0x03,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xdd, 0x02,
+	0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x6f,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x0f,
+	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x18, 0xca, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe7, 0x09,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x75, 0x6e, 0x62, 0x6f,
 	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
@@ -6546,49 +9167,133 @@ var file_cosmos_symStaking_v1beta1_staking_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22,
 	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
 	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x79,
-	0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x5e, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e,
-	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x2a,
-	0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c,
-	0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20,
-	0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14,
-	0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f,
-	0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a, 0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a,
-	0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22,
-	0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f,
-	0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a, 0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64,
-	0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e, 0x66, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57,
-	0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xf1, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
-	0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74,
+	0x69, 0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53,
+	0x79, 0x6e, 0x63, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x49, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x38, 0x0a, 0x18, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6c, 0x6f,
+	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a,
+	0x0a, 0x0e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x62, 0x69,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x42, 0x49,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x62, 0x69, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x73, 0x79, 0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69,
+	0x63, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x73, 0x79,
+	0x6d, 0x62, 0x69, 0x6f, 0x74, 0x69, 0x63, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x59, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x69, 0x0a, 0x15, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x27,
+	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0d, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x41, 0x42, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x5e, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x2a, 0xb6, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x17, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x14, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x0c, 0x8a,
+	0x9d, 0x20, 0x08, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x15, 0x42,
+	0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x12, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x0a, 0x8a,
+	0x9d, 0x20, 0x06, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
+	0xf8, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x1e, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1f, 0x8a, 0x9d, 0x20, 0x1b, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x29, 0x53, 0x54, 0x41,
+	0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x48, 0x41, 0x4c, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x53, 0x10, 0x01, 0x1a, 0x28, 0x8a, 0x9d, 0x20, 0x24, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
+	0x6c, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4b,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x10, 0x02, 0x1a, 0x1d, 0x8a, 0x9d, 0x20, 0x19, 0x53, 0x74, 0x61, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6c, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x5d, 0x0a, 0x0a, 0x49, 0x6e,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x4f, 0x57, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x42, 0xf1, 0x01, 0x0a, 0x1d, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa,
+	0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x79, 0x6d, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6603,44 +9308,52 @@ func file_cosmos_symStaking_v1beta1_staking_proto_rawDescGZIP() []byte {
 	return file_cosmos_symStaking_v1beta1_staking_proto_rawDescData
 }
 
-var file_cosmos_symStaking_v1beta1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_symStaking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_symStaking_v1beta1_staking_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cosmos_symStaking_v1beta1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_cosmos_symStaking_v1beta1_staking_proto_goTypes = []interface{}{
 	(BondStatus)(0),               // 0: cosmos.symStaking.v1beta1.BondStatus
-	(Infraction)(0),               // 1: cosmos.symStaking.v1beta1.Infraction
-	(*HistoricalInfo)(nil),        // 2: cosmos.symStaking.v1beta1.HistoricalInfo
-	(*HistoricalRecord)(nil),      // 3: cosmos.symStaking.v1beta1.HistoricalRecord
-	(*CommissionRates)(nil),       // 4: cosmos.symStaking.v1beta1.CommissionRates
-	(*Commission)(nil),            // 5: cosmos.symStaking.v1beta1.Commission
-	(*Description)(nil),           // 6: cosmos.symStaking.v1beta1.Description
-	(*Validator)(nil),             // 7: cosmos.symStaking.v1beta1.Validator
-	(*ValAddresses)(nil),          // 8: cosmos.symStaking.v1beta1.ValAddresses
-	(*Params)(nil),                // 9: cosmos.symStaking.v1beta1.Params
-	(*ValidatorUpdates)(nil),      // 10: cosmos.symStaking.v1beta1.ValidatorUpdates
-	(*v1.Header)(nil),             // 11: cometbft.types.v1.Header
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*anypb.Any)(nil),             // 13: google.protobuf.Any
-	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
-	(*v11.ValidatorUpdate)(nil),   // 15: cometbft.abci.v1.ValidatorUpdate
+	(StaleStakeAction)(0),         // 1: cosmos.symStaking.v1beta1.StaleStakeAction
+	(Infraction)(0),               // 2: cosmos.symStaking.v1beta1.Infraction
+	(*HistoricalInfo)(nil),        // 3: cosmos.symStaking.v1beta1.HistoricalInfo
+	(*HistoricalRecord)(nil),      // 4: cosmos.symStaking.v1beta1.HistoricalRecord
+	(*CommissionRates)(nil),       // 5: cosmos.symStaking.v1beta1.CommissionRates
+	(*Commission)(nil),            // 6: cosmos.symStaking.v1beta1.Commission
+	(*Description)(nil),           // 7: cosmos.symStaking.v1beta1.Description
+	(*Validator)(nil),             // 8: cosmos.symStaking.v1beta1.Validator
+	(*ValAddresses)(nil),          // 9: cosmos.symStaking.v1beta1.ValAddresses
+	(*ConsPubKeyRotation)(nil),    // 10: cosmos.symStaking.v1beta1.ConsPubKeyRotation
+	(*Params)(nil),                // 11: cosmos.symStaking.v1beta1.Params
+	(*MiddlewareABI)(nil),         // 12: cosmos.symStaking.v1beta1.MiddlewareABI
+	(*ValidatorUpdates)(nil),      // 13: cosmos.symStaking.v1beta1.ValidatorUpdates
+	(*v1.Header)(nil),             // 14: cometbft.types.v1.Header
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 16: google.protobuf.Any
+	(*durationpb.Duration)(nil),   // 17: google.protobuf.Duration
+	(*v11.ValidatorUpdate)(nil),   // 18: cometbft.abci.v1.ValidatorUpdate
 }
 var file_cosmos_symStaking_v1beta1_staking_proto_depIdxs = []int32{
-	11, // 0: cosmos.symStaking.v1beta1.HistoricalInfo.header:type_name -> cometbft.types.v1.Header
-	7,  // 1: cosmos.symStaking.v1beta1.HistoricalInfo.valset:type_name -> cosmos.symStaking.v1beta1.Validator
-	12, // 2: cosmos.symStaking.v1beta1.HistoricalRecord.time:type_name -> google.protobuf.Timestamp
-	4,  // 3: cosmos.symStaking.v1beta1.Commission.commission_rates:type_name -> cosmos.symStaking.v1beta1.CommissionRates
-	12, // 4: cosmos.symStaking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
-	13, // 5: cosmos.symStaking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
+	14, // 0: cosmos.symStaking.v1beta1.HistoricalInfo.header:type_name -> cometbft.types.v1.Header
+	8,  // 1: cosmos.symStaking.v1beta1.HistoricalInfo.valset:type_name -> cosmos.symStaking.v1beta1.Validator
+	15, // 2: cosmos.symStaking.v1beta1.HistoricalRecord.time:type_name -> google.protobuf.Timestamp
+	5,  // 3: cosmos.symStaking.v1beta1.Commission.commission_rates:type_name -> cosmos.symStaking.v1beta1.CommissionRates
+	15, // 4: cosmos.symStaking.v1beta1.Commission.update_time:type_name -> google.protobuf.Timestamp
+	16, // 5: cosmos.symStaking.v1beta1.Validator.consensus_pubkey:type_name -> google.protobuf.Any
 	0,  // 6: cosmos.symStaking.v1beta1.Validator.status:type_name -> cosmos.symStaking.v1beta1.BondStatus
-	6,  // 7: cosmos.symStaking.v1beta1.Validator.description:type_name -> cosmos.symStaking.v1beta1.Description
-	12, // 8: cosmos.symStaking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
-	5,  // 9: cosmos.symStaking.v1beta1.Validator.commission:type_name -> cosmos.symStaking.v1beta1.Commission
-	14, // 10: cosmos.symStaking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
-	15, // 11: cosmos.symStaking.v1beta1.ValidatorUpdates.updates:type_name -> cometbft.abci.v1.ValidatorUpdate
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	7,  // 7: cosmos.symStaking.v1beta1.Validator.description:type_name -> cosmos.symStaking.v1beta1.Description
+	15, // 8: cosmos.symStaking.v1beta1.Validator.unbonding_time:type_name -> google.protobuf.Timestamp
+	6,  // 9: cosmos.symStaking.v1beta1.Validator.commission:type_name -> cosmos.symStaking.v1beta1.Commission
+	16, // 10: cosmos.symStaking.v1beta1.ConsPubKeyRotation.old_cons_pubkey:type_name -> google.protobuf.Any
+	16, // 11: cosmos.symStaking.v1beta1.ConsPubKeyRotation.new_cons_pubkey:type_name -> google.protobuf.Any
+	17, // 12: cosmos.symStaking.v1beta1.Params.unbonding_time:type_name -> google.protobuf.Duration
+	12, // 13: cosmos.symStaking.v1beta1.Params.middleware_abi:type_name -> cosmos.symStaking.v1beta1.MiddlewareABI
+	17, // 14: cosmos.symStaking.v1beta1.Params.max_stake_staleness:type_name -> google.protobuf.Duration
+	1,  // 15: cosmos.symStaking.v1beta1.Params.stale_stake_action:type_name -> cosmos.symStaking.v1beta1.StaleStakeAction
+	18, // 16: cosmos.symStaking.v1beta1.ValidatorUpdates.updates:type_name -> cometbft.abci.v1.ValidatorUpdate
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_symStaking_v1beta1_staking_proto_init() }
@@ -6734,7 +9447,7 @@ func file_cosmos_symStaking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*ConsPubKeyRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6746,6 +9459,30 @@ func file_cosmos_symStaking_v1beta1_staking_proto_init() {
 			}
 		}
 		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiddlewareABI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_symStaking_v1beta1_staking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidatorUpdates); i {
 			case 0:
 				return &v.state
@@ -6763,8 +9500,8 @@ func file_cosmos_symStaking_v1beta1_staking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_symStaking_v1beta1_staking_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName = "/cosmos.symStaking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName   = "/cosmos.symStaking.v1beta1.Msg/EditValidator"
-	Msg_UpdateParams_FullMethodName    = "/cosmos.symStaking.v1beta1.Msg/UpdateParams"
+	Msg_CreateValidator_FullMethodName       = "/cosmos.symStaking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName         = "/cosmos.symStaking.v1beta1.Msg/EditValidator"
+	Msg_UpdateOperatorBinding_FullMethodName = "/cosmos.symStaking.v1beta1.Msg/UpdateOperatorBinding"
+	Msg_RotateConsPubKey_FullMethodName      = "/cosmos.symStaking.v1beta1.Msg/RotateConsPubKey"
+	Msg_OperatorExec_FullMethodName          = "/cosmos.symStaking.v1beta1.Msg/OperatorExec"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.symStaking.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +35,16 @@ type MsgClient interface {
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
+	// UpdateOperatorBinding binds an existing validator to another Symbiotic
+	// operator, or binds it again to rotate the signature.
+	UpdateOperatorBinding(ctx context.Context, in *MsgUpdateOperatorBinding, opts ...grpc.CallOption) (*MsgUpdateOperatorBindingResponse, error)
+	// RotateConsPubKey requests the rotation of the consensus public key of an
+	// existing validator. The new key is used once the Symbiotic middleware
+	// reports it.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// OperatorExec executes messages on behalf of a validator registered by a
+	// Symbiotic sync, authorized by a signature of its operator key.
+	OperatorExec(ctx context.Context, in *MsgOperatorExec, opts ...grpc.CallOption) (*MsgOperatorExecResponse, error)
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -63,6 +76,33 @@ func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opt
 	return out, nil
 }
 
+func (c *msgClient) UpdateOperatorBinding(ctx context.Context, in *MsgUpdateOperatorBinding, opts ...grpc.CallOption) (*MsgUpdateOperatorBindingResponse, error) {
+	out := new(MsgUpdateOperatorBindingResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateOperatorBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OperatorExec(ctx context.Context, in *MsgOperatorExec, opts ...grpc.CallOption) (*MsgOperatorExecResponse, error) {
+	out := new(MsgOperatorExecResponse)
+	err := c.cc.Invoke(ctx, Msg_OperatorExec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -80,6 +120,16 @@ type MsgServer interface {
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
+	// UpdateOperatorBinding binds an existing validator to another Symbiotic
+	// operator, or binds it again to rotate the signature.
+	UpdateOperatorBinding(context.Context, *MsgUpdateOperatorBinding) (*MsgUpdateOperatorBindingResponse, error)
+	// RotateConsPubKey requests the rotation of the consensus public key of an
+	// existing validator. The new key is used once the Symbiotic middleware
+	// reports it.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// OperatorExec executes messages on behalf of a validator registered by a
+	// Symbiotic sync, authorized by a signature of its operator key.
+	OperatorExec(context.Context, *MsgOperatorExec) (*MsgOperatorExecResponse, error)
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -96,6 +146,15 @@ func (UnimplementedMsgServer) CreateValidator(context.Context, *MsgCreateValidat
 func (UnimplementedMsgServer) EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
+func (UnimplementedMsgServer) UpdateOperatorBinding(context.Context, *MsgUpdateOperatorBinding) (*MsgUpdateOperatorBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperatorBinding not implemented")
+}
+func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
+func (UnimplementedMsgServer) OperatorExec(context.Context, *MsgOperatorExec) (*MsgOperatorExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorExec not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -148,6 +207,60 @@ func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateOperatorBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperatorBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperatorBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateOperatorBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperatorBinding(ctx, req.(*MsgUpdateOperatorBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OperatorExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OperatorExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorExec(ctx, req.(*MsgOperatorExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -181,6 +294,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
 		},
+		{
+			MethodName: "UpdateOperatorBinding",
+			Handler:    _Msg_UpdateOperatorBinding_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
+		{
+			MethodName: "OperatorExec",
+			Handler:    _Msg_OperatorExec_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -817,6 +817,12 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	// vote extensions, so skip those.
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
 	for _, rawTx := range req.Txs {
+		// injected system txs are consumed by the PreBlocker, they are not
+		// executed and get an empty successful result
+		if sdk.IsInjectedTx(rawTx) {
+			txResults = append(txResults, &abci.ExecTxResult{})
+			continue
+		}
 
 		response := app.deliverTx(rawTx)
 
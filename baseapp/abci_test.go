@@ -683,6 +683,41 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_InjectedTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	injectedTx := append(append([]byte{}, sdk.InjectedTxPrefix...), []byte("system data")...)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{injectedTx, txBytes},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+
+	// the injected tx is not executed
+	require.True(t, res.TxResults[0].IsOK())
+	require.Empty(t, res.TxResults[0].Events)
+	require.Zero(t, res.TxResults[0].GasUsed)
+
+	require.True(t, res.TxResults[1].IsOK(), fmt.Sprintf("%v", res))
+	require.Len(t, res.TxResults[1].GetEvents(), 3)
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -20,6 +20,7 @@ import (
 	poolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/symGenutil/module/v1"
 	govmodulev1 "cosmossdk.io/api/cosmos/symGov/module/v1"
+	rewardsmodulev1 "cosmossdk.io/api/cosmos/symRewards/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/symSlash/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/symStaking/module/v1"
 	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
@@ -49,6 +50,8 @@ import (
 	pooltypes "cosmossdk.io/x/protocolpool/types"
 	_ "cosmossdk.io/x/symGov" // import for side-effects
 	govtypes "cosmossdk.io/x/symGov/types"
+	_ "cosmossdk.io/x/symRewards" // import for side-effects
+	rewardstypes "cosmossdk.io/x/symRewards/types"
 	_ "cosmossdk.io/x/symSlash" // import for side-effects
 	slashingtypes "cosmossdk.io/x/symSlash/types"
 	_ "cosmossdk.io/x/symStaking" // import for side-effects
@@ -69,11 +72,13 @@ var (
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
+		{Account: rewardstypes.ModuleName, Permissions: []string{authtypes.Minter}},
 	}
 
 	// blocked account addresses
 	blockAccAddrs = []string{
 		authtypes.FeeCollectorName,
+		rewardstypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 		// pooltypes.ModuleName
@@ -90,11 +95,12 @@ var (
 					PreBlockers: []string{
 						upgradetypes.ModuleName,
 					},
-					// During begin block slashing happens after distr.BeginBlocker so that
-					// there is nothing left over in the validator fee pool, so as to keep the
-					// CanWithdrawInvariant invariant.
+					// During begin block the rewards are allocated before slashing so that
+					// a validator jailed in the block still gets its share, split by tokens
+					// across the bonded validators of the previous block.
 					// NOTE: staking module is required if HistoricalEntries param > 0
 					BeginBlockers: []string{
+						rewardstypes.ModuleName,
 						slashingtypes.ModuleName,
 						stakingtypes.ModuleName,
 						authz.ModuleName,
@@ -128,6 +134,7 @@ var (
 						stakingtypes.ModuleName,
 						slashingtypes.ModuleName,
 						govtypes.ModuleName,
+						rewardstypes.ModuleName,
 						genutiltypes.ModuleName,
 						authz.ModuleName,
 						feegrant.ModuleName,
@@ -183,6 +190,10 @@ var (
 				Name:   slashingtypes.ModuleName,
 				Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
 			},
+			{
+				Name:   rewardstypes.ModuleName,
+				Config: appconfig.WrapAny(&rewardsmodulev1.Module{}),
+			},
 			{
 				Name: "tx",
 				Config: appconfig.WrapAny(&txconfigv1.Config{
@@ -11,6 +11,8 @@ import (
 	"io"
 	"path/filepath"
 
+	cmtabci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
@@ -34,8 +36,10 @@ import (
 	_ "cosmossdk.io/x/protocolpool"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	govkeeper "cosmossdk.io/x/symGov/keeper"
+	rewardskeeper "cosmossdk.io/x/symRewards/keeper"
 	slashingkeeper "cosmossdk.io/x/symSlash/keeper"
 	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -49,12 +53,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // DefaultNodeHome default home directories for the application daemon
 var DefaultNodeHome string
 
+// FlagSymbioticDataSource is the app option holding a
+// stakingtypes.SymbioticDataSource that replaces the RPC one. It has no
+// app.toml counterpart and is only set by tests.
+const FlagSymbioticDataSource = "symbiotic.data-source"
+
 var (
 	_ runtime.AppI            = (*SymApp)(nil)
 	_ servertypes.Application = (*SymApp)(nil)
@@ -79,6 +89,7 @@ type SymApp struct {
 	StakingKeeper         *stakingkeeper.Keeper
 	SlashingKeeper        slashingkeeper.Keeper
 	GovKeeper             *govkeeper.Keeper
+	RewardsKeeper         rewardskeeper.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
@@ -90,6 +101,10 @@ type SymApp struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// symbioticConfigChecked is set once the [symbiotic] app.toml config was
+	// checked against the chain
+	symbioticConfigChecked bool
 }
 
 func init() {
@@ -171,6 +186,13 @@ func NewSymApp(
 		)
 	)
 
+	// tests supply their own Symbiotic data source to run syncs offline
+	if appOpts != nil {
+		if dataSource, ok := appOpts.Get(FlagSymbioticDataSource).(stakingtypes.SymbioticDataSource); ok {
+			appConfig = depinject.Configs(appConfig, depinject.Supply(dataSource))
+		}
+	}
+
 	var appModules map[string]appmodule.AppModule
 	if err := depinject.Inject(appConfig,
 		&appBuilder,
@@ -185,6 +207,7 @@ func NewSymApp(
 		&app.StakingKeeper,
 		&app.SlashingKeeper,
 		&app.GovKeeper,
+		&app.RewardsKeeper,
 		&app.UpgradeKeeper,
 		&app.AuthzKeeper,
 		&app.FeeGrantKeeper,
@@ -217,18 +240,23 @@ func NewSymApp(
 	//
 	// Example:
 	//
-	// create and set dummy vote extension handler
+	// create and set the Symbiotic vote extension and proposal handlers
 	abciPropHandler := abci.NewProposalHandler(logger, app.StakingKeeper)
-	voteExtensionHandler := abci.NewVoteExtensionHandler()
+	voteExtensionHandler := abci.NewVoteExtensionHandler(logger, app.StakingKeeper)
 	baseAppOptions = append(baseAppOptions, func(ba *baseapp.BaseApp) {
 		ba.SetExtendVoteHandler(voteExtensionHandler.ExtendVote())
 		ba.SetVerifyVoteExtensionHandler(voteExtensionHandler.VerifyVoteExtension())
 		ba.SetPrepareProposal(abciPropHandler.PrepareProposal())
-		ba.SetPreBlocker(abciPropHandler.PreBlocker())
+		ba.SetProcessProposal(abciPropHandler.ProcessProposal())
+		ba.SetPreBlocker(app.preBlocker(abciPropHandler.PreBlocker()))
 	})
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// reject every message while the chain is halted because of stale
+	// Symbiotic stake, on top of the x/circuit breaker
+	app.SetCircuitBreaker(app.StakingKeeper.CircuitBreaker(&app.CircuitBreakerKeeper))
+
 	if indexerOpts := appOpts.Get("indexer"); indexerOpts != nil {
 		// if we have indexer options in app.toml, then enable the built-in indexer framework
 		moduleSet := map[string]any{}
@@ -305,6 +333,47 @@ func NewSymApp(
 	return app
 }
 
+// preBlocker runs the module PreBlockers, applying upgrades and their
+// migrations, then the Symbiotic one. The first block the node executes stops
+// it if it reads another Symbiotic middleware or Ethereum network than the
+// ones set on chain: the params are only checked once migrated, fresh chains
+// are checked in InitGenesis.
+func (app *SymApp) preBlocker(symbiotic sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *cmtabci.FinalizeBlockRequest) error {
+		if err := app.App.PreBlocker(ctx, req); err != nil {
+			return err
+		}
+
+		if !app.symbioticConfigChecked {
+			if err := app.validateSymbioticConfig(ctx); err != nil {
+				return fmt.Errorf("the [symbiotic] app.toml config does not match the chain: %w", err)
+			}
+			app.symbioticConfigChecked = true
+		}
+
+		return symbiotic(ctx, req)
+	}
+}
+
+// validateSymbioticConfig checks the Symbiotic middleware address and Ethereum
+// network of the node config against the module params, unless no middleware
+// is set on chain yet.
+func (app *SymApp) validateSymbioticConfig(ctx sdk.Context) error {
+	params, err := app.StakingKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MiddlewareAddress == "" {
+		return nil
+	}
+
+	if err := app.StakingKeeper.ValidateMiddlewareAddress(ctx); err != nil {
+		return err
+	}
+
+	return app.StakingKeeper.ValidateEthereumNetwork(ctx)
+}
+
 // overwrite default ante handlers with custom ante handlers
 // set SkipAnteHandler to true in app config and set custom ante handler on baseapp
 func (app *SymApp) setCustomAnteHandler() {
@@ -318,7 +387,7 @@ func (app *SymApp) setCustomAnteHandler() {
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 				Environment:     app.AuthKeeper.Environment,
 			},
-			&app.CircuitBreakerKeeper,
+			app.StakingKeeper.CircuitBreaker(&app.CircuitBreakerKeeper),
 			app.UnorderedTxManager,
 		},
 	)
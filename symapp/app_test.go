@@ -1,21 +1,29 @@
 package symapp
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/auth/vesting"
 	authzmodule "cosmossdk.io/x/authz/module"
 	"cosmossdk.io/x/bank"
@@ -29,10 +37,15 @@ import (
 	"cosmossdk.io/x/protocolpool"
 	"cosmossdk.io/x/slashing"
 	"cosmossdk.io/x/symGov"
+	"cosmossdk.io/x/symRewards"
+	"cosmossdk.io/x/symSlash"
 	staking "cosmossdk.io/x/symStaking"
+	stakingtestutil "cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 	"cosmossdk.io/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,7 +60,7 @@ func TestSymAppExportAndBlockedAddrs(t *testing.T) {
 	app := NewSymAppWithCustomOptions(t, false, SetupOptions{
 		Logger:  logger.With("instance", "first"),
 		DB:      db,
-		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+		AppOpts: NewTestAppOptions(t.TempDir(), nil),
 	})
 
 	// BlockedAddresses returns a map of addresses in app v1 and a map of modules name in app v2.
@@ -76,7 +89,7 @@ func TestSymAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err)
 
 	// Making a new app object with the db, so that initchain hasn't been called
-	app2 := NewSymApp(logger.With("instance", "second"), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	app2 := NewSymApp(logger.With("instance", "second"), db, nil, true, NewTestAppOptions(t.TempDir(), nil))
 	_, err = app2.ExportAppStateAndValidators(false, []string{}, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
@@ -84,7 +97,7 @@ func TestSymAppExportAndBlockedAddrs(t *testing.T) {
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	logger := log.NewTestLogger(t)
-	app := NewSymApp(logger.With("instance", "symapp"), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	app := NewSymApp(logger.With("instance", "symapp"), db, nil, true, NewTestAppOptions(t.TempDir(), nil))
 
 	// Create a new baseapp and configurator for the purpose of this test.
 	bApp := baseapp.NewBaseApp(app.Name(), logger.With("instance", "baseapp"), db, app.TxConfig().TxDecoder())
@@ -203,6 +216,8 @@ func TestRunMigrations(t *testing.T) {
 					"distribution": distribution.AppModule{}.ConsensusVersion(),
 					"slashing":     slashing.AppModule{}.ConsensusVersion(),
 					"symGov":       symGov.AppModule{}.ConsensusVersion(),
+					"symRewards":   symRewards.AppModule{}.ConsensusVersion(),
+					"symSlash":     symSlash.AppModule{}.ConsensusVersion(),
 					"group":        group.AppModule{}.ConsensusVersion(),
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
@@ -226,7 +241,7 @@ func TestRunMigrations(t *testing.T) {
 
 func TestInitGenesisOnMigration(t *testing.T) {
 	db := dbm.NewMemDB()
-	app := NewSymApp(log.NewTestLogger(t), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	app := NewSymApp(log.NewTestLogger(t), db, nil, true, NewTestAppOptions(t.TempDir(), nil))
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
 	// Create a mock module. This module will serve as the new module we're
@@ -268,7 +283,7 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	app := NewSymAppWithCustomOptions(t, false, SetupOptions{
 		Logger:  log.NewTestLogger(t),
 		DB:      db,
-		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+		AppOpts: NewTestAppOptions(t.TempDir(), nil),
 	})
 
 	// make sure the upgrade keeper has version map in state
@@ -282,6 +297,121 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	}
 }
 
+// TestSymbioticSyncOffline runs the first Symbiotic sync height through the
+// ABCI handlers, with the validator set read from an in-memory data source.
+func TestSymbioticSyncOffline(t *testing.T) {
+	const chainID = "symapp-test"
+
+	dataSource := stakingtestutil.NewInMemoryDataSource()
+	app := NewSymApp(log.NewTestLogger(t), dbm.NewMemDB(), nil, true,
+		NewTestAppOptions(t.TempDir(), dataSource), baseapp.SetChainID(chainID))
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	genesisState := genesisStateWithValSet(t, app, app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	consensusParams := *simtestutil.DefaultConsensusParams
+	consensusParams.Feature = &cmtproto.FeatureParams{
+		VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 1},
+	}
+	blockTime := time.Unix(stakingtypes.DefaultBeaconGenesisTimestamp, 0).Add(24 * time.Hour).UTC()
+	_, err = app.InitChain(&abci.InitChainRequest{
+		ChainId:         chainID,
+		Time:            blockTime,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	// a finalized execution block holding the middleware validator set
+	blockHash := dataSource.AddBlock(1, uint64(blockTime.Add(-time.Minute).Unix()))
+	dataSource.SetBeaconBlockHash(0, blockHash)
+	dataSource.SetFinalizedEpoch(blockTime.Unix() / stakingtypes.DefaultSlotDuration / stakingtypes.DefaultSlotsInEpoch)
+	stake := sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
+	var validator stakingtypes.SymbioticValidator
+	validator.Stake = stake.BigInt()
+	copy(validator.ConsAddr[:], pubKey.Address())
+	dataSource.SetValidatorSet(blockHash, []stakingtypes.SymbioticValidator{validator})
+
+	syncHeight := stakingtypes.DefaultSymbioticSyncPeriod
+	for height := int64(1); height < syncHeight; height++ {
+		_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height, Time: blockTime})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	// the vote of the last height before the sync attests the validator set
+	ext, err := app.ExtendVote(context.Background(), &abci.ExtendVoteRequest{Height: syncHeight - 1, Time: blockTime})
+	require.NoError(t, err)
+	require.NotEmpty(t, ext.VoteExtension)
+
+	cve := cmtproto.CanonicalVoteExtension{Extension: ext.VoteExtension, Height: syncHeight - 1, ChainId: chainID}
+	var buf bytes.Buffer
+	require.NoError(t, protoio.NewDelimitedWriter(&buf).WriteMsg(&cve))
+	sig, err := privVal.PrivKey.Sign(buf.Bytes())
+	require.NoError(t, err)
+
+	val := abci.Validator{Address: pubKey.Address(), Power: 1}
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{{
+		Validator:          val,
+		VoteExtension:      ext.VoteExtension,
+		ExtensionSignature: sig,
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}}}
+	lastCommit := abci.CommitInfo{Votes: []abci.VoteInfo{{Validator: val, BlockIdFlag: cmtproto.BlockIDFlagCommit}}}
+
+	proposal, err := app.PrepareProposal(&abci.PrepareProposalRequest{
+		Height:          syncHeight,
+		Time:            blockTime,
+		MaxTxBytes:      consensusParams.Block.MaxBytes,
+		LocalLastCommit: extCommit,
+	})
+	require.NoError(t, err)
+	require.Len(t, proposal.Txs, 1)
+
+	processed, err := app.ProcessProposal(&abci.ProcessProposalRequest{
+		Height:             syncHeight,
+		Time:               blockTime,
+		Txs:                proposal.Txs,
+		ProposedLastCommit: lastCommit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processed.Status)
+
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height:            syncHeight,
+		Time:              blockTime,
+		Txs:               proposal.Txs,
+		DecidedLastCommit: lastCommit,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	ctx := app.NewContext(true)
+	record, err := app.StakingKeeper.SymbioticSyncs.Get(ctx, syncHeight)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, record.BlockHash)
+	require.Len(t, record.Stakes, 1)
+	require.Equal(t, stake, record.Stakes[0].Stake)
+
+	bonded, err := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(pubKey.Address()))
+	require.NoError(t, err)
+	require.True(t, bonded.Tokens.GT(sdk.DefaultPowerReduction))
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
@@ -0,0 +1,60 @@
+package ethdevnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/x/symStaking/keeper"
+)
+
+// beaconHandler serves the beacon API endpoints the symStaking data source
+// reads.
+func (d *Devnet) beaconHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(keeper.BLOCK_PATH, d.serveBlock)
+	mux.HandleFunc(keeper.FINALITY_CHECKPOINTS_PATH, d.serveFinalityCheckpoints)
+	return mux
+}
+
+// serveBlock serves the block of a past slot, holding the last execution block
+// produced at or before the slot start. Future slots are not found.
+func (d *Devnet) serveBlock(w http.ResponseWriter, r *http.Request) {
+	slot, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, keeper.BLOCK_PATH), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid slot", http.StatusBadRequest)
+		return
+	}
+
+	timestamp := d.genesis + slot*d.config.SlotDuration
+	if slot < 0 || timestamp > time.Now().Unix() {
+		http.NotFound(w, r)
+		return
+	}
+
+	b, ok := d.blockAt(timestamp)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var res keeper.Block
+	res.Data.Message.Body.ExecutionPayload.BlockHash = b.hash.Hex()
+	writeJSON(w, res)
+}
+
+// serveFinalityCheckpoints serves the current epoch as finalized.
+func (d *Devnet) serveFinalityCheckpoints(w http.ResponseWriter, _ *http.Request) {
+	epoch := max(time.Now().Unix()-d.genesis, 0) / d.config.SlotDuration / d.config.SlotsInEpoch
+
+	var res keeper.FinalityCheckpoints
+	res.Data.Finalized.Epoch = strconv.FormatInt(epoch, 10)
+	writeJSON(w, res)
+}
+
+func writeJSON(w http.ResponseWriter, res any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
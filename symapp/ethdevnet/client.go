@@ -0,0 +1,60 @@
+package ethdevnet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Client is a client of the devnet JSON-RPC namespace of a running devnet.
+type Client struct {
+	rpc *rpc.Client
+}
+
+// Dial connects to the execution JSON-RPC endpoint of a devnet.
+func Dial(ctx context.Context, url string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{rpc: c}, nil
+}
+
+// Close closes the connection.
+func (c *Client) Close() {
+	c.rpc.Close()
+}
+
+// ValidatorSet returns the middleware validator set at the last execution
+// block.
+func (c *Client) ValidatorSet(ctx context.Context) ([]Validator, error) {
+	var res []rpcValidator
+	if err := c.rpc.CallContext(ctx, &res, "devnet_validatorSet"); err != nil {
+		return nil, err
+	}
+
+	validators := make([]Validator, len(res))
+	for i, v := range res {
+		validators[i] = Validator{ConsAddr: sdk.ConsAddress(v.ConsAddr), Stake: (*big.Int)(v.Stake)}
+	}
+
+	return validators, nil
+}
+
+// SetValidatorSet replaces the middleware validator set in a new execution
+// block and returns the block hash.
+func (c *Client) SetValidatorSet(ctx context.Context, validators []Validator) (common.Hash, error) {
+	args := make([]rpcValidator, len(validators))
+	for i, v := range validators {
+		args[i] = newRPCValidator(v)
+	}
+
+	var hash common.Hash
+	err := c.rpc.CallContext(ctx, &hash, "devnet_setValidatorSet", args)
+	return hash, err
+}
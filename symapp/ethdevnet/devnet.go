@@ -0,0 +1,306 @@
+// Package ethdevnet runs a local Ethereum devnet the symStaking module can sync
+// validator power from, for end-to-end tests of symapp without public
+// endpoints.
+//
+// The execution layer is an in-process JSON-RPC node serving the eth methods
+// the symStaking data source uses, with a SimpleMiddleware compatible
+// middleware at MiddlewareAddress whose validator set is replaced through the
+// devnet JSON-RPC namespace. The beacon layer is a fake beacon HTTP API: the
+// execution block of a slot is the last block produced at or before the slot
+// start, and every epoch up to the current one is finalized.
+//
+// The middleware is not an EVM contract: its eth_call answers are encoded from
+// the SimpleMiddleware ABI, and eth_getProof is not served, so the devnet
+// exercises the RPC data source but not the light client data source. The
+// go-ethereum simulated backend cannot be used instead while the workspace
+// pins github.com/cockroachdb/pebble v1.1.0 through cosmos-db: the
+// go-ethereum v1.13 ethdb/pebble package it depends on does not build against
+// it, and go-ethereum v1.14 requires a btcec version the CometBFT secp256k1
+// keys do not build against.
+package ethdevnet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+// Config is the configuration of a devnet.
+type Config struct {
+	// EthPort is the port of the execution JSON-RPC endpoint, a free port is
+	// picked if zero.
+	EthPort int
+	// BeaconPort is the port of the beacon HTTP API, a free port is picked if
+	// zero.
+	BeaconPort int
+	// SlotDuration is the duration of a slot, in seconds. An execution block
+	// is produced every slot.
+	SlotDuration int64
+	// SlotsInEpoch is the number of slots in an epoch.
+	SlotsInEpoch int64
+}
+
+// DefaultConfig returns the default devnet configuration: the usual execution
+// and beacon ports and short epochs, so that stake changes are finalized a few
+// seconds after they are made.
+func DefaultConfig() Config {
+	return Config{
+		EthPort:      8545,
+		BeaconPort:   5052,
+		SlotDuration: 1,
+		SlotsInEpoch: 2,
+	}
+}
+
+// Validate performs basic validation of the devnet configuration.
+func (c Config) Validate() error {
+	if c.EthPort < 0 || c.BeaconPort < 0 {
+		return fmt.Errorf("invalid devnet ports %d and %d", c.EthPort, c.BeaconPort)
+	}
+	if c.SlotDuration <= 0 {
+		return fmt.Errorf("devnet slot duration must be positive: %d", c.SlotDuration)
+	}
+	if c.SlotsInEpoch <= 0 {
+		return fmt.Errorf("devnet slots in epoch must be positive: %d", c.SlotsInEpoch)
+	}
+
+	return nil
+}
+
+// Devnet is a running local Ethereum devnet.
+type Devnet struct {
+	config  Config
+	genesis int64
+
+	eth    *http.Server
+	ethURL string
+
+	beacon    *http.Server
+	beaconURL string
+
+	mu     sync.Mutex
+	blocks []block
+	byHash map[common.Hash]int
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// New starts a devnet. The beacon chain and the execution genesis block start
+// at the current time, with an empty validator set.
+func New(config Config) (*Devnet, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	ethListener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", config.EthPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open devnet execution endpoint: %w", err)
+	}
+
+	beaconListener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", config.BeaconPort))
+	if err != nil {
+		_ = ethListener.Close()
+		return nil, fmt.Errorf("failed to open devnet beacon endpoint: %w", err)
+	}
+
+	d := &Devnet{
+		config:    config,
+		genesis:   time.Now().Unix(),
+		ethURL:    "http://" + ethListener.Addr().String(),
+		beaconURL: "http://" + beaconListener.Addr().String(),
+		byHash:    make(map[common.Hash]int),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	d.appendBlock(newBlock(nil, uint64(d.genesis), nil))
+
+	rpcServer := rpc.NewServer()
+	if err := errors.Join(
+		rpcServer.RegisterName("eth", &ethAPI{devnet: d}),
+		rpcServer.RegisterName("devnet", &devnetAPI{devnet: d}),
+	); err != nil {
+		return nil, errors.Join(err, ethListener.Close(), beaconListener.Close())
+	}
+
+	d.eth = &http.Server{Handler: rpcServer, ReadHeaderTimeout: 10 * time.Second}
+	d.beacon = &http.Server{Handler: d.beaconHandler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = d.eth.Serve(ethListener) }()
+	go func() { _ = d.beacon.Serve(beaconListener) }()
+	go d.produceBlocks()
+
+	return d, nil
+}
+
+// Close stops the devnet.
+func (d *Devnet) Close() error {
+	close(d.stop)
+	<-d.done
+
+	return errors.Join(d.eth.Close(), d.beacon.Close())
+}
+
+// EthURL returns the execution JSON-RPC endpoint.
+func (d *Devnet) EthURL() string {
+	return d.ethURL
+}
+
+// BeaconURL returns the beacon HTTP API endpoint.
+func (d *Devnet) BeaconURL() string {
+	return d.beaconURL
+}
+
+// GenesisTimestamp returns the unix time of the beacon chain genesis.
+func (d *Devnet) GenesisTimestamp() int64 {
+	return d.genesis
+}
+
+// HeadBlockHash returns the hash of the last execution block.
+func (d *Devnet) HeadBlockHash() common.Hash {
+	return d.head().hash
+}
+
+// ValidatorSet returns the middleware validator set at the last execution
+// block.
+func (d *Devnet) ValidatorSet() []Validator {
+	return d.head().validators
+}
+
+// SetValidatorSet replaces the middleware validator set in a new execution
+// block and returns the block hash.
+func (d *Devnet) SetValidatorSet(validators []Validator) (common.Hash, error) {
+	if err := validateValidators(validators); err != nil {
+		return common.Hash{}, err
+	}
+
+	return d.commit(append([]Validator{}, validators...)), nil
+}
+
+// SymbioticConfig returns the [symbiotic] app.toml config of a node syncing
+// from the devnet.
+func (d *Devnet) SymbioticConfig() stakingtypes.SymbioticConfig {
+	config := stakingtypes.DefaultSymbioticConfig()
+	config.BeaconAPIURLs = []string{d.beaconURL}
+	config.EthAPIURLs = []string{d.ethURL}
+	config.MiddlewareAddress = MiddlewareAddress.Hex()
+	config.Chain = stakingtypes.ChainDevnet
+
+	return config
+}
+
+// SetParams sets the symStaking params of a chain syncing from the devnet:
+// the devnet network and middleware, and the beacon chain timing.
+func (d *Devnet) SetParams(params *stakingtypes.Params) {
+	params.EthereumNetwork = stakingtypes.ChainDevnet
+	params.MiddlewareAddress = MiddlewareAddress.Hex()
+	params.BeaconGenesisTimestamp = d.genesis
+	params.SlotDuration = d.config.SlotDuration
+	params.SlotsInEpoch = d.config.SlotsInEpoch
+}
+
+// produceBlocks produces an execution block every slot until the devnet is
+// closed.
+func (d *Devnet) produceBlocks() {
+	defer close(d.done)
+
+	ticker := time.NewTicker(time.Duration(d.config.SlotDuration) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			d.commit(nil)
+		}
+	}
+}
+
+// commit produces an execution block with the given validator set, or the
+// validator set of the head if nil, and returns its hash. Block times never go
+// backwards.
+func (d *Devnet) commit(validators []Validator) common.Hash {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	parent := d.blocks[len(d.blocks)-1]
+	if validators == nil {
+		validators = parent.validators
+	}
+
+	b := newBlock(parent.header, max(uint64(time.Now().Unix()), parent.header.Time), validators)
+	d.blocks = append(d.blocks, b)
+	d.byHash[b.hash] = len(d.blocks) - 1
+
+	return b.hash
+}
+
+// appendBlock appends a block to the chain.
+func (d *Devnet) appendBlock(b block) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.blocks = append(d.blocks, b)
+	d.byHash[b.hash] = len(d.blocks) - 1
+}
+
+// head returns the last execution block.
+func (d *Devnet) head() block {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.blocks[len(d.blocks)-1]
+}
+
+// blockByHash returns the execution block with the given hash.
+func (d *Devnet) blockByHash(hash common.Hash) (block, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i, ok := d.byHash[hash]
+	if !ok {
+		return block{}, false
+	}
+
+	return d.blocks[i], true
+}
+
+// blockByNumber returns the execution block with the given number. Every block
+// is final, so the latest, safe, finalized and pending tags all return the
+// head.
+func (d *Devnet) blockByNumber(number rpc.BlockNumber) (block, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch {
+	case number < 0:
+		return d.blocks[len(d.blocks)-1], true
+	case int64(number) >= int64(len(d.blocks)):
+		return block{}, false
+	default:
+		return d.blocks[number], true
+	}
+}
+
+// blockAt returns the last execution block produced at or before the given
+// unix time.
+func (d *Devnet) blockAt(timestamp int64) (block, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := sort.Search(len(d.blocks), func(i int) bool { return int64(d.blocks[i].header.Time) > timestamp })
+	if i == 0 {
+		return block{}, false
+	}
+
+	return d.blocks[i-1], true
+}
@@ -0,0 +1,111 @@
+package ethdevnet_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/symapp/ethdevnet"
+	"cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func startDevnet(t *testing.T) *ethdevnet.Devnet {
+	t.Helper()
+
+	config := ethdevnet.DefaultConfig()
+	config.EthPort = 0
+	config.BeaconPort = 0
+
+	d, err := ethdevnet.New(config)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, d.Close()) })
+
+	return d
+}
+
+func TestDevnetDataSource(t *testing.T) {
+	d := startDevnet(t)
+	ctx := context.Background()
+
+	validators := []ethdevnet.Validator{
+		{ConsAddr: sdk.ConsAddress(make([]byte, 20)), Stake: big.NewInt(100_000_000)},
+		{ConsAddr: sdk.ConsAddress(append(make([]byte, 19), 1)), Stake: big.NewInt(2_000_000)},
+	}
+	hash, err := d.SetValidatorSet(validators)
+	require.NoError(t, err)
+	require.Equal(t, hash, d.HeadBlockHash())
+
+	ds := keeper.NewRPCDataSource(log.NewNopLogger(), d.SymbioticConfig())
+
+	block, err := ds.GetBlockByHash(ctx, hash.Hex())
+	require.NoError(t, err)
+	require.Equal(t, hash, block.Hash())
+
+	canonical, err := ds.GetBlockByNumber(ctx, block.Number())
+	require.NoError(t, err)
+	require.Equal(t, hash, canonical.Hash())
+
+	adapter, err := types.NewMiddlewareAdapter(types.MiddlewareABI{})
+	require.NoError(t, err)
+
+	set, err := ds.GetValidatorSet(ctx, ethdevnet.MiddlewareAddress.Hex(), hash.Hex(), adapter)
+	require.NoError(t, err)
+	require.Len(t, set, len(validators))
+	for i, v := range validators {
+		require.Equal(t, v.Stake, set[i].Stake)
+		require.Equal(t, []byte(v.ConsAddr), set[i].ConsAddr[:20])
+	}
+
+	// the block is served by the beacon API once the next slot has started
+	slot := int64(block.Time()) - d.GenesisTimestamp() + 1
+	var beaconHash string
+	require.Eventually(t, func() bool {
+		beaconHash, err = ds.GetBeaconBlockHash(ctx, slot)
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	epoch, err := ds.GetFinalizedEpoch(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, epoch, slot/ethdevnet.DefaultConfig().SlotsInEpoch)
+
+	canonical, err = ds.GetBlockByHash(ctx, beaconHash)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, canonical.NumberU64(), block.NumberU64())
+
+	_, err = ds.GetBeaconBlockHash(ctx, slot+1000)
+	require.ErrorIs(t, err, types.ErrSymbioticNotFound)
+}
+
+func TestClientValidatorSet(t *testing.T) {
+	d := startDevnet(t)
+	ctx := context.Background()
+
+	client, err := ethdevnet.Dial(ctx, d.EthURL())
+	require.NoError(t, err)
+	defer client.Close()
+
+	set, err := client.ValidatorSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, set)
+
+	validators := []ethdevnet.Validator{
+		{ConsAddr: sdk.ConsAddress(append(make([]byte, 19), 7)), Stake: big.NewInt(5_000_000)},
+	}
+	hash, err := client.SetValidatorSet(ctx, validators)
+	require.NoError(t, err)
+	require.Equal(t, d.HeadBlockHash(), hash)
+
+	set, err = client.ValidatorSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, validators, set)
+
+	_, err = client.SetValidatorSet(ctx, []ethdevnet.Validator{{ConsAddr: sdk.ConsAddress{1}, Stake: big.NewInt(1)}})
+	require.ErrorContains(t, err, "invalid consensus address length")
+}
@@ -0,0 +1,250 @@
+package ethdevnet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChainID is the chain id of the devnet execution layer.
+const ChainID = 1337
+
+// MiddlewareAddress is the address the devnet middleware is deployed at.
+var MiddlewareAddress = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+
+// middlewareEpoch is the epoch returned by getCurrentEpoch, the devnet
+// middleware has a single validator set.
+const middlewareEpoch = 1
+
+// middlewareABI is the SimpleMiddleware ABI the devnet middleware implements.
+var middlewareABI = func() abi.ABI {
+	a, err := abi.JSON(strings.NewReader(stakingtypes.SimpleMiddlewareABI))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}()
+
+var errExecutionReverted = errors.New("execution reverted")
+
+// Validator is an entry of the middleware validator set.
+type Validator struct {
+	ConsAddr sdk.ConsAddress
+	Stake    *big.Int
+}
+
+// validateValidators checks that every validator has a consensus address and
+// a non-negative stake.
+func validateValidators(validators []Validator) error {
+	for _, v := range validators {
+		if len(v.ConsAddr) != 20 {
+			return fmt.Errorf("invalid consensus address length %d", len(v.ConsAddr))
+		}
+		if v.Stake == nil || v.Stake.Sign() < 0 {
+			return fmt.Errorf("invalid stake of %s: %v", v.ConsAddr, v.Stake)
+		}
+	}
+
+	return nil
+}
+
+// validatorData is the SimpleMiddleware encoding of a Validator, the
+// consensus address is left aligned in a bytes32.
+type validatorData struct {
+	Stake    *big.Int
+	ConsAddr [32]byte
+}
+
+// block is an execution block of the devnet and the middleware validator set
+// at that block.
+type block struct {
+	header     *ethtypes.Header
+	hash       common.Hash
+	validators []Validator
+}
+
+// newBlock returns the child block of parent with the given validator set.
+// Blocks hold no transactions, only their number, time and parent matter.
+func newBlock(parent *ethtypes.Header, timestamp uint64, validators []Validator) block {
+	header := &ethtypes.Header{
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Root:        ethtypes.EmptyRootHash,
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: ethtypes.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int),
+		GasLimit:    30_000_000,
+		Time:        timestamp,
+		Extra:       []byte("symbiotic devnet"),
+	}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number.Add(parent.Number, big.NewInt(1))
+	}
+
+	return block{header: header, hash: header.Hash(), validators: validators}
+}
+
+// marshal returns the JSON-RPC representation of the block.
+func (b block) marshal() (map[string]json.RawMessage, error) {
+	bz, err := json.Marshal(b.header)
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, err
+	}
+	res["transactions"] = json.RawMessage("[]")
+	res["uncles"] = json.RawMessage("[]")
+
+	return res, nil
+}
+
+// call runs a read-only call on the middleware at the block.
+func (b block) call(to *common.Address, data []byte) (hexutil.Bytes, error) {
+	if to == nil || *to != MiddlewareAddress || len(data) < 4 {
+		return nil, errExecutionReverted
+	}
+
+	method, err := middlewareABI.MethodById(data[:4])
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	if _, err := method.Inputs.Unpack(data[4:]); err != nil {
+		return nil, errExecutionReverted
+	}
+
+	switch method.Name {
+	case "getCurrentEpoch":
+		return method.Outputs.Pack(big.NewInt(middlewareEpoch))
+	case "getValidatorSet":
+		validators := make([]validatorData, len(b.validators))
+		for i, v := range b.validators {
+			validators[i].Stake = v.Stake
+			copy(validators[i].ConsAddr[:], v.ConsAddr)
+		}
+		return method.Outputs.Pack(validators)
+	default:
+		return nil, errExecutionReverted
+	}
+}
+
+// ethAPI is the subset of the eth JSON-RPC namespace the symStaking data
+// source uses.
+type ethAPI struct {
+	devnet *Devnet
+}
+
+// callArgs are the eth_call arguments, only the target and input are used.
+type callArgs struct {
+	To    *common.Address `json:"to"`
+	Data  hexutil.Bytes   `json:"data"`
+	Input hexutil.Bytes   `json:"input"`
+}
+
+// ChainId implements eth_chainId.
+func (api *ethAPI) ChainId() hexutil.Uint64 { //nolint:revive,stylecheck // JSON-RPC method name
+	return ChainID
+}
+
+// BlockNumber implements eth_blockNumber.
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.devnet.head().header.Number.Uint64())
+}
+
+// GetBlockByHash implements eth_getBlockByHash, it returns null if the block
+// is not found.
+func (api *ethAPI) GetBlockByHash(hash common.Hash, _ bool) (map[string]json.RawMessage, error) {
+	b, ok := api.devnet.blockByHash(hash)
+	if !ok {
+		return nil, nil
+	}
+
+	return b.marshal()
+}
+
+// GetBlockByNumber implements eth_getBlockByNumber, it returns null if the
+// block is not found.
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, _ bool) (map[string]json.RawMessage, error) {
+	b, ok := api.devnet.blockByNumber(number)
+	if !ok {
+		return nil, nil
+	}
+
+	return b.marshal()
+}
+
+// Call implements eth_call.
+func (api *ethAPI) Call(args callArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	b, ok := api.devnet.head(), true
+	if blockNrOrHash != nil {
+		if hash, isHash := blockNrOrHash.Hash(); isHash {
+			b, ok = api.devnet.blockByHash(hash)
+		} else if number, isNumber := blockNrOrHash.Number(); isNumber {
+			b, ok = api.devnet.blockByNumber(number)
+		}
+	}
+	if !ok {
+		return nil, errors.New("header not found")
+	}
+
+	data := args.Input
+	if len(data) == 0 {
+		data = args.Data
+	}
+
+	return b.call(args.To, data)
+}
+
+// devnetAPI is the devnet JSON-RPC namespace, it reads and replaces the
+// middleware validator set.
+type devnetAPI struct {
+	devnet *Devnet
+}
+
+// rpcValidator is the JSON-RPC representation of a Validator.
+type rpcValidator struct {
+	ConsAddr hexutil.Bytes `json:"consAddr"`
+	Stake    *hexutil.Big  `json:"stake"`
+}
+
+func newRPCValidator(v Validator) rpcValidator {
+	return rpcValidator{ConsAddr: hexutil.Bytes(v.ConsAddr), Stake: (*hexutil.Big)(v.Stake)}
+}
+
+// ValidatorSet implements devnet_validatorSet.
+func (api *devnetAPI) ValidatorSet() []rpcValidator {
+	validators := api.devnet.ValidatorSet()
+
+	res := make([]rpcValidator, len(validators))
+	for i, v := range validators {
+		res[i] = newRPCValidator(v)
+	}
+
+	return res
+}
+
+// SetValidatorSet implements devnet_setValidatorSet, it returns the hash of
+// the block the validator set is replaced at.
+func (api *devnetAPI) SetValidatorSet(validators []rpcValidator) (common.Hash, error) {
+	set := make([]Validator, len(validators))
+	for i, v := range validators {
+		set[i] = Validator{ConsAddr: sdk.ConsAddress(v.ConsAddr), Stake: (*big.Int)(v.Stake)}
+	}
+
+	return api.devnet.SetValidatorSet(set)
+}
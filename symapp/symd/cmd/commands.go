@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"syscall"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/client/v2/offchain"
 	"cosmossdk.io/log"
@@ -45,7 +50,10 @@ func initRootCmd(
 		snapshot.Cmd(newApp),
 	)
 
-	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{})
+	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{
+		PostSetup:           haltOnSymbioticSyncFailure,
+		PostSetupStandalone: haltOnSymbioticSyncFailure,
+	})
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
@@ -58,6 +66,32 @@ func initRootCmd(
 	)
 }
 
+// haltOnSymbioticSyncFailure shuts the node down when the staking keeper
+// requests a halt on a Symbiotic sync failure. The quit signal goes through the
+// regular graceful shutdown, the returned error makes symd exit with a non-zero
+// code.
+func haltOnSymbioticSyncFailure(app servertypes.Application, svrCtx *server.Context, _ client.Context, ctx context.Context, g *errgroup.Group) error {
+	symApp, ok := app.(*symapp.SymApp)
+	if !ok {
+		return nil
+	}
+
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-symApp.StakingKeeper.HaltCh():
+			svrCtx.Logger.Error("halting node on symbiotic sync failure", "err", err)
+			if p, pErr := os.FindProcess(os.Getpid()); pErr == nil {
+				_ = p.Signal(syscall.SIGTERM)
+			}
+			return fmt.Errorf("node halted on symbiotic sync failure: %w", err)
+		}
+	})
+
+	return nil
+}
+
 // genesisCommand builds genesis-related `symd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(moduleManager *module.Manager, appExport servertypes.AppExporter, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(moduleManager.Modules[genutiltypes.ModuleName].(genutil.AppModule), moduleManager, appExport)
@@ -5,6 +5,8 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
 	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -68,25 +70,24 @@ gas-adjustment = {{ .GasConfig.GasAdjustment }}
 	return customClientConfigTemplate, customClientConfig
 }
 
-// initAppConfig helps to override default appConfig template and configs.
-// return "", nil if no custom configuration is required for the application.
-func initAppConfig() (string, interface{}) {
-	// The following code snippet is just for reference.
-
-	// CustomConfig defines an arbitrary custom config to extend app.toml.
-	// If you don't need it, you can remove it.
-	// If you wish to add fields that correspond to flags that aren't in the SDK server config,
-	// this custom config can as well help.
-	type CustomConfig struct {
-		CustomField string `mapstructure:"custom-field"`
-	}
+// CustomAppConfig is the app.toml config of symd: the server config and the
+// [symbiotic] section.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
 
-	type CustomAppConfig struct {
-		serverconfig.Config `mapstructure:",squash"`
+	// Symbiotic holds the beacon and execution endpoints and the middleware
+	// address the symStaking module syncs validator power from.
+	Symbiotic stakingtypes.SymbioticConfig `mapstructure:"symbiotic"`
+}
 
-		Custom CustomConfig `mapstructure:"custom"`
-	}
+// customAppConfigTemplate returns the app.toml template of CustomAppConfig.
+func customAppConfigTemplate() string {
+	return serverconfig.DefaultConfigTemplate + stakingtypes.DefaultSymbioticConfigTemplate
+}
 
+// initAppConfig helps to override default appConfig template and configs.
+// return "", nil if no custom configuration is required for the application.
+func initAppConfig() (string, interface{}) {
 	// Optionally allow the chain developer to overwrite the SDK's default
 	// server config.
 	srvCfg := serverconfig.DefaultConfig()
@@ -106,21 +107,15 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	// Now we set the custom config default values.
+	// The Symbiotic endpoints and middleware address are left empty on purpose,
+	// the node refuses to start until the operator sets them.
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
-		Custom: CustomConfig{
-			CustomField: "anything",
-		},
+		Config:    *srvCfg,
+		Symbiotic: stakingtypes.DefaultSymbioticConfig(),
 	}
 
 	// The default SDK app template is defined in serverconfig.DefaultConfigTemplate.
-	// We append the custom config template to the default one.
+	// We append the Symbiotic config template to the default one.
 	// And we set the default config to the custom app template.
-	customAppTemplate := serverconfig.DefaultConfigTemplate + `
-[custom]
-# That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
-# Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
-
-	return customAppTemplate, customAppConfig
+	return customAppConfigTemplate(), customAppConfig
 }
@@ -114,6 +114,7 @@ func NewTestnetCmd(mm *module.Manager) *cobra.Command {
 
 	testnetCmd.AddCommand(testnetStartCmd())
 	testnetCmd.AddCommand(testnetInitFilesCmd(mm))
+	testnetCmd.AddCommand(testnetDevnetCmd())
 
 	return testnetCmd
 }
@@ -378,11 +379,13 @@ func initTestnetFiles(
 			return err
 		}
 
-		if err := srvconfig.SetConfigTemplate(srvconfig.DefaultConfigTemplate); err != nil {
+		if err := srvconfig.SetConfigTemplate(customAppConfigTemplate()); err != nil {
 			return err
 		}
 
-		if err := srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig); err != nil {
+		// the [symbiotic] section is left to the operator, or to `testnet devnet start`
+		customAppConfig := CustomAppConfig{Config: *appConfig, Symbiotic: stakingtypes.DefaultSymbioticConfig()}
+		if err := srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), customAppConfig); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/creachadair/tomledit"
+	"github.com/creachadair/tomledit/parser"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/symapp/ethdevnet"
+	"cosmossdk.io/tools/confix"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+var (
+	flagStake        = "stake"
+	flagEthPort      = "eth-port"
+	flagBeaconPort   = "beacon-port"
+	flagSlotDuration = "slot-duration"
+	flagSlotsInEpoch = "slots-in-epoch"
+	flagEthURL       = "eth-url"
+)
+
+type devnetArgs struct {
+	outputDir      string
+	nodeDirPrefix  string
+	nodeDaemonHome string
+	numValidators  int
+	stake          *big.Int
+	config         ethdevnet.Config
+}
+
+// testnetDevnetCmd returns a cmd to run a local Ethereum devnet the testnet
+// nodes sync validator power from
+func testnetDevnetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "devnet",
+		Short:                      "subcommands for running a local Ethereum devnet for a testnet",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(testnetDevnetStartCmd())
+	cmd.AddCommand(testnetDevnetSetStakeCmd())
+
+	return cmd
+}
+
+// testnetDevnetStartCmd returns a cmd to start a local Ethereum devnet for the
+// node directories created by init-files
+func testnetDevnetStartCmd() *cobra.Command {
+	defaultConfig := ethdevnet.DefaultConfig()
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Launch a local Ethereum devnet the validators of an init-files testnet sync their power from",
+		Long: fmt.Sprintf(`start launches an in-process Ethereum execution node and beacon API with a
+Symbiotic middleware holding every testnet validator with the same stake.
+
+The genesis of every node is updated to sync from the devnet: symStaking params,
+symGenutil init block hash and vote extensions. The [symbiotic] section of every
+app.toml is pointed at the devnet. The devnet runs until interrupted, start the
+nodes once it is ready and change stakes with "testnet devnet set-stake".
+
+Example:
+	%s testnet init-files --validator-count 4 --output-dir ./.testnets --single-host
+	%s testnet devnet start --validator-count 4 --output-dir ./.testnets
+	`, version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			args := devnetArgs{}
+			args.outputDir, _ = cmd.Flags().GetString(flagOutputDir)
+			args.nodeDirPrefix, _ = cmd.Flags().GetString(flagNodeDirPrefix)
+			args.nodeDaemonHome, _ = cmd.Flags().GetString(flagNodeDaemonHome)
+			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
+			args.config.EthPort, _ = cmd.Flags().GetInt(flagEthPort)
+			args.config.BeaconPort, _ = cmd.Flags().GetInt(flagBeaconPort)
+			args.config.SlotDuration, _ = cmd.Flags().GetInt64(flagSlotDuration)
+			args.config.SlotsInEpoch, _ = cmd.Flags().GetInt64(flagSlotsInEpoch)
+
+			stake, _ := cmd.Flags().GetString(flagStake)
+			var ok bool
+			if args.stake, ok = new(big.Int).SetString(stake, 10); !ok || args.stake.Sign() < 0 {
+				return fmt.Errorf("invalid stake %q", stake)
+			}
+
+			return startDevnet(cmd, clientCtx.Codec, args)
+		},
+	}
+
+	cmd.Flags().IntP(flagNumValidators, "n", 4, "Number of validators of the testnet")
+	cmd.Flags().StringP(flagOutputDir, "o", "./.testnets", "Directory the testnet was initialized in")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix for the name of per-validator subdirectories (to be number-suffixed like node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, "symd", "Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagStake, "100000000", "Initial middleware stake of every validator")
+	cmd.Flags().Int(flagEthPort, defaultConfig.EthPort, "Port of the execution JSON-RPC endpoint")
+	cmd.Flags().Int(flagBeaconPort, defaultConfig.BeaconPort, "Port of the beacon HTTP API")
+	cmd.Flags().Int64(flagSlotDuration, defaultConfig.SlotDuration, "Duration of a slot in seconds")
+	cmd.Flags().Int64(flagSlotsInEpoch, defaultConfig.SlotsInEpoch, "Number of slots in an epoch")
+
+	return cmd
+}
+
+// testnetDevnetSetStakeCmd returns a cmd to change the middleware stake of a
+// validator on a running devnet
+func testnetDevnetSetStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-stake [consensus-address] [stake]",
+		Short: "Set the middleware stake of a validator on a running devnet",
+		Long: fmt.Sprintf(`set-stake replaces the middleware stake of a validator in a new devnet
+execution block, adding the validator if it is not in the middleware yet. The
+testnet applies it at the first sync after the block is finalized.
+
+Example:
+	%s testnet devnet set-stake cosmosvalcons1... 300000000
+	`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			consAddr, err := clientCtx.ConsensusAddressCodec.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			stake, ok := new(big.Int).SetString(args[1], 10)
+			if !ok || stake.Sign() < 0 {
+				return fmt.Errorf("invalid stake %q", args[1])
+			}
+
+			ethURL, _ := cmd.Flags().GetString(flagEthURL)
+			devnet, err := ethdevnet.Dial(cmd.Context(), ethURL)
+			if err != nil {
+				return err
+			}
+			defer devnet.Close()
+
+			validators, err := devnet.ValidatorSet(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			found := false
+			for i, v := range validators {
+				if v.ConsAddr.Equals(sdk.ConsAddress(consAddr)) {
+					validators[i].Stake, found = stake, true
+				}
+			}
+			if !found {
+				validators = append(validators, ethdevnet.Validator{ConsAddr: consAddr, Stake: stake})
+			}
+
+			hash, err := devnet.SetValidatorSet(cmd.Context(), validators)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Set stake of %s to %s at block %s\n", args[0], stake, hash.Hex())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEthURL, fmt.Sprintf("http://127.0.0.1:%d", ethdevnet.DefaultConfig().EthPort), "Execution JSON-RPC endpoint of the devnet")
+
+	return cmd
+}
+
+// startDevnet starts a devnet holding the testnet validators, points the
+// testnet nodes at it and runs it until interrupted
+func startDevnet(cmd *cobra.Command, cdc codec.Codec, args devnetArgs) error {
+	nodeDirs := make([]string, args.numValidators)
+	validators := make([]ethdevnet.Validator, args.numValidators)
+	for i := range nodeDirs {
+		nodeDirs[i] = filepath.Join(args.outputDir, fmt.Sprintf("%s%d", args.nodeDirPrefix, i), args.nodeDaemonHome)
+
+		consAddr, err := readConsAddress(nodeDirs[i])
+		if err != nil {
+			return err
+		}
+		validators[i] = ethdevnet.Validator{ConsAddr: consAddr, Stake: args.stake}
+	}
+
+	devnet, err := ethdevnet.New(args.config)
+	if err != nil {
+		return err
+	}
+	defer devnet.Close()
+
+	initBlockHash, err := devnet.SetValidatorSet(validators)
+	if err != nil {
+		return err
+	}
+
+	for _, nodeDir := range nodeDirs {
+		if err := updateDevnetGenesis(cdc, nodeDir, devnet, initBlockHash); err != nil {
+			return err
+		}
+		if err := updateDevnetAppConfig(nodeDir, devnet.SymbioticConfig()); err != nil {
+			return err
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd.Printf("Devnet ready: execution %s, beacon %s, init block %s\n", devnet.EthURL(), devnet.BeaconURL(), initBlockHash.Hex())
+	<-ctx.Done()
+
+	return nil
+}
+
+// readConsAddress returns the consensus address of the validator key of a node
+func readConsAddress(nodeDir string) (sdk.ConsAddress, error) {
+	nodeConfig := cmtconfig.DefaultConfig()
+	nodeConfig.SetRoot(nodeDir)
+
+	bz, err := os.ReadFile(nodeConfig.PrivValidatorKeyFile())
+	if err != nil {
+		return nil, err
+	}
+
+	var key privval.FilePVKey
+	if err := cmtjson.Unmarshal(bz, &key); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", nodeConfig.PrivValidatorKeyFile(), err)
+	}
+
+	return sdk.ConsAddress(key.PubKey.Address()), nil
+}
+
+// updateDevnetGenesis sets the genesis of a node to sync from the devnet,
+// starting with the validator set at initBlockHash
+func updateDevnetGenesis(cdc codec.Codec, nodeDir string, devnet *ethdevnet.Devnet, initBlockHash common.Hash) error {
+	nodeConfig := cmtconfig.DefaultConfig()
+	nodeConfig.SetRoot(nodeDir)
+	genFile := nodeConfig.GenesisFile()
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return err
+	}
+
+	var stakingGenState stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState); err != nil {
+		return err
+	}
+	devnet.SetParams(&stakingGenState.Params)
+	if err := stakingGenState.Params.Validate(); err != nil {
+		return err
+	}
+	if appState[stakingtypes.ModuleName], err = cdc.MarshalJSON(&stakingGenState); err != nil {
+		return err
+	}
+
+	genutilGenState := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	genutilGenState.InitBlockHash = initBlockHash.Hex()
+	appState = genutiltypes.SetGenesisStateInAppState(cdc, appState, genutilGenState)
+
+	if appGenesis.AppState, err = json.MarshalIndent(appState, "", "  "); err != nil {
+		return err
+	}
+
+	// validator sets are agreed on through vote extensions
+	if appGenesis.Consensus == nil {
+		appGenesis.Consensus = &genutiltypes.ConsensusGenesis{}
+	}
+	if appGenesis.Consensus.Params == nil {
+		appGenesis.Consensus.Params = cmttypes.DefaultConsensusParams()
+	}
+	if appGenesis.Consensus.Params.Feature.VoteExtensionsEnableHeight == 0 {
+		appGenesis.Consensus.Params.Feature.VoteExtensionsEnableHeight = 1
+	}
+
+	return appGenesis.SaveAs(genFile)
+}
+
+// updateDevnetAppConfig points the [symbiotic] section of the app.toml of a
+// node at the devnet
+func updateDevnetAppConfig(nodeDir string, config stakingtypes.SymbioticConfig) error {
+	appConfigFile := filepath.Join(nodeDir, "config", "app.toml")
+
+	doc, err := confix.LoadConfig(appConfigFile)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{
+		"beacon-api-urls":    tomlStrings(config.BeaconAPIURLs),
+		"eth-api-urls":       tomlStrings(config.EthAPIURLs),
+		"middleware-address": fmt.Sprintf("%q", config.MiddlewareAddress),
+		"chain":              fmt.Sprintf("%q", config.Chain),
+	}
+	for key, value := range values {
+		entry := doc.First("symbiotic", key)
+		if entry == nil {
+			return fmt.Errorf("%s has no symbiotic.%s entry, re-create it with init-files", appConfigFile, key)
+		}
+		if entry.Value, err = parser.ParseValue(value); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tomledit.Format(&buf, doc); err != nil {
+		return err
+	}
+
+	return os.WriteFile(appConfigFile, buf.Bytes(), 0o600)
+}
+
+func tomlStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
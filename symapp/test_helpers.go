@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -19,10 +21,13 @@ import (
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 	minttypes "cosmossdk.io/x/mint/types"
+	stakingtestutil "cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -40,11 +45,33 @@ type SetupOptions struct {
 	AppOpts servertypes.AppOptions
 }
 
+// TestSymbioticMiddlewareAddress is the middleware address of the test
+// [symbiotic] config.
+const TestSymbioticMiddlewareAddress = "0x0000000000000000000000000000000000000001"
+
+// NewTestAppOptions returns the app options of a test symapp with the given
+// home: a valid [symbiotic] config and the given data source, so that the
+// Symbiotic sync runs offline. An empty in-memory data source is used if none
+// is given.
+func NewTestAppOptions(home string, dataSource stakingtypes.SymbioticDataSource) simtestutil.AppOptionsMap {
+	if dataSource == nil {
+		dataSource = stakingtestutil.NewInMemoryDataSource()
+	}
+
+	return simtestutil.AppOptionsMap{
+		flags.FlagHome:                           home,
+		stakingtypes.FlagSymbioticBeaconAPIURLs:  []string{"http://127.0.0.1:5052"},
+		stakingtypes.FlagSymbioticEthAPIURLs:     []string{"http://127.0.0.1:8545"},
+		stakingtypes.FlagSymbioticMiddlewareAddr: TestSymbioticMiddlewareAddress,
+		stakingtypes.FlagSymbioticChain:          stakingtypes.ChainHolesky,
+		FlagSymbioticDataSource:                  dataSource,
+	}
+}
+
 func setup(withGenesis bool, invCheckPeriod uint) (*SymApp, GenesisState) {
 	db := dbm.NewMemDB()
 
-	appOptions := make(simtestutil.AppOptionsMap, 0)
-	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions := NewTestAppOptions(DefaultNodeHome, nil)
 	appOptions[server.FlagInvCheckPeriod] = invCheckPeriod
 
 	app := NewSymApp(coretesting.NewNopLogger(), db, nil, true, appOptions)
@@ -54,6 +81,42 @@ func setup(withGenesis bool, invCheckPeriod uint) (*SymApp, GenesisState) {
 	return app, GenesisState{}
 }
 
+// genesisStateWithValSet returns genesisState with the given validator set and
+// genesis accounts. Every validator is created in x/symStaking with the tokens
+// of one consensus power and bonded by InitGenesis, which runs the bonding
+// hooks. The staking params point at the middleware of the test [symbiotic]
+// config.
+func genesisStateWithValSet(
+	t *testing.T,
+	app *SymApp,
+	genesisState GenesisState,
+	valSet *cmttypes.ValidatorSet,
+	genAccs []authtypes.GenesisAccount,
+	balances ...banktypes.Balance,
+) GenesisState {
+	t.Helper()
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, genAccs, balances...)
+	require.NoError(t, err)
+
+	stakingGenesis := stakingtypes.GetGenesisStateFromAppState(app.AppCodec(), genesisState)
+	stakingGenesis.Params.MiddlewareAddress = TestSymbioticMiddlewareAddress
+	for _, val := range valSet.Validators {
+		pk, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
+		require.NoError(t, err)
+		valAddr, err := app.StakingKeeper.ValidatorAddressCodec().BytesToString(val.Address)
+		require.NoError(t, err)
+
+		validator, err := stakingtypes.NewValidator(valAddr, pk, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Tokens = sdk.DefaultPowerReduction
+		stakingGenesis.Validators = append(stakingGenesis.Validators, validator)
+	}
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	return genesisState
+}
+
 // NewSymAppWithCustomOptions initializes a new symapp with custom options.
 func NewSymAppWithCustomOptions(t *testing.T, isCheckTx bool, options SetupOptions) *SymApp {
 	t.Helper()
@@ -74,9 +137,7 @@ func NewSymAppWithCustomOptions(t *testing.T, isCheckTx bool, options SetupOptio
 	}
 
 	app := NewSymApp(options.Logger, options.DB, nil, true, options.AppOpts)
-	genesisState := app.DefaultGenesis()
-	genesisState, err = simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, []authtypes.GenesisAccount{acc}, balance)
-	require.NoError(t, err)
+	genesisState := genesisStateWithValSet(t, app, app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
 
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
@@ -128,8 +189,7 @@ func SetupWithGenesisValSet(t *testing.T, valSet *cmttypes.ValidatorSet, genAccs
 	t.Helper()
 
 	app, genesisState := setup(true, 5)
-	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, genAccs, balances...)
-	require.NoError(t, err)
+	genesisState = genesisStateWithValSet(t, app, genesisState, valSet, genAccs, balances...)
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 	require.NoError(t, err)
@@ -177,11 +237,7 @@ func GenesisStateWithSingleValidator(t *testing.T, app *SymApp) GenesisState {
 		},
 	}
 
-	genesisState := app.DefaultGenesis()
-	genesisState, err = simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, []authtypes.GenesisAccount{acc}, balances...)
-	require.NoError(t, err)
-
-	return genesisState
+	return genesisStateWithValSet(t, app, app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balances...)
 }
 
 // AddTestAddrsIncremental constructs and returns accNum amount of accounts with an
@@ -218,7 +274,20 @@ func initAccountWithCoins(app *SymApp, ctx sdk.Context, addr sdk.AccAddress, coi
 	}
 }
 
-// NewTestNetworkFixture returns a new symapp AppConstructor for network simulation tests
+// testNetworkMnemonics are the validator mnemonics of the test network, the
+// consensus keys of its genesis validators are derived from them.
+var testNetworkMnemonics = []string{
+	"laugh tackle ill cheap furnace impose rookie media critic primary draft dynamic guitar asset speak column mosquito over coast cigar help online initial profit",
+	"table secret adjust inform often toilet brother bar virtual boil plate east raven brown cluster shove match company nurse video divert inside evidence voice",
+	"shadow moral rule couch borrow border merit taxi bless tool cousin giggle post side hen attack catch wear discover chaos need awkward hobby cause",
+	"main order palm saddle suspect voyage core tray this vote post female repeat chuckle alley nut narrow bleak almost perfect pupil explain huge fragile",
+}
+
+// NewTestNetworkFixture returns a new symapp AppConstructor for network simulation tests.
+// The genesis validators are the validators of testNetworkMnemonics, with the
+// tokens of one consensus power each, and vote extensions are enabled for the
+// Symbiotic syncs. The nodes share an in-memory data source seeded with the
+// same validator set, so that the syncs keep it.
 func NewTestNetworkFixture() network.TestFixture {
 	dir, err := os.MkdirTemp("", "symapp")
 	if err != nil {
@@ -226,26 +295,66 @@ func NewTestNetworkFixture() network.TestFixture {
 	}
 	defer os.RemoveAll(dir)
 
-	app := NewSymApp(coretesting.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(dir))
+	app := NewSymApp(coretesting.NewNopLogger(), dbm.NewMemDB(), nil, true, NewTestAppOptions(dir, nil))
+
+	// a finalized execution block holding the genesis validator set
+	now := time.Now()
+	dataSource := stakingtestutil.NewInMemoryDataSource()
+	blockHash := dataSource.AddBlock(1, uint64(now.Add(-time.Minute).Unix()))
+	dataSource.SetBeaconBlockHash(0, blockHash)
+	dataSource.SetFinalizedEpoch(now.Unix() / stakingtypes.DefaultSlotDuration / stakingtypes.DefaultSlotsInEpoch)
 
 	appCtr := func(val network.ValidatorI) servertypes.Application {
 		return NewSymApp(
 			val.GetLogger(), dbm.NewMemDB(), nil, true,
-			simtestutil.NewAppOptionsWithFlagHome(client.GetConfigFromViper(val.GetViper()).RootDir),
+			NewTestAppOptions(client.GetConfigFromViper(val.GetViper()).RootDir, dataSource),
 			bam.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
 			bam.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
 			bam.SetChainID(val.GetViper().GetString(flags.FlagChainID)),
 		)
 	}
 
+	genesisState := app.DefaultGenesis()
+	stakingGenesis := stakingtypes.GetGenesisStateFromAppState(app.AppCodec(), genesisState)
+	stakingGenesis.Params.MiddlewareAddress = TestSymbioticMiddlewareAddress
+
+	symbioticValidators := make([]stakingtypes.SymbioticValidator, len(testNetworkMnemonics))
+	for i, mnemonic := range testNetworkMnemonics {
+		pk, err := cryptocodec.FromCmtPubKeyInterface(cmted25519.GenPrivKeyFromSecret([]byte(mnemonic)).PubKey())
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := app.StakingKeeper.ValidatorAddressCodec().BytesToString(pk.Address())
+		if err != nil {
+			panic(err)
+		}
+
+		validator, err := stakingtypes.NewValidator(valAddr, pk, stakingtypes.Description{})
+		if err != nil {
+			panic(err)
+		}
+		validator.Tokens = sdk.DefaultPowerReduction
+		stakingGenesis.Validators = append(stakingGenesis.Validators, validator)
+
+		symbioticValidators[i].Stake = validator.Tokens.BigInt()
+		copy(symbioticValidators[i].ConsAddr[:], pk.Address())
+	}
+	dataSource.SetValidatorSet(blockHash, symbioticValidators)
+	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
+
+	consensusParams := cmttypes.DefaultConsensusParams()
+	consensusParams.Feature.VoteExtensionsEnableHeight = 1
+
 	return network.TestFixture{
 		AppConstructor: appCtr,
-		GenesisState:   app.DefaultGenesis(),
+		GenesisState:   genesisState,
 		EncodingConfig: testutil.TestEncodingConfig{
 			InterfaceRegistry: app.InterfaceRegistry(),
 			Codec:             app.AppCodec(),
 			TxConfig:          app.TxConfig(),
 			Amino:             app.LegacyAmino(),
 		},
+		Mnemonics:       testNetworkMnemonics,
+		ConsensusParams: consensusParams,
 	}
 }
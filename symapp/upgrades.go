@@ -2,6 +2,8 @@ package symapp
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
@@ -9,6 +11,8 @@ import (
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	epochstypes "cosmossdk.io/x/epochs/types"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
@@ -22,16 +26,35 @@ import (
 // v0.50.x to v0.51.x.
 const UpgradeName = "v050-to-v051"
 
+// symbioticUpgradeInfo is the JSON upgrade plan info of a chain upgrading from
+// x/symStaking consensus version 6, whose nodes read the Symbiotic middleware
+// address from their environment.
+type symbioticUpgradeInfo struct {
+	MiddlewareAddress string `json:"symbiotic_middleware_address"`
+}
+
 func (app SymApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
-		func(ctx context.Context, _ upgradetypes.Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
+		func(ctx context.Context, plan upgradetypes.Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
 			// sync accounts and auth module account number
 			err := authkeeper.MigrateAccountNumberUnsafe(ctx, &app.AuthKeeper)
 			if err != nil {
 				return nil, err
 			}
 
+			// the symStaking migration to consensus version 7 needs the
+			// middleware address, carried by the plan info
+			if version, ok := fromVM[stakingtypes.ModuleName]; ok && version <= 6 {
+				var info symbioticUpgradeInfo
+				if err := json.Unmarshal([]byte(plan.Info), &info); err != nil {
+					return nil, fmt.Errorf("invalid upgrade plan info: %w", err)
+				}
+				if err := stakingkeeper.NewMigrator(app.StakingKeeper).SetMiddlewareAddress(ctx, info.MiddlewareAddress); err != nil {
+					return nil, err
+				}
+			}
+
 			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
 		},
 	)
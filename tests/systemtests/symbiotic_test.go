@@ -0,0 +1,115 @@
+//go:build system_test
+
+package systemtests
+
+import (
+	"bufio"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSymbioticStakeChange(t *testing.T) {
+	// Scenario:
+	// start a local Ethereum devnet holding every validator with the same stake
+	// raise the stake of a validator on the devnet
+	// the validator power changes on CometBFT, first by the share of the
+	// max_power_change_rate param, and the other validators keep theirs
+	// lower the stake back, the validator power follows
+	if GetExecutableName() != "symd" {
+		t.Skip("the Ethereum devnet is only supported by symd")
+	}
+
+	sut.ResetChain(t)
+	startEthDevnet(t, sut)
+	sut.MarkDirty()
+	sut.StartChain(t)
+
+	validators := sut.RPCClient(t).Validators()
+	require.Len(t, validators, sut.nodesCount)
+	for _, v := range validators {
+		require.Equal(t, int64(100), v.VotingPower)
+	}
+
+	consAddr := sdk.ConsAddress(validators[0].Address).String()
+	votingPowers := func() map[string]int64 {
+		powers := make(map[string]int64)
+		for _, v := range sut.RPCClient(t).Validators() {
+			powers[sdk.ConsAddress(v.Address).String()] = v.VotingPower
+		}
+		return powers
+	}
+	waitPower := func(changed func(power int64) bool) int64 {
+		var power int64
+		require.Eventually(t, func() bool {
+			power = votingPowers()[consAddr]
+			return changed(power)
+		}, 50*sut.blockTime, sut.blockTime/2)
+		return power
+	}
+
+	runShellCmd(t, sut.execBinary, "testnet", "devnet", "set-stake", consAddr, "300000000")
+
+	// the first change is limited to 30% of the total power
+	power := waitPower(func(power int64) bool { return power != 100 })
+	require.Greater(t, power, int64(100))
+	require.Less(t, power, int64(300))
+
+	waitPower(func(power int64) bool { return power == 300 })
+	for addr, p := range votingPowers() {
+		if addr != consAddr {
+			require.Equal(t, int64(100), p, addr)
+		}
+	}
+
+	runShellCmd(t, sut.execBinary, "testnet", "devnet", "set-stake", consAddr, "100000000")
+	waitPower(func(power int64) bool { return power == 100 })
+}
+
+// startEthDevnet runs `testnet devnet start` for the testnet nodes until the
+// test ends.
+func startEthDevnet(t *testing.T, s *SystemUnderTest) {
+	t.Helper()
+
+	cmd := exec.Command( //nolint:gosec // used by tests only
+		locateExecutable(s.execBinary),
+		"testnet", "devnet", "start",
+		"--validator-count="+strconv.Itoa(s.nodesCount),
+		"--output-dir="+filepath.Join(WorkDir, s.outputDir),
+		"--node-daemon-home="+s.projectName,
+	)
+	cmd.Dir = WorkDir
+	out, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	cmd.Stderr = cmd.Stdout
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Signal(os.Interrupt)
+		_ = cmd.Wait()
+	})
+
+	ready := make(chan struct{})
+	go func() {
+		scanner := bufio.NewScanner(out)
+		for scanner.Scan() {
+			s.Logf("devnet: %s\n", scanner.Text())
+			if strings.HasPrefix(scanner.Text(), "Devnet ready") {
+				close(ready)
+			}
+		}
+	}()
+
+	select {
+	case <-ready:
+	case <-time.After(10 * time.Second):
+		t.Fatal("devnet did not start")
+	}
+}
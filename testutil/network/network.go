@@ -18,6 +18,7 @@ import (
 	"time"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -89,9 +90,11 @@ type (
 )
 
 type TestFixture struct {
-	AppConstructor AppConstructor
-	GenesisState   map[string]json.RawMessage
-	EncodingConfig moduletestutil.TestEncodingConfig
+	AppConstructor  AppConstructor
+	GenesisState    map[string]json.RawMessage
+	EncodingConfig  moduletestutil.TestEncodingConfig
+	Mnemonics       []string                  // validator mnemonics the genesis state is built for, if any
+	ConsensusParams *cmttypes.ConsensusParams // genesis consensus params, CometBFT defaults if nil
 }
 
 // Config defines the necessary configuration used to bootstrap and start an
@@ -105,6 +108,7 @@ type Config struct {
 	AccountRetriever client.AccountRetriever
 	AppConstructor   AppConstructor             // the ABCI application constructor
 	GenesisState     map[string]json.RawMessage // custom genesis state to provide
+	ConsensusParams  *cmttypes.ConsensusParams  // custom genesis consensus params, CometBFT defaults if nil
 	GenesisTime      time.Time                  // the genesis time
 	TimeoutCommit    time.Duration              // the consensus commitment timeout
 	ChainID          string                     // the network chain-id
@@ -144,6 +148,8 @@ func DefaultConfig(factory TestFixtureFactory) Config {
 		AccountRetriever:      authtypes.AccountRetriever{},
 		AppConstructor:        fixture.AppConstructor,
 		GenesisState:          fixture.GenesisState,
+		ConsensusParams:       fixture.ConsensusParams,
+		Mnemonics:             fixture.Mnemonics,
 		TimeoutCommit:         2 * time.Second,
 		ChainID:               "chain-" + unsafe.Str(6),
 		NumValidators:         4,
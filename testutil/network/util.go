@@ -170,6 +170,16 @@ func collectGenFiles(cfg Config, vals []*Validator, cmtConfigs []*cmtcfg.Config,
 			return err
 		}
 
+		if cfg.ConsensusParams != nil {
+			if appGenesis, err = genutiltypes.AppGenesisFromFile(genFile); err != nil {
+				return err
+			}
+			appGenesis.Consensus.Params = cfg.ConsensusParams
+			if err := appGenesis.SaveAs(genFile); err != nil {
+				return err
+			}
+		}
+
 		v := vals[i].GetViper()
 		err = genutiltest.TrackCometConfig(v, nodeDir)
 		if err != nil {
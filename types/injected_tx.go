@@ -0,0 +1,16 @@
+package types
+
+import "bytes"
+
+// InjectedTxPrefix prefixes the system txs injected into a block proposal by
+// an application PrepareProposal handler, e.g. data agreed on through vote
+// extensions. A leading 0x00 byte is an invalid protobuf field tag, hence an
+// injected tx can never be decoded as a regular tx.
+var InjectedTxPrefix = []byte{0x00, 's', 'y', 's'}
+
+// IsInjectedTx reports whether tx is a system tx injected into a block
+// proposal. Injected txs are not executed by FinalizeBlock and are ignored by
+// the tx query paths.
+func IsInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, InjectedTxPrefix)
+}
@@ -0,0 +1,17 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestIsInjectedTx(t *testing.T) {
+	require.True(t, sdk.IsInjectedTx(append(sdk.InjectedTxPrefix, 0x08, 0x01)))
+	require.True(t, sdk.IsInjectedTx(sdk.InjectedTxPrefix))
+	require.False(t, sdk.IsInjectedTx(nil))
+	require.False(t, sdk.IsInjectedTx(sdk.InjectedTxPrefix[:2]))
+	require.False(t, sdk.IsInjectedTx([]byte{0x0a, 0x00, 's', 'y', 's'}))
+}
@@ -78,6 +78,10 @@ func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, erro
 		return nil, err
 	}
 
+	if sdk.IsInjectedTx(resTx.Tx) {
+		return nil, fmt.Errorf("tx %s is an injected system tx", hashHexStr)
+	}
+
 	resBlocks, err := getBlocksForTxResults(clientCtx, []*coretypes.ResultTx{resTx})
 	if err != nil {
 		return nil, err
@@ -92,14 +96,19 @@ func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, erro
 }
 
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
+// Injected system txs are skipped.
 func formatTxResults(txConfig client.TxConfig, resTxs []*coretypes.ResultTx, resBlocks map[int64]*coretypes.ResultBlock) ([]*sdk.TxResponse, error) {
-	var err error
-	out := make([]*sdk.TxResponse, len(resTxs))
+	out := make([]*sdk.TxResponse, 0, len(resTxs))
 	for i := range resTxs {
-		out[i], err = mkTxResult(txConfig, resTxs[i], resBlocks[resTxs[i].Height])
+		if sdk.IsInjectedTx(resTxs[i].Tx) {
+			continue
+		}
+
+		txResult, err := mkTxResult(txConfig, resTxs[i], resBlocks[resTxs[i].Height])
 		if err != nil {
 			return nil, err
 		}
+		out = append(out, txResult)
 	}
 
 	return out, nil
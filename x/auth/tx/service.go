@@ -164,7 +164,14 @@ func (s txServer) GetBlockWithTxs(ctx context.Context, req *txtypes.GetBlockWith
 		limit = query.DefaultLimit
 	}
 
-	blockTxs := block.Data.Txs
+	// injected system txs are not sdk txs, they are neither returned nor
+	// counted by the pagination
+	blockTxs := make([][]byte, 0, len(block.Data.Txs))
+	for _, tx := range block.Data.Txs {
+		if !sdk.IsInjectedTx(tx) {
+			blockTxs = append(blockTxs, tx)
+		}
+	}
 	blockTxsLn := uint64(len(blockTxs))
 	txs := make([]*txtypes.Tx, 0, limit)
 	if offset >= blockTxsLn && blockTxsLn != 0 {
@@ -0,0 +1,127 @@
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// blockNode is a CometBFT node serving a single block.
+type blockNode struct {
+	client.CometRPC
+
+	block *cmttypes.Block
+}
+
+func (n blockNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if height != nil && *height != n.block.Height {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+	return &coretypes.ResultBlock{Block: n.block}, nil
+}
+
+func (n blockNode) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	for i, bz := range n.block.Txs {
+		if bz := cmttypes.Tx(bz); string(bz.Hash()) == string(hash) {
+			return &coretypes.ResultTx{Hash: hash, Height: n.block.Height, Index: uint32(i), Tx: bz}, nil
+		}
+	}
+	return nil, fmt.Errorf("tx (%X) not found", hash)
+}
+
+// TestServiceSkipsInjectedTx checks that the injected system tx of a block is
+// neither returned nor counted by the tx service.
+func TestServiceSkipsInjectedTx(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	txConfig := encodingConfig.TxConfig
+
+	// a sync block: the injected tx first, then two sdk txs
+	txs := []cmttypes.Tx{append(append([]byte{}, sdk.InjectedTxPrefix...), []byte("sync data")...)}
+	for _, memo := range []string{"first", "second"} {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetMemo(memo)
+		txBuilder.SetGasLimit(gas)
+		txBuilder.SetFeeAmount(fee)
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+	block := cmttypes.MakeBlock(10, txs, nil, nil)
+
+	clientCtx := client.Context{}.WithClient(blockNode{block: block}).WithTxConfig(txConfig)
+	server := tx.NewTxServer(clientCtx, nil, encodingConfig.InterfaceRegistry)
+	ctx := sdk.Context{}.WithContext(context.Background()).WithLogger(log.NewNopLogger()).WithBlockHeight(10)
+
+	// the sdk txs of the block are found, the injected one is not
+	res, err := server.GetTx(ctx, &txtypes.GetTxRequest{Hash: fmt.Sprintf("%X", txs[2].Hash())})
+	require.NoError(t, err)
+	require.Equal(t, "second", res.Tx.Body.Memo)
+	require.Equal(t, int64(10), res.TxResponse.Height)
+
+	_, err = server.GetTx(ctx, &txtypes.GetTxRequest{Hash: fmt.Sprintf("%X", txs[0].Hash())})
+	require.ErrorContains(t, err, "injected system tx")
+
+	testCases := []struct {
+		name       string
+		pagination *query.PageRequest
+		expMemos   []string
+		expErr     string
+	}{
+		{
+			name:     "no pagination",
+			expMemos: []string{"first", "second"},
+		},
+		{
+			name:       "first page",
+			pagination: &query.PageRequest{Offset: 0, Limit: 1},
+			expMemos:   []string{"first"},
+		},
+		{
+			name:       "second page",
+			pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			expMemos:   []string{"second"},
+		},
+		{
+			name:       "offset past the sdk txs",
+			pagination: &query.PageRequest{Offset: 2, Limit: 1},
+			expErr:     "out of range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := server.GetBlockWithTxs(ctx, &txtypes.GetBlockWithTxsRequest{Height: 10, Pagination: tc.pagination})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			memos := make([]string, len(res.Txs))
+			for i, tx := range res.Txs {
+				memos[i] = tx.Body.Memo
+			}
+			require.Equal(t, tc.expMemos, memos)
+			require.Equal(t, uint64(2), res.Pagination.Total)
+			// the block itself is returned as committed
+			require.Len(t, res.Block.Data.Txs, 3)
+		})
+	}
+}
@@ -42,6 +42,7 @@ func CommandsWithCustomMigrationMap(symGenutilModule symGenutil.AppModule, genMM
 		ValidateGenesisCmd(genMM),
 		AddGenesisAccountCmd(),
 		ExportCmd(appExport),
+		ImportSymbioticCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/symGenutil"
+	symGenutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+const (
+	flagSnapshot     = "snapshot"
+	flagSaveSnapshot = "save-snapshot"
+	flagEthAPIURLs   = "eth-api-urls"
+)
+
+// ImportSymbioticCmd returns import-symbiotic cobra Command.
+func ImportSymbioticCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-symbiotic [block-hash-or-number]",
+		Short: "Bootstrap the genesis validator power from a Symbiotic middleware snapshot",
+		Long: `Bootstrap the genesis validator power from the validator set of the Symbiotic
+middleware at a finalized Ethereum block, the latest finalized block if none is given.
+The validator set is read from the execution endpoints of the [symbiotic] app.toml
+section, or from a snapshot file previously saved with --save-snapshot.
+
+The genesis validators get the stake of their consensus key, and the snapshot is
+recorded as the initial symbiotic sync. It is also cached for the validators created
+by genesis transactions, so that the init block hash is no longer needed.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := client.GetConfigFromCmd(cmd)
+			genFile := config.GenesisFile()
+
+			var snapshot stakingtypes.CachedBlockHash
+			snapshotFile, _ := cmd.Flags().GetString(flagSnapshot)
+			if snapshotFile != "" {
+				if len(args) > 0 {
+					return errors.New("a block cannot be given with a snapshot file")
+				}
+
+				bz, err := os.ReadFile(snapshotFile)
+				if err != nil {
+					return fmt.Errorf("failed to read snapshot file: %w", err)
+				}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &snapshot); err != nil {
+					return fmt.Errorf("failed to unmarshal snapshot file: %w", err)
+				}
+			} else {
+				var err error
+				if snapshot, err = fetchSymbioticSnapshot(cmd, clientCtx, genFile, args); err != nil {
+					return err
+				}
+			}
+
+			if saveFile, _ := cmd.Flags().GetString(flagSaveSnapshot); saveFile != "" {
+				bz, err := clientCtx.Codec.MarshalJSON(&snapshot)
+				if err != nil {
+					return fmt.Errorf("failed to marshal snapshot: %w", err)
+				}
+				if err := os.WriteFile(saveFile, bz, 0o600); err != nil {
+					return fmt.Errorf("failed to write snapshot file: %w", err)
+				}
+			}
+
+			if err := symGenutil.ImportSymbioticSnapshot(clientCtx.Codec, clientCtx.ConsensusAddressCodec, genFile, snapshot); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Imported the symbiotic validator set of block %d (%s), %d validators\n",
+				snapshot.BlockNumber, snapshot.BlockHash, len(snapshot.Validators))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSnapshot, "", "Import the validator set from a snapshot file instead of Ethereum")
+	cmd.Flags().String(flagSaveSnapshot, "", "Save the imported validator set to a snapshot file")
+	cmd.Flags().StringSlice(flagEthAPIURLs, nil, "Execution node JSON-RPC endpoints, overriding the ones of app.toml")
+
+	return cmd
+}
+
+// fetchSymbioticSnapshot reads the middleware validator set at the given
+// finalized execution block, with the middleware of the staking genesis
+// params.
+func fetchSymbioticSnapshot(cmd *cobra.Command, clientCtx client.Context, genFile string, args []string) (stakingtypes.CachedBlockHash, error) {
+	appState, _, err := symGenutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return stakingtypes.CachedBlockHash{}, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+	params := stakingtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState).Params
+	if params.MiddlewareAddress == "" {
+		return stakingtypes.CachedBlockHash{}, errors.New("the symbiotic middleware address must be set in the staking genesis params")
+	}
+
+	symConfig, err := stakingtypes.SymbioticConfigFromAppOptions(client.GetViperFromCmd(cmd))
+	if err != nil {
+		return stakingtypes.CachedBlockHash{}, err
+	}
+	if urls, _ := cmd.Flags().GetStringSlice(flagEthAPIURLs); len(urls) > 0 {
+		symConfig.EthAPIURLs = urls
+	}
+	if len(symConfig.EthAPIURLs) == 0 {
+		return stakingtypes.CachedBlockHash{}, fmt.Errorf("no execution endpoint, set %s or --%s", stakingtypes.FlagSymbioticEthAPIURLs, flagEthAPIURLs)
+	}
+
+	ctx := cmd.Context()
+	ds := stakingkeeper.NewRPCDataSource(client.GetLoggerFromCmd(cmd), symConfig)
+
+	finalized, err := ds.GetBlockByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return stakingtypes.CachedBlockHash{}, fmt.Errorf("failed to get the finalized block: %w", err)
+	}
+
+	block := finalized
+	if len(args) > 0 {
+		if block, err = getSymbioticBlock(cmd, ds, args[0]); err != nil {
+			return stakingtypes.CachedBlockHash{}, err
+		}
+		if block.NumberU64() > finalized.NumberU64() {
+			return stakingtypes.CachedBlockHash{}, fmt.Errorf("block %d is not finalized, the finalized block is %d", block.NumberU64(), finalized.NumberU64())
+		}
+		// a block given by hash may have been reorged out before finality
+		canonical, err := ds.GetBlockByNumber(ctx, block.Number())
+		if err != nil {
+			return stakingtypes.CachedBlockHash{}, fmt.Errorf("failed to get block %d: %w", block.NumberU64(), err)
+		}
+		if canonical.Hash() != block.Hash() {
+			return stakingtypes.CachedBlockHash{}, fmt.Errorf("block %s is not canonical", block.Hash().Hex())
+		}
+	}
+
+	adapter, err := stakingtypes.NewMiddlewareAdapter(params.MiddlewareAbi)
+	if err != nil {
+		return stakingtypes.CachedBlockHash{}, err
+	}
+
+	blockHash := block.Hash().Hex()
+	validators, err := ds.GetValidatorSet(ctx, params.MiddlewareAddress, blockHash, adapter)
+	if err != nil {
+		return stakingtypes.CachedBlockHash{}, fmt.Errorf("failed to get the symbiotic validator set at block %s: %w", blockHash, err)
+	}
+
+	return stakingtypes.CachedBlockHash{
+		BlockHash:      blockHash,
+		Attested:       true,
+		Validators:     stakingtypes.NewSymbioticValidatorStakes(validators),
+		BlockNumber:    block.NumberU64(),
+		BlockTimestamp: block.Time(),
+	}, nil
+}
+
+func getSymbioticBlock(cmd *cobra.Command, ds *stakingkeeper.RPCDataSource, block string) (*ethtypes.Block, error) {
+	if strings.HasPrefix(block, "0x") && len(block) == 2+2*common.HashLength {
+		b, err := ds.GetBlockByHash(cmd.Context(), block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %s: %w", block, err)
+		}
+		return b, nil
+	}
+
+	number, err := strconv.ParseUint(block, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block hash or number %q", block)
+	}
+	b, err := ds.GetBlockByNumber(cmd.Context(), new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,157 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	corectx "cosmossdk.io/core/context"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth"
+	staking "cosmossdk.io/x/symStaking"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/symGenutil"
+	symGenutilcli "github.com/cosmos/cosmos-sdk/x/symGenutil/client/cli"
+	symGenutiltest "github.com/cosmos/cosmos-sdk/x/symGenutil/client/testutil"
+	symGenutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+func TestImportSymbioticCmd(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	appCodec := encodingConfig.Codec
+	valCodec := codectestutil.CodecOptions{}.GetValidatorCodec()
+	consCodec := address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+
+	pk1, pk2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	valAddr1, err := valCodec.BytesToString(pk1.Address())
+	require.NoError(t, err)
+	valAddr2, err := valCodec.BytesToString(pk2.Address())
+	require.NoError(t, err)
+
+	stake := math.NewInt(100)
+	snapshotValidator := func(consAddr sdk.ConsAddress, stake math.Int) stakingtypes.SymbioticValidator {
+		v := stakingtypes.SymbioticValidator{Stake: stake.BigInt()}
+		copy(v.ConsAddr[:], consAddr)
+		return v
+	}
+	snapshot := stakingtypes.CachedBlockHash{
+		BlockHash:      "0x01",
+		Attested:       true,
+		BlockNumber:    5,
+		BlockTimestamp: uint64(stakingtypes.DefaultBeaconGenesisTimestamp),
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+			snapshotValidator(sdk.ConsAddress(pk1.Address()), stake),
+			snapshotValidator(sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()), math.NewInt(50)),
+		}),
+	}
+
+	tests := []struct {
+		name              string
+		middlewareAddress string
+		expectErr         bool
+	}{
+		{
+			name:      "middleware address not set",
+			expectErr: true,
+		},
+		{
+			name:              "valid snapshot",
+			middlewareAddress: "0x0000000000000000000000000000000000000001",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			logger := log.NewNopLogger()
+			v := viper.New()
+
+			err := symGenutiltest.ExecInitCmd(testMbm, home, appCodec)
+			require.NoError(t, err)
+			require.NoError(t, writeAndTrackDefaultConfig(v, home))
+
+			genFile := filepath.Join(home, "config", "genesis.json")
+			appState, appGenesis, err := symGenutiltypes.GenesisStateFromGenFile(genFile)
+			require.NoError(t, err)
+
+			stakingGenState := stakingtypes.GetGenesisStateFromAppState(appCodec, appState)
+			stakingGenState.Params.MiddlewareAddress = tc.middlewareAddress
+			for _, val := range []struct {
+				addr string
+				pk   *ed25519.PubKey
+			}{{valAddr1, pk1.(*ed25519.PubKey)}, {valAddr2, pk2.(*ed25519.PubKey)}} {
+				validator, err := stakingtypes.NewValidator(val.addr, val.pk, stakingtypes.Description{})
+				require.NoError(t, err)
+				validator.Tokens = math.NewInt(1)
+				stakingGenState.Validators = append(stakingGenState.Validators, validator)
+			}
+			appState[stakingtypes.ModuleName] = appCodec.MustMarshalJSON(stakingGenState)
+			appGenesis.AppState, err = json.Marshal(appState)
+			require.NoError(t, err)
+			require.NoError(t, symGenutil.ExportGenesisFile(appGenesis, genFile))
+
+			snapshotFile := filepath.Join(home, "snapshot.json")
+			bz, err := appCodec.MarshalJSON(&snapshot)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(snapshotFile, bz, 0o600))
+
+			clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home).
+				WithConsensusAddressCodec(consCodec)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+			ctx = context.WithValue(ctx, corectx.ViperContextKey, v)
+			ctx = context.WithValue(ctx, corectx.LoggerContextKey, logger)
+
+			cmd := symGenutilcli.ImportSymbioticCmd()
+			cmd.SetArgs([]string{"--snapshot", snapshotFile})
+
+			if tc.expectErr {
+				require.Error(t, cmd.ExecuteContext(ctx))
+				return
+			}
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			appState, _, err = symGenutiltypes.GenesisStateFromGenFile(genFile)
+			require.NoError(t, err)
+			stakingGenState = stakingtypes.GetGenesisStateFromAppState(appCodec, appState)
+			require.NoError(t, staking.ValidateGenesis(stakingGenState))
+
+			// the validator absent from the snapshot has no stake
+			require.Equal(t, stake, stakingGenState.Validators[0].Tokens)
+			require.True(t, stakingGenState.Validators[1].Tokens.IsZero())
+
+			require.Len(t, stakingGenState.SymbioticSyncs, 1)
+			record := stakingGenState.SymbioticSyncs[0]
+			require.Equal(t, int64(0), record.Height)
+			require.Equal(t, snapshot.BlockHash, record.BlockHash)
+			require.Equal(t, snapshot.BlockNumber, record.BlockNumber)
+			require.Len(t, record.Stakes, 2)
+			require.Equal(t, valAddr1, record.Stakes[0].ValidatorAddress)
+			require.Empty(t, record.Stakes[1].ValidatorAddress)
+
+			require.NotNil(t, stakingGenState.CachedBlockHash)
+			require.True(t, stakingGenState.CachedBlockHash.Attested)
+			require.Equal(t, snapshot.BlockHash, stakingGenState.CachedBlockHash.BlockHash)
+			require.Empty(t, symGenutiltypes.GetGenesisStateFromAppState(appCodec, appState).InitBlockHash)
+
+			// genesis power must match the snapshot
+			stakingGenState.Validators[1].Tokens = math.NewInt(1)
+			require.Error(t, staking.ValidateGenesis(stakingGenState))
+		})
+	}
+}
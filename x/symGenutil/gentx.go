@@ -88,7 +88,9 @@ func ValidateAccountInGenesis(
 
 // DeliverGenTxs iterates over all genesis txs, decodes each into a Tx and
 // invokes the provided deliverTxfn with the decoded Tx. It returns the result
-// of the staking module's ApplyAndReturnValidatorSetUpdates.
+// of the staking module's ApplyAndReturnValidatorSetUpdates. The validator set
+// is synced at initBlockHash, or from the Symbiotic snapshot of the staking
+// genesis if initBlockHash is empty.
 func DeliverGenTxs(
 	ctx context.Context, initBlockHash string, genTxs []json.RawMessage,
 	stakingKeeper types.StakingKeeper, deliverTx genesis.TxHandler,
@@ -111,8 +113,10 @@ func DeliverGenTxs(
 		}
 	}
 
-	if err := stakingKeeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: initBlockHash, Height: 0}); err != nil {
-		return nil, fmt.Errorf("failed to cache block hash %w", err)
+	if initBlockHash != "" {
+		if err := stakingKeeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: initBlockHash, Height: 0}); err != nil {
+			return nil, fmt.Errorf("failed to cache block hash %w", err)
+		}
 	}
 
 	return stakingKeeper.BlockValidatorUpdates(ctx)
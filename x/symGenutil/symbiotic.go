@@ -0,0 +1,59 @@
+package symGenutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	symGenutiltypes "github.com/cosmos/cosmos-sdk/x/symGenutil/types"
+)
+
+// ImportSymbioticSnapshot bootstraps the staking genesis validator power from
+// a Symbiotic middleware validator set snapshot. Where `cdc` is client codec,
+// `consAddrCodec` encodes the consensus addresses of the snapshot stakes and
+// `genesisFileURL` is the path/url of current genesis file.
+// The genesis validators get the stake of their consensus key, the snapshot
+// is recorded as the initial sync and cached for the genesis transactions,
+// which then no longer need an init block hash.
+func ImportSymbioticSnapshot(
+	cdc codec.Codec,
+	consAddrCodec address.Codec,
+	genesisFileURL string,
+	snapshot stakingtypes.CachedBlockHash,
+) error {
+	appState, appGenesis, err := symGenutiltypes.GenesisStateFromGenFile(genesisFileURL)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	if stakingGenState.Params.MiddlewareAddress == "" {
+		return errors.New("the symbiotic middleware address must be set in the staking genesis params")
+	}
+
+	if err := stakingGenState.ApplySymbioticSnapshot(snapshot, appGenesis.GenesisTime, consAddrCodec); err != nil {
+		return fmt.Errorf("failed to apply symbiotic snapshot: %w", err)
+	}
+
+	stakingGenStateBz, err := cdc.MarshalJSON(stakingGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal staking genesis state: %w", err)
+	}
+	appState[stakingtypes.ModuleName] = stakingGenStateBz
+
+	symGenutilGenState := symGenutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	symGenutilGenState.InitBlockHash = ""
+	appState = symGenutiltypes.SetGenesisStateInAppState(cdc, appState, symGenutilGenState)
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return ExportGenesisFile(appGenesis, genesisFileURL)
+}
@@ -28,8 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the raw genesis transaction in JSON.
 type GenesisState struct {
 	// gen_txs defines the genesis transactions.
-	GenTxs        []encoding_json.RawMessage `protobuf:"bytes,1,rep,name=gen_txs,json=genTxs,proto3,casttype=encoding/json.RawMessage" json:"gentxs"`
-	InitBlockHash string                     `protobuf:"bytes,2,opt,name=init_block_hash,json=initBlockHash,proto3" json:"init_block_hash,omitempty"`
+	GenTxs []encoding_json.RawMessage `protobuf:"bytes,1,rep,name=gen_txs,json=genTxs,proto3,casttype=encoding/json.RawMessage" json:"gentxs"`
+	// init_block_hash is the execution block hash the validator set is synced
+	// at on InitChain. If empty, the Symbiotic snapshot imported in the
+	// symStaking genesis is applied instead.
+	InitBlockHash string `protobuf:"bytes,2,opt,name=init_block_hash,json=initBlockHash,proto3" json:"init_block_hash,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
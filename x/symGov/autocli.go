@@ -89,6 +89,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "VotingPowerSnapshot",
+					Use:       "voting-power-snapshot [proposal-id]",
+					Short:     "Query the validator voting power snapshot a proposal is tallied against",
+					Example:   fmt.Sprintf("%s query symGov voting-power-snapshot 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "RestakerVotes",
+					Use:       "restaker-votes [proposal-id]",
+					Short:     "Query the votes of Ethereum restakers on a proposal",
+					Example:   fmt.Sprintf("%s query symGov restaker-votes 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -134,6 +152,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "VoteRestaker",
+					Skip:      true, // skipped because the vote is signed with an Ethereum key, see custom command
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
 	govutils "cosmossdk.io/x/symGov/client/utils"
@@ -21,14 +23,16 @@ import (
 
 // Proposal flags
 const (
-	FlagTitle     = "title"
-	FlagDeposit   = "deposit"
-	flagVoter     = "voter"
-	flagDepositor = "depositor"
-	flagStatus    = "status"
-	FlagMetadata  = "metadata"
-	FlagSummary   = "summary"
-	FlagExpedited = "expedited"
+	FlagTitle      = "title"
+	FlagDeposit    = "deposit"
+	flagVoter      = "voter"
+	flagDepositor  = "depositor"
+	flagStatus     = "status"
+	FlagMetadata   = "metadata"
+	FlagSummary    = "summary"
+	FlagExpedited  = "expedited"
+	FlagEthKeyFile = "eth-key-file"
+	FlagNonce      = "nonce"
 
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
@@ -70,6 +74,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(
 		NewCmdWeightedVote(),
+		NewCmdVoteRestaker(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 
@@ -290,3 +295,89 @@ $ %s tx symGov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no-with-veto=0.05 --f
 
 	return cmd
 }
+
+// NewCmdVoteRestaker implements voting on behalf of an Ethereum restaker.
+func NewCmdVoteRestaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-restaker [proposal-id] [validator-addr] [vault] [weighted-options]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Vote for an active proposal with the stake an Ethereum restaker delegates to a validator through a vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a vote with the Ethereum key of a restaker or vault curator and submit it.
+The vote overrides the vote of the validator for the stake the restaker backs its operator
+with through the vault, at the block of the proposal voting power snapshot. The nonce must
+be higher than the one of any previous vote of the restaker on the same proposal, validator
+and vault.
+
+Example:
+$ %s tx symGov vote-restaker 1 cosmosvaloper1... 0x5FbDB2315678afecb367f032d93F642f64180aa3 yes=1 --eth-key-file restaker.key --nonce 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get submitter address
+			from, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			if !common.IsHexAddress(args[2]) {
+				return fmt.Errorf("invalid vault address %q", args[2])
+			}
+			vault := common.HexToAddress(args[2])
+
+			// Figure out which vote options user chose
+			options, err := v1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[3]))
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagEthKeyFile)
+			key, err := crypto.LoadECDSA(keyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load the Ethereum key: %w", err)
+			}
+
+			digest := v1.RestakerVoteSignBytes(clientCtx.ChainID, proposalID, vault, args[1], options, metadata, nonce)
+			signature, err := crypto.Sign(digest, key)
+			if err != nil {
+				return err
+			}
+
+			voter := crypto.PubkeyToAddress(key.PublicKey)
+			msg := v1.NewMsgVoteRestaker(from, proposalID, voter.Hex(), vault.Hex(), args[1], options, metadata, nonce, signature)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	cmd.Flags().String(FlagEthKeyFile, "", "File holding the hex encoded private key of the restaker")
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the vote, higher than the one of any previous vote of the restaker")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagEthKeyFile)
+	_ = cmd.MarkFlagRequired(FlagNonce)
+
+	return cmd
+}
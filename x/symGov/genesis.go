@@ -73,6 +73,18 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, snapshot := range data.VotingPowerSnapshots {
+		if err := k.VotingPowerSnapshots.Set(ctx, snapshot.ProposalId, *snapshot); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range data.RestakerVotes {
+		if err := k.SetRestakerVote(ctx, *vote); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +143,34 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export proposals voting power snapshots
+	var snapshots []*v1.VotingPowerSnapshot
+	err = k.VotingPowerSnapshots.Walk(ctx, nil, func(_ uint64, value v1.VotingPowerSnapshot) (stop bool, err error) {
+		snapshots = append(snapshots, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export proposals restaker votes
+	var restakerVotes []*v1.RestakerVote
+	err = k.RestakerVotes.Walk(ctx, nil, func(_ collections.Triple[uint64, sdk.ValAddress, []byte], value v1.RestakerVote) (stop bool, err error) {
+		restakerVotes = append(restakerVotes, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:   startingProposalID,
+		Deposits:             proposalsDeposits,
+		Votes:                proposalsVotes,
+		Proposals:            proposals,
+		Params:               &params,
+		Constitution:         constitution,
+		VotingPowerSnapshots: snapshots,
+		RestakerVotes:        restakerVotes,
 	}, nil
 }
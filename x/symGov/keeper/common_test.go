@@ -20,6 +20,7 @@ import (
 	"cosmossdk.io/x/symGov/types"
 	v1 "cosmossdk.io/x/symGov/types/v1"
 	"cosmossdk.io/x/symGov/types/v1beta1"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -104,6 +105,7 @@ func mockDefaultExpectations(ctx sdk.Context, m mocks) error {
 	m.stakingKeeper.EXPECT().BondDenom(ctx).Return("stake", nil).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
+	m.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
 	return nil
 }
 
@@ -334,6 +334,47 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// VotingPowerSnapshot queries the voting power snapshot of a proposal
+func (q queryServer) VotingPowerSnapshot(ctx context.Context, req *v1.QueryVotingPowerSnapshotRequest) (*v1.QueryVotingPowerSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	snapshot, err := q.k.VotingPowerSnapshots.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "voting power snapshot for proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVotingPowerSnapshotResponse{Snapshot: &snapshot}, nil
+}
+
+// RestakerVotes returns the restaker votes of a proposal
+func (q queryServer) RestakerVotes(ctx context.Context, req *v1.QueryRestakerVotesRequest) (*v1.QueryRestakerVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	votes, pageRes, err := query.CollectionPaginate(ctx, q.k.RestakerVotes, req.Pagination, func(_ collections.Triple[uint64, sdk.ValAddress, []byte], value v1.RestakerVote) (vote *v1.RestakerVote, err error) {
+		return &value, nil
+	}, query.WithCollectionPaginationTriplePrefix[uint64, sdk.ValAddress, []byte](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryRestakerVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
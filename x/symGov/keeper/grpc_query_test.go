@@ -2041,3 +2041,62 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryVotingPowerSnapshot() {
+	suite.reset()
+
+	valAddr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	snapshot := v1.VotingPowerSnapshot{
+		ProposalId: 1,
+		Height:     10,
+		Validators: []v1.ValidatorVotingPower{
+			{ValidatorAddress: valAddr, Power: math.NewInt(1000000)},
+		},
+		TotalPower: math.NewInt(1000000),
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *v1.QueryVotingPowerSnapshotRequest
+		expResp  *v1.QueryVotingPowerSnapshotResponse
+		errStr   string
+	}{
+		{
+			name:   "invalid proposal id",
+			req:    &v1.QueryVotingPowerSnapshotRequest{},
+			errStr: "proposal id can not be 0",
+		},
+		{
+			name:   "snapshot not found",
+			req:    &v1.QueryVotingPowerSnapshotRequest{ProposalId: 1},
+			errStr: "voting power snapshot for proposal 1 doesn't exist",
+		},
+		{
+			name: "valid request",
+			malleate: func() {
+				suite.Require().NoError(suite.govKeeper.VotingPowerSnapshots.Set(suite.ctx, snapshot.ProposalId, snapshot))
+			},
+			req:     &v1.QueryVotingPowerSnapshotRequest{ProposalId: 1},
+			expResp: &v1.QueryVotingPowerSnapshotResponse{Snapshot: &snapshot},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			resp, err := suite.queryClient.VotingPowerSnapshot(suite.ctx, tc.req)
+			if tc.errStr != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errStr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResp, resp)
+			}
+		})
+	}
+}
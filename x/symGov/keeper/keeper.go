@@ -65,6 +65,12 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// VotingPowerSnapshots key: proposalID | value: the validator voting power captured at voting start
+	VotingPowerSnapshots collections.Map[uint64, v1.VotingPowerSnapshot]
+	// RestakerVotes key: proposalID+validatorAddr+voterAddr+vaultAddr | value: RestakerVote
+	RestakerVotes collections.Map[collections.Triple[uint64, sdk.ValAddress, []byte], v1.RestakerVote]
+	// PendingRestakerVotes key: proposalID+validatorAddr+voterAddr+vaultAddr | value: none used (restaker votes without agreed stake)
+	PendingRestakerVotes collections.KeySet[collections.Triple[uint64, sdk.ValAddress, []byte]]
 }
 
 // GetAuthority returns the x/symGov module's authority.
@@ -131,6 +137,9 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		VotingPowerSnapshots:   collections.NewMap(sb, types.VotingPowerSnapshotsPrefix, "voting_power_snapshots", collections.Uint64Key, codec.CollValue[v1.VotingPowerSnapshot](cdc)),
+		RestakerVotes:          collections.NewMap(sb, types.RestakerVotesKeyPrefix, "restaker_votes", collections.TripleKeyCodec(collections.Uint64Key, sdk.ValAddressKey, collections.BytesKey), codec.CollValue[v1.RestakerVote](cdc)),
+		PendingRestakerVotes:   collections.NewKeySet(sb, types.PendingRestakerVotesPrefix, "pending_restaker_votes", collections.TripleKeyCodec(collections.Uint64Key, sdk.ValAddressKey, collections.BytesKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	corecontext "cosmossdk.io/core/context"
@@ -245,41 +246,91 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", accErr)
 	}
 
-	if len(msg.Options) == 0 {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, v1.WeightedVoteOptions(msg.Options).String())
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteWeightedResponse{}, nil
+}
+
+// VoteRestaker implements the MsgServer.VoteRestaker method.
+func (k msgServer) VoteRestaker(ctx context.Context, msg *v1.MsgVoteRestaker) (*v1.MsgVoteRestakerResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Submitter); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
+	}
+
+	if !common.IsHexAddress(msg.Voter) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", msg.Voter)
+	}
+
+	if !common.IsHexAddress(msg.Vault) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid vault address: %s", msg.Vault)
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
+	}
+
+	voter, vault := common.HexToAddress(msg.Voter), common.HexToAddress(msg.Vault)
+	digest := v1.RestakerVoteSignBytes(k.HeaderService.HeaderInfo(ctx).ChainID, msg.ProposalId, vault, msg.ValidatorAddress, msg.Options, msg.Metadata, msg.Nonce)
+	signer, err := v1.RecoverRestakerVoteSigner(digest, msg.Signature)
+	if err != nil {
+		return nil, errors.Wrap(govtypes.ErrInvalidRestakerVoteSig, err.Error())
+	}
+	if signer != voter {
+		return nil, errors.Wrapf(govtypes.ErrInvalidRestakerVoteSig, "signed by %s, expected %s", signer, voter)
+	}
+
+	if err := k.Keeper.AddRestakerVote(ctx, msg.ProposalId, voter, vault, valAddr, msg.Options, msg.Metadata, msg.Nonce); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteRestakerResponse{}, nil
+}
+
+// validateWeightedVoteOptions checks that the options of a weighted vote are valid,
+// not duplicated and that their weights sum to 1.
+func validateWeightedVoteOptions(options []*v1.WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, v1.WeightedVoteOptions(options).String())
 	}
 
 	totalWeight := math.LegacyNewDec(0)
 	usedOptions := make(map[v1.VoteOption]bool)
-	for _, option := range msg.Options {
+	for _, option := range options {
 		if !option.IsValid() {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
+			return errors.Wrap(govtypes.ErrInvalidVote, option.String())
 		}
 		weight, err := math.LegacyNewDecFromStr(option.Weight)
 		if err != nil {
-			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
+			return errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
 		}
 		totalWeight = totalWeight.Add(weight)
 		if usedOptions[option.Option] {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
+			return errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
 		}
 		usedOptions[option.Option] = true
 	}
 
 	if totalWeight.GT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
 	}
 
 	if totalWeight.LT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
 	}
 
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
-	if err != nil {
-		return nil, err
-	}
-
-	return &v1.MsgVoteWeightedResponse{}, nil
+	return nil
 }
 
 // Deposit implements the MsgServer.Deposit method.
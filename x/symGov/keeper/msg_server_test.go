@@ -1,14 +1,23 @@
 package keeper_test
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/symGov/types/v1"
 	"cosmossdk.io/x/symGov/types/v1beta1"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -960,6 +969,263 @@ func (suite *KeeperTestSuite) TestMsgVoteWeighted() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgVoteRestaker() {
+	suite.reset()
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	suite.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	addrs := suite.addrs
+	proposer := addrs[0]
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(proposer)
+	suite.Require().NoError(err)
+	govStrAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
+	suite.Require().NoError(err)
+
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govStrAcct,
+		ToAddress:   proposerAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100000))),
+	}
+	submitProposal := func() uint64 {
+		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, params.MinDeposit, proposerAddr, "", "Proposal", "description of proposal", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	valAddr, err := valCodec.BytesToString(sdk.ValAddress(addrs[1]))
+	suite.Require().NoError(err)
+	operator := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	vault := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	blockHash := "0x0000000000000000000000000000000000000000000000000000000000000001"
+	setSnapshot := func(proposalID uint64, blockHash string) {
+		suite.Require().NoError(suite.govKeeper.VotingPowerSnapshots.Set(suite.ctx, proposalID, v1.VotingPowerSnapshot{
+			ProposalId: proposalID,
+			Validators: []v1.ValidatorVotingPower{{ValidatorAddress: valAddr, Power: sdkmath.NewInt(1000), Operator: operator.Bytes()}},
+			TotalPower: sdkmath.NewInt(1000),
+			BlockHash:  blockHash,
+		}))
+	}
+
+	restaker, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	restakerAddr := crypto.PubkeyToAddress(restaker.PublicKey)
+	other, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	unstaked, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	unstakedAddr := crypto.PubkeyToAddress(unstaked.PublicKey)
+	unresolved, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	unresolvedAddr := crypto.PubkeyToAddress(unresolved.PublicKey)
+
+	expired, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	expiredAddr := crypto.PubkeyToAddress(expired.PublicKey)
+	newcomer, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	// the stakes are only requested when voting, and read once agreed on in a sync
+	var (
+		agreed      = make(map[common.Address]sdkmath.Int)
+		expiredReqs = make(map[common.Address]bool)
+		syncHeight  int64
+	)
+	suite.stakingKeeper.EXPECT().RequestSymbioticVoterStake(gomock.Any(), blockHash, vault.Bytes(), operator.Bytes(), gomock.Any()).Return(nil).AnyTimes()
+	suite.stakingKeeper.EXPECT().GetSymbioticVoterStake(gomock.Any(), blockHash, vault.Bytes(), operator.Bytes(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _, _, voter []byte) (sdkmath.Int, error) {
+			addr := common.BytesToAddress(voter)
+			if expiredReqs[addr] {
+				return sdkmath.Int{}, stakingtypes.ErrSymbioticNotFound
+			}
+			if stake, ok := agreed[addr]; ok {
+				return stake, nil
+			}
+			return sdkmath.Int{}, stakingtypes.ErrSymbioticPending
+		}).AnyTimes()
+	suite.stakingKeeper.EXPECT().GetSymbioticSync(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error) {
+			if height != syncHeight {
+				return stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound
+			}
+			return stakingtypes.SymbioticSyncRecord{Height: height, BlockHash: blockHash}, nil
+		}).AnyTimes()
+
+	params.RestakerVaults = []string{vault.Hex()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	options := v1.NewNonSplitVoteOption(v1.OptionNo)
+	sign := func(key *ecdsa.PrivateKey, proposalID, nonce uint64) *v1.MsgVoteRestaker {
+		digest := v1.RestakerVoteSignBytes(suite.ctx.HeaderInfo().ChainID, proposalID, vault, valAddr, options, "", nonce)
+		signature, err := crypto.Sign(digest, key)
+		suite.Require().NoError(err)
+		voter := crypto.PubkeyToAddress(key.PublicKey)
+		return v1.NewMsgVoteRestaker(proposerAddr, proposalID, voter.Hex(), vault.Hex(), valAddr, options, "", nonce, signature)
+	}
+
+	cases := map[string]struct {
+		preRun    func() *v1.MsgVoteRestaker
+		expErr    bool
+		expErrMsg string
+	}{
+		"invalid voter address": {
+			preRun: func() *v1.MsgVoteRestaker {
+				msg := sign(restaker, submitProposal(), 1)
+				msg.Voter = "0x1234"
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "invalid voter address",
+		},
+		"signed by another key": {
+			preRun: func() *v1.MsgVoteRestaker {
+				msg := sign(other, submitProposal(), 1)
+				msg.Voter = restakerAddr.Hex()
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "invalid restaker vote signature",
+		},
+		"signed vote tampered with": {
+			preRun: func() *v1.MsgVoteRestaker {
+				proposalID := submitProposal()
+				setSnapshot(proposalID, blockHash)
+				msg := sign(restaker, proposalID, 1)
+				msg.Options = v1.NewNonSplitVoteOption(v1.OptionYes)
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "invalid restaker vote signature",
+		},
+		"no Symbiotic snapshot": {
+			preRun: func() *v1.MsgVoteRestaker {
+				return sign(restaker, submitProposal(), 1)
+			},
+			expErr:    true,
+			expErrMsg: "has no Symbiotic voting power snapshot",
+		},
+		"not a restaker vault": {
+			preRun: func() *v1.MsgVoteRestaker {
+				proposalID := submitProposal()
+				setSnapshot(proposalID, blockHash)
+				msg := sign(restaker, proposalID, 1)
+				digest := v1.RestakerVoteSignBytes(suite.ctx.HeaderInfo().ChainID, proposalID, operator, valAddr, options, "", 1)
+				msg.Signature, err = crypto.Sign(digest, restaker)
+				suite.Require().NoError(err)
+				msg.Vault = operator.Hex()
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "is not a restaker vault",
+		},
+		"replayed nonce": {
+			preRun: func() *v1.MsgVoteRestaker {
+				proposalID := submitProposal()
+				setSnapshot(proposalID, blockHash)
+				msg := sign(restaker, proposalID, 5)
+				_, err := suite.msgSrvr.VoteRestaker(suite.ctx, msg)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "restaker vote nonce too low",
+		},
+		"all good": {
+			preRun: func() *v1.MsgVoteRestaker {
+				proposalID := submitProposal()
+				setSnapshot(proposalID, blockHash)
+				return sign(restaker, proposalID, 1)
+			},
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg := tc.preRun()
+			_, err := suite.msgSrvr.VoteRestaker(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			res, err := suite.queryClient.RestakerVotes(suite.ctx, &v1.QueryRestakerVotesRequest{ProposalId: msg.ProposalId})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Votes, 1)
+			suite.Require().Equal(restakerAddr.Hex(), res.Votes[0].Voter)
+			suite.Require().Equal(vault.Hex(), res.Votes[0].Vault)
+			// the vote is pending until its stake is agreed on
+			suite.Require().True(res.Votes[0].Power.IsZero())
+		})
+	}
+
+	// the pending votes get their agreed stake in the EndBlocker following a
+	// sync, votes without stake or whose request expired are dropped and the
+	// others stay pending
+	proposalID := submitProposal()
+	setSnapshot(proposalID, blockHash)
+	for _, key := range []*ecdsa.PrivateKey{restaker, unstaked, unresolved, expired} {
+		_, err := suite.msgSrvr.VoteRestaker(suite.ctx, sign(key, proposalID, 1))
+		suite.Require().NoError(err)
+	}
+	agreed[restakerAddr] = sdkmath.NewInt(400)
+	agreed[unstakedAddr] = sdkmath.ZeroInt()
+	expiredReqs[expiredAddr] = true
+
+	valBz, err := valCodec.StringToBytes(valAddr)
+	suite.Require().NoError(err)
+	isPending := func(voter common.Address) bool {
+		pending, err := suite.govKeeper.PendingRestakerVotes.Has(suite.ctx, collections.Join3(proposalID, sdk.ValAddress(valBz), append(voter.Bytes(), vault.Bytes()...)))
+		suite.Require().NoError(err)
+		return pending
+	}
+
+	// no sync in the previous block
+	suite.Require().NoError(suite.govKeeper.EndBlocker(suite.ctx))
+	for _, voter := range []common.Address{restakerAddr, unstakedAddr, unresolvedAddr, expiredAddr} {
+		suite.Require().True(isPending(voter))
+	}
+
+	syncHeight = suite.ctx.HeaderInfo().Height - 1
+	suite.Require().NoError(suite.govKeeper.EndBlocker(suite.ctx))
+
+	res, err := suite.queryClient.RestakerVotes(suite.ctx, &v1.QueryRestakerVotesRequest{ProposalId: proposalID})
+	suite.Require().NoError(err)
+	powers := make(map[string]sdkmath.Int)
+	for _, vote := range res.Votes {
+		powers[vote.Voter] = vote.Power
+	}
+	suite.Require().Len(powers, 2)
+	suite.Require().Equal(sdkmath.NewInt(400), powers[restakerAddr.Hex()])
+	suite.Require().True(powers[unresolvedAddr.Hex()].IsZero())
+	suite.Require().NotContains(powers, unstakedAddr.Hex())
+	suite.Require().NotContains(powers, expiredAddr.Hex())
+	suite.Require().True(isPending(unresolvedAddr))
+	suite.Require().False(isPending(restakerAddr))
+
+	// a new vote of a resolved voter keeps its power
+	_, err = suite.msgSrvr.VoteRestaker(suite.ctx, sign(restaker, proposalID, 2))
+	suite.Require().NoError(err)
+	suite.Require().False(isPending(restakerAddr))
+
+	// a voter agreed to have no stake cannot vote
+	_, err = suite.msgSrvr.VoteRestaker(suite.ctx, sign(unstaked, proposalID, 2))
+	suite.Require().ErrorContains(err, "has no stake")
+
+	// the pending votes of a proposal are bounded, a pending vote can still
+	// be replaced
+	params.MaxPendingRestakerVotes = 1
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+	_, err = suite.msgSrvr.VoteRestaker(suite.ctx, sign(newcomer, proposalID, 1))
+	suite.Require().ErrorContains(err, "restaker votes pending")
+	_, err = suite.msgSrvr.VoteRestaker(suite.ctx, sign(unresolved, proposalID, 2))
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestMsgDeposit() {
 	suite.reset()
 	govStrAcct, err := suite.acctKeeper.AddressCodec().BytesToString(suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress())
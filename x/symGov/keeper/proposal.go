@@ -260,6 +260,10 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
+	if err := k.VotingPowerSnapshots.Remove(ctx, proposalID); err != nil {
+		return err
+	}
+
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
@@ -302,5 +306,9 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 		return err
 	}
 
+	if err = k.SnapshotVotingPower(ctx, proposal.Id); err != nil {
+		return err
+	}
+
 	return k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
 }
@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symGov/types"
+	v1 "cosmossdk.io/x/symGov/types/v1"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddRestakerVote adds the vote of an Ethereum restaker on a specific proposal. The vote
+// overrides the vote of the given validator for the stake the voter backs its operator with
+// through vault at the block of the proposal voting power snapshot. The vault must be one of
+// the restaker vaults of the params. Ethereum is not read here: unless the stake was already
+// agreed on, it is requested from x/symStaking and the vote is pending, without power, until
+// validators agree on the stake in a Symbiotic sync. A voter agreed to have no stake cannot
+// vote, and a proposal has at most MaxPendingRestakerVotes pending votes. The signature of the
+// vote must have been verified by the caller.
+func (k Keeper) AddRestakerVote(ctx context.Context, proposalID uint64, voter, vault common.Address, valAddr sdk.ValAddress, options v1.WeightedVoteOptions, metadata string, nonce uint64) error {
+	if err := k.assertVoteValid(ctx, proposalID, options, metadata); err != nil {
+		return err
+	}
+
+	snapshot, err := k.VotingPowerSnapshots.Get(ctx, proposalID)
+	if err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err != nil || snapshot.BlockHash == "" {
+		return errors.Wrapf(types.ErrInvalidRestakerVote, "proposal %d has no Symbiotic voting power snapshot", proposalID)
+	}
+
+	valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	operator := snapshotOperator(snapshot, valAddrStr)
+	if len(operator) != common.AddressLength {
+		return errors.Wrapf(types.ErrInvalidRestakerVote, "validator %s has no Ethereum operator in the voting power snapshot of proposal %d", valAddrStr, proposalID)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !isRestakerVault(params, vault) {
+		return errors.Wrapf(types.ErrInvalidRestakerVote, "vault %s is not a restaker vault", vault.Hex())
+	}
+
+	key := collections.Join3(proposalID, valAddr, restakerVoteKey(voter, vault))
+	prev, err := k.RestakerVotes.Get(ctx, key)
+	if err == nil && nonce <= prev.Nonce {
+		return errors.Wrapf(types.ErrRestakerVoteNonce, "got %d, expected more than %d", nonce, prev.Nonce)
+	} else if err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// the stake is the same for every vote of the voter on the proposal
+	power := math.ZeroInt()
+	if err == nil {
+		power = prev.Power
+	}
+	if !power.IsPositive() {
+		power, err = k.restakerVoteStake(ctx, params, key, snapshot.BlockHash, vault, operator, voter)
+		if err != nil {
+			return err
+		}
+	}
+
+	vote := v1.RestakerVote{
+		ProposalId:       proposalID,
+		Voter:            voter.Hex(),
+		Vault:            vault.Hex(),
+		ValidatorAddress: valAddrStr,
+		Options:          options,
+		Metadata:         metadata,
+		Nonce:            nonce,
+		Power:            power,
+	}
+	if err := k.setRestakerVote(ctx, key, vote); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeRestakerVote,
+		event.NewAttribute(types.AttributeKeyVoter, vote.Voter),
+		event.NewAttribute(types.AttributeKeyVault, vote.Vault),
+		event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+		event.NewAttribute(types.AttributeKeyOption, options.String()),
+		event.NewAttribute(types.AttributeKeyPower, power.String()),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	)
+}
+
+// restakerVoteStake returns the stake agreed on for a restaker vote, or zero after
+// requesting it if it is not agreed on yet. It fails if the voter was agreed to have
+// no stake or if the proposal has too many pending votes.
+func (k Keeper) restakerVoteStake(ctx context.Context, params v1.Params, key collections.Triple[uint64, sdk.ValAddress, []byte], blockHash string, vault common.Address, operator []byte, voter common.Address) (math.Int, error) {
+	stake, err := k.sk.GetSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator, voter.Bytes())
+	switch {
+	case err == nil && !stake.IsPositive():
+		return math.Int{}, errors.Wrapf(types.ErrInvalidRestakerVote, "voter %s has no stake in vault %s", voter.Hex(), vault.Hex())
+	case err == nil:
+		return stake, nil
+	case !stderrors.Is(err, stakingtypes.ErrSymbioticPending) && !stderrors.Is(err, stakingtypes.ErrSymbioticNotFound):
+		return math.Int{}, err
+	}
+
+	// a vote replacing a pending vote does not add to the pending votes
+	pending, err := k.PendingRestakerVotes.Has(ctx, key)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if !pending {
+		count, err := k.countPendingRestakerVotes(ctx, key.K1(), params.MaxPendingRestakerVotes)
+		if err != nil {
+			return math.Int{}, err
+		}
+		if count >= params.MaxPendingRestakerVotes {
+			return math.Int{}, errors.Wrapf(types.ErrInvalidRestakerVote, "proposal %d has %d restaker votes pending", key.K1(), count)
+		}
+	}
+
+	if err := k.sk.RequestSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator, voter.Bytes()); err != nil {
+		return math.Int{}, err
+	}
+
+	return math.ZeroInt(), nil
+}
+
+// countPendingRestakerVotes counts the pending restaker votes of a proposal, up
+// to limit.
+func (k Keeper) countPendingRestakerVotes(ctx context.Context, proposalID, limit uint64) (uint64, error) {
+	var count uint64
+	rng := collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, []byte](proposalID)
+	err := k.PendingRestakerVotes.Walk(ctx, rng, func(collections.Triple[uint64, sdk.ValAddress, []byte]) (bool, error) {
+		count++
+		return count >= limit, nil
+	})
+	return count, err
+}
+
+// SetRestakerVote stores a restaker vote as is. A vote without power is
+// pending until its stake is agreed on.
+func (k Keeper) SetRestakerVote(ctx context.Context, vote v1.RestakerVote) error {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(vote.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(vote.Voter) || !common.IsHexAddress(vote.Vault) {
+		return errors.Wrap(types.ErrInvalidRestakerVote, "invalid voter or vault address")
+	}
+
+	key := restakerVoteKey(common.HexToAddress(vote.Voter), common.HexToAddress(vote.Vault))
+	return k.setRestakerVote(ctx, collections.Join3(vote.ProposalId, sdk.ValAddress(valAddr), key), vote)
+}
+
+// setRestakerVote stores a restaker vote, marking it pending if it has no
+// power.
+func (k Keeper) setRestakerVote(ctx context.Context, key collections.Triple[uint64, sdk.ValAddress, []byte], vote v1.RestakerVote) error {
+	if err := k.RestakerVotes.Set(ctx, key, vote); err != nil {
+		return err
+	}
+
+	if vote.Power.IsNil() || !vote.Power.IsPositive() {
+		return k.PendingRestakerVotes.Set(ctx, key)
+	}
+
+	return k.PendingRestakerVotes.Remove(ctx, key)
+}
+
+// resolveRestakerVotes gives the pending restaker votes the stake agreed on
+// for them in the Symbiotic syncs. Voter stakes are only agreed on in a sync,
+// applied in the x/symStaking EndBlocker, so the pending votes are resolved in
+// the block following a sync only. A vote of a voter without stake, or whose
+// stake request expired before being agreed on, is deleted.
+func (k Keeper) resolveRestakerVotes(ctx context.Context) error {
+	iter, err := k.PendingRestakerVotes.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	_, err = k.sk.GetSymbioticSync(ctx, k.HeaderService.HeaderInfo(ctx).Height-1)
+	if stderrors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		vote, err := k.RestakerVotes.Get(ctx, key)
+		if stderrors.Is(err, collections.ErrNotFound) {
+			if err := k.PendingRestakerVotes.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := k.VotingPowerSnapshots.Get(ctx, vote.ProposalId)
+		if err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		operator := snapshotOperator(snapshot, vote.ValidatorAddress)
+		voter, vault := common.HexToAddress(vote.Voter), common.HexToAddress(vote.Vault)
+
+		// the vote can no longer be resolved without its snapshot or stake request
+		power := math.ZeroInt()
+		if err == nil && len(operator) == common.AddressLength {
+			power, err = k.sk.GetSymbioticVoterStake(ctx, snapshot.BlockHash, vault.Bytes(), operator, voter.Bytes())
+			if stderrors.Is(err, stakingtypes.ErrSymbioticPending) {
+				continue
+			}
+			if stderrors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+				power = math.ZeroInt()
+			} else if err != nil {
+				return err
+			}
+		}
+
+		if err := k.PendingRestakerVotes.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if power.IsPositive() {
+			vote.Power = power
+			err = k.RestakerVotes.Set(ctx, key, vote)
+		} else {
+			err = k.RestakerVotes.Remove(ctx, key)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeRestakerVotePower,
+			event.NewAttribute(types.AttributeKeyVoter, vote.Voter),
+			event.NewAttribute(types.AttributeKeyVault, vote.Vault),
+			event.NewAttribute(types.AttributeKeyValidator, vote.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyPower, power.String()),
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalId)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotOperator returns the Ethereum operator of a validator in a voting
+// power snapshot, nil if it has none.
+func snapshotOperator(snapshot v1.VotingPowerSnapshot, valAddr string) []byte {
+	for _, val := range snapshot.Validators {
+		if val.ValidatorAddress == valAddr {
+			return val.Operator
+		}
+	}
+	return nil
+}
+
+// isRestakerVault returns true if vault is one of the restaker vaults of the
+// params.
+func isRestakerVault(params v1.Params, vault common.Address) bool {
+	for _, v := range params.RestakerVaults {
+		if common.HexToAddress(v) == vault {
+			return true
+		}
+	}
+	return false
+}
+
+// restakerVoteKey returns the key part of a restaker vote identifying the voter
+// and vault, the voter address followed by the vault address.
+func restakerVoteKey(voter, vault common.Address) []byte {
+	return bytes.Join([][]byte{voter.Bytes(), vault.Bytes()}, nil)
+}
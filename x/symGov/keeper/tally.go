@@ -11,9 +11,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
+// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters.
+// The voting power is read from the snapshot taken when the proposal entered its voting period, so that
+// restaked collateral moved on the Ethereum side during voting cannot swing the result.
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	validators, err := k.getCurrentValidators(ctx)
+	validators, totalBonded, err := k.getTallyValidators(ctx, proposal.Id)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -34,11 +36,6 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is no staked coins, the proposal fails
-	totalBonded, err := k.sk.TotalBondedTokens(ctx)
-	if err != nil {
-		return false, false, v1.TallyResult{}, err
-	}
-
 	if totalBonded.IsZero() {
 		return false, false, tallyResults, nil
 	}
@@ -210,6 +207,82 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 	return true, false, tallyResults, nil
 }
 
+// SnapshotVotingPower stores the power of the current bonded validator set as the
+// voting power snapshot of the given proposal. The execution block of the last applied
+// Symbiotic sync and the operators it reported are recorded along, so that restaker
+// votes can be weighted at that block.
+func (k Keeper) SnapshotVotingPower(ctx context.Context, proposalID uint64) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	snapshot := v1.VotingPowerSnapshot{
+		ProposalId: proposalID,
+		Height:     height,
+		TotalPower: math.ZeroInt(),
+	}
+
+	operators := make(map[string][]byte)
+	record, err := k.sk.GetLastAppliedSymbioticSync(ctx, height)
+	if err != nil && !errors.Is(err, stakingTypes.ErrSymbioticNotFound) {
+		return err
+	} else if err == nil {
+		snapshot.BlockHash = record.BlockHash
+		snapshot.BlockNumber = record.BlockNumber
+		for _, stake := range record.Stakes {
+			if stake.ValidatorAddress != "" {
+				operators[stake.ValidatorAddress] = stake.Operator
+			}
+		}
+	}
+
+	if err := k.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingTypes.Validator) (stop bool) {
+		power := validator.GetBondedTokens()
+		snapshot.Validators = append(snapshot.Validators, v1.ValidatorVotingPower{
+			ValidatorAddress: validator.GetOperator(),
+			Power:            power,
+			Operator:         operators[validator.GetOperator()],
+		})
+		snapshot.TotalPower = snapshot.TotalPower.Add(power)
+
+		return false
+	}); err != nil {
+		return err
+	}
+
+	return k.VotingPowerSnapshots.Set(ctx, proposalID, snapshot)
+}
+
+// getTallyValidators returns the validators and the total bonded tokens a proposal is tallied against.
+// Proposals that entered their voting period before snapshots were recorded fall back to the current
+// bonded validator set.
+func (k Keeper) getTallyValidators(ctx context.Context, proposalID uint64) (map[string]v1.ValidatorGovInfo, math.Int, error) {
+	snapshot, err := k.VotingPowerSnapshots.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		validators, err := k.getCurrentValidators(ctx)
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+
+		totalBonded, err := k.sk.TotalBondedTokens(ctx)
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+
+		return validators, totalBonded, nil
+	} else if err != nil {
+		return nil, math.Int{}, err
+	}
+
+	validators := make(map[string]v1.ValidatorGovInfo, len(snapshot.Validators))
+	for _, val := range snapshot.Validators {
+		valBz, err := k.sk.ValidatorAddressCodec().StringToBytes(val.ValidatorAddress)
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+		validators[val.ValidatorAddress] = v1.NewValidatorGovInfo(valBz, val.Power, v1.WeightedVoteOptions{})
+	}
+
+	return validators, snapshot.TotalPower, nil
+}
+
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -276,13 +349,55 @@ func defaultCalculateVoteResultsAndVotingPower(
 		}
 	}
 
+	// iterate over all restaker votes, each one overrides the vote of its validator
+	// for the stake the restaker backs it with, pending votes have no power yet
+	restakerRng := collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, []byte](proposalID)
+	restakerVotesToRemove := []collections.Triple[uint64, sdk.ValAddress, []byte]{}
+	if err := k.RestakerVotes.Walk(ctx, restakerRng, func(key collections.Triple[uint64, sdk.ValAddress, []byte], vote v1.RestakerVote) (bool, error) {
+		restakerVotesToRemove = append(restakerVotesToRemove, key)
+
+		val, ok := validators[vote.ValidatorAddress]
+		if !ok {
+			return false, nil
+		}
+
+		// the restaker cannot take more power than is left to its validator
+		votingPower := math.MinInt(vote.Power, val.BondedTokens.Sub(val.RestakerDeductions))
+		if !votingPower.IsPositive() {
+			return false, nil
+		}
+		val.RestakerDeductions = val.RestakerDeductions.Add(votingPower)
+		validators[vote.ValidatorAddress] = val
+
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.ToLegacyDec().Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVP = totalVP.Add(votingPower.ToLegacyDec())
+
+		return false, nil
+	}); err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	// remove all restaker votes from store
+	for _, key := range restakerVotesToRemove {
+		if err := k.RestakerVotes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+		if err := k.PendingRestakerVotes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range validators {
 		if len(val.Vote) == 0 {
 			continue
 		}
 
-		votingPower := val.BondedTokens.ToLegacyDec()
+		votingPower := val.BondedTokens.Sub(val.RestakerDeductions).ToLegacyDec()
 
 		for _, option := range val.Vote {
 			weight, _ := math.LegacyNewDecFromStr(option.Weight)
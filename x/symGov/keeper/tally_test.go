@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/symGov/keeper"
 	v1 "cosmossdk.io/x/symGov/types/v1"
@@ -32,9 +34,12 @@ type tallyFixture struct {
 
 var (
 	// handy functions
+	// setTotalBonded overrides the total power of the voting power snapshot taken at voting start
 	setTotalBonded = func(s tallyFixture, n int64) {
-		s.mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
-		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil)
+		snapshot, err := s.keeper.VotingPowerSnapshots.Get(s.ctx, s.proposal.Id)
+		require.NoError(s.t, err)
+		snapshot.TotalPower = sdkmath.NewInt(n)
+		require.NoError(s.t, s.keeper.VotingPowerSnapshots.Set(s.ctx, s.proposal.Id, snapshot))
 	}
 
 	validatorVote = func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
@@ -327,6 +332,8 @@ func TestTally_Standard(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -641,6 +648,8 @@ func TestTally_Expedited(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -775,6 +784,8 @@ func TestTally_Optimistic(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -1005,6 +1016,8 @@ func TestTally_MultipleChoice(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+			mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -1058,3 +1071,152 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_VotingPowerSnapshot(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
+
+	var (
+		addrs    = simtestutil.CreateRandomAccounts(4)
+		valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:3])
+		tokens   = []int64{1000000, 1000000, 1000000}
+	)
+	// the bonded tokens are read on every call, so moving stake is visible to the live validator set
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator stakingTypes.Validator) bool) error {
+				for i, valAddr := range valAddrs {
+					valAddrStr, err := valCodec.BytesToString(valAddr)
+					require.NoError(t, err)
+					fn(int64(i), stakingtypes.Validator{
+						OperatorAddress: valAddrStr,
+						Status:          stakingtypes.Bonded,
+						Tokens:          sdkmath.NewInt(tokens[i]),
+					})
+				}
+				return nil
+			}).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[3], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 7, Time: ctx.HeaderInfo().Time})
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	snapshot, err := govKeeper.VotingPowerSnapshots.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Id, snapshot.ProposalId)
+	require.Equal(t, int64(7), snapshot.Height)
+	require.Len(t, snapshot.Validators, 3)
+	require.Equal(t, sdkmath.NewInt(3000000), snapshot.TotalPower)
+
+	vote := func() {
+		for i, option := range []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo} {
+			require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, sdk.AccAddress(valAddrs[i]), v1.NewNonSplitVoteOption(option), ""))
+		}
+	}
+
+	// restaked collateral moves to the validator voting no during the voting period
+	tokens[2] = 100000000
+	vote()
+
+	pass, _, tally, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, "2000000", tally.YesCount)
+	require.Equal(t, "1000000", tally.NoCount)
+
+	// proposals without a snapshot are tallied against the live validator set
+	require.NoError(t, govKeeper.VotingPowerSnapshots.Remove(ctx, proposal.Id))
+	mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(102000000), nil)
+	vote()
+
+	pass, _, tally, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, pass)
+	require.Equal(t, "2000000", tally.YesCount)
+	require.Equal(t, "100000000", tally.NoCount)
+}
+
+func TestTally_RestakerVotes(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	var (
+		addrs      = simtestutil.CreateRandomAccounts(4)
+		valAddrs   = simtestutil.ConvertAddrsToValAddrs(addrs[:3])
+		valStrs    = make([]string, len(valAddrs))
+		operators  = [][]byte{common.HexToAddress("0xa1").Bytes(), common.HexToAddress("0xa2").Bytes(), common.HexToAddress("0xa3").Bytes()}
+		blockHash  = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		syncRecord = stakingtypes.SymbioticSyncRecord{BlockHash: blockHash, BlockNumber: 42}
+	)
+	for i, valAddr := range valAddrs {
+		valStr, err := valCodec.BytesToString(valAddr)
+		require.NoError(t, err)
+		valStrs[i] = valStr
+		syncRecord.Stakes = append(syncRecord.Stakes, stakingtypes.SymbioticSyncStake{ValidatorAddress: valStr, Operator: operators[i], Stake: sdkmath.NewInt(1000000)})
+	}
+	mocks.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(syncRecord, nil).AnyTimes()
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator stakingTypes.Validator) bool) error {
+				for i, valStr := range valStrs {
+					fn(int64(i), stakingtypes.Validator{
+						OperatorAddress: valStr,
+						Status:          stakingtypes.Bonded,
+						Tokens:          sdkmath.NewInt(1000000),
+					})
+				}
+				return nil
+			}).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[3], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	snapshot, err := govKeeper.VotingPowerSnapshots.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, blockHash, snapshot.BlockHash)
+	require.Equal(t, uint64(42), snapshot.BlockNumber)
+	for i, val := range snapshot.Validators {
+		require.Equal(t, operators[i], val.Operator)
+	}
+
+	// the first and last validators vote yes, the second one doesn't vote
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, sdk.AccAddress(valAddrs[0]), v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, sdk.AccAddress(valAddrs[2]), v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	restakerVote := func(voter string, val int, power int64) {
+		require.NoError(t, govKeeper.SetRestakerVote(ctx, v1.RestakerVote{
+			ProposalId:       proposal.Id,
+			Voter:            voter,
+			Vault:            common.HexToAddress("0xb1").Hex(),
+			ValidatorAddress: valStrs[val],
+			Options:          v1.NewNonSplitVoteOption(v1.OptionNo),
+			Nonce:            1,
+			Power:            sdkmath.NewInt(power),
+		}))
+	}
+	// restakers override the vote of their validator for their share
+	restakerVote(common.HexToAddress("0xc1").Hex(), 0, 300000)
+	// restakers vote even if their validator doesn't
+	restakerVote(common.HexToAddress("0xc2").Hex(), 1, 200000)
+	// restakers cannot take more than the power of their validator
+	restakerVote(common.HexToAddress("0xc3").Hex(), 2, 800000)
+	restakerVote(common.HexToAddress("0xc4").Hex(), 2, 800000)
+
+	_, _, tally, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, "700000", tally.YesCount)
+	require.Equal(t, "1500000", tally.NoCount)
+
+	// restaker votes are removed after tally
+	iter, err := govKeeper.RestakerVotes.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, []byte](proposal.Id))
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
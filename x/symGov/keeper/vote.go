@@ -16,6 +16,35 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	if err := k.assertVoteValid(ctx, proposalID, options, metadata); err != nil {
+		return err
+	}
+
+	voterStrAddr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
+	if err != nil {
+		return err
+	}
+	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
+	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
+	if err != nil {
+		return err
+	}
+
+	// called after a vote on a proposal is cast
+	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeProposalVote,
+		event.NewAttribute(types.AttributeKeyVoter, voterStrAddr),
+		event.NewAttribute(types.AttributeKeyOption, options.String()),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	)
+}
+
+// assertVoteValid checks that a proposal is in voting period and that the vote
+// options and metadata can be cast on it.
+func (k Keeper) assertVoteValid(ctx context.Context, proposalID uint64, options v1.WeightedVoteOptions, metadata string) error {
 	// get proposal
 	proposal, err := k.Proposals.Get(ctx, proposalID)
 	if err != nil {
@@ -73,26 +102,7 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		}
 	}
 
-	voterStrAddr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
-	if err != nil {
-		return err
-	}
-	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
-	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
-	if err != nil {
-		return err
-	}
-
-	// called after a vote on a proposal is cast
-	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
-		return err
-	}
-
-	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeProposalVote,
-		event.NewAttribute(types.AttributeKeyVoter, voterStrAddr),
-		event.NewAttribute(types.AttributeKeyOption, options.String()),
-		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-	)
+	return nil
 }
 
 // deleteVotes deletes all the votes from a given proposalID.
@@ -103,5 +113,10 @@ func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 		return err
 	}
 
-	return nil
+	restakerRng := collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, []byte](proposalID)
+	if err := k.PendingRestakerVotes.Clear(ctx, restakerRng); err != nil {
+		return err
+	}
+
+	return k.RestakerVotes.Clear(ctx, restakerRng)
 }
@@ -195,6 +195,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			optimisticRejectedThreshold.String(),
 			[]string{},
 			10_000_000,
+			nil,
+			v1.DefaultMaxPendingRestakerVotes,
 		),
 	)
 
@@ -342,6 +342,51 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// GetLastAppliedSymbioticSync mocks base method.
+func (m *MockStakingKeeper) GetLastAppliedSymbioticSync(ctx context.Context, height int64) (stakingTypes.SymbioticSyncRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastAppliedSymbioticSync", ctx, height)
+	ret0, _ := ret[0].(stakingTypes.SymbioticSyncRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastAppliedSymbioticSync indicates an expected call of GetLastAppliedSymbioticSync.
+func (mr *MockStakingKeeperMockRecorder) GetLastAppliedSymbioticSync(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAppliedSymbioticSync", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastAppliedSymbioticSync), ctx, height)
+}
+
+// GetSymbioticSync mocks base method.
+func (m *MockStakingKeeper) GetSymbioticSync(ctx context.Context, height int64) (stakingTypes.SymbioticSyncRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSymbioticSync", ctx, height)
+	ret0, _ := ret[0].(stakingTypes.SymbioticSyncRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSymbioticSync indicates an expected call of GetSymbioticSync.
+func (mr *MockStakingKeeperMockRecorder) GetSymbioticSync(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSymbioticSync", reflect.TypeOf((*MockStakingKeeper)(nil).GetSymbioticSync), ctx, height)
+}
+
+// GetSymbioticVoterStake mocks base method.
+func (m *MockStakingKeeper) GetSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSymbioticVoterStake", ctx, blockHash, vault, operator, voter)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSymbioticVoterStake indicates an expected call of GetSymbioticVoterStake.
+func (mr *MockStakingKeeperMockRecorder) GetSymbioticVoterStake(ctx, blockHash, vault, operator, voter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSymbioticVoterStake", reflect.TypeOf((*MockStakingKeeper)(nil).GetSymbioticVoterStake), ctx, blockHash, vault, operator, voter)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, stakingTypes.Validator) bool) error {
 	m.ctrl.T.Helper()
@@ -370,6 +415,20 @@ func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
 }
 
+// RequestSymbioticVoterStake mocks base method.
+func (m *MockStakingKeeper) RequestSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestSymbioticVoterStake", ctx, blockHash, vault, operator, voter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestSymbioticVoterStake indicates an expected call of RequestSymbioticVoterStake.
+func (mr *MockStakingKeeperMockRecorder) RequestSymbioticVoterStake(ctx, blockHash, vault, operator, voter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSymbioticVoterStake", reflect.TypeOf((*MockStakingKeeper)(nil).RequestSymbioticVoterStake), ctx, blockHash, vault, operator, voter)
+}
+
 // TokensFromConsensusPower mocks base method.
 func (m *MockStakingKeeper) TokensFromConsensusPower(ctx context.Context, power int64) math.Int {
 	m.ctrl.T.Helper()
@@ -28,4 +28,8 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrInvalidRestakerVote     = errors.Register(ModuleName, 27, "invalid restaker vote")
+	ErrInvalidRestakerVoteSig  = errors.Register(ModuleName, 28, "invalid restaker vote signature")
+	ErrRestakerVoteNonce       = errors.Register(ModuleName, 29, "restaker vote nonce too low")
+	ErrNoRestakerStake         = errors.Register(ModuleName, 30, "voter has no stake backing the validator")
 )
@@ -2,12 +2,14 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeProposalDeposit   = "proposal_deposit"
+	EventTypeProposalVote      = "proposal_vote"
+	EventTypeInactiveProposal  = "inactive_proposal"
+	EventTypeActiveProposal    = "active_proposal"
+	EventTypeCancelProposal    = "cancel_proposal"
+	EventTypeRestakerVote      = "restaker_vote"
+	EventTypeRestakerVotePower = "restaker_vote_power"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +21,9 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyVault                = "vault"                  // Ethereum address of the vault of a restaker vote
+	AttributeKeyValidator            = "validator"              // operator address of the validator a restaker vote overrides
+	AttributeKeyPower                = "power"                  // voting power of a restaker vote
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
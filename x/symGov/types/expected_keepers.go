@@ -19,6 +19,17 @@ type StakingKeeper interface {
 	) error
 
 	TotalBondedTokens(context.Context) (math.Int, error) // total bonded tokens within the validator set
+
+	// GetLastAppliedSymbioticSync returns the last Symbiotic sync at or before height that updated the validator set
+	GetLastAppliedSymbioticSync(ctx context.Context, height int64) (stakingTypes.SymbioticSyncRecord, error)
+	// GetSymbioticSync returns the Symbiotic sync at height, applied or skipped, or ErrSymbioticNotFound if there is none
+	GetSymbioticSync(ctx context.Context, height int64) (stakingTypes.SymbioticSyncRecord, error)
+	// RequestSymbioticVoterStake requests the stake an Ethereum voter backs an operator with through a vault at a
+	// block to be agreed on in the next Symbiotic syncs
+	RequestSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) error
+	// GetSymbioticVoterStake returns the agreed stake an Ethereum voter backs an operator with through a vault at a
+	// block, ErrSymbioticPending if it was not agreed on yet or ErrSymbioticNotFound if its request expired
+	GetSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) (math.Int, error)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -26,6 +26,9 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based symGov params.
+	VotingPowerSnapshotsPrefix   = collections.NewPrefix(52) // VotingPowerSnapshotsPrefix stores the voting power snapshots of proposals.
+	RestakerVotesKeyPrefix       = collections.NewPrefix(53) // RestakerVotesKeyPrefix stores the votes of Ethereum restakers on proposals.
+	PendingRestakerVotesPrefix   = collections.NewPrefix(54) // PendingRestakerVotesPrefix stores the restaker votes whose stake is not agreed on yet.
 )
 
 // Reserved kvstore keys
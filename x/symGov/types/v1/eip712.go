@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EIP-712 domain of the restaker votes.
+const (
+	RestakerVoteDomainName    = "Symbiotic Governance"
+	RestakerVoteDomainVersion = "1"
+)
+
+var (
+	eip712DomainTypeHash       = crypto.Keccak256([]byte("EIP712Domain(string name,string version)"))
+	weightedVoteOptionTypeHash = crypto.Keccak256([]byte("WeightedVoteOption(uint8 option,string weight)"))
+	restakerVoteTypeHash       = crypto.Keccak256([]byte(
+		"RestakerVote(string chainId,uint64 proposalId,address vault,string validator,WeightedVoteOption[] options,string metadata,uint64 nonce)" +
+			"WeightedVoteOption(uint8 option,string weight)",
+	))
+)
+
+// RestakerVoteSignBytes returns the EIP-712 digest an Ethereum restaker signs to
+// vote on a proposal of the chain with the given id. The vote is encoded as:
+//
+//	RestakerVote(string chainId,uint64 proposalId,address vault,string validator,WeightedVoteOption[] options,string metadata,uint64 nonce)
+//	WeightedVoteOption(uint8 option,string weight)
+//
+// in the domain EIP712Domain(string name,string version) of RestakerVoteDomainName
+// and RestakerVoteDomainVersion.
+func RestakerVoteSignBytes(chainID string, proposalID uint64, vault common.Address, validator string, options WeightedVoteOptions, metadata string, nonce uint64) []byte {
+	domainSeparator := crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(RestakerVoteDomainName)),
+		crypto.Keccak256([]byte(RestakerVoteDomainVersion)),
+	)
+
+	optionHashes := make([][]byte, 0, len(options))
+	for _, option := range options {
+		optionHashes = append(optionHashes, crypto.Keccak256(
+			weightedVoteOptionTypeHash,
+			uint256Word(uint64(option.Option)),
+			crypto.Keccak256([]byte(option.Weight)),
+		))
+	}
+
+	structHash := crypto.Keccak256(
+		restakerVoteTypeHash,
+		crypto.Keccak256([]byte(chainID)),
+		uint256Word(proposalID),
+		common.LeftPadBytes(vault.Bytes(), 32),
+		crypto.Keccak256([]byte(validator)),
+		crypto.Keccak256(optionHashes...),
+		crypto.Keccak256([]byte(metadata)),
+		uint256Word(nonce),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// RecoverRestakerVoteSigner returns the Ethereum address that produced the 65
+// bytes signature of digest. The recovery id is accepted as 0/1 or 27/28.
+func RecoverRestakerVoteSigner(digest, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d, expected %d", len(signature), crypto.SignatureLength)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, errors.New("invalid signature recovery id")
+	}
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// uint256Word returns n as a 32 bytes big endian word.
+func uint256Word(n uint64) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], n)
+	return word
+}
@@ -0,0 +1,54 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	v1 "cosmossdk.io/x/symGov/types/v1"
+)
+
+func TestRestakerVoteSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	vault := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	options := v1.NewNonSplitVoteOption(v1.OptionYes)
+	digest := v1.RestakerVoteSignBytes("symbiotic-1", 1, vault, "cosmosvaloper1", options, "", 1)
+	require.Len(t, digest, 32)
+
+	signature, err := crypto.Sign(digest, key)
+	require.NoError(t, err)
+
+	recovered, err := v1.RecoverRestakerVoteSigner(digest, signature)
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+
+	// wallets return the recovery id as 27/28
+	signature[crypto.RecoveryIDOffset] += 27
+	recovered, err = v1.RecoverRestakerVoteSigner(digest, signature)
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+
+	// every field of the vote is signed
+	for _, other := range [][]byte{
+		v1.RestakerVoteSignBytes("symbiotic-2", 1, vault, "cosmosvaloper1", options, "", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 2, vault, "cosmosvaloper1", options, "", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 1, common.HexToAddress("0xb2"), "cosmosvaloper1", options, "", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 1, vault, "cosmosvaloper2", options, "", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 1, vault, "cosmosvaloper1", v1.NewNonSplitVoteOption(v1.OptionNo), "", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 1, vault, "cosmosvaloper1", options, "metadata", 1),
+		v1.RestakerVoteSignBytes("symbiotic-1", 1, vault, "cosmosvaloper1", options, "", 2),
+	} {
+		require.NotEqual(t, digest, other)
+		recovered, err = v1.RecoverRestakerVoteSigner(other, signature)
+		require.NoError(t, err)
+		require.NotEqual(t, signer, recovered)
+	}
+
+	_, err = v1.RecoverRestakerVoteSigner(digest, signature[:64])
+	require.ErrorContains(t, err, "invalid signature length 64")
+}
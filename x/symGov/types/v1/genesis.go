@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -34,7 +36,8 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if symGov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit, vote, restaker vote or voting power snapshot records and none of
+// them refer to non-existent proposals
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -98,6 +101,69 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate voting power snapshots
+	errGroup.Go(func() error {
+		snapshotIds := make(map[uint64]struct{})
+		for _, s := range data.VotingPowerSnapshots {
+			if _, ok := proposalIds[s.ProposalId]; !ok {
+				return fmt.Errorf("voting power snapshot has non-existent proposal id: %d", s.ProposalId)
+			}
+
+			if _, ok := snapshotIds[s.ProposalId]; ok {
+				return fmt.Errorf("duplicate voting power snapshot for proposal id: %d", s.ProposalId)
+			}
+
+			total := math.ZeroInt()
+			for _, val := range s.Validators {
+				if val.Power.IsNil() || val.Power.IsNegative() {
+					return fmt.Errorf("voting power snapshot for proposal id %d has invalid power for validator %s", s.ProposalId, val.ValidatorAddress)
+				}
+				total = total.Add(val.Power)
+			}
+
+			if s.TotalPower.IsNil() || !s.TotalPower.Equal(total) {
+				return fmt.Errorf("voting power snapshot for proposal id %d has total power %s, expected %s", s.ProposalId, s.TotalPower, total)
+			}
+
+			snapshotIds[s.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate restaker votes
+	errGroup.Go(func() error {
+		type restakerVoteKey struct {
+			ProposalId uint64
+			Validator  string
+			Voter      common.Address
+			Vault      common.Address
+		}
+		voteIds := make(map[restakerVoteKey]struct{})
+		for _, v := range data.RestakerVotes {
+			if _, ok := proposalIds[v.ProposalId]; !ok {
+				return fmt.Errorf("restaker vote %v has non-existent proposal id: %d", v, v.ProposalId)
+			}
+
+			if !common.IsHexAddress(v.Voter) || !common.IsHexAddress(v.Vault) {
+				return fmt.Errorf("restaker vote %v has invalid voter or vault address", v)
+			}
+
+			if v.Power.IsNil() || !v.Power.IsPositive() {
+				return fmt.Errorf("restaker vote %v has non-positive power", v)
+			}
+
+			vk := restakerVoteKey{v.ProposalId, v.ValidatorAddress, common.HexToAddress(v.Voter), common.HexToAddress(v.Vault)}
+			if _, ok := voteIds[vk]; ok {
+				return fmt.Errorf("duplicate restaker vote: %v", v)
+			}
+
+			voteIds[vk] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -49,6 +49,10 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// voting_power_snapshots defines the voting power snapshots of proposals in or past their voting period.
+	VotingPowerSnapshots []*VotingPowerSnapshot `protobuf:"bytes,10,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots,omitempty"`
+	// restaker_votes defines all the restaker votes present at genesis.
+	RestakerVotes []*RestakerVote `protobuf:"bytes,11,rep,name=restaker_votes,json=restakerVotes,proto3" json:"restaker_votes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +154,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetVotingPowerSnapshots() []*VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRestakerVotes() []*RestakerVote {
+	if m != nil {
+		return m.RestakerVotes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.symGov.v1.GenesisState")
 }
@@ -157,33 +175,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/symGov/v1/genesis.proto", fileDescriptor_36d56c728b8bb8fe) }
 
 var fileDescriptor_36d56c728b8bb8fe = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x19, 0xfe, 0x09, 0x43, 0x51, 0x33, 0x1a, 0x33, 0x34, 0x71, 0x6c, 0x3c, 0xf5, 0x20,
-	0x2d, 0xa0, 0x04, 0xce, 0x04, 0x43, 0xf4, 0x44, 0xaa, 0xf1, 0xe0, 0x85, 0x54, 0xda, 0x90, 0x06,
-	0xe8, 0x34, 0xbc, 0xe3, 0x44, 0xbe, 0x85, 0x1f, 0xc6, 0x93, 0x9f, 0x60, 0x8f, 0x1c, 0x37, 0x7b,
-	0xda, 0xc0, 0x17, 0xd9, 0x30, 0xd3, 0x2e, 0xec, 0x96, 0x3d, 0xce, 0xfb, 0x3c, 0xbf, 0x67, 0x9e,
-	0xbc, 0x79, 0x31, 0x9b, 0x73, 0x58, 0x73, 0x70, 0x61, 0xbb, 0x9e, 0x70, 0xe9, 0xca, 0xae, 0xbb,
-	0x08, 0xe3, 0x10, 0x22, 0x70, 0x92, 0x0d, 0x17, 0x9c, 0xbc, 0xd4, 0xba, 0xa3, 0x75, 0x47, 0x76,
-	0x4d, 0x33, 0x4f, 0x70, 0xa9, 0xdd, 0x66, 0x4b, 0x6b, 0x33, 0xf5, 0x72, 0x53, 0x54, 0x3d, 0xde,
-	0xff, 0x2f, 0x63, 0x63, 0xa2, 0xa3, 0xbf, 0x09, 0x5f, 0x84, 0xa4, 0x83, 0x5f, 0x83, 0xf0, 0x37,
-	0x22, 0x8a, 0x17, 0x47, 0x7f, 0xc2, 0xc1, 0x5f, 0xcd, 0xa2, 0x80, 0x22, 0x0b, 0xd9, 0x65, 0x8f,
-	0x64, 0xda, 0x34, 0x95, 0xbe, 0x04, 0xa4, 0x8f, 0x6b, 0x41, 0x98, 0x70, 0x88, 0x04, 0xd0, 0xa2,
-	0x55, 0xb2, 0x1b, 0xbd, 0x96, 0xf3, 0xb8, 0x9e, 0x33, 0xd6, 0x0e, 0xef, 0xde, 0x4a, 0x3e, 0xe0,
-	0x8a, 0xe4, 0x22, 0x04, 0x5a, 0x52, 0xcc, 0x9b, 0x3c, 0xf3, 0x83, 0x8b, 0xd0, 0xd3, 0x26, 0x32,
-	0xc4, 0xf5, 0xac, 0x0d, 0xd0, 0xb2, 0x22, 0xcc, 0x3c, 0x91, 0xb5, 0xf2, 0x4e, 0x66, 0xf2, 0x15,
-	0x3f, 0x4f, 0xff, 0x9c, 0x25, 0xfe, 0xc6, 0x5f, 0x03, 0xad, 0x58, 0xc8, 0x6e, 0xf4, 0xde, 0x3d,
-	0x59, 0x72, 0xaa, 0x6c, 0xa3, 0x22, 0x45, 0x5e, 0x33, 0x38, 0x1f, 0x91, 0x09, 0x6e, 0x4a, 0xae,
-	0x57, 0xa3, 0xa3, 0xaa, 0x2a, 0x8a, 0x5d, 0xec, 0x7e, 0xdc, 0xd2, 0x29, 0xc9, 0x90, 0x67, 0x13,
-	0x32, 0xc6, 0x86, 0xf0, 0x57, 0xab, 0x6d, 0x96, 0xf3, 0x4c, 0xe5, 0xbc, 0xcd, 0xe7, 0x7c, 0x3f,
-	0xba, 0xce, 0x62, 0x1a, 0xe2, 0x34, 0x20, 0x9f, 0x71, 0x35, 0xe5, 0x6b, 0x8a, 0xa7, 0x17, 0x36,
-	0xa2, 0xd1, 0x57, 0x37, 0xff, 0xda, 0x2f, 0xb4, 0xd8, 0x86, 0x60, 0x69, 0x75, 0x9c, 0x4f, 0x03,
-	0x2f, 0x85, 0xc9, 0x00, 0x1b, 0x73, 0x1e, 0x83, 0x88, 0xc4, 0x6f, 0x11, 0xf1, 0x98, 0xd6, 0x2d,
-	0x64, 0xd7, 0x2f, 0x20, 0xfd, 0x8e, 0xf7, 0xc0, 0x38, 0x1a, 0x5e, 0xed, 0x19, 0xda, 0xed, 0x19,
-	0xba, 0xdd, 0x33, 0xf4, 0xf7, 0xc0, 0x0a, 0xbb, 0x03, 0x2b, 0x5c, 0x1f, 0x58, 0xe1, 0x67, 0x7a,
-	0xbf, 0x10, 0x2c, 0x9d, 0x88, 0xbb, 0x7f, 0xb2, 0xab, 0x14, 0xdb, 0x24, 0x04, 0x57, 0x76, 0x7f,
-	0x55, 0xd5, 0xf5, 0x7d, 0xbc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x17, 0x39, 0xac, 0xe8, 0x02,
-	0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xb6, 0x09, 0xcd, 0x26, 0x29, 0x68, 0xa9, 0xaa, 0x6d, 0x24, 0x8c, 0x85, 0x84,
+	0x94, 0x03, 0xb5, 0x93, 0x42, 0xd5, 0x9e, 0xab, 0xa2, 0x08, 0x4e, 0xd5, 0x16, 0x71, 0x80, 0x83,
+	0x65, 0xea, 0x55, 0xb1, 0x92, 0x78, 0x2c, 0xcf, 0xb0, 0x90, 0xb7, 0xe0, 0x61, 0x78, 0x08, 0x8e,
+	0x3d, 0x22, 0x0e, 0x08, 0x25, 0x2f, 0x82, 0xbc, 0x6b, 0x93, 0x80, 0xd3, 0xe3, 0xce, 0xff, 0xfd,
+	0x7f, 0x26, 0xbf, 0x77, 0x99, 0x7b, 0x0d, 0x38, 0x03, 0x0c, 0x70, 0x3e, 0x1b, 0x83, 0x0e, 0xf4,
+	0x28, 0xb8, 0x51, 0xa9, 0xc2, 0x04, 0xfd, 0x2c, 0x07, 0x02, 0xfe, 0xc0, 0xea, 0xbe, 0xd5, 0x7d,
+	0x3d, 0xea, 0xf7, 0xeb, 0x0e, 0xd0, 0x96, 0xee, 0x1f, 0x5a, 0x2d, 0x34, 0xa7, 0xa0, 0xb4, 0x9a,
+	0xc3, 0x93, 0x5f, 0x4d, 0xd6, 0x1d, 0xdb, 0xe8, 0x2b, 0x8a, 0x48, 0xf1, 0x21, 0xdb, 0x47, 0x8a,
+	0x72, 0x4a, 0xd2, 0x9b, 0x82, 0xcf, 0x00, 0xa3, 0x69, 0x98, 0xc4, 0xc2, 0xf1, 0x9c, 0xc1, 0x8e,
+	0xe4, 0x95, 0x76, 0x59, 0x4a, 0xaf, 0x62, 0x7e, 0xc2, 0x76, 0x63, 0x95, 0x01, 0x26, 0x84, 0x62,
+	0xcb, 0xdb, 0x1e, 0x74, 0x8e, 0x0f, 0xfd, 0xff, 0xd7, 0xf3, 0x2f, 0x2c, 0x21, 0xff, 0xa2, 0xfc,
+	0x19, 0x6b, 0x6a, 0x20, 0x85, 0x62, 0xdb, 0x78, 0x0e, 0xea, 0x9e, 0xb7, 0x40, 0x4a, 0x5a, 0x88,
+	0x9f, 0xb1, 0x76, 0xb5, 0x0d, 0x8a, 0x1d, 0xe3, 0xe8, 0xd7, 0x1d, 0xd5, 0x56, 0x72, 0x05, 0xf3,
+	0xd7, 0x6c, 0xaf, 0xfc, 0xcd, 0x30, 0x8b, 0xf2, 0x68, 0x86, 0xa2, 0xe9, 0x39, 0x83, 0xce, 0xf1,
+	0xe3, 0x3b, 0x97, 0xbc, 0x34, 0xd8, 0xf9, 0x96, 0x70, 0x64, 0x2f, 0x5e, 0x1f, 0xf1, 0x31, 0xeb,
+	0x69, 0xb0, 0xd5, 0xd8, 0xa8, 0x96, 0x89, 0x72, 0x37, 0xee, 0x5e, 0xb4, 0xb4, 0x4a, 0xea, 0xea,
+	0xb5, 0x09, 0xbf, 0x60, 0x5d, 0x8a, 0xa6, 0xd3, 0x79, 0x95, 0x73, 0xcf, 0xe4, 0x3c, 0xaa, 0xe7,
+	0xbc, 0x29, 0xa8, 0xb5, 0x98, 0x0e, 0xad, 0x06, 0xfc, 0x25, 0x6b, 0x95, 0xfe, 0x5d, 0xe3, 0x17,
+	0x1b, 0x1a, 0xb1, 0xd6, 0x87, 0x3f, 0xbf, 0x1d, 0xdd, 0xb7, 0xe2, 0x11, 0xc6, 0x13, 0x6f, 0xe8,
+	0xbf, 0x38, 0x95, 0xa5, 0x99, 0x9f, 0xb2, 0xee, 0x35, 0xa4, 0x48, 0x09, 0x7d, 0xa2, 0x04, 0x52,
+	0xd1, 0xf6, 0x9c, 0x41, 0x7b, 0x83, 0xe5, 0x64, 0x28, 0xff, 0x01, 0xf9, 0x7b, 0x76, 0x50, 0xd5,
+	0x01, 0x9f, 0x55, 0x1e, 0x62, 0x1a, 0x65, 0xf8, 0x11, 0x08, 0x05, 0x33, 0x5f, 0xe8, 0xe9, 0x9d,
+	0xbd, 0x14, 0xf8, 0x55, 0x49, 0xcb, 0x7d, 0x5d, 0x1f, 0x16, 0x7f, 0x6e, 0x2f, 0x57, 0x48, 0xd1,
+	0x44, 0xe5, 0xa1, 0xbd, 0x28, 0x1d, 0x13, 0xba, 0xa1, 0x6c, 0x59, 0x72, 0xe6, 0xc2, 0xf4, 0xf2,
+	0xb5, 0x13, 0x9e, 0x9f, 0x7d, 0x5f, 0xb8, 0xce, 0xed, 0xc2, 0x75, 0x7e, 0x2f, 0x5c, 0xe7, 0xeb,
+	0xd2, 0x6d, 0xdc, 0x2e, 0xdd, 0xc6, 0x8f, 0xa5, 0xdb, 0x78, 0x57, 0xbe, 0x31, 0x8c, 0x27, 0x7e,
+	0x02, 0xc1, 0x97, 0xea, 0xe5, 0xd0, 0x3c, 0x53, 0x18, 0xe8, 0xd1, 0x87, 0x96, 0x79, 0x21, 0xcf,
+	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x99, 0xbc, 0x93, 0xa2, 0x8c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +227,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RestakerVotes) > 0 {
+		for iNdEx := len(m.RestakerVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RestakerVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +418,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RestakerVotes) > 0 {
+		for _, e := range m.RestakerVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +765,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, &VotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakerVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakerVotes = append(m.RestakerVotes, &RestakerVote{})
+			if err := m.RestakerVotes[len(m.RestakerVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
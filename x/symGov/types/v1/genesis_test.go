@@ -172,6 +172,115 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid voting power snapshot",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots, &v1.VotingPowerSnapshot{
+					ProposalId: 1,
+					Validators: []v1.ValidatorVotingPower{{ValidatorAddress: "val1", Power: sdkmath.NewInt(10)}, {ValidatorAddress: "val2", Power: sdkmath.NewInt(5)}},
+					TotalPower: sdkmath.NewInt(15),
+				})
+
+				return state
+			},
+		},
+		{
+			name: "non-existent proposal id in voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots, &v1.VotingPowerSnapshot{ProposalId: 1, TotalPower: sdkmath.ZeroInt()})
+
+				return state
+			},
+			expErrMsg: "voting power snapshot has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, TotalPower: sdkmath.ZeroInt()},
+					&v1.VotingPowerSnapshot{ProposalId: 1, TotalPower: sdkmath.ZeroInt()})
+
+				return state
+			},
+			expErrMsg: "duplicate voting power snapshot for proposal id: 1",
+		},
+		{
+			name: "voting power snapshot total mismatch",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots, &v1.VotingPowerSnapshot{
+					ProposalId: 1,
+					Validators: []v1.ValidatorVotingPower{{ValidatorAddress: "val1", Power: sdkmath.NewInt(10)}},
+					TotalPower: sdkmath.NewInt(15),
+				})
+
+				return state
+			},
+			expErrMsg: "voting power snapshot for proposal id 1 has total power 15, expected 10",
+		},
+		{
+			name: "valid restaker votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.RestakerVotes = append(state.RestakerVotes,
+					&v1.RestakerVote{ProposalId: 1, Voter: "0x00000000000000000000000000000000000000c1", Vault: "0x00000000000000000000000000000000000000b1", ValidatorAddress: "val1", Power: sdkmath.NewInt(10)},
+					&v1.RestakerVote{ProposalId: 1, Voter: "0x00000000000000000000000000000000000000c1", Vault: "0x00000000000000000000000000000000000000b2", ValidatorAddress: "val1", Power: sdkmath.NewInt(10)})
+
+				return state
+			},
+		},
+		{
+			name: "non-existent proposal id in restaker votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.RestakerVotes = append(state.RestakerVotes, &v1.RestakerVote{ProposalId: 1, Power: sdkmath.NewInt(10)})
+
+				return state
+			},
+			expErrMsg: "has non-existent proposal id: 1",
+		},
+		{
+			name: "invalid restaker vote voter",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.RestakerVotes = append(state.RestakerVotes, &v1.RestakerVote{ProposalId: 1, Voter: "0x1234", Vault: "0x00000000000000000000000000000000000000b1", Power: sdkmath.NewInt(10)})
+
+				return state
+			},
+			expErrMsg: "has invalid voter or vault address",
+		},
+		{
+			name: "zero restaker vote power",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.RestakerVotes = append(state.RestakerVotes, &v1.RestakerVote{ProposalId: 1, Voter: "0x00000000000000000000000000000000000000c1", Vault: "0x00000000000000000000000000000000000000b1", Power: sdkmath.ZeroInt()})
+
+				return state
+			},
+			expErrMsg: "has non-positive power",
+		},
+		{
+			name: "duplicate restaker votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.RestakerVotes = append(state.RestakerVotes,
+					&v1.RestakerVote{ProposalId: 1, Voter: "0x00000000000000000000000000000000000000c1", Vault: "0x00000000000000000000000000000000000000b1", ValidatorAddress: "val1", Power: sdkmath.NewInt(10)},
+					&v1.RestakerVote{ProposalId: 1, Voter: "0x00000000000000000000000000000000000000C1", Vault: "0x00000000000000000000000000000000000000B1", ValidatorAddress: "val1", Power: sdkmath.NewInt(5)})
+
+				return state
+			},
+			expErrMsg: "duplicate restaker vote",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,7 @@
 package v1
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -698,6 +699,255 @@ func (m *TallyResult) GetSpamCount() string {
 	return ""
 }
 
+// ValidatorVotingPower defines the voting power of a single validator captured
+// in a proposal's voting power snapshot.
+type ValidatorVotingPower struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// power is the Symbiotic restaked power (bonded tokens) of the validator at voting start.
+	Power cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+	// operator is the Ethereum operator address reported by the middleware for the validator, if any.
+	Operator []byte `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *ValidatorVotingPower) Reset()         { *m = ValidatorVotingPower{} }
+func (m *ValidatorVotingPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVotingPower) ProtoMessage()    {}
+func (*ValidatorVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4115062d5571d036, []int{5}
+}
+func (m *ValidatorVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVotingPower.Merge(m, src)
+}
+func (m *ValidatorVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVotingPower proto.InternalMessageInfo
+
+func (m *ValidatorVotingPower) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorVotingPower) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+// VotingPowerSnapshot defines the validator voting power captured when a proposal
+// enters its voting period. Proposals are tallied against this snapshot so that
+// stake moved on the Ethereum side during voting cannot change the outcome.
+type VotingPowerSnapshot struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height is the block height at which the snapshot was taken.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// validators is the bonded validator set with their power at voting start.
+	Validators []ValidatorVotingPower `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+	// total_power is the sum of the power of all validators in the snapshot.
+	TotalPower cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_power,json=totalPower,proto3,customtype=cosmossdk.io/math.Int" json:"total_power"`
+	// block_hash is the Ethereum execution block hash the validator power was read at, empty if
+	// no Symbiotic sync was applied yet. Restaker votes are weighted at this block.
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the Ethereum execution block.
+	BlockNumber uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4115062d5571d036, []int{6}
+}
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
+}
+func (m *VotingPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
+
+func (m *VotingPowerSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetValidators() []ValidatorVotingPower {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *VotingPowerSnapshot) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *VotingPowerSnapshot) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// RestakerVote defines a vote cast on a governance proposal by an Ethereum address restaking
+// through a Symbiotic vault. It overrides the vote of the validator it backs for its power.
+type RestakerVote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the hex encoded Ethereum address of the vault depositor or curator.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// vault is the hex encoded Ethereum address of the vault the voter restakes through.
+	Vault string `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
+	// validator_address is the operator address of the validator whose vote is overridden.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// nonce is the nonce of the signed vote, a vote is only replaced by one with a higher nonce.
+	Nonce uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// power is the stake the voter backs the validator with, read from the middleware at the
+	// block of the proposal voting power snapshot.
+	Power cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+}
+
+func (m *RestakerVote) Reset()         { *m = RestakerVote{} }
+func (m *RestakerVote) String() string { return proto.CompactTextString(m) }
+func (*RestakerVote) ProtoMessage()    {}
+func (*RestakerVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4115062d5571d036, []int{7}
+}
+func (m *RestakerVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestakerVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestakerVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestakerVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestakerVote.Merge(m, src)
+}
+func (m *RestakerVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestakerVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestakerVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestakerVote proto.InternalMessageInfo
+
+func (m *RestakerVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *RestakerVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *RestakerVote) GetVault() string {
+	if m != nil {
+		return m.Vault
+	}
+	return ""
+}
+
+func (m *RestakerVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RestakerVote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *RestakerVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *RestakerVote) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -716,7 +966,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{5}
+	return fileDescriptor_4115062d5571d036, []int{8}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +1038,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{6}
+	return fileDescriptor_4115062d5571d036, []int{9}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,7 +1093,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{7}
+	return fileDescriptor_4115062d5571d036, []int{10}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1147,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{8}
+	return fileDescriptor_4115062d5571d036, []int{11}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,13 +1255,19 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// restaker_vaults are the hex encoded Ethereum addresses of the Symbiotic vaults restakers can vote
+	// through. Restaker votes are disabled if empty.
+	RestakerVaults []string `protobuf:"bytes,23,rep,name=restaker_vaults,json=restakerVaults,proto3" json:"restaker_vaults,omitempty"`
+	// max_pending_restaker_votes defines the maximum number of restaker votes of a proposal waiting for
+	// their stake to be agreed on.
+	MaxPendingRestakerVotes uint64 `protobuf:"varint,24,opt,name=max_pending_restaker_votes,json=maxPendingRestakerVotes,proto3" json:"max_pending_restaker_votes,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{9}
+	return fileDescriptor_4115062d5571d036, []int{12}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,6 +1450,20 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetRestakerVaults() []string {
+	if m != nil {
+		return m.RestakerVaults
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPendingRestakerVotes() uint64 {
+	if m != nil {
+		return m.MaxPendingRestakerVotes
+	}
+	return 0
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1215,7 +1485,7 @@ func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
 func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
 func (*MessageBasedParams) ProtoMessage()    {}
 func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4115062d5571d036, []int{10}
+	return fileDescriptor_4115062d5571d036, []int{13}
 }
 func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,6 +1558,9 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.symGov.v1.Proposal")
 	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.symGov.v1.ProposalVoteOptions")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.symGov.v1.TallyResult")
+	proto.RegisterType((*ValidatorVotingPower)(nil), "cosmos.symGov.v1.ValidatorVotingPower")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "cosmos.symGov.v1.VotingPowerSnapshot")
+	proto.RegisterType((*RestakerVote)(nil), "cosmos.symGov.v1.RestakerVote")
 	proto.RegisterType((*Vote)(nil), "cosmos.symGov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.symGov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.symGov.v1.VotingParams")
@@ -1299,133 +1572,152 @@ func init() {
 func init() { proto.RegisterFile("cosmos/symGov/v1/gov.proto", fileDescriptor_4115062d5571d036) }
 
 var fileDescriptor_4115062d5571d036 = []byte{
-	// 2004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x65, 0x99, 0x1e, 0xdb, 0x31, 0xe3, 0xc4, 0x1f, 0x11, 0x16,
-	0x8b, 0x34, 0xbb, 0x96, 0xec, 0xec, 0xa6, 0x4d, 0x83, 0xdd, 0xa2, 0xb2, 0xc5, 0x24, 0x4c, 0x6d,
-	0x4b, 0xa5, 0x18, 0x27, 0x29, 0xd0, 0x12, 0xb4, 0x39, 0x91, 0xb9, 0x2b, 0x72, 0x54, 0x72, 0x24,
-	0x5b, 0xfd, 0x15, 0x7b, 0xec, 0xa9, 0xe8, 0xad, 0x05, 0x7a, 0xe9, 0x21, 0x97, 0xfe, 0x83, 0xbd,
-	0x6c, 0xb1, 0xc8, 0xa9, 0x2d, 0xd0, 0xb4, 0x48, 0x0e, 0x05, 0xf6, 0x27, 0x14, 0x3d, 0x14, 0x9c,
-	0x19, 0x8a, 0xd4, 0xd7, 0x5a, 0x59, 0xec, 0xc5, 0x90, 0x67, 0x9e, 0xe7, 0x99, 0x99, 0xf7, 0x5b,
-	0x82, 0xb5, 0x53, 0x12, 0xb8, 0x24, 0x28, 0x05, 0x5d, 0xf7, 0x21, 0xe9, 0x94, 0x3a, 0xbb, 0xa5,
-	0x06, 0xe9, 0x14, 0x5b, 0x3e, 0xa1, 0x04, 0xc9, 0x7c, 0xaf, 0xc8, 0xf7, 0x8a, 0x9d, 0xdd, 0xb5,
-	0x0d, 0x81, 0x3e, 0xb1, 0x02, 0x5c, 0xea, 0xec, 0x9e, 0x60, 0x6a, 0xed, 0x96, 0x4e, 0x89, 0xe3,
-	0x71, 0xc6, 0xda, 0x72, 0x83, 0x34, 0x08, 0xfb, 0x58, 0x0a, 0x3f, 0x89, 0xd5, 0xcd, 0x06, 0x21,
-	0x8d, 0x26, 0x2e, 0xb1, 0xff, 0x4e, 0xda, 0x2f, 0x4a, 0xd4, 0x71, 0x71, 0x40, 0x2d, 0xb7, 0x25,
-	0x00, 0xd7, 0x06, 0x01, 0x96, 0xd7, 0x15, 0x5b, 0x1b, 0x83, 0x5b, 0x76, 0xdb, 0xb7, 0xa8, 0x43,
-	0xa2, 0x13, 0xaf, 0xf1, 0x1b, 0x99, 0xfc, 0x50, 0x71, 0x61, 0xbe, 0xb5, 0x68, 0xb9, 0x8e, 0x47,
-	0x4a, 0xec, 0x2f, 0x5f, 0x2a, 0xf8, 0x80, 0x9e, 0x62, 0xa7, 0x71, 0x46, 0xb1, 0x7d, 0x4c, 0x28,
-	0xae, 0xb6, 0x42, 0x25, 0xf4, 0x31, 0x4c, 0x13, 0xf6, 0x49, 0x91, 0xb6, 0xa4, 0x5b, 0xf9, 0x3b,
-	0x37, 0x8a, 0x83, 0x0f, 0x2f, 0xc6, 0x68, 0x5d, 0x60, 0xd1, 0xfb, 0x30, 0x7d, 0xce, 0xb4, 0x94,
-	0xd4, 0x96, 0x74, 0x2b, 0xbb, 0x97, 0x7f, 0xf5, 0x72, 0x1b, 0x04, 0xb1, 0x82, 0x4f, 0x75, 0xb1,
-	0x5b, 0xf8, 0xbd, 0x04, 0x33, 0x15, 0xdc, 0x22, 0x81, 0x43, 0xd1, 0x26, 0xcc, 0xb5, 0x7c, 0xd2,
-	0x22, 0x81, 0xd5, 0x34, 0x1d, 0x9b, 0x1d, 0x97, 0xd1, 0x21, 0x5a, 0xd2, 0x6c, 0xf4, 0x43, 0xc8,
-	0xda, 0x1c, 0x4b, 0x7c, 0xa1, 0xab, 0xbc, 0x7a, 0xb9, 0xbd, 0x2c, 0x74, 0xcb, 0xb6, 0xed, 0xe3,
-	0x20, 0xa8, 0x53, 0xdf, 0xf1, 0x1a, 0x7a, 0x0c, 0x45, 0x9f, 0xc0, 0xb4, 0xe5, 0x92, 0xb6, 0x47,
-	0x95, 0xf4, 0x56, 0xfa, 0xd6, 0xdc, 0x9d, 0x6b, 0xd1, 0x13, 0x42, 0x4f, 0x15, 0x85, 0xa7, 0x8a,
-	0xfb, 0xc4, 0xf1, 0xf6, 0xb2, 0x5f, 0xbe, 0xde, 0xbc, 0xf2, 0xc7, 0xff, 0xfc, 0xf9, 0xb6, 0xa4,
-	0x0b, 0x4e, 0xe1, 0xab, 0x19, 0x98, 0xad, 0x89, 0x4b, 0xa0, 0x3c, 0xa4, 0x7a, 0x57, 0x4b, 0x39,
-	0x36, 0xda, 0x81, 0x59, 0x17, 0x07, 0x81, 0xd5, 0xc0, 0x81, 0x92, 0x62, 0xe2, 0xcb, 0x45, 0xee,
-	0x94, 0x62, 0xe4, 0x94, 0x62, 0xd9, 0xeb, 0xea, 0x3d, 0x14, 0xba, 0x07, 0xd3, 0x01, 0xb5, 0x68,
-	0x3b, 0x50, 0xd2, 0xcc, 0x9e, 0x5b, 0xc3, 0xf6, 0x8c, 0x4e, 0xab, 0x33, 0x9c, 0x2e, 0xf0, 0xe8,
-	0x67, 0x80, 0x5e, 0x38, 0x9e, 0xd5, 0x34, 0xa9, 0xd5, 0x6c, 0x76, 0x4d, 0x1f, 0x07, 0xed, 0x26,
-	0x55, 0x32, 0x5b, 0xd2, 0xad, 0xb9, 0x3b, 0xeb, 0xc3, 0x2a, 0x46, 0x88, 0xd2, 0x19, 0x48, 0x97,
-	0x19, 0x31, 0xb1, 0x82, 0xca, 0x30, 0x17, 0xb4, 0x4f, 0x5c, 0x87, 0x9a, 0x61, 0xbc, 0x29, 0x53,
-	0x4c, 0x65, 0x6d, 0xe8, 0xee, 0x46, 0x14, 0x8c, 0x7b, 0x99, 0x2f, 0xfe, 0xb5, 0x29, 0xe9, 0xc0,
-	0x49, 0xe1, 0x32, 0x7a, 0x0c, 0xb2, 0xb0, 0xb1, 0x89, 0x3d, 0x9b, 0xeb, 0x4c, 0x4f, 0xa8, 0x93,
-	0x17, 0x4c, 0xd5, 0xb3, 0x99, 0x96, 0x06, 0xf3, 0x94, 0x50, 0xab, 0x69, 0x8a, 0x75, 0x65, 0xe6,
-	0x1d, 0x3c, 0x95, 0x63, 0xd4, 0x28, 0x8c, 0x0e, 0x60, 0xb1, 0x43, 0xa8, 0xe3, 0x35, 0xcc, 0x80,
-	0x5a, 0xbe, 0x78, 0xdf, 0xec, 0x84, 0xf7, 0x5a, 0xe0, 0xd4, 0x7a, 0xc8, 0x64, 0x17, 0x7b, 0x04,
-	0x62, 0x29, 0x7e, 0x63, 0x76, 0x42, 0xad, 0x79, 0x4e, 0x8c, 0x9e, 0xb8, 0x16, 0x86, 0x0a, 0xb5,
-	0x6c, 0x8b, 0x5a, 0x0a, 0x84, 0xc1, 0xab, 0xf7, 0xfe, 0x47, 0x3f, 0x80, 0x29, 0xea, 0xd0, 0x26,
-	0x56, 0xe6, 0x58, 0x54, 0x2f, 0xfd, 0xe3, 0xe5, 0xf6, 0x02, 0x7f, 0xf9, 0x76, 0x60, 0x7f, 0xbe,
-	0xb5, 0x53, 0xfc, 0xf8, 0x47, 0x3a, 0x47, 0xa0, 0x6d, 0x98, 0x09, 0xda, 0xae, 0x6b, 0xf9, 0x5d,
-	0x25, 0x37, 0x1e, 0x1c, 0x61, 0xd0, 0x43, 0x98, 0xe5, 0x19, 0x84, 0x7d, 0x65, 0x9e, 0xe1, 0x3f,
-	0x18, 0x97, 0x32, 0xa3, 0x74, 0x7a, 0x64, 0xf4, 0x11, 0x64, 0xf1, 0x45, 0x0b, 0xdb, 0x0e, 0xc5,
-	0xb6, 0x92, 0xdf, 0x92, 0x6e, 0xcd, 0xee, 0xad, 0x0c, 0x31, 0xee, 0xee, 0x28, 0x92, 0x1e, 0xe3,
-	0xd0, 0x3d, 0x98, 0x7f, 0x61, 0x39, 0x4d, 0x6c, 0x9b, 0x3e, 0xb6, 0x02, 0xe2, 0x29, 0x0b, 0x63,
-	0xae, 0x7c, 0x77, 0x47, 0xcf, 0x71, 0xa4, 0xce, 0x80, 0xe8, 0x19, 0xcc, 0xf7, 0x8a, 0x01, 0xed,
-	0xb6, 0xb0, 0x22, 0xb3, 0x6c, 0xd9, 0x18, 0x9f, 0x2d, 0x46, 0xb7, 0x85, 0xb9, 0xf2, 0x85, 0x28,
-	0xd8, 0x5b, 0x9d, 0x9d, 0xe2, 0x9d, 0xe2, 0x8e, 0x9e, 0x6b, 0x25, 0x20, 0x85, 0xaf, 0x24, 0x58,
-	0x8a, 0x38, 0x71, 0xe5, 0x0a, 0xd0, 0x3a, 0x00, 0x2f, 0x5e, 0x26, 0xf1, 0x30, 0x4b, 0xf1, 0xac,
-	0x9e, 0xe5, 0x2b, 0x55, 0x0f, 0x27, 0xb6, 0xe9, 0x39, 0xe1, 0xd5, 0x27, 0xda, 0x36, 0xce, 0x09,
-	0xba, 0x09, 0xb9, 0x68, 0xfb, 0xcc, 0xc7, 0x98, 0x25, 0x77, 0x56, 0x9f, 0x13, 0x80, 0x70, 0x29,
-	0xac, 0x6f, 0x02, 0xf2, 0x82, 0xb4, 0x7d, 0x96, 0xb8, 0x59, 0x5d, 0x88, 0x3e, 0x20, 0x6d, 0x3f,
-	0x01, 0x08, 0x5a, 0x96, 0xcb, 0x72, 0xb2, 0x07, 0xa8, 0xb7, 0x2c, 0xf7, 0xfe, 0xd2, 0xab, 0xe1,
-	0xd7, 0x15, 0xfe, 0x97, 0x86, 0xb9, 0x64, 0x66, 0x6f, 0x43, 0xb6, 0x8b, 0x03, 0xf3, 0x94, 0x15,
-	0x3c, 0xf6, 0x8c, 0x3d, 0x39, 0x51, 0x7d, 0xb5, 0x70, 0x55, 0x9f, 0xed, 0xe2, 0x60, 0x3f, 0x44,
-	0xa0, 0xbb, 0x30, 0x6f, 0x9d, 0x04, 0xd4, 0x72, 0x3c, 0x41, 0x49, 0x8d, 0xa1, 0xe4, 0x04, 0x8c,
-	0xd3, 0x3e, 0x80, 0x59, 0x8f, 0x08, 0x46, 0x7a, 0x0c, 0x63, 0xc6, 0x23, 0x1c, 0xfc, 0x29, 0x20,
-	0x8f, 0x98, 0xe7, 0x0e, 0x3d, 0x33, 0x3b, 0x98, 0x46, 0xb4, 0xcc, 0x18, 0xda, 0x82, 0x47, 0x9e,
-	0x3a, 0xf4, 0xec, 0x18, 0x53, 0x41, 0xbf, 0x07, 0x72, 0xec, 0x19, 0x41, 0x9e, 0x1a, 0x6a, 0x2b,
-	0x9a, 0x47, 0xf5, 0x7c, 0xcf, 0x5f, 0x83, 0x4c, 0x7a, 0x1e, 0x1d, 0x3b, 0xfd, 0x6d, 0x4c, 0xe3,
-	0x5c, 0x9c, 0xf9, 0x09, 0xa0, 0xa4, 0x3f, 0x05, 0x77, 0x66, 0x24, 0x57, 0x4e, 0x78, 0x99, 0xb3,
-	0xef, 0xc3, 0x62, 0xc2, 0xd5, 0x82, 0x3c, 0x3b, 0x92, 0xbc, 0x10, 0x07, 0x00, 0xe7, 0x6e, 0x03,
-	0x84, 0xee, 0x17, 0xa4, 0xec, 0x48, 0x52, 0x36, 0x44, 0x30, 0x78, 0xe1, 0x2f, 0x12, 0x64, 0xc2,
-	0x30, 0xbe, 0xbc, 0x7d, 0x16, 0x61, 0xaa, 0x43, 0x28, 0xbe, 0xbc, 0x75, 0x72, 0x18, 0xfa, 0x09,
-	0xcc, 0xf0, 0xbb, 0x05, 0x4a, 0x86, 0x55, 0xe3, 0xf7, 0x86, 0x93, 0x6f, 0x78, 0x60, 0xd0, 0x23,
-	0x52, 0x5f, 0xc1, 0x9b, 0xea, 0x2f, 0x78, 0x8f, 0x33, 0xb3, 0x69, 0x39, 0x53, 0xf8, 0xa7, 0x04,
-	0xf3, 0xa2, 0x6c, 0xd7, 0x2c, 0xdf, 0x72, 0x03, 0xf4, 0x1c, 0xe6, 0x5c, 0xc7, 0xeb, 0x75, 0x01,
-	0xe9, 0xb2, 0x2e, 0xb0, 0x1e, 0x76, 0x81, 0x6f, 0x5e, 0x6f, 0xae, 0x24, 0x58, 0x1f, 0x12, 0xd7,
-	0xa1, 0xd8, 0x6d, 0xd1, 0xae, 0x0e, 0xae, 0xe3, 0x45, 0x7d, 0xc1, 0x05, 0xe4, 0x5a, 0x17, 0x11,
-	0xc8, 0x6c, 0x61, 0xdf, 0x21, 0x36, 0xb3, 0x45, 0x78, 0xc2, 0x60, 0x31, 0xaf, 0x88, 0x49, 0x6a,
-	0xef, 0xbd, 0x6f, 0x5e, 0x6f, 0xde, 0x18, 0x26, 0xc6, 0x87, 0xfc, 0x36, 0xac, 0xf5, 0xb2, 0x6b,
-	0x5d, 0x44, 0x2f, 0x61, 0xfb, 0xf7, 0x53, 0x8a, 0x54, 0x78, 0x06, 0xb9, 0x63, 0xd6, 0x03, 0xc4,
-	0xeb, 0x2a, 0x20, 0x7a, 0x42, 0x74, 0xba, 0x74, 0xd9, 0xe9, 0x19, 0xa6, 0x9e, 0xe3, 0xac, 0x84,
-	0xf2, 0xef, 0x24, 0x91, 0xf4, 0x42, 0xf9, 0x7d, 0x98, 0xfe, 0x75, 0x9b, 0xf8, 0x6d, 0x57, 0x64,
-	0xfc, 0xd0, 0xbc, 0xc5, 0x77, 0xd1, 0x87, 0x90, 0x0d, 0xe3, 0x39, 0x38, 0x23, 0x4d, 0x7b, 0xcc,
-	0x68, 0x16, 0x03, 0xd0, 0x5d, 0xc8, 0xb3, 0x7c, 0x8d, 0x29, 0xe9, 0x91, 0x94, 0xf9, 0x10, 0x65,
-	0x44, 0x20, 0x76, 0xc1, 0xbf, 0xcf, 0xc3, 0xb4, 0xb8, 0x9b, 0xfa, 0x8e, 0x3e, 0x4d, 0x74, 0xf6,
-	0xa4, 0xff, 0x0e, 0xbf, 0x9b, 0xff, 0x32, 0xa3, 0xfd, 0x33, 0xec, 0x8b, 0xf4, 0x77, 0xf0, 0x45,
-	0xc2, 0xee, 0x99, 0xc9, 0xed, 0x3e, 0xf5, 0xee, 0x76, 0x9f, 0x9e, 0xc0, 0xee, 0x48, 0x83, 0x6b,
-	0xa1, 0xa1, 0x1d, 0xcf, 0xa1, 0x4e, 0x3c, 0x4a, 0x99, 0xec, 0xfa, 0x23, 0x4a, 0x57, 0xa8, 0x70,
-	0xd5, 0x75, 0x3c, 0x8d, 0xe3, 0x85, 0x79, 0xf4, 0x10, 0x8d, 0x9e, 0xc0, 0x4a, 0xaf, 0x98, 0x9c,
-	0x5a, 0xde, 0x29, 0x6e, 0x0a, 0x19, 0x5e, 0xc4, 0x6e, 0xf6, 0xcb, 0x8c, 0x6a, 0xe7, 0x4b, 0x11,
-	0x7f, 0x9f, 0xd1, 0xb9, 0xec, 0x2f, 0x61, 0x79, 0x50, 0xd6, 0xc6, 0x41, 0x54, 0xe5, 0x26, 0x9f,
-	0x4c, 0xee, 0xee, 0xe8, 0xa8, 0x5f, 0xbf, 0x82, 0x03, 0x8a, 0x3e, 0x83, 0xd5, 0xde, 0xec, 0x61,
-	0xf6, 0x7b, 0x17, 0x2e, 0xf3, 0xee, 0x6a, 0xe8, 0xdd, 0x51, 0x07, 0xad, 0xf4, 0x24, 0x8f, 0x93,
-	0x9e, 0xd7, 0x61, 0x29, 0x3e, 0x2b, 0x76, 0xd4, 0xdc, 0xa4, 0xf6, 0x41, 0x3d, 0x76, 0xec, 0xc0,
-	0x67, 0x10, 0x1f, 0x66, 0x26, 0x73, 0x26, 0xf7, 0x0e, 0x39, 0x13, 0x5f, 0xeb, 0x30, 0x4e, 0x9e,
-	0x4f, 0x41, 0x3e, 0x69, 0xfb, 0x5e, 0x68, 0x14, 0x6c, 0x8a, 0x88, 0x9d, 0x67, 0x43, 0xdc, 0xc8,
-	0xf1, 0x31, 0x1f, 0x82, 0xc3, 0x9a, 0xfe, 0x73, 0x1e, 0xbe, 0xc7, 0xb0, 0xce, 0xe8, 0x3d, 0xe7,
-	0xf5, 0xb2, 0xd0, 0xc7, 0xa1, 0xa4, 0x18, 0x08, 0x47, 0x6a, 0xad, 0x85, 0xcc, 0x68, 0xe0, 0x8a,
-	0x72, 0x90, 0xd3, 0xd0, 0x8f, 0x21, 0x1f, 0x5f, 0x2b, 0x0c, 0x66, 0x36, 0x20, 0x8e, 0x11, 0xca,
-	0x45, 0x97, 0x0a, 0x27, 0x03, 0x74, 0x08, 0x8b, 0x09, 0x0b, 0x89, 0xe8, 0x94, 0x27, 0xb5, 0xfe,
-	0x42, 0x5c, 0x58, 0x78, 0x64, 0xfe, 0x0a, 0xd6, 0x06, 0x23, 0x33, 0xac, 0x36, 0x22, 0x7a, 0x16,
-	0xc7, 0xe9, 0x0e, 0x8e, 0x9a, 0xab, 0xfd, 0x51, 0x79, 0x68, 0x5d, 0x88, 0x70, 0x09, 0x60, 0x33,
-	0xec, 0x8b, 0xae, 0x13, 0x50, 0xe7, 0xd4, 0xb4, 0xda, 0xf4, 0x8c, 0xf8, 0xce, 0x6f, 0xb0, 0x6d,
-	0x5a, 0x3c, 0xd0, 0x71, 0xa0, 0xa0, 0xad, 0xf4, 0x65, 0x49, 0x30, 0x78, 0xdc, 0x7a, 0xac, 0x59,
-	0xee, 0x49, 0x96, 0x23, 0x45, 0x84, 0x21, 0x01, 0x30, 0x7d, 0xfc, 0x19, 0x3e, 0xed, 0x8f, 0xd6,
-	0xa5, 0x49, 0xdf, 0x75, 0x3d, 0xd6, 0xd1, 0x85, 0x4c, 0x1c, 0xb6, 0x3f, 0x05, 0x08, 0x27, 0x4e,
-	0x11, 0x56, 0xcb, 0x93, 0x6a, 0x86, 0x63, 0xaa, 0x88, 0xaf, 0x03, 0x90, 0xe3, 0xc0, 0x17, 0x3a,
-	0x2b, 0x97, 0xeb, 0xec, 0x16, 0x77, 0x8a, 0x3b, 0xfa, 0x42, 0x8f, 0x2a, 0xd4, 0x34, 0xb8, 0xda,
-	0xf3, 0x25, 0xbe, 0xc0, 0xa7, 0x6d, 0x36, 0x89, 0x35, 0xac, 0x40, 0xb9, 0x1a, 0x0e, 0x45, 0xa3,
-	0xbf, 0x24, 0xf4, 0x0a, 0x93, 0x1a, 0x31, 0x1e, 0x5a, 0x01, 0x9f, 0xb8, 0x07, 0x02, 0xb1, 0xf0,
-	0xa7, 0x14, 0xa0, 0x43, 0xfe, 0x7d, 0x7e, 0xcf, 0x0a, 0xb0, 0xfd, 0x7d, 0x76, 0xf7, 0x44, 0x47,
-	0x49, 0x7d, 0x6b, 0x47, 0xd9, 0x1e, 0x61, 0xf4, 0xa1, 0x96, 0x12, 0x5b, 0xb8, 0xaf, 0x01, 0xa5,
-	0xdf, 0xbd, 0x01, 0x65, 0x26, 0x69, 0xfc, 0xa3, 0xbe, 0x9f, 0xdc, 0xfe, 0x83, 0x04, 0xb9, 0xe4,
-	0x77, 0x34, 0xb4, 0x0e, 0xd7, 0x6a, 0x7a, 0xb5, 0x56, 0xad, 0x97, 0x0f, 0x4c, 0xe3, 0x79, 0x4d,
-	0x35, 0x9f, 0x1c, 0xd5, 0x6b, 0xea, 0xbe, 0xf6, 0x40, 0x53, 0x2b, 0xf2, 0x15, 0xb4, 0x06, 0x57,
-	0xfb, 0xb7, 0xeb, 0x46, 0xf9, 0xa8, 0x52, 0xd6, 0x2b, 0xb2, 0x84, 0x6e, 0xc2, 0x7a, 0xff, 0xde,
-	0xe1, 0x93, 0x03, 0x43, 0xab, 0x1d, 0xa8, 0xe6, 0xfe, 0xa3, 0xaa, 0xb6, 0xaf, 0xca, 0x29, 0x74,
-	0x03, 0x94, 0x7e, 0x48, 0xb5, 0x66, 0x68, 0x87, 0x5a, 0xdd, 0xd0, 0xf6, 0xe5, 0x34, 0xba, 0x0e,
-	0xab, 0xfd, 0xbb, 0xea, 0xb3, 0x9a, 0x5a, 0xd1, 0x0c, 0xb5, 0x22, 0x67, 0x6e, 0xff, 0x57, 0x02,
-	0x48, 0xfc, 0xf2, 0x75, 0x1d, 0x56, 0x8f, 0xab, 0x06, 0x17, 0xa8, 0x1e, 0x0d, 0xdc, 0x72, 0x09,
-	0x16, 0x92, 0x9b, 0xcf, 0xd5, 0xba, 0x2c, 0x0d, 0x2e, 0x56, 0x8f, 0x54, 0x59, 0x42, 0xab, 0xb0,
-	0x94, 0x5c, 0x2c, 0xef, 0xd5, 0x8d, 0xb2, 0x76, 0x24, 0xa7, 0x06, 0xd1, 0xc6, 0xd3, 0xaa, 0x9c,
-	0x42, 0x08, 0xf2, 0xc9, 0xc5, 0xa3, 0xaa, 0x9c, 0x46, 0x2b, 0xb0, 0xd8, 0x07, 0x7c, 0xa4, 0xab,
-	0xaa, 0x9c, 0x0e, 0x5f, 0xda, 0x0f, 0x35, 0x9f, 0x6a, 0xc6, 0x23, 0xf3, 0x58, 0x35, 0xaa, 0x72,
-	0x06, 0x2d, 0x83, 0x9c, 0xdc, 0x7d, 0x50, 0x7d, 0xa2, 0x0f, 0xaf, 0xd6, 0x6b, 0xe5, 0x43, 0x79,
-	0x6a, 0x2d, 0x25, 0x4b, 0xb7, 0xff, 0x2a, 0x41, 0xbe, 0xff, 0x87, 0x27, 0xb4, 0x09, 0xd7, 0x7b,
-	0xc6, 0xaa, 0x1b, 0x65, 0xe3, 0x49, 0x7d, 0xc0, 0x08, 0x05, 0xd8, 0x18, 0x04, 0x54, 0xd4, 0x5a,
-	0xb5, 0xae, 0x19, 0x66, 0x4d, 0xd5, 0xb5, 0xea, 0xa0, 0xcb, 0x04, 0xe6, 0xb8, 0x6a, 0x68, 0x47,
-	0x0f, 0x23, 0x48, 0xaa, 0xcf, 0xe3, 0x02, 0x52, 0x2b, 0xd7, 0xeb, 0x6a, 0x85, 0x3f, 0x72, 0x70,
-	0x4f, 0x57, 0x1f, 0xab, 0xfb, 0xcc, 0x63, 0xa3, 0x98, 0x0f, 0xca, 0xda, 0x81, 0x5a, 0x91, 0xa7,
-	0xf6, 0xee, 0x7d, 0xf9, 0x66, 0x43, 0xfa, 0xfa, 0xcd, 0x86, 0xf4, 0xef, 0x37, 0x1b, 0xd2, 0x17,
-	0x6f, 0x37, 0xae, 0x7c, 0xfd, 0x76, 0xe3, 0xca, 0xdf, 0xde, 0x6e, 0x5c, 0xf9, 0x85, 0xf8, 0xa1,
-	0x36, 0xb0, 0x3f, 0x2f, 0x3a, 0xa4, 0x14, 0xc5, 0x6b, 0x89, 0x76, 0x5b, 0x38, 0x28, 0x75, 0x76,
-	0x4f, 0xa6, 0x59, 0xa6, 0x7e, 0xf4, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x72, 0xd7, 0xbe, 0xc6,
-	0xff, 0x15, 0x00, 0x00,
+	// 2305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x19, 0xf6, 0x92, 0x14, 0x25, 0xbe, 0xa2, 0xa8, 0xd5, 0x48, 0xb2, 0xd6, 0x72, 0xf4, 0x11, 0x22,
+	0x08, 0x5c, 0x27, 0x22, 0x25, 0x27, 0x6e, 0xdd, 0x20, 0x29, 0x4a, 0x89, 0xb4, 0x4d, 0x57, 0x12,
+	0xd9, 0x25, 0x2d, 0xdb, 0x05, 0xda, 0xc5, 0x4a, 0x3b, 0x16, 0x37, 0xe6, 0xee, 0xb0, 0x3b, 0x4b,
+	0x4a, 0xea, 0xaf, 0xc8, 0xb1, 0xa7, 0xa2, 0xb7, 0x16, 0x68, 0x0f, 0x3d, 0xf8, 0xd2, 0x1f, 0x50,
+	0x20, 0x97, 0x14, 0x81, 0x4f, 0x45, 0x80, 0xba, 0x81, 0x7d, 0x28, 0x9a, 0x9f, 0x50, 0xf4, 0x50,
+	0xcc, 0xc7, 0x7e, 0xf0, 0x43, 0x16, 0xe5, 0xf6, 0x22, 0x68, 0x67, 0x9e, 0xe7, 0x99, 0x77, 0xde,
+	0x8f, 0x99, 0x77, 0x08, 0xcb, 0x47, 0x84, 0x3a, 0x84, 0x16, 0xe9, 0x99, 0x73, 0x8f, 0xf4, 0x8a,
+	0xbd, 0xad, 0xe2, 0x31, 0xe9, 0x15, 0x3a, 0x1e, 0xf1, 0x09, 0x52, 0xc5, 0x5c, 0x41, 0xcc, 0x15,
+	0x7a, 0x5b, 0xcb, 0xab, 0x12, 0x7d, 0x68, 0x52, 0x5c, 0xec, 0x6d, 0x1d, 0x62, 0xdf, 0xdc, 0x2a,
+	0x1e, 0x11, 0xdb, 0x15, 0x8c, 0xe5, 0x85, 0x63, 0x72, 0x4c, 0xf8, 0xbf, 0x45, 0xf6, 0x9f, 0x1c,
+	0x5d, 0x3b, 0x26, 0xe4, 0xb8, 0x8d, 0x8b, 0xfc, 0xeb, 0xb0, 0xfb, 0xb4, 0xe8, 0xdb, 0x0e, 0xa6,
+	0xbe, 0xe9, 0x74, 0x24, 0xe0, 0xda, 0x20, 0xc0, 0x74, 0xcf, 0xe4, 0xd4, 0xea, 0xe0, 0x94, 0xd5,
+	0xf5, 0x4c, 0xdf, 0x26, 0xc1, 0x8a, 0xd7, 0x84, 0x45, 0x86, 0x58, 0x54, 0x1a, 0x2c, 0xa6, 0xe6,
+	0x4c, 0xc7, 0x76, 0x49, 0x91, 0xff, 0x15, 0x43, 0x79, 0x0f, 0xd0, 0x23, 0x6c, 0x1f, 0xb7, 0x7c,
+	0x6c, 0x1d, 0x10, 0x1f, 0xd7, 0x3a, 0x4c, 0x09, 0x7d, 0x0c, 0x69, 0xc2, 0xff, 0xd3, 0x94, 0x75,
+	0xe5, 0x46, 0xee, 0xd6, 0x3b, 0x85, 0xc1, 0x8d, 0x17, 0x22, 0xb4, 0x2e, 0xb1, 0xe8, 0x7d, 0x48,
+	0x9f, 0x70, 0x2d, 0x2d, 0xb1, 0xae, 0xdc, 0xc8, 0x6c, 0xe7, 0x5e, 0x3c, 0xdf, 0x00, 0x49, 0x2c,
+	0xe3, 0x23, 0x5d, 0xce, 0xe6, 0x7f, 0xab, 0xc0, 0x64, 0x19, 0x77, 0x08, 0xb5, 0x7d, 0xb4, 0x06,
+	0xd3, 0x1d, 0x8f, 0x74, 0x08, 0x35, 0xdb, 0x86, 0x6d, 0xf1, 0xe5, 0x52, 0x3a, 0x04, 0x43, 0x55,
+	0x0b, 0x7d, 0x1f, 0x32, 0x96, 0xc0, 0x12, 0x4f, 0xea, 0x6a, 0x2f, 0x9e, 0x6f, 0x2c, 0x48, 0xdd,
+	0x92, 0x65, 0x79, 0x98, 0xd2, 0x86, 0xef, 0xd9, 0xee, 0xb1, 0x1e, 0x41, 0xd1, 0xa7, 0x90, 0x36,
+	0x1d, 0xd2, 0x75, 0x7d, 0x2d, 0xb9, 0x9e, 0xbc, 0x31, 0x7d, 0xeb, 0x5a, 0xb0, 0x05, 0x16, 0xa9,
+	0x82, 0x8c, 0x54, 0x61, 0x87, 0xd8, 0xee, 0x76, 0xe6, 0xcb, 0x97, 0x6b, 0x57, 0x7e, 0xff, 0xcf,
+	0x3f, 0xdd, 0x54, 0x74, 0xc9, 0xc9, 0x7f, 0x35, 0x09, 0x53, 0x75, 0x69, 0x04, 0xca, 0x41, 0x22,
+	0x34, 0x2d, 0x61, 0x5b, 0x68, 0x13, 0xa6, 0x1c, 0x4c, 0xa9, 0x79, 0x8c, 0xa9, 0x96, 0xe0, 0xe2,
+	0x0b, 0x05, 0x11, 0x94, 0x42, 0x10, 0x94, 0x42, 0xc9, 0x3d, 0xd3, 0x43, 0x14, 0xba, 0x03, 0x69,
+	0xea, 0x9b, 0x7e, 0x97, 0x6a, 0x49, 0xee, 0xcf, 0xf5, 0x61, 0x7f, 0x06, 0xab, 0x35, 0x38, 0x4e,
+	0x97, 0x78, 0xf4, 0x13, 0x40, 0x4f, 0x6d, 0xd7, 0x6c, 0x1b, 0xbe, 0xd9, 0x6e, 0x9f, 0x19, 0x1e,
+	0xa6, 0xdd, 0xb6, 0xaf, 0xa5, 0xd6, 0x95, 0x1b, 0xd3, 0xb7, 0x56, 0x86, 0x55, 0x9a, 0x0c, 0xa5,
+	0x73, 0x90, 0xae, 0x72, 0x62, 0x6c, 0x04, 0x95, 0x60, 0x9a, 0x76, 0x0f, 0x1d, 0xdb, 0x37, 0x58,
+	0xbe, 0x69, 0x13, 0x5c, 0x65, 0x79, 0xc8, 0xf6, 0x66, 0x90, 0x8c, 0xdb, 0xa9, 0x2f, 0xfe, 0xb1,
+	0xa6, 0xe8, 0x20, 0x48, 0x6c, 0x18, 0x3d, 0x00, 0x55, 0xfa, 0xd8, 0xc0, 0xae, 0x25, 0x74, 0xd2,
+	0x63, 0xea, 0xe4, 0x24, 0xb3, 0xe2, 0x5a, 0x5c, 0xab, 0x0a, 0x33, 0x3e, 0xf1, 0xcd, 0xb6, 0x21,
+	0xc7, 0xb5, 0xc9, 0x4b, 0x44, 0x2a, 0xcb, 0xa9, 0x41, 0x1a, 0xed, 0xc2, 0x5c, 0x8f, 0xf8, 0xb6,
+	0x7b, 0x6c, 0x50, 0xdf, 0xf4, 0xe4, 0xfe, 0xa6, 0xc6, 0xb4, 0x6b, 0x56, 0x50, 0x1b, 0x8c, 0xc9,
+	0x0d, 0xbb, 0x0f, 0x72, 0x28, 0xda, 0x63, 0x66, 0x4c, 0xad, 0x19, 0x41, 0x0c, 0xb6, 0xb8, 0xcc,
+	0x52, 0xc5, 0x37, 0x2d, 0xd3, 0x37, 0x35, 0x60, 0xc9, 0xab, 0x87, 0xdf, 0xe8, 0x7b, 0x30, 0xe1,
+	0xdb, 0x7e, 0x1b, 0x6b, 0xd3, 0x3c, 0xab, 0xe7, 0xbf, 0x79, 0xbe, 0x31, 0x2b, 0x76, 0xbe, 0x41,
+	0xad, 0x67, 0xeb, 0x9b, 0x85, 0x8f, 0x7f, 0xa0, 0x0b, 0x04, 0xda, 0x80, 0x49, 0xda, 0x75, 0x1c,
+	0xd3, 0x3b, 0xd3, 0xb2, 0xe7, 0x83, 0x03, 0x0c, 0xba, 0x07, 0x53, 0xa2, 0x82, 0xb0, 0xa7, 0xcd,
+	0x70, 0xfc, 0x07, 0xe7, 0x95, 0xcc, 0x28, 0x9d, 0x90, 0x8c, 0x3e, 0x82, 0x0c, 0x3e, 0xed, 0x60,
+	0xcb, 0xf6, 0xb1, 0xa5, 0xe5, 0xd6, 0x95, 0x1b, 0x53, 0xdb, 0x8b, 0x43, 0x8c, 0xdb, 0x9b, 0x9a,
+	0xa2, 0x47, 0x38, 0x74, 0x07, 0x66, 0x9e, 0x9a, 0x76, 0x1b, 0x5b, 0x86, 0x87, 0x4d, 0x4a, 0x5c,
+	0x6d, 0xf6, 0x1c, 0x93, 0x6f, 0x6f, 0xea, 0x59, 0x81, 0xd4, 0x39, 0x10, 0x3d, 0x86, 0x99, 0xf0,
+	0x30, 0xf0, 0xcf, 0x3a, 0x58, 0x53, 0x79, 0xb5, 0xac, 0x9e, 0x5f, 0x2d, 0xcd, 0xb3, 0x0e, 0x16,
+	0xca, 0xa7, 0xf2, 0xc0, 0x5e, 0xef, 0x6d, 0x16, 0x6e, 0x15, 0x36, 0xf5, 0x6c, 0x27, 0x06, 0xc9,
+	0x7f, 0xa5, 0xc0, 0x7c, 0xc0, 0x89, 0x4e, 0x2e, 0x8a, 0x56, 0x00, 0xc4, 0xe1, 0x65, 0x10, 0x17,
+	0xf3, 0x12, 0xcf, 0xe8, 0x19, 0x31, 0x52, 0x73, 0x71, 0x6c, 0xda, 0x3f, 0x21, 0xe2, 0xf4, 0x09,
+	0xa6, 0x9b, 0x27, 0x04, 0xbd, 0x0b, 0xd9, 0x60, 0xba, 0xe5, 0x61, 0xcc, 0x8b, 0x3b, 0xa3, 0x4f,
+	0x4b, 0x00, 0x1b, 0x62, 0xe7, 0x9b, 0x84, 0x3c, 0x25, 0x5d, 0x8f, 0x17, 0x6e, 0x46, 0x97, 0xa2,
+	0x77, 0x49, 0xd7, 0x8b, 0x01, 0x68, 0xc7, 0x74, 0x78, 0x4d, 0x86, 0x80, 0x46, 0xc7, 0x74, 0x3e,
+	0x99, 0x7f, 0x31, 0xbc, 0xbb, 0xfc, 0x7f, 0x92, 0x30, 0x1d, 0xaf, 0xec, 0x0d, 0xc8, 0x9c, 0x61,
+	0x6a, 0x1c, 0xf1, 0x03, 0x8f, 0x6f, 0x63, 0x5b, 0x8d, 0x9d, 0xbe, 0x55, 0x36, 0xaa, 0x4f, 0x9d,
+	0x61, 0xba, 0xc3, 0x10, 0xe8, 0x36, 0xcc, 0x98, 0x87, 0xd4, 0x37, 0x6d, 0x57, 0x52, 0x12, 0xe7,
+	0x50, 0xb2, 0x12, 0x26, 0x68, 0x1f, 0xc0, 0x94, 0x4b, 0x24, 0x23, 0x79, 0x0e, 0x63, 0xd2, 0x25,
+	0x02, 0xfc, 0x19, 0x20, 0x97, 0x18, 0x27, 0xb6, 0xdf, 0x32, 0x7a, 0xd8, 0x0f, 0x68, 0xa9, 0x73,
+	0x68, 0xb3, 0x2e, 0x79, 0x64, 0xfb, 0xad, 0x03, 0xec, 0x4b, 0xfa, 0x1d, 0x50, 0xa3, 0xc8, 0x48,
+	0xf2, 0xc4, 0xd0, 0xb5, 0x52, 0x75, 0x7d, 0x3d, 0x17, 0xc6, 0x6b, 0x90, 0xe9, 0x9f, 0x04, 0xcb,
+	0xa6, 0xdf, 0xc4, 0x6c, 0x9e, 0xc8, 0x35, 0x3f, 0x05, 0x14, 0x8f, 0xa7, 0xe4, 0x4e, 0x8e, 0xe4,
+	0xaa, 0xb1, 0x28, 0x0b, 0xf6, 0x27, 0x30, 0x17, 0x0b, 0xb5, 0x24, 0x4f, 0x8d, 0x24, 0xcf, 0x46,
+	0x09, 0x20, 0xb8, 0x1b, 0x00, 0x2c, 0xfc, 0x92, 0x94, 0x19, 0x49, 0xca, 0x30, 0x04, 0x87, 0xe7,
+	0xff, 0xa2, 0xc0, 0xc2, 0x81, 0xd9, 0xb6, 0x2d, 0xd3, 0x27, 0xde, 0x01, 0x3f, 0x71, 0xea, 0xe4,
+	0x04, 0x7b, 0x68, 0x1f, 0xe6, 0x7a, 0xc1, 0xb8, 0x61, 0x8a, 0x42, 0x97, 0xf9, 0xf0, 0xee, 0x8b,
+	0xe7, 0x1b, 0x2b, 0x52, 0x2e, 0xe4, 0xf6, 0x5f, 0x9f, 0x6a, 0x6f, 0x60, 0x1c, 0x95, 0x60, 0xa2,
+	0xc3, 0x84, 0x65, 0x82, 0x7c, 0xc0, 0xce, 0xdf, 0x6f, 0x5e, 0xae, 0x2d, 0x0a, 0x1d, 0x6a, 0x3d,
+	0x2b, 0xd8, 0xa4, 0xe8, 0x98, 0x7e, 0x8b, 0x59, 0x38, 0x60, 0xaf, 0x60, 0xb2, 0x23, 0x90, 0x74,
+	0xb0, 0xc7, 0x54, 0x79, 0xd2, 0x64, 0xf5, 0xf0, 0x3b, 0xff, 0xc7, 0x04, 0xcc, 0xc7, 0xcc, 0x6f,
+	0xb8, 0x66, 0x87, 0xb6, 0xc8, 0x18, 0x5d, 0xc1, 0x55, 0x48, 0xb7, 0xa2, 0x56, 0x23, 0xa9, 0xcb,
+	0x2f, 0xb4, 0x0b, 0x10, 0xee, 0x81, 0xca, 0x9b, 0xff, 0xfd, 0x11, 0xcd, 0xcb, 0x08, 0xdf, 0x6d,
+	0xa7, 0xd8, 0xe6, 0xf4, 0x18, 0x1f, 0xed, 0xc2, 0xb4, 0xb8, 0xa0, 0x84, 0x0f, 0x52, 0x97, 0xf7,
+	0x01, 0x70, 0xbe, 0x88, 0xcd, 0x0a, 0xc0, 0x61, 0x9b, 0x1c, 0x3d, 0x33, 0x5a, 0x26, 0x6d, 0xc9,
+	0x42, 0xcf, 0xf0, 0x91, 0xfb, 0x26, 0x6d, 0xb1, 0xc3, 0x44, 0x4c, 0xbb, 0x5d, 0xe7, 0x10, 0x7b,
+	0x3c, 0x65, 0x53, 0xfa, 0x34, 0x1f, 0xdb, 0xe7, 0x43, 0xf9, 0x6f, 0x13, 0x90, 0xd5, 0xd9, 0x75,
+	0xf3, 0x0c, 0x33, 0xcb, 0xf1, 0xc5, 0x7e, 0x5a, 0x80, 0x89, 0x1e, 0xf1, 0x83, 0xf8, 0xe9, 0xe2,
+	0x83, 0x8f, 0x9a, 0xac, 0x8f, 0x48, 0xca, 0x51, 0xf6, 0x31, 0x3a, 0x77, 0x52, 0x6f, 0x9f, 0x3b,
+	0x3f, 0x82, 0x49, 0x91, 0xe6, 0x54, 0x9b, 0xe0, 0x81, 0x78, 0x6f, 0x38, 0x10, 0xc3, 0xbd, 0xa7,
+	0x1e, 0x90, 0xfa, 0xee, 0xce, 0xf4, 0xc0, 0xdd, 0xb9, 0x00, 0x13, 0x2e, 0x71, 0x8f, 0x30, 0x2f,
+	0xce, 0x94, 0x2e, 0x3e, 0xa2, 0x6c, 0x9d, 0x7a, 0xdb, 0x6c, 0xcd, 0xff, 0x59, 0x81, 0xd4, 0x78,
+	0xae, 0x2d, 0xf4, 0xb9, 0xf6, 0x0d, 0x4d, 0xa9, 0x74, 0x7a, 0xcc, 0x1d, 0xa9, 0xff, 0xd5, 0x1d,
+	0x13, 0xfd, 0xee, 0x78, 0x90, 0x9a, 0x4a, 0xaa, 0xa9, 0xfc, 0xdf, 0x15, 0x98, 0x91, 0x0d, 0x51,
+	0xdd, 0xf4, 0x4c, 0x87, 0xa2, 0x27, 0x30, 0xed, 0xd8, 0x6e, 0xd8, 0x5f, 0x29, 0x17, 0xf5, 0x57,
+	0x2b, 0xcc, 0x63, 0xdf, 0xbd, 0x5c, 0x5b, 0x8c, 0xb1, 0x3e, 0x24, 0x8e, 0xed, 0x63, 0xa7, 0xe3,
+	0x9f, 0xe9, 0xe0, 0xd8, 0x6e, 0xd0, 0x71, 0x39, 0x80, 0x1c, 0xf3, 0x34, 0x00, 0x19, 0x1d, 0xec,
+	0xd9, 0xc4, 0xe2, 0xbe, 0x60, 0x2b, 0x0c, 0xb6, 0x49, 0x65, 0xf9, 0x46, 0xd9, 0x7e, 0xef, 0xbb,
+	0x97, 0x6b, 0xef, 0x0c, 0x13, 0xa3, 0x45, 0x7e, 0xcd, 0xba, 0x28, 0xd5, 0x31, 0x4f, 0x83, 0x9d,
+	0xf0, 0xf9, 0x4f, 0x12, 0x9a, 0x92, 0x7f, 0x0c, 0x59, 0x59, 0xaf, 0x62, 0x77, 0x65, 0x90, 0xdd,
+	0x56, 0xb0, 0xba, 0x72, 0xd1, 0xea, 0x29, 0xae, 0x9e, 0x15, 0xac, 0x98, 0xf2, 0x6f, 0x14, 0x79,
+	0x9d, 0x4a, 0xe5, 0xf7, 0x21, 0xfd, 0xcb, 0x2e, 0xf1, 0xba, 0x8e, 0x3c, 0x3b, 0x87, 0x5e, 0x32,
+	0x62, 0x16, 0x7d, 0x08, 0x19, 0x76, 0x53, 0xd0, 0x16, 0x69, 0x5b, 0xe7, 0x3c, 0x7a, 0x22, 0x00,
+	0xba, 0x0d, 0x39, 0x7e, 0x13, 0x46, 0x94, 0xe4, 0x48, 0xca, 0x0c, 0x43, 0x35, 0x03, 0x10, 0x37,
+	0xf0, 0x5f, 0x39, 0x48, 0x4b, 0xdb, 0x2a, 0x97, 0x8c, 0x69, 0xac, 0x67, 0x8e, 0xc7, 0x6f, 0xef,
+	0xed, 0xe2, 0x97, 0x1a, 0x1d, 0x9f, 0xe1, 0x58, 0x24, 0xdf, 0x22, 0x16, 0x31, 0xbf, 0xa7, 0xc6,
+	0xf7, 0xfb, 0xc4, 0xe5, 0xfd, 0x9e, 0x1e, 0xc3, 0xef, 0xa8, 0x0a, 0xd7, 0x98, 0xa3, 0x6d, 0xd7,
+	0xf6, 0xed, 0xe8, 0x91, 0x62, 0x70, 0xf3, 0x47, 0x34, 0x05, 0x4c, 0xe1, 0xaa, 0x63, 0xbb, 0x55,
+	0x81, 0x97, 0xee, 0xd1, 0x19, 0x1a, 0x3d, 0x84, 0xc5, 0xf0, 0x30, 0x39, 0x32, 0xdd, 0x23, 0xdc,
+	0x96, 0x32, 0x53, 0xe1, 0xf1, 0x1a, 0x93, 0x19, 0xd5, 0x28, 0xcf, 0x07, 0xfc, 0x1d, 0x4e, 0x17,
+	0xb2, 0x3f, 0x87, 0x85, 0x41, 0x59, 0x0b, 0xd3, 0xa0, 0x7f, 0x18, 0xbf, 0xe7, 0xbf, 0xbd, 0xa9,
+	0xa3, 0x7e, 0xfd, 0x32, 0xa6, 0x3e, 0xfa, 0x1c, 0x96, 0xc2, 0xae, 0xde, 0xe8, 0x8f, 0x2e, 0x5c,
+	0x14, 0xdd, 0x25, 0x16, 0xdd, 0x51, 0x0b, 0x2d, 0x86, 0x92, 0x07, 0xf1, 0xc8, 0xeb, 0x30, 0x1f,
+	0xad, 0x15, 0x05, 0x6a, 0x7a, 0x5c, 0xff, 0xa0, 0x90, 0x1d, 0x05, 0xf0, 0x31, 0x44, 0x8b, 0x19,
+	0xf1, 0x9a, 0xc9, 0x5e, 0xa2, 0x66, 0x22, 0xb3, 0xf6, 0xa2, 0xe2, 0xf9, 0x0c, 0xd4, 0xc3, 0xae,
+	0xe7, 0x32, 0xa7, 0x60, 0x43, 0x66, 0xec, 0x0c, 0x7f, 0x1e, 0x8d, 0x7c, 0x98, 0xe5, 0x18, 0x98,
+	0x9d, 0xe9, 0x3f, 0x15, 0xe9, 0x7b, 0x00, 0x2b, 0x9c, 0x1e, 0x06, 0x2f, 0xac, 0x42, 0x0f, 0x33,
+	0x49, 0xf9, 0xd4, 0x1a, 0xa9, 0xb5, 0xcc, 0x98, 0xc1, 0x53, 0x26, 0xa8, 0x41, 0x41, 0x43, 0x3f,
+	0x84, 0x5c, 0x64, 0x16, 0x4b, 0x66, 0xfe, 0xf4, 0x3a, 0x47, 0x28, 0x1b, 0x18, 0xc5, 0x7a, 0x6e,
+	0xb4, 0x07, 0x73, 0x31, 0x0f, 0xc9, 0xec, 0x54, 0xc7, 0xf5, 0xfe, 0x6c, 0x74, 0xb0, 0x88, 0xcc,
+	0xfc, 0x05, 0x2c, 0x0f, 0x66, 0x26, 0x3b, 0x6d, 0x64, 0xf6, 0xcc, 0x9d, 0xa7, 0x3b, 0xf8, 0x88,
+	0x5b, 0xea, 0xcf, 0xca, 0x3d, 0xf3, 0x54, 0xa6, 0x0b, 0x85, 0x35, 0x76, 0x2f, 0x3a, 0x36, 0xf5,
+	0xed, 0x23, 0xc3, 0xec, 0xfa, 0x2d, 0xe2, 0xd9, 0xbf, 0xc2, 0x56, 0xd0, 0xb7, 0x60, 0xaa, 0xa1,
+	0xf5, 0xe4, 0x45, 0x45, 0x30, 0xb8, 0xdc, 0x4a, 0xa4, 0x59, 0x0a, 0x25, 0x4b, 0x81, 0x22, 0xc2,
+	0x10, 0x03, 0x18, 0x1e, 0xfe, 0x1c, 0x1f, 0xf5, 0x67, 0xeb, 0xfc, 0xb8, 0xfb, 0xba, 0x1e, 0xe9,
+	0xe8, 0x52, 0x26, 0x4a, 0xdb, 0x1f, 0x03, 0xb0, 0xb7, 0x9c, 0x4c, 0xab, 0x85, 0x71, 0x35, 0xd9,
+	0x03, 0x50, 0xe6, 0xd7, 0x2e, 0xa8, 0x51, 0xe2, 0x4b, 0x9d, 0xc5, 0x8b, 0x75, 0xb6, 0x0a, 0x9b,
+	0x85, 0x4d, 0x7d, 0x36, 0xa4, 0x4a, 0xb5, 0x2a, 0x5c, 0x0d, 0x63, 0x89, 0x4f, 0xf1, 0x51, 0x97,
+	0xbf, 0x71, 0x8e, 0x4d, 0xaa, 0x5d, 0x65, 0x4d, 0xd1, 0xe8, 0xe7, 0x77, 0x78, 0x30, 0x55, 0x02,
+	0xc6, 0x3d, 0x93, 0xa2, 0x4f, 0x61, 0xd6, 0x93, 0xfd, 0xab, 0xc1, 0x9b, 0x4e, 0xaa, 0x2d, 0xf1,
+	0x30, 0xcd, 0x8f, 0xb2, 0x24, 0x17, 0x60, 0x0f, 0x38, 0x14, 0xd5, 0x61, 0x59, 0x24, 0x91, 0x6b,
+	0xb1, 0xa3, 0x28, 0x52, 0x22, 0x3e, 0xa6, 0x9a, 0x36, 0xca, 0x18, 0x21, 0xb4, 0xe4, 0xb0, 0xc4,
+	0xe1, 0xac, 0x78, 0xfb, 0x4c, 0xc5, 0xdb, 0x7a, 0xa0, 0x30, 0xf2, 0x7f, 0x48, 0x00, 0xda, 0x13,
+	0xbf, 0xdc, 0x6d, 0x9b, 0x14, 0x5b, 0xff, 0xcf, 0x6e, 0x23, 0x76, 0xc3, 0x25, 0xde, 0x78, 0xc3,
+	0x6d, 0x8c, 0x48, 0x82, 0xa1, 0x2b, 0x2e, 0x8a, 0x78, 0xdf, 0x85, 0x98, 0xbc, 0xfc, 0x85, 0x98,
+	0x1a, 0xa7, 0x11, 0x19, 0xf5, 0x4b, 0xc4, 0xcd, 0xdf, 0x29, 0x90, 0x8d, 0xff, 0x1a, 0x83, 0x56,
+	0xe0, 0x5a, 0x5d, 0xaf, 0xd5, 0x6b, 0x8d, 0xd2, 0xae, 0xd1, 0x7c, 0x52, 0xaf, 0x18, 0x0f, 0xf7,
+	0x1b, 0xf5, 0xca, 0x4e, 0xf5, 0x6e, 0xb5, 0x52, 0x56, 0xaf, 0xa0, 0x65, 0xb8, 0xda, 0x3f, 0xdd,
+	0x68, 0x96, 0xf6, 0xcb, 0x25, 0xbd, 0xac, 0x2a, 0xe8, 0x5d, 0x58, 0xe9, 0x9f, 0xdb, 0x7b, 0xb8,
+	0xdb, 0xac, 0xd6, 0x77, 0x2b, 0xc6, 0xce, 0xfd, 0x5a, 0x75, 0xa7, 0xa2, 0x26, 0xd0, 0x3b, 0xa0,
+	0xf5, 0x43, 0x6a, 0xf5, 0x66, 0x75, 0xaf, 0xda, 0x68, 0x56, 0x77, 0xd4, 0x24, 0xba, 0x0e, 0x4b,
+	0xfd, 0xb3, 0x95, 0xc7, 0xf5, 0x4a, 0xb9, 0xda, 0xac, 0x94, 0xd5, 0xd4, 0xcd, 0x7f, 0x2b, 0x00,
+	0xb1, 0xdf, 0xb8, 0xaf, 0xc3, 0xd2, 0x41, 0xad, 0x29, 0x04, 0x6a, 0xfb, 0x03, 0x56, 0xce, 0xc3,
+	0x6c, 0x7c, 0xf2, 0x49, 0xa5, 0xa1, 0x2a, 0x83, 0x83, 0xb5, 0xfd, 0x8a, 0xaa, 0xa0, 0x25, 0x98,
+	0x8f, 0x0f, 0x96, 0xb6, 0x1b, 0xcd, 0x52, 0x75, 0x5f, 0x4d, 0x0c, 0xa2, 0x9b, 0x8f, 0x6a, 0x6a,
+	0x02, 0x21, 0xc8, 0xc5, 0x07, 0xf7, 0x6b, 0x6a, 0x12, 0x2d, 0xc2, 0x5c, 0x1f, 0xf0, 0xbe, 0x5e,
+	0xa9, 0xa8, 0x49, 0xb6, 0xd3, 0x7e, 0xa8, 0xf1, 0xa8, 0xda, 0xbc, 0x6f, 0x1c, 0x54, 0x9a, 0x35,
+	0x35, 0x85, 0x16, 0x40, 0x8d, 0xcf, 0xde, 0xad, 0x3d, 0xd4, 0x87, 0x47, 0x1b, 0xf5, 0xd2, 0x9e,
+	0x3a, 0xb1, 0x9c, 0x50, 0x95, 0x9b, 0x7f, 0x55, 0x20, 0xd7, 0xff, 0x13, 0x33, 0x5a, 0x83, 0xeb,
+	0xa1, 0xb3, 0x1a, 0xcd, 0x52, 0xf3, 0x61, 0x63, 0xc0, 0x09, 0x79, 0x58, 0x1d, 0x04, 0x94, 0x2b,
+	0xf5, 0x5a, 0xa3, 0xda, 0x34, 0xea, 0x15, 0xbd, 0x5a, 0x1b, 0x0c, 0x99, 0xc4, 0x1c, 0xd4, 0x9a,
+	0xd5, 0xfd, 0x7b, 0x01, 0x24, 0xd1, 0x17, 0x71, 0x09, 0xa9, 0x97, 0x1a, 0x8d, 0x4a, 0x59, 0x6c,
+	0x72, 0x70, 0x4e, 0xaf, 0x3c, 0xa8, 0xec, 0xf0, 0x88, 0x8d, 0x62, 0xde, 0x2d, 0x55, 0x77, 0x2b,
+	0x65, 0x75, 0x62, 0xfb, 0xce, 0x97, 0xaf, 0x56, 0x95, 0xaf, 0x5f, 0xad, 0x2a, 0xdf, 0xbe, 0x5a,
+	0x55, 0xbe, 0x78, 0xbd, 0x7a, 0xe5, 0xeb, 0xd7, 0xab, 0x57, 0xfe, 0xf6, 0x7a, 0xf5, 0xca, 0xcf,
+	0x56, 0xfb, 0x9e, 0x7b, 0x41, 0xbe, 0x16, 0xfd, 0xb3, 0x0e, 0xa6, 0xc5, 0xde, 0xd6, 0x61, 0x9a,
+	0x57, 0xea, 0x47, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x18, 0xa7, 0x72, 0x78, 0xe9, 0x19, 0x00,
+	0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1821,7 +2113,7 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorVotingPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1831,27 +2123,89 @@ func (m *Vote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorVotingPower) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.BlockHash)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1859,15 +2213,13 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.ProposalId != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
@@ -1877,7 +2229,7 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+func (m *RestakerVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1887,30 +2239,42 @@ func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestakerVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestakerVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Nonce != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x38
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1918,13 +2282,142 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
 	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2021,6 +2514,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPendingRestakerVotes != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxPendingRestakerVotes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.RestakerVaults) > 0 {
+		for iNdEx := len(m.RestakerVaults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestakerVaults[iNdEx])
+			copy(dAtA[i:], m.RestakerVaults[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.RestakerVaults[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2483,6 +2994,94 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.TotalPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovGov(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *RestakerVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGov(uint64(m.Nonce))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2658,6 +3257,15 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if len(m.RestakerVaults) > 0 {
+		for _, s := range m.RestakerVaults {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxPendingRestakerVotes != 0 {
+		n += 2 + sovGov(uint64(m.MaxPendingRestakerVotes))
+	}
 	return n
 }
 
@@ -3394,10 +4002,691 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Expedited = bool(v != 0)
-		case 15:
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOne = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionSpam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionSpam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpamCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpamCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3425,13 +4714,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.ProposalType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3441,11 +4732,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalType |= ProposalType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3467,7 +4773,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,17 +4796,17 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3510,29 +4816,16 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionOne = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3542,29 +4835,16 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionTwo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3574,27 +4854,29 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionThree = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, ValidatorVotingPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3622,11 +4904,13 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionFour = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionSpam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3654,8 +4938,27 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionSpam = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3677,7 +4980,7 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *RestakerVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3700,17 +5003,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestakerVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestakerVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3720,27 +5023,14 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3768,11 +5058,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3800,11 +5090,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.Vault = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3832,13 +5122,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3848,27 +5138,29 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3896,13 +5188,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3912,27 +5204,14 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3960,39 +5239,9 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpamCount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SpamCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5234,6 +6483,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakerVaults", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestakerVaults = append(m.RestakerVaults, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingRestakerVotes", wireType)
+			}
+			m.MaxPendingRestakerVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingRestakerVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -103,6 +103,21 @@ func NewMsgVoteWeighted(voter string, proposalID uint64, options WeightedVoteOpt
 	return &MsgVoteWeighted{proposalID, voter, options, metadata}
 }
 
+// NewMsgVoteRestaker creates a message to relay the signed vote of an Ethereum restaker on an active proposal
+func NewMsgVoteRestaker(submitter string, proposalID uint64, voter, vault, validator string, options WeightedVoteOptions, metadata string, nonce uint64, signature []byte) *MsgVoteRestaker {
+	return &MsgVoteRestaker{
+		Submitter:        submitter,
+		ProposalId:       proposalID,
+		Voter:            voter,
+		Vault:            vault,
+		ValidatorAddress: validator,
+		Options:          options,
+		Metadata:         metadata,
+		Nonce:            nonce,
+		Signature:        signature,
+	}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
 
@@ -38,6 +40,8 @@ var (
 	DefaultOptimisticRejectedThreshold         = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses        = []string(nil)
 	DefaultProposalExecutionGas         uint64 = 10_000_000 // ten million
+	DefaultRestakerVaults                      = []string(nil)
+	DefaultMaxPendingRestakerVotes      uint64 = 1000
 )
 
 // NewParams creates a new Params instance with given values.
@@ -49,6 +53,8 @@ func NewParams(
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
 	proposalExecutionGas uint64,
+	restakerVaults []string,
+	maxPendingRestakerVotes uint64,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -73,6 +79,8 @@ func NewParams(
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 		ProposalExecutionGas:          proposalExecutionGas,
+		RestakerVaults:                restakerVaults,
+		MaxPendingRestakerVotes:       maxPendingRestakerVotes,
 	}
 }
 
@@ -101,6 +109,8 @@ func DefaultParams() Params {
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
 		DefaultProposalExecutionGas,
+		DefaultRestakerVaults,
+		DefaultMaxPendingRestakerVotes,
 	)
 }
 
@@ -230,6 +240,15 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		}
 	}
 
+	for _, vault := range p.RestakerVaults {
+		if !common.IsHexAddress(vault) {
+			return fmt.Errorf("invalid restaker vault address: %s", vault)
+		}
+	}
+	if len(p.RestakerVaults) > 0 && p.MaxPendingRestakerVotes == 0 {
+		return fmt.Errorf("max pending restaker votes must be positive when restaker vaults are set")
+	}
+
 	minInitialDepositRatio, err := sdkmath.LegacyNewDecFromStr(p.MinInitialDepositRatio)
 	if err != nil {
 		return fmt.Errorf("invalid minimum initial deposit ratio of proposal: %w", err)
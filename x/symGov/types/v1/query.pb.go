@@ -1157,6 +1157,208 @@ func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
 	return nil
 }
 
+// QueryVotingPowerSnapshotRequest is the request type for the Query/VotingPowerSnapshot RPC method.
+type QueryVotingPowerSnapshotRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryVotingPowerSnapshotRequest) Reset()         { *m = QueryVotingPowerSnapshotRequest{} }
+func (m *QueryVotingPowerSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerSnapshotRequest) ProtoMessage()    {}
+func (*QueryVotingPowerSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2e18fb45585ed65, []int{22}
+}
+func (m *QueryVotingPowerSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerSnapshotRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerSnapshotRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryVotingPowerSnapshotResponse is the response type for the Query/VotingPowerSnapshot RPC method.
+type QueryVotingPowerSnapshotResponse struct {
+	// snapshot defines the voting power snapshot of the proposal.
+	Snapshot *VotingPowerSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *QueryVotingPowerSnapshotResponse) Reset()         { *m = QueryVotingPowerSnapshotResponse{} }
+func (m *QueryVotingPowerSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerSnapshotResponse) ProtoMessage()    {}
+func (*QueryVotingPowerSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2e18fb45585ed65, []int{23}
+}
+func (m *QueryVotingPowerSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerSnapshotResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerSnapshotResponse) GetSnapshot() *VotingPowerSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+// QueryRestakerVotesRequest is the request type for the Query/RestakerVotes RPC method.
+type QueryRestakerVotesRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakerVotesRequest) Reset()         { *m = QueryRestakerVotesRequest{} }
+func (m *QueryRestakerVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakerVotesRequest) ProtoMessage()    {}
+func (*QueryRestakerVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2e18fb45585ed65, []int{24}
+}
+func (m *QueryRestakerVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakerVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakerVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakerVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakerVotesRequest.Merge(m, src)
+}
+func (m *QueryRestakerVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakerVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakerVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakerVotesRequest proto.InternalMessageInfo
+
+func (m *QueryRestakerVotesRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryRestakerVotesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRestakerVotesResponse is the response type for the Query/RestakerVotes RPC method.
+type QueryRestakerVotesResponse struct {
+	// votes defines the queried restaker votes.
+	Votes []*RestakerVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRestakerVotesResponse) Reset()         { *m = QueryRestakerVotesResponse{} }
+func (m *QueryRestakerVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRestakerVotesResponse) ProtoMessage()    {}
+func (*QueryRestakerVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2e18fb45585ed65, []int{25}
+}
+func (m *QueryRestakerVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRestakerVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRestakerVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRestakerVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRestakerVotesResponse.Merge(m, src)
+}
+func (m *QueryRestakerVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRestakerVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRestakerVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRestakerVotesResponse proto.InternalMessageInfo
+
+func (m *QueryRestakerVotesResponse) GetVotes() []*RestakerVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *QueryRestakerVotesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.symGov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.symGov.v1.QueryConstitutionResponse")
@@ -1180,89 +1382,102 @@ func init() {
 	proto.RegisterType((*QueryProposalVoteOptionsResponse)(nil), "cosmos.symGov.v1.QueryProposalVoteOptionsResponse")
 	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.symGov.v1.QueryMessageBasedParamsRequest")
 	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.symGov.v1.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryVotingPowerSnapshotRequest)(nil), "cosmos.symGov.v1.QueryVotingPowerSnapshotRequest")
+	proto.RegisterType((*QueryVotingPowerSnapshotResponse)(nil), "cosmos.symGov.v1.QueryVotingPowerSnapshotResponse")
+	proto.RegisterType((*QueryRestakerVotesRequest)(nil), "cosmos.symGov.v1.QueryRestakerVotesRequest")
+	proto.RegisterType((*QueryRestakerVotesResponse)(nil), "cosmos.symGov.v1.QueryRestakerVotesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/symGov/v1/query.proto", fileDescriptor_b2e18fb45585ed65) }
 
 var fileDescriptor_b2e18fb45585ed65 = []byte{
-	// 1228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x51, 0x6f, 0xdb, 0x54,
-	0x14, 0x9e, 0xb3, 0xb6, 0x6b, 0x4f, 0xda, 0x6e, 0x9c, 0x95, 0x91, 0x9a, 0x2d, 0x8d, 0xdc, 0xae,
-	0xdb, 0x5a, 0x6a, 0x27, 0xd9, 0x68, 0xbb, 0x49, 0x50, 0x28, 0x53, 0xcb, 0x40, 0xd3, 0xba, 0x6c,
-	0xf0, 0xc0, 0x4b, 0xe4, 0x36, 0x96, 0x15, 0x2d, 0x8d, 0xbd, 0x5c, 0xc7, 0x50, 0x95, 0x0a, 0x09,
-	0x1e, 0x27, 0xc1, 0x04, 0x0f, 0x7b, 0x40, 0x48, 0xfc, 0x88, 0x3e, 0xec, 0x27, 0x20, 0x5e, 0x98,
-	0xe0, 0x05, 0xed, 0x09, 0xb5, 0xfc, 0x10, 0xe4, 0x7b, 0x8f, 0x1d, 0x3b, 0x8e, 0xeb, 0x64, 0x9a,
-	0x78, 0xf4, 0xbd, 0xdf, 0xf9, 0xce, 0x77, 0xce, 0x3d, 0xe7, 0x9e, 0x9b, 0xc0, 0xc5, 0x1d, 0x8b,
-	0xed, 0x5a, 0x4c, 0x63, 0x7b, 0xbb, 0x9b, 0x96, 0xab, 0xb9, 0x25, 0xed, 0x71, 0xdb, 0x68, 0xed,
-	0xa9, 0x76, 0xcb, 0x72, 0x2c, 0x3c, 0x27, 0x76, 0x55, 0xb1, 0xab, 0xba, 0x25, 0x79, 0x81, 0xf0,
-	0xdb, 0x3a, 0x33, 0x04, 0x54, 0x73, 0x4b, 0xdb, 0x86, 0xa3, 0x97, 0x34, 0x5b, 0x37, 0xeb, 0x4d,
-	0xdd, 0xa9, 0x5b, 0x4d, 0x61, 0x2d, 0x5f, 0x34, 0x2d, 0xcb, 0x6c, 0x18, 0x9a, 0x6e, 0xd7, 0x35,
-	0xbd, 0xd9, 0xb4, 0x1c, 0xbe, 0xc9, 0x68, 0x57, 0x8e, 0x79, 0x36, 0x2d, 0x97, 0xf6, 0xa6, 0xc5,
-	0x5e, 0x95, 0x7f, 0x69, 0x24, 0x82, 0x7f, 0x28, 0x32, 0xe4, 0xee, 0x7b, 0x6e, 0x3f, 0xb2, 0x9a,
-	0xcc, 0xa9, 0x3b, 0x6d, 0x8f, 0xb2, 0x62, 0x3c, 0x6e, 0x1b, 0xcc, 0x51, 0xd6, 0x60, 0xba, 0xc7,
-	0x1e, 0xb3, 0xad, 0x26, 0x33, 0x50, 0x81, 0xf1, 0x9d, 0xd0, 0x7a, 0x4e, 0x2a, 0x48, 0x57, 0xc7,
-	0x2a, 0x91, 0x35, 0x65, 0x05, 0xa6, 0x38, 0xc1, 0x56, 0xcb, 0xb2, 0x2d, 0xa6, 0x37, 0x88, 0x18,
-	0x67, 0x20, 0x6b, 0xd3, 0x52, 0xb5, 0x5e, 0xe3, 0xa6, 0x43, 0x15, 0xf0, 0x97, 0xee, 0xd4, 0x94,
-	0x7b, 0xf0, 0x66, 0x97, 0x21, 0x79, 0x5d, 0x86, 0x51, 0x1f, 0xc6, 0xcd, 0xb2, 0x65, 0x59, 0xed,
-	0x4e, 0xaa, 0x1a, 0x58, 0x05, 0x58, 0xe5, 0xc7, 0x4c, 0x17, 0x23, 0xf3, 0xb5, 0xdc, 0x81, 0xb3,
-	0x81, 0x16, 0xe6, 0xe8, 0x4e, 0x9b, 0x71, 0xe2, 0xc9, 0x72, 0x21, 0x99, 0xf8, 0x01, 0xc7, 0x55,
-	0x26, 0xed, 0xc8, 0x37, 0xaa, 0x30, 0xec, 0x5a, 0x8e, 0xd1, 0xca, 0x65, 0xbc, 0x5c, 0xac, 0xe7,
-	0xfe, 0x3c, 0x5c, 0x9a, 0x22, 0x8e, 0x0f, 0x6b, 0xb5, 0x96, 0xc1, 0xd8, 0x03, 0xa7, 0x55, 0x6f,
-	0x9a, 0x15, 0x01, 0xc3, 0x65, 0x18, 0xab, 0x19, 0xb6, 0xc5, 0xea, 0x8e, 0xd5, 0xca, 0x9d, 0x4e,
-	0xb1, 0xe9, 0x40, 0x71, 0x03, 0xa0, 0x53, 0x1c, 0xb9, 0x21, 0x9e, 0x86, 0x79, 0x5f, 0xad, 0x57,
-	0x49, 0xaa, 0x28, 0x3a, 0xaa, 0x24, 0x75, 0x4b, 0x37, 0x0d, 0x0a, 0xb7, 0x12, 0xb2, 0x54, 0x7e,
-	0x96, 0xe0, 0x42, 0x77, 0x52, 0x28, 0xcf, 0xab, 0x30, 0xe6, 0x07, 0xe7, 0xe5, 0xe3, 0x74, 0x4a,
-	0xa2, 0x3b, 0x60, 0xdc, 0x8c, 0x88, 0xcb, 0x70, 0x71, 0x57, 0x52, 0xc5, 0x09, 0xb7, 0x11, 0x75,
-	0x3b, 0x70, 0x8e, 0x8b, 0xfb, 0xdc, 0x72, 0x8c, 0x7e, 0x0b, 0x67, 0xd0, 0x23, 0x50, 0xd6, 0xe0,
-	0x8d, 0x90, 0x13, 0x0a, 0x7e, 0x01, 0x86, 0xbc, 0x5d, 0x2a, 0xb0, 0x0b, 0xf1, 0xb8, 0x39, 0x9a,
-	0x63, 0x94, 0xaf, 0x43, 0x04, 0xac, 0x6f, 0x99, 0x1b, 0x3d, 0x92, 0xf4, 0x2a, 0x27, 0xf8, 0x44,
-	0x02, 0x0c, 0xbb, 0xa7, 0x00, 0xde, 0x11, 0x59, 0xf0, 0x4f, 0x2e, 0x29, 0x02, 0x01, 0x7a, 0x7d,
-	0x27, 0x76, 0x93, 0xc4, 0x6c, 0xe9, 0x2d, 0x7d, 0x37, 0x48, 0xc6, 0x2c, 0x64, 0x6d, 0xbe, 0x50,
-	0x75, 0xf6, 0x6c, 0x91, 0xd4, 0xb1, 0xf5, 0x4c, 0x4e, 0xf2, 0x4c, 0xbd, 0xe5, 0x87, 0x7b, 0xb6,
-	0xa1, 0x3c, 0xcf, 0xc0, 0xf9, 0x88, 0x2d, 0x45, 0xb2, 0x09, 0x13, 0xae, 0xe5, 0xd4, 0x9b, 0x66,
-	0x55, 0x80, 0xe9, 0x4c, 0xf2, 0x3d, 0x23, 0xaa, 0x37, 0x4d, 0x61, 0xce, 0xe9, 0xc7, 0xdd, 0xd0,
-	0x0a, 0x7e, 0x02, 0x93, 0xd4, 0x40, 0x3e, 0x93, 0x08, 0x74, 0x26, 0xce, 0x74, 0x5b, 0xe0, 0x42,
-	0x54, 0x13, 0xb5, 0xf0, 0x12, 0xde, 0x86, 0x71, 0x47, 0x6f, 0x34, 0xf6, 0x7c, 0xa6, 0xd3, 0x9c,
-	0xe9, 0x52, 0x9c, 0xe9, 0xa1, 0x87, 0x0a, 0xf1, 0x64, 0x9d, 0xce, 0x02, 0x6e, 0xc0, 0x08, 0xd9,
-	0x8b, 0x0e, 0xce, 0xf5, 0xe8, 0x2f, 0x61, 0x3a, 0xf5, 0xf2, 0x70, 0x89, 0x46, 0xc7, 0x12, 0xab,
-	0x3d, 0x2a, 0xb8, 0x45, 0xf5, 0xc6, 0x4a, 0x85, 0xac, 0x95, 0x26, 0x65, 0x8e, 0x64, 0xf7, 0x5d,
-	0x83, 0x91, 0xdb, 0x27, 0xd3, 0xf7, 0xed, 0xa3, 0x7c, 0x4a, 0x97, 0x7a, 0xe0, 0x8f, 0x8e, 0xea,
-	0x3a, 0x9c, 0x21, 0x10, 0x1d, 0xd2, 0x74, 0x62, 0x6a, 0x2b, 0x3e, 0x52, 0xf9, 0x26, 0x4a, 0xf6,
-	0xff, 0x77, 0xd0, 0x33, 0x89, 0x06, 0x43, 0x47, 0x01, 0xc5, 0xf3, 0x2e, 0x8c, 0x92, 0x4a, 0xbf,
-	0x8f, 0x4e, 0x08, 0x28, 0x80, 0xbe, 0xbe, 0x6e, 0xba, 0x05, 0x6f, 0x71, 0x61, 0xbc, 0x88, 0x2a,
-	0x06, 0x6b, 0x37, 0x9c, 0x01, 0xe6, 0x67, 0x2e, 0x6e, 0x1b, 0x9c, 0xd3, 0x30, 0x2f, 0x43, 0x3a,
-	0xa5, 0xa4, 0xb2, 0x25, 0x2b, 0x81, 0x55, 0xd6, 0x61, 0x26, 0x32, 0x29, 0xbc, 0xfb, 0xe3, 0x9e,
-	0xcd, 0xdf, 0x1f, 0x7d, 0x8b, 0x6a, 0x40, 0x21, 0x99, 0x83, 0xc4, 0x7d, 0x0c, 0x5e, 0xdb, 0x1a,
-	0x55, 0x4b, 0xac, 0x93, 0xc6, 0xcb, 0xc9, 0xa3, 0x27, 0x4c, 0x92, 0x75, 0x3b, 0x1f, 0xca, 0x5d,
-	0xc8, 0x73, 0x6f, 0x77, 0x0d, 0xc6, 0x74, 0xd3, 0x58, 0xd7, 0x99, 0x51, 0x8b, 0x5e, 0x4c, 0x8b,
-	0x70, 0x66, 0x97, 0x99, 0xd5, 0x76, 0xab, 0x41, 0x97, 0x12, 0xbe, 0x3c, 0x5c, 0x9a, 0xfc, 0x8a,
-	0x5e, 0x50, 0x85, 0x92, 0x5a, 0x54, 0x8b, 0x95, 0x91, 0x5d, 0x66, 0x7e, 0xd6, 0x6a, 0x28, 0x8c,
-	0x12, 0xd0, 0x8b, 0x8e, 0xb4, 0x6f, 0x05, 0x0d, 0x2d, 0x54, 0xcf, 0xc5, 0x55, 0xc7, 0xad, 0x7b,
-	0x3b, 0x15, 0x3c, 0xe5, 0xc3, 0x09, 0x18, 0xe6, 0x5e, 0xf1, 0x07, 0x09, 0xc6, 0xc3, 0xcf, 0x30,
-	0x5c, 0x88, 0x93, 0x27, 0xbd, 0xe3, 0xe4, 0xc5, 0xbe, 0xb0, 0x22, 0x0a, 0x65, 0xfe, 0xdb, 0xbf,
-	0xfe, 0xfd, 0x29, 0x53, 0xc0, 0xbc, 0x16, 0x7b, 0x50, 0x86, 0xdf, 0x76, 0xf8, 0xbd, 0x04, 0xa3,
-	0xfe, 0x21, 0xe0, 0x7c, 0x82, 0x87, 0xae, 0x87, 0x9f, 0x7c, 0x25, 0x15, 0x47, 0x2a, 0x4a, 0x5c,
-	0xc5, 0x22, 0x5e, 0x8b, 0xab, 0x08, 0x9e, 0x1a, 0xda, 0x7e, 0xa8, 0xde, 0x0e, 0xf0, 0x3b, 0x09,
-	0xc6, 0x82, 0x87, 0x0c, 0xa6, 0x79, 0xf2, 0xab, 0x40, 0xbe, 0x9a, 0x0e, 0x24, 0x4d, 0xb3, 0x5c,
-	0xd3, 0x25, 0x7c, 0xfb, 0x04, 0x4d, 0xf8, 0x54, 0x82, 0x21, 0xaf, 0x26, 0x51, 0x49, 0xe0, 0x0d,
-	0x3d, 0x67, 0xe4, 0xd9, 0x13, 0x31, 0xe4, 0x76, 0x8d, 0xbb, 0xbd, 0x89, 0x2b, 0x7d, 0xa7, 0x42,
-	0xe3, 0x73, 0x5d, 0xdb, 0xe7, 0x4f, 0x9c, 0x03, 0x7c, 0x22, 0xc1, 0x30, 0x7f, 0x1f, 0xe0, 0x49,
-	0xfe, 0x82, 0x84, 0xcc, 0x9d, 0x0c, 0x22, 0x55, 0x2b, 0x5c, 0x55, 0x09, 0xb5, 0x01, 0x55, 0xe1,
-	0x97, 0x30, 0x42, 0x03, 0x30, 0xc9, 0x51, 0xa4, 0x4b, 0xe5, 0xcb, 0x29, 0x28, 0xd2, 0x53, 0xe0,
-	0x7a, 0x64, 0xcc, 0xf5, 0xd0, 0x23, 0xdc, 0xfd, 0x2a, 0xc1, 0x19, 0xba, 0xae, 0x31, 0x89, 0x34,
-	0x3a, 0x43, 0xe5, 0xf9, 0x34, 0x18, 0x39, 0xdf, 0xe0, 0xce, 0x3f, 0xc0, 0xf7, 0xfb, 0x4f, 0x86,
-	0x3f, 0x2f, 0xb4, 0xfd, 0x60, 0xb2, 0x1e, 0xe0, 0x33, 0x09, 0x46, 0xfd, 0x39, 0x84, 0x29, 0xce,
-	0x59, 0x5a, 0x4f, 0x75, 0x0f, 0x34, 0xe5, 0x16, 0x57, 0x79, 0x03, 0xcb, 0x83, 0xab, 0xc4, 0x5f,
-	0x24, 0xc8, 0x86, 0xc6, 0x02, 0x5e, 0x4b, 0x70, 0x1a, 0x1f, 0x56, 0xf2, 0x42, 0x3f, 0xd0, 0x57,
-	0xaf, 0x2a, 0x3e, 0x9f, 0xf0, 0x0f, 0x09, 0xce, 0xf7, 0x18, 0x09, 0x58, 0x4a, 0xe9, 0xee, 0xf8,
-	0x1c, 0x93, 0xcb, 0x83, 0x98, 0x90, 0xee, 0xfb, 0xbf, 0x1f, 0x2e, 0x9d, 0x0d, 0x2e, 0x71, 0xb7,
-	0xa8, 0x96, 0xd5, 0x22, 0x0f, 0x65, 0x15, 0x97, 0x07, 0x6b, 0x10, 0x7f, 0xf2, 0xe1, 0x73, 0x09,
-	0x30, 0x3e, 0x2e, 0xb0, 0x98, 0xa0, 0x2e, 0x71, 0xcc, 0xc9, 0xa5, 0x01, 0x2c, 0x28, 0x9c, 0xf7,
-	0x92, 0xc2, 0x99, 0x43, 0x25, 0xa9, 0xbf, 0xb4, 0x7d, 0x1a, 0xa6, 0x07, 0xeb, 0xab, 0xbf, 0x1d,
-	0xe5, 0xa5, 0x17, 0x47, 0x79, 0xe9, 0x9f, 0xa3, 0xbc, 0xf4, 0xf4, 0x38, 0x7f, 0xea, 0xc5, 0x71,
-	0xfe, 0xd4, 0xdf, 0xc7, 0xf9, 0x53, 0x5f, 0xe4, 0x85, 0x31, 0xab, 0x3d, 0x52, 0xeb, 0x96, 0xe6,
-	0x7b, 0xd0, 0xbc, 0x1f, 0x06, 0x4c, 0x73, 0x4b, 0xdb, 0x23, 0xfc, 0x4f, 0x89, 0xeb, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x4d, 0x23, 0x8e, 0xe5, 0x47, 0x11, 0x00, 0x00,
+	// 1369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xd1, 0x6f, 0xd3, 0xd6,
+	0x17, 0xc6, 0xa1, 0x2d, 0xed, 0x49, 0x29, 0xfc, 0x0e, 0xfc, 0x58, 0xf0, 0x20, 0x44, 0x06, 0x0a,
+	0x14, 0x6a, 0x27, 0x81, 0xd1, 0x52, 0x69, 0x2b, 0xed, 0x50, 0x3b, 0x36, 0x21, 0x4a, 0xca, 0xf6,
+	0xb0, 0x97, 0xc8, 0x6d, 0xac, 0x2c, 0x6a, 0x1a, 0x1b, 0x5f, 0xc7, 0xac, 0xea, 0xaa, 0x49, 0xdb,
+	0xde, 0x90, 0x36, 0xb4, 0x3d, 0xf0, 0x80, 0x26, 0xed, 0x7d, 0x7b, 0xec, 0x03, 0xff, 0xc0, 0xa4,
+	0x69, 0x2f, 0x43, 0xdb, 0xcb, 0xc4, 0xd3, 0xd4, 0xee, 0x0f, 0x99, 0x7c, 0x7d, 0xec, 0xd8, 0xb1,
+	0x9d, 0x38, 0xa8, 0xda, 0x1e, 0x7d, 0xef, 0x39, 0xdf, 0xf9, 0xce, 0xb9, 0xe7, 0xde, 0xf3, 0x25,
+	0x70, 0x66, 0x5d, 0x67, 0x9b, 0x3a, 0x53, 0xd8, 0xd6, 0xe6, 0xb2, 0x6e, 0x2b, 0x76, 0x49, 0x79,
+	0xd4, 0xd6, 0xcc, 0x2d, 0xd9, 0x30, 0x75, 0x4b, 0xc7, 0xe3, 0xee, 0xae, 0xec, 0xee, 0xca, 0x76,
+	0x49, 0x9c, 0x22, 0xfb, 0x35, 0x95, 0x69, 0xae, 0xa9, 0x62, 0x97, 0xd6, 0x34, 0x4b, 0x2d, 0x29,
+	0x86, 0x5a, 0x6f, 0xb4, 0x54, 0xab, 0xa1, 0xb7, 0x5c, 0x6f, 0xf1, 0x4c, 0x5d, 0xd7, 0xeb, 0x4d,
+	0x4d, 0x51, 0x8d, 0x86, 0xa2, 0xb6, 0x5a, 0xba, 0xc5, 0x37, 0x19, 0xed, 0x8a, 0x91, 0xc8, 0x75,
+	0xdd, 0xa6, 0xbd, 0xd3, 0xee, 0x5e, 0x95, 0x7f, 0x29, 0x44, 0x82, 0x7f, 0x48, 0x22, 0xe4, 0x1e,
+	0x38, 0x61, 0xdf, 0xd5, 0x5b, 0xcc, 0x6a, 0x58, 0x6d, 0x07, 0xb2, 0xa2, 0x3d, 0x6a, 0x6b, 0xcc,
+	0x92, 0xe6, 0xe1, 0x74, 0xcc, 0x1e, 0x33, 0xf4, 0x16, 0xd3, 0x50, 0x82, 0xf1, 0xf5, 0xc0, 0x7a,
+	0x4e, 0x28, 0x08, 0x97, 0xc7, 0x2a, 0xa1, 0x35, 0x69, 0x06, 0x4e, 0x72, 0x80, 0x15, 0x53, 0x37,
+	0x74, 0xa6, 0x36, 0x09, 0x18, 0xcf, 0x41, 0xd6, 0xa0, 0xa5, 0x6a, 0xa3, 0xc6, 0x5d, 0x87, 0x2a,
+	0xe0, 0x2d, 0xdd, 0xad, 0x49, 0xf7, 0xe1, 0xff, 0x5d, 0x8e, 0x14, 0xf5, 0x26, 0x8c, 0x7a, 0x66,
+	0xdc, 0x2d, 0x5b, 0x16, 0xe5, 0xee, 0xa2, 0xca, 0xbe, 0x97, 0x6f, 0x2b, 0x7d, 0x9b, 0xe9, 0x42,
+	0x64, 0x1e, 0x97, 0xbb, 0x70, 0xcc, 0xe7, 0xc2, 0x2c, 0xd5, 0x6a, 0x33, 0x0e, 0x3c, 0x51, 0x2e,
+	0x24, 0x03, 0xaf, 0x72, 0xbb, 0xca, 0x84, 0x11, 0xfa, 0x46, 0x19, 0x86, 0x6d, 0xdd, 0xd2, 0xcc,
+	0x5c, 0xc6, 0xa9, 0xc5, 0x62, 0xee, 0xf7, 0xdd, 0xe9, 0x93, 0x84, 0xb1, 0x50, 0xab, 0x99, 0x1a,
+	0x63, 0xab, 0x96, 0xd9, 0x68, 0xd5, 0x2b, 0xae, 0x19, 0xde, 0x84, 0xb1, 0x9a, 0x66, 0xe8, 0xac,
+	0x61, 0xe9, 0x66, 0xee, 0x70, 0x1f, 0x9f, 0x8e, 0x29, 0x2e, 0x01, 0x74, 0x9a, 0x23, 0x37, 0xc4,
+	0xcb, 0x30, 0xe9, 0xb1, 0x75, 0x3a, 0x49, 0x76, 0x9b, 0x8e, 0x3a, 0x49, 0x5e, 0x51, 0xeb, 0x1a,
+	0xa5, 0x5b, 0x09, 0x78, 0x4a, 0xcf, 0x05, 0x38, 0xd5, 0x5d, 0x14, 0xaa, 0xf3, 0x2c, 0x8c, 0x79,
+	0xc9, 0x39, 0xf5, 0x38, 0xdc, 0xa7, 0xd0, 0x1d, 0x63, 0x5c, 0x0e, 0x91, 0xcb, 0x70, 0x72, 0x97,
+	0xfa, 0x92, 0x73, 0xc3, 0x86, 0xd8, 0xad, 0xc3, 0x71, 0x4e, 0xee, 0x23, 0xdd, 0xd2, 0xd2, 0x36,
+	0xce, 0xa0, 0x47, 0x20, 0xcd, 0xc3, 0xff, 0x02, 0x41, 0x28, 0xf9, 0x29, 0x18, 0x72, 0x76, 0xa9,
+	0xc1, 0x4e, 0x45, 0xf3, 0xe6, 0xd6, 0xdc, 0x46, 0xfa, 0x2c, 0x00, 0xc0, 0x52, 0xd3, 0x5c, 0x8a,
+	0x29, 0xd2, 0xeb, 0x9c, 0xe0, 0x13, 0x01, 0x30, 0x18, 0x9e, 0x12, 0xb8, 0xe6, 0x56, 0xc1, 0x3b,
+	0xb9, 0xa4, 0x0c, 0x5c, 0xa3, 0x83, 0x3b, 0xb1, 0x5b, 0x44, 0x66, 0x45, 0x35, 0xd5, 0x4d, 0xbf,
+	0x18, 0xe7, 0x21, 0x6b, 0xf0, 0x85, 0xaa, 0xb5, 0x65, 0xb8, 0x45, 0x1d, 0x5b, 0xcc, 0xe4, 0x04,
+	0xc7, 0xd5, 0x59, 0x7e, 0xb8, 0x65, 0x68, 0xd2, 0x8b, 0x0c, 0x9c, 0x08, 0xf9, 0x52, 0x26, 0xcb,
+	0x70, 0xd4, 0xd6, 0xad, 0x46, 0xab, 0x5e, 0x75, 0x8d, 0xe9, 0x4c, 0xf2, 0xb1, 0x19, 0x35, 0x5a,
+	0x75, 0xd7, 0x9d, 0xc3, 0x8f, 0xdb, 0x81, 0x15, 0x7c, 0x1f, 0x26, 0xe8, 0x02, 0x79, 0x48, 0x6e,
+	0xa2, 0xe7, 0xa2, 0x48, 0x77, 0x5c, 0xbb, 0x00, 0xd4, 0xd1, 0x5a, 0x70, 0x09, 0xef, 0xc0, 0xb8,
+	0xa5, 0x36, 0x9b, 0x5b, 0x1e, 0xd2, 0x61, 0x8e, 0x74, 0x36, 0x8a, 0xf4, 0xd0, 0xb1, 0x0a, 0xe0,
+	0x64, 0xad, 0xce, 0x02, 0x2e, 0xc1, 0x08, 0xf9, 0xbb, 0x37, 0x38, 0x17, 0x73, 0xbf, 0x5c, 0xd7,
+	0x93, 0xaf, 0x76, 0xa7, 0x69, 0x74, 0x4c, 0xb3, 0xda, 0x46, 0xc1, 0x2e, 0xca, 0x37, 0x66, 0x2a,
+	0xe4, 0x2d, 0xb5, 0xa8, 0x72, 0x44, 0x3b, 0x75, 0x0f, 0x86, 0x5e, 0x9f, 0x4c, 0xea, 0xd7, 0x47,
+	0xfa, 0x80, 0x1e, 0x75, 0x3f, 0x1e, 0x1d, 0xd5, 0x75, 0x38, 0x42, 0x46, 0x74, 0x48, 0xa7, 0x13,
+	0x4b, 0x5b, 0xf1, 0x2c, 0xa5, 0xcf, 0xc3, 0x60, 0xff, 0xfe, 0x0d, 0x7a, 0x26, 0xd0, 0x60, 0xe8,
+	0x30, 0xa0, 0x7c, 0xde, 0x82, 0x51, 0x62, 0xe9, 0xdd, 0xa3, 0x1e, 0x09, 0xf9, 0xa6, 0x07, 0x77,
+	0x9b, 0xe6, 0xe0, 0x0d, 0x4e, 0x8c, 0x37, 0x51, 0x45, 0x63, 0xed, 0xa6, 0x35, 0xc0, 0xfc, 0xcc,
+	0x45, 0x7d, 0xfd, 0x73, 0x1a, 0xe6, 0x6d, 0x48, 0xa7, 0x94, 0xd4, 0xb6, 0xe4, 0xe5, 0xda, 0x4a,
+	0x8b, 0x70, 0x2e, 0x34, 0x29, 0x9c, 0xf7, 0xe3, 0xbe, 0xc1, 0xf5, 0x47, 0x6a, 0x52, 0x4d, 0x28,
+	0x24, 0x63, 0x10, 0xb9, 0xf7, 0xc0, 0xb9, 0xb6, 0x5a, 0x55, 0x77, 0xd7, 0x89, 0xe3, 0xc5, 0xe4,
+	0xd1, 0x13, 0x04, 0xc9, 0xda, 0x9d, 0x0f, 0xe9, 0x1e, 0xe4, 0x79, 0xb4, 0x7b, 0x1a, 0x63, 0x6a,
+	0x5d, 0x5b, 0x54, 0x99, 0x56, 0x0b, 0x3f, 0x4c, 0x57, 0xe1, 0xc8, 0x26, 0xab, 0x57, 0xdb, 0x66,
+	0x93, 0x1e, 0x25, 0x7c, 0xb5, 0x3b, 0x3d, 0xf1, 0x29, 0x29, 0xa8, 0x42, 0x49, 0x2e, 0xca, 0xc5,
+	0xca, 0xc8, 0x26, 0xab, 0x7f, 0x68, 0x36, 0x25, 0x46, 0x05, 0x88, 0x83, 0x23, 0xee, 0x2b, 0xfe,
+	0x85, 0x76, 0x59, 0x5f, 0x88, 0xb2, 0x8e, 0x7a, 0xc7, 0x07, 0xa5, 0xab, 0xed, 0x55, 0x9d, 0xde,
+	0x36, 0xfd, 0xb1, 0x66, 0xae, 0xb6, 0x54, 0x83, 0x7d, 0xa2, 0xa7, 0x6f, 0x05, 0x8d, 0xaa, 0x1e,
+	0x8b, 0x41, 0xcc, 0x17, 0x60, 0x94, 0xd1, 0x5a, 0x72, 0xc5, 0xe3, 0x00, 0x7c, 0x37, 0xe9, 0x2b,
+	0x81, 0xc4, 0x62, 0x45, 0x63, 0x96, 0xba, 0xa1, 0x99, 0xff, 0xcd, 0x40, 0x7c, 0x2e, 0x80, 0x18,
+	0x47, 0x83, 0x12, 0xbd, 0x11, 0x1e, 0x8c, 0x31, 0x63, 0x24, 0xe8, 0x77, 0xd0, 0x03, 0xb2, 0xfc,
+	0xe3, 0x71, 0x18, 0xe6, 0xec, 0xf0, 0x1b, 0x01, 0xc6, 0x83, 0xb2, 0x1a, 0xa7, 0xa2, 0x54, 0x92,
+	0x74, 0xb9, 0x78, 0x35, 0x95, 0xad, 0x1b, 0x5f, 0x9a, 0xfc, 0xe2, 0x8f, 0xbf, 0xbf, 0xcb, 0x14,
+	0x30, 0xaf, 0x44, 0x7e, 0x20, 0x04, 0xb5, 0x3a, 0x7e, 0x2d, 0xc0, 0xa8, 0x77, 0xa9, 0x70, 0x32,
+	0x21, 0x42, 0x97, 0x90, 0x17, 0x2f, 0xf5, 0xb5, 0x23, 0x16, 0x25, 0xce, 0xe2, 0x2a, 0x5e, 0x89,
+	0xb2, 0xf0, 0xa5, 0xa3, 0xb2, 0x1d, 0xe8, 0x91, 0x1d, 0xfc, 0x52, 0x80, 0x31, 0x5f, 0x98, 0x62,
+	0xbf, 0x48, 0x5e, 0xab, 0x89, 0x97, 0xfb, 0x1b, 0x12, 0xa7, 0xf3, 0x9c, 0xd3, 0x59, 0x7c, 0xb3,
+	0x07, 0x27, 0x7c, 0x2a, 0xc0, 0x90, 0xd3, 0x0b, 0x28, 0x25, 0xe0, 0x06, 0xe4, 0xa9, 0x78, 0xbe,
+	0xa7, 0x0d, 0x85, 0x9d, 0xe7, 0x61, 0x6f, 0xe1, 0x4c, 0xea, 0x52, 0x28, 0xbc, 0x0d, 0x95, 0x6d,
+	0x2e, 0x59, 0x77, 0xf0, 0x89, 0x00, 0xc3, 0xbc, 0xad, 0xb1, 0x57, 0x3c, 0xbf, 0x20, 0x17, 0x7a,
+	0x1b, 0x11, 0xab, 0x19, 0xce, 0xaa, 0x84, 0xca, 0x80, 0xac, 0xf0, 0x31, 0x8c, 0x90, 0xa0, 0x49,
+	0x0a, 0x14, 0x7a, 0x75, 0xc5, 0x8b, 0x7d, 0xac, 0x88, 0x4f, 0x81, 0xf3, 0x11, 0x31, 0x17, 0xc3,
+	0xc7, 0x0d, 0xf7, 0x83, 0x00, 0x47, 0x68, 0xfc, 0x62, 0x12, 0x68, 0x58, 0x13, 0x89, 0x93, 0xfd,
+	0xcc, 0x28, 0xf8, 0x12, 0x0f, 0x7e, 0x1b, 0xdf, 0x49, 0x5f, 0x0c, 0x6f, 0xfe, 0x2b, 0xdb, 0xbe,
+	0x52, 0xda, 0xc1, 0x67, 0x02, 0x8c, 0x7a, 0xba, 0x02, 0xfb, 0x04, 0x67, 0xfd, 0xee, 0x54, 0xb7,
+	0x40, 0x91, 0xe6, 0x38, 0xcb, 0x1b, 0x58, 0x1e, 0x9c, 0x25, 0x7e, 0x2f, 0x40, 0x36, 0x30, 0xe6,
+	0xf1, 0x4a, 0x42, 0xd0, 0xa8, 0xf8, 0x10, 0xa7, 0xd2, 0x98, 0xbe, 0x7e, 0x57, 0x71, 0xbd, 0x81,
+	0xbf, 0x09, 0x70, 0x22, 0x66, 0xc4, 0x63, 0xa9, 0xcf, 0xed, 0x8e, 0xea, 0x12, 0xb1, 0x3c, 0x88,
+	0x0b, 0xf1, 0x7e, 0xf0, 0xeb, 0xee, 0xf4, 0x31, 0x7f, 0x28, 0xdb, 0x45, 0xb9, 0x2c, 0x17, 0x79,
+	0x2a, 0xb3, 0x78, 0x73, 0xb0, 0x0b, 0xe2, 0x29, 0x19, 0x7c, 0x21, 0x00, 0x46, 0xc7, 0x3f, 0x16,
+	0x13, 0xd8, 0x25, 0xca, 0x16, 0xb1, 0x34, 0x80, 0x07, 0xa5, 0xf3, 0x76, 0x52, 0x3a, 0x17, 0x50,
+	0x4a, 0xba, 0x5f, 0xca, 0x36, 0x89, 0xa3, 0x1d, 0xfc, 0x59, 0x80, 0x13, 0x31, 0xd3, 0x3f, 0xf1,
+	0x30, 0x92, 0xe5, 0x4a, 0xe2, 0x61, 0xf4, 0x50, 0x27, 0xd2, 0x32, 0xa7, 0xba, 0x80, 0xf3, 0x03,
+	0x55, 0x9e, 0xff, 0x66, 0x74, 0xf0, 0xaa, 0x9e, 0x46, 0xc1, 0x9f, 0x04, 0x38, 0x1a, 0xd2, 0x05,
+	0x98, 0x34, 0x49, 0xe3, 0x44, 0x8c, 0x78, 0x2d, 0x9d, 0x31, 0xb1, 0xbe, 0xcd, 0x59, 0xcf, 0xe1,
+	0x6c, 0x7a, 0xd6, 0x26, 0x01, 0x55, 0xf9, 0xcb, 0xba, 0x38, 0xfb, 0xcb, 0x5e, 0x5e, 0x78, 0xb9,
+	0x97, 0x17, 0xfe, 0xda, 0xcb, 0x0b, 0x4f, 0xf7, 0xf3, 0x87, 0x5e, 0xee, 0xe7, 0x0f, 0xfd, 0xb9,
+	0x9f, 0x3f, 0xf4, 0x71, 0xde, 0x85, 0x64, 0xb5, 0x0d, 0xb9, 0xa1, 0x2b, 0xde, 0xc1, 0x2a, 0xce,
+	0xef, 0x6b, 0xa6, 0xd8, 0xa5, 0xb5, 0x11, 0xfe, 0xdf, 0xde, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff,
+	0xff, 0xa4, 0x70, 0x0b, 0xcc, 0x8e, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1299,6 +1514,10 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VotingPowerSnapshot queries the voting power snapshot a proposal is tallied against.
+	VotingPowerSnapshot(ctx context.Context, in *QueryVotingPowerSnapshotRequest, opts ...grpc.CallOption) (*QueryVotingPowerSnapshotResponse, error)
+	// RestakerVotes queries the votes cast by Ethereum restakers on a proposal.
+	RestakerVotes(ctx context.Context, in *QueryRestakerVotesRequest, opts ...grpc.CallOption) (*QueryRestakerVotesResponse, error)
 }
 
 type queryClient struct {
@@ -1408,6 +1627,24 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VotingPowerSnapshot(ctx context.Context, in *QueryVotingPowerSnapshotRequest, opts ...grpc.CallOption) (*QueryVotingPowerSnapshotResponse, error) {
+	out := new(QueryVotingPowerSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symGov.v1.Query/VotingPowerSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RestakerVotes(ctx context.Context, in *QueryRestakerVotesRequest, opts ...grpc.CallOption) (*QueryRestakerVotesResponse, error) {
+	out := new(QueryRestakerVotesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symGov.v1.Query/RestakerVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1432,6 +1669,10 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VotingPowerSnapshot queries the voting power snapshot a proposal is tallied against.
+	VotingPowerSnapshot(context.Context, *QueryVotingPowerSnapshotRequest) (*QueryVotingPowerSnapshotResponse, error)
+	// RestakerVotes queries the votes cast by Ethereum restakers on a proposal.
+	RestakerVotes(context.Context, *QueryRestakerVotesRequest) (*QueryRestakerVotesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1471,6 +1712,12 @@ func (*UnimplementedQueryServer) ProposalVoteOptions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (*UnimplementedQueryServer) VotingPowerSnapshot(ctx context.Context, req *QueryVotingPowerSnapshotRequest) (*QueryVotingPowerSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) RestakerVotes(ctx context.Context, req *QueryRestakerVotesRequest) (*QueryRestakerVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestakerVotes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1674,6 +1921,42 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPowerSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symGov.v1.Query/VotingPowerSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerSnapshot(ctx, req.(*QueryVotingPowerSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RestakerVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestakerVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RestakerVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symGov.v1.Query/RestakerVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestakerVotes(ctx, req.(*QueryRestakerVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symGov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1722,6 +2005,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VotingPowerSnapshot",
+			Handler:    _Query_VotingPowerSnapshot_Handler,
+		},
+		{
+			MethodName: "RestakerVotes",
+			Handler:    _Query_RestakerVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symGov/v1/query.proto",
@@ -2556,72 +2847,224 @@ func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryConstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVotingPowerSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryConstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Constitution)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryVotingPowerSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVotingPowerSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVotingPowerSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVotingPowerSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRestakerVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRestakerVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestakerVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRestakerVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRestakerVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRestakerVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
 	}
 	l = len(m.Voter)
 	if l > 0 {
@@ -2899,6 +3342,66 @@ func (m *QueryMessageBasedParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVotingPowerSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRestakerVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRestakerVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5059,6 +5562,386 @@ func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVotingPowerSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &VotingPowerSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakerVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakerVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakerVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRestakerVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRestakerVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRestakerVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &RestakerVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
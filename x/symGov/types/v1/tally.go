@@ -8,17 +8,19 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address      sdk.ValAddress      // address of the validator operator
-	BondedTokens math.Int            // Power of a Validator
-	Vote         WeightedVoteOptions // Vote of the validator
+	Address            sdk.ValAddress      // address of the validator operator
+	BondedTokens       math.Int            // Power of a Validator
+	RestakerDeductions math.Int            // Power of the restakers that voted instead of the validator
+	Vote               WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens math.Int, options WeightedVoteOptions) ValidatorGovInfo {
 	return ValidatorGovInfo{
-		Address:      address,
-		BondedTokens: bondedTokens,
-		Vote:         options,
+		Address:            address,
+		BondedTokens:       bondedTokens,
+		RestakerDeductions: math.ZeroInt(),
+		Vote:               options,
 	}
 }
 
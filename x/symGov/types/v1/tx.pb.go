@@ -1169,6 +1169,162 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgVoteRestaker defines a message to relay the vote of an Ethereum restaker, signed with its
+// Ethereum key following EIP-712.
+type MsgVoteRestaker struct {
+	// submitter is the account relaying the vote and paying its fees.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// voter is the hex encoded Ethereum address of the vault depositor or curator.
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	// vault is the hex encoded Ethereum address of the vault the voter restakes through.
+	Vault string `protobuf:"bytes,4,opt,name=vault,proto3" json:"vault,omitempty"`
+	// validator_address is the operator address of the validator whose vote is overridden.
+	ValidatorAddress string `protobuf:"bytes,5,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// options defines the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// nonce is the nonce of the signed vote, it must be higher than the one of the vote it replaces.
+	Nonce uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// signature is the 65 bytes EIP-712 signature of the vote by the voter.
+	Signature []byte `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgVoteRestaker) Reset()         { *m = MsgVoteRestaker{} }
+func (m *MsgVoteRestaker) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteRestaker) ProtoMessage()    {}
+func (*MsgVoteRestaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f467d969401f537c, []int{20}
+}
+func (m *MsgVoteRestaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteRestaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteRestaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteRestaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteRestaker.Merge(m, src)
+}
+func (m *MsgVoteRestaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteRestaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteRestaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteRestaker proto.InternalMessageInfo
+
+func (m *MsgVoteRestaker) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgVoteRestaker) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVoteRestaker) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteRestaker) GetVault() string {
+	if m != nil {
+		return m.Vault
+	}
+	return ""
+}
+
+func (m *MsgVoteRestaker) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgVoteRestaker) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MsgVoteRestaker) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgVoteRestaker) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgVoteRestaker) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgVoteRestakerResponse defines the Msg/VoteRestaker response type.
+type MsgVoteRestakerResponse struct {
+}
+
+func (m *MsgVoteRestakerResponse) Reset()         { *m = MsgVoteRestakerResponse{} }
+func (m *MsgVoteRestakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteRestakerResponse) ProtoMessage()    {}
+func (*MsgVoteRestakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f467d969401f537c, []int{21}
+}
+func (m *MsgVoteRestakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteRestakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteRestakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteRestakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteRestakerResponse.Merge(m, src)
+}
+func (m *MsgVoteRestakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteRestakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteRestakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteRestakerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.symGov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.symGov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1346,116 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.symGov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.symGov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.symGov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgVoteRestaker)(nil), "cosmos.symGov.v1.MsgVoteRestaker")
+	proto.RegisterType((*MsgVoteRestakerResponse)(nil), "cosmos.symGov.v1.MsgVoteRestakerResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/symGov/v1/tx.proto", fileDescriptor_f467d969401f537c) }
 
 var fileDescriptor_f467d969401f537c = []byte{
-	// 1484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x1b, 0x55,
-	0x17, 0xcf, 0xc4, 0x89, 0xed, 0xdc, 0xb8, 0xce, 0x97, 0x89, 0xdb, 0x4c, 0xe6, 0x4b, 0x6d, 0x77,
-	0xbe, 0x3e, 0xdc, 0xf4, 0xf3, 0xd8, 0x4e, 0x13, 0x1e, 0xa6, 0x42, 0xaa, 0x53, 0xa0, 0x95, 0x6a,
-	0x51, 0xa6, 0x2d, 0x20, 0x84, 0xb0, 0xc6, 0x9e, 0xcb, 0x64, 0x54, 0x8f, 0xef, 0xe0, 0x3b, 0x36,
-	0xf1, 0x0e, 0x75, 0xc1, 0xa2, 0xab, 0xae, 0x41, 0x2c, 0xd8, 0x21, 0xc4, 0x22, 0x8b, 0xac, 0x11,
-	0xcb, 0xd2, 0x55, 0x15, 0x21, 0x84, 0x58, 0xb4, 0xa8, 0x95, 0x08, 0xe2, 0xaf, 0x40, 0xf7, 0xce,
-	0x9d, 0xf1, 0x63, 0xae, 0x9d, 0xb4, 0x48, 0x88, 0x4d, 0x32, 0x73, 0xcf, 0xe3, 0x9e, 0xf3, 0x3b,
-	0x67, 0x7e, 0xe7, 0x24, 0x60, 0xa5, 0x81, 0xb0, 0x8d, 0x70, 0x01, 0xf7, 0xec, 0xb7, 0x50, 0xb7,
-	0xd0, 0x2d, 0x15, 0xdc, 0x1d, 0xd5, 0x69, 0x23, 0x17, 0x89, 0xff, 0xf1, 0x44, 0xaa, 0x27, 0x52,
-	0xbb, 0x25, 0x39, 0xcd, 0x94, 0xeb, 0x3a, 0x86, 0x85, 0x6e, 0xa9, 0x0e, 0x5d, 0xbd, 0x54, 0x68,
-	0x20, 0xab, 0xe5, 0x59, 0xc8, 0x72, 0xc8, 0x99, 0x89, 0xba, 0x4c, 0x96, 0x32, 0x91, 0x89, 0xe8,
-	0x63, 0x81, 0x3c, 0xb1, 0x53, 0x76, 0x7d, 0xcd, 0x13, 0xb0, 0x0b, 0x99, 0xc8, 0x44, 0xc8, 0x6c,
-	0xc2, 0x02, 0x7d, 0xab, 0x77, 0x3e, 0x2e, 0xe8, 0xad, 0x1e, 0x13, 0x2d, 0xb3, 0x7b, 0x6c, 0x6c,
-	0x92, 0x4b, 0x6c, 0x6c, 0x32, 0xc1, 0xa2, 0x6e, 0x5b, 0x2d, 0x54, 0xa0, 0x3f, 0xd9, 0x51, 0x66,
-	0xd4, 0x8d, 0x6b, 0xd9, 0x10, 0xbb, 0xba, 0xed, 0x78, 0x0a, 0xca, 0x0f, 0x33, 0x60, 0xb1, 0x8a,
-	0xcd, 0x9b, 0x9d, 0xba, 0x6d, 0xb9, 0x37, 0xda, 0xc8, 0x41, 0x58, 0x6f, 0x8a, 0x45, 0x10, 0xb7,
-	0x21, 0xc6, 0xba, 0x09, 0xb1, 0x24, 0x64, 0x23, 0xb9, 0xf9, 0xf5, 0x94, 0xea, 0x79, 0x52, 0x7d,
-	0x4f, 0xea, 0xe5, 0x56, 0x4f, 0x0b, 0xb4, 0xc4, 0x7b, 0x02, 0x58, 0xb0, 0x5a, 0x96, 0x6b, 0xe9,
-	0xcd, 0x9a, 0x01, 0x1d, 0x84, 0x2d, 0x57, 0x9a, 0xa6, 0x96, 0x2b, 0x2a, 0x4b, 0x8c, 0xe0, 0xa6,
-	0x32, 0xdc, 0xd4, 0x2d, 0x64, 0xb5, 0x2a, 0x6f, 0x3e, 0x78, 0x9c, 0x99, 0xfa, 0xf6, 0x49, 0x26,
-	0x67, 0x5a, 0xee, 0x76, 0xa7, 0xae, 0x36, 0x90, 0xcd, 0x50, 0x60, 0xbf, 0xf2, 0xd8, 0xb8, 0x53,
-	0x70, 0x7b, 0x0e, 0xc4, 0xd4, 0x00, 0x7f, 0x71, 0xb0, 0xbb, 0x96, 0x68, 0x42, 0x53, 0x6f, 0xf4,
-	0x6a, 0x04, 0x79, 0xfc, 0xcd, 0xc1, 0xee, 0x9a, 0xa0, 0x25, 0xd9, 0xcd, 0x57, 0xbc, 0x8b, 0xc5,
-	0x0d, 0x10, 0x77, 0x68, 0x2a, 0xb0, 0x2d, 0x45, 0xb2, 0x42, 0x6e, 0xae, 0x22, 0xed, 0xef, 0xe5,
-	0x53, 0x2c, 0x8e, 0xcb, 0x86, 0xd1, 0x86, 0x18, 0xdf, 0x74, 0xdb, 0x56, 0xcb, 0xd4, 0x02, 0x4d,
-	0x51, 0x26, 0x49, 0xbb, 0xba, 0xa1, 0xbb, 0xba, 0x34, 0x43, 0xac, 0xb4, 0xe0, 0x5d, 0x3c, 0x0f,
-	0x66, 0x5d, 0xcb, 0x6d, 0x42, 0x69, 0x96, 0xba, 0x5b, 0xfa, 0x75, 0x2f, 0xbf, 0xd0, 0x0f, 0x31,
-	0x5b, 0x54, 0x37, 0x5e, 0xd6, 0x3c, 0x0d, 0x31, 0x0f, 0x62, 0xb8, 0x63, 0xdb, 0x7a, 0xbb, 0x27,
-	0x45, 0xc7, 0x2b, 0xfb, 0x3a, 0xe2, 0x45, 0x30, 0x07, 0x77, 0x1c, 0x68, 0x58, 0x2e, 0x34, 0xa4,
-	0x58, 0x56, 0xc8, 0xc5, 0x2b, 0xc7, 0x43, 0x06, 0x9b, 0x45, 0x49, 0xd0, 0xfa, 0x7a, 0xe2, 0xfb,
-	0xe0, 0x98, 0xc3, 0x6a, 0x55, 0x23, 0xf0, 0x48, 0xf1, 0xac, 0x90, 0x4b, 0xae, 0xa7, 0xd5, 0xd1,
-	0xa6, 0x55, 0xfd, 0x92, 0xde, 0xea, 0x39, 0xd0, 0x8b, 0x64, 0x87, 0x35, 0x68, 0xb6, 0x5b, 0x54,
-	0xd7, 0xd5, 0xa2, 0x96, 0x70, 0x06, 0x54, 0xca, 0xa5, 0xbb, 0x07, 0xbb, 0x6b, 0x01, 0x26, 0xf7,
-	0x0e, 0x76, 0xd7, 0x32, 0x03, 0xa5, 0xe8, 0x96, 0x0a, 0xa1, 0x66, 0x51, 0x2e, 0x81, 0x95, 0xd0,
-	0xa1, 0x06, 0xb1, 0x83, 0x5a, 0x18, 0x8a, 0x19, 0x30, 0x1f, 0x44, 0x6a, 0x19, 0x92, 0x90, 0x15,
-	0x72, 0x33, 0x1a, 0xf0, 0x8f, 0xae, 0x19, 0xca, 0xf7, 0x02, 0x48, 0x55, 0xb1, 0xf9, 0xc6, 0x0e,
-	0x6c, 0x5c, 0xa7, 0x85, 0xdd, 0x42, 0x2d, 0x17, 0xb6, 0x5c, 0xf1, 0x1d, 0x10, 0x6b, 0x78, 0x8f,
-	0xd4, 0x6a, 0x4c, 0x0b, 0x56, 0x4e, 0x3d, 0xdc, 0xcb, 0x9f, 0x1c, 0x4d, 0xdb, 0xef, 0x31, 0x6a,
-	0xae, 0xf9, 0x7e, 0xc4, 0x55, 0x30, 0xa7, 0x77, 0xdc, 0x6d, 0xd4, 0xb6, 0xdc, 0x9e, 0x34, 0x4d,
-	0x4b, 0xdc, 0x3f, 0x28, 0x6f, 0x92, 0xd4, 0xfb, 0xef, 0x24, 0x77, 0x25, 0x94, 0x7b, 0x28, 0x4e,
-	0x25, 0x0d, 0x56, 0x79, 0xe7, 0x3e, 0x02, 0xca, 0x1f, 0x02, 0x88, 0x55, 0xb1, 0xf9, 0x2e, 0x72,
-	0xa1, 0xb8, 0xc9, 0x41, 0xa3, 0x92, 0xfa, 0xf3, 0x71, 0x66, 0xf0, 0xd8, 0xeb, 0xe9, 0x01, 0x8c,
-	0x44, 0x15, 0xcc, 0x76, 0x91, 0x0b, 0xdb, 0x5e, 0xcc, 0x13, 0x9a, 0xd9, 0x53, 0x13, 0x37, 0x40,
-	0x14, 0x39, 0xae, 0x85, 0x5a, 0xb4, 0xfb, 0x93, 0xeb, 0xab, 0xe1, 0xbe, 0x20, 0xe1, 0xbc, 0x4d,
-	0x75, 0x34, 0xa6, 0x3b, 0xa9, 0xff, 0xcb, 0xa7, 0x09, 0x36, 0x9e, 0x77, 0x82, 0xcb, 0xf1, 0x10,
-	0x2e, 0xc4, 0x9f, 0xb2, 0x08, 0x16, 0xd8, 0x63, 0x90, 0xfd, 0xdd, 0xe9, 0xe0, 0xec, 0x3d, 0x68,
-	0x99, 0xdb, 0xa4, 0x7b, 0xff, 0x21, 0x14, 0x5e, 0x07, 0x31, 0x2f, 0x33, 0x2c, 0x45, 0x28, 0x13,
-	0x9d, 0x0e, 0xc3, 0xe0, 0xc7, 0x34, 0x00, 0x87, 0x6f, 0x34, 0x11, 0x8f, 0xff, 0x0f, 0xe3, 0x71,
-	0x92, 0x8b, 0x87, 0xef, 0x5c, 0x59, 0x01, 0xcb, 0x23, 0x47, 0x01, 0x3e, 0xbf, 0x0b, 0x00, 0x54,
-	0xb1, 0xe9, 0x33, 0xd7, 0x0b, 0x42, 0xf3, 0x12, 0x98, 0x63, 0xa4, 0x8b, 0x0e, 0x87, 0xa7, 0xaf,
-	0x2a, 0x5e, 0x02, 0x51, 0xdd, 0x46, 0x9d, 0x96, 0xcb, 0x10, 0x9a, 0xc0, 0xd5, 0x73, 0x84, 0xab,
-	0xbd, 0x9b, 0x99, 0x4d, 0xf9, 0x02, 0xfd, 0x60, 0x02, 0x6f, 0x04, 0x08, 0x29, 0x04, 0x04, 0xcb,
-	0x4c, 0x49, 0x01, 0xb1, 0xff, 0x16, 0xa4, 0xff, 0x93, 0x40, 0xdb, 0xe3, 0xb6, 0x63, 0xe8, 0x2e,
-	0xbc, 0xa1, 0xb7, 0x75, 0x1b, 0x93, 0x64, 0xfa, 0x5f, 0xa9, 0x70, 0x58, 0x32, 0x81, 0xaa, 0xf8,
-	0x1a, 0x88, 0x3a, 0xd4, 0x03, 0x45, 0x60, 0x7e, 0x5d, 0xe2, 0xb0, 0x21, 0x95, 0x0f, 0xe5, 0xe2,
-	0x99, 0x94, 0xaf, 0xef, 0x87, 0x49, 0x3a, 0xcc, 0x07, 0x67, 0x07, 0xd2, 0xdb, 0x19, 0x18, 0xf4,
-	0x23, 0x29, 0x28, 0x2a, 0x2d, 0xf8, 0xe0, 0x91, 0x9f, 0x71, 0x79, 0x89, 0x73, 0x91, 0xf2, 0x95,
-	0x40, 0xa7, 0xf0, 0x96, 0xde, 0x6a, 0xc0, 0xe6, 0xc0, 0x14, 0xe6, 0x34, 0xc3, 0xc2, 0x48, 0x33,
-	0x0c, 0xf5, 0xc1, 0xe0, 0xe0, 0x9b, 0x3e, 0xea, 0xe0, 0x2b, 0x67, 0xf7, 0xc3, 0xf3, 0x66, 0x68,
-	0x0c, 0x28, 0x3f, 0x0b, 0x94, 0xe3, 0x87, 0xe3, 0x0b, 0x38, 0xfe, 0xf9, 0xe3, 0xbc, 0x06, 0x8e,
-	0x35, 0xa8, 0x2f, 0x68, 0xd4, 0xc8, 0x46, 0xc2, 0x2a, 0x26, 0x87, 0x18, 0xfe, 0x96, 0xbf, 0xae,
-	0x54, 0xe2, 0xa4, 0x66, 0xf7, 0x9f, 0x64, 0x04, 0x2d, 0xe1, 0x9b, 0x12, 0xa1, 0x78, 0x0e, 0x2c,
-	0x04, 0xae, 0xb6, 0xe9, 0xd7, 0x45, 0x49, 0x6f, 0x46, 0x4b, 0xfa, 0xc7, 0x57, 0xe9, 0x29, 0x07,
-	0xf8, 0xcd, 0xa2, 0xf2, 0x65, 0x04, 0x64, 0x82, 0xe1, 0x55, 0xed, 0x34, 0x5d, 0xcb, 0x69, 0xc2,
-	0xad, 0x6d, 0x64, 0x35, 0x60, 0x50, 0x06, 0xde, 0x6a, 0x23, 0xfc, 0x1b, 0x56, 0x9b, 0xe9, 0x17,
-	0x5a, 0x6d, 0x22, 0x23, 0xab, 0x4d, 0xca, 0x5f, 0x6d, 0x3c, 0x8e, 0x63, 0x5b, 0x8c, 0xd4, 0xdf,
-	0x62, 0xe8, 0xca, 0xd3, 0x5f, 0x58, 0xae, 0x82, 0x04, 0xe1, 0xbd, 0x9a, 0xcf, 0xad, 0x51, 0x5a,
-	0xba, 0x33, 0xe3, 0x57, 0x8f, 0x3e, 0xb7, 0x62, 0x6d, 0xbe, 0xdb, 0x7f, 0x29, 0x67, 0xf6, 0xf7,
-	0xf2, 0xc9, 0x60, 0x1d, 0x29, 0xa9, 0x45, 0x75, 0xa4, 0xed, 0x3e, 0x02, 0xe7, 0x0e, 0x29, 0xce,
-	0x91, 0xf7, 0x8c, 0xb2, 0x18, 0xbe, 0x4c, 0xf9, 0x51, 0x00, 0x27, 0x82, 0xef, 0xb4, 0xea, 0xad,
-	0xb2, 0x7f, 0x93, 0x84, 0x96, 0x41, 0xcc, 0xc6, 0x66, 0xad, 0xd3, 0x6e, 0xb2, 0x05, 0x23, 0x6a,
-	0x63, 0xf3, 0x76, 0xbb, 0x49, 0xa8, 0x96, 0xb1, 0x53, 0x84, 0x02, 0xc6, 0x19, 0x46, 0x2c, 0x82,
-	0x8a, 0x8e, 0xa1, 0xc1, 0x58, 0xc3, 0xa7, 0xa7, 0x2c, 0x1f, 0xaa, 0xfe, 0xc5, 0xca, 0x06, 0x48,
-	0xf3, 0x53, 0x09, 0x98, 0x87, 0x87, 0xc0, 0x77, 0x02, 0x98, 0xa7, 0x10, 0x1b, 0x88, 0x6c, 0x30,
-	0x2f, 0x9c, 0xf6, 0x16, 0x88, 0xd8, 0xd8, 0x64, 0x9f, 0x31, 0x7f, 0x51, 0xfb, 0xef, 0xc3, 0xbd,
-	0xfc, 0x32, 0xef, 0x7b, 0xa9, 0x62, 0x53, 0x23, 0xd6, 0x47, 0x48, 0xf2, 0x32, 0x58, 0x1a, 0x88,
-	0x36, 0x28, 0xfe, 0x09, 0x10, 0x6d, 0x43, 0xdc, 0x69, 0x7a, 0x9b, 0x62, 0x42, 0x63, 0x6f, 0xbc,
-	0x8c, 0xd7, 0x1f, 0xc5, 0x41, 0xa4, 0x8a, 0x4d, 0xb1, 0x0e, 0x92, 0x23, 0x7f, 0xf4, 0xfc, 0x8f,
-	0x53, 0x91, 0xd1, 0xbd, 0x56, 0xbe, 0x70, 0x04, 0xa5, 0x20, 0xae, 0x3b, 0x60, 0x31, 0xbc, 0xd7,
-	0x9e, 0xe5, 0x7a, 0x08, 0xe9, 0xc9, 0xea, 0xd1, 0xf4, 0x82, 0xcb, 0xae, 0x80, 0x19, 0xba, 0x63,
-	0xae, 0x70, 0xed, 0x88, 0x48, 0x3e, 0x35, 0x56, 0x14, 0x78, 0xf9, 0x10, 0x24, 0x86, 0x76, 0xb5,
-	0xf1, 0x26, 0xbe, 0x8a, 0x7c, 0xfe, 0x50, 0x95, 0xc0, 0x7b, 0x15, 0xc4, 0x7c, 0x22, 0x5b, 0xe5,
-	0x5a, 0x31, 0xa9, 0x7c, 0x7a, 0x92, 0x34, 0x70, 0xf7, 0x09, 0x48, 0x0c, 0x6d, 0x0e, 0xfc, 0x60,
-	0x07, 0x55, 0xc6, 0x04, 0xcb, 0x9b, 0xd4, 0xca, 0xd2, 0xc3, 0xf0, 0xa4, 0x16, 0x3f, 0x05, 0xc9,
-	0x91, 0x29, 0xcd, 0x6f, 0x9b, 0x61, 0xa5, 0x31, 0x6d, 0xc3, 0x9f, 0xa7, 0x9c, 0x8b, 0x37, 0x8b,
-	0xe2, 0xd7, 0x02, 0x58, 0x9d, 0x38, 0xa6, 0x4a, 0x13, 0x3a, 0x93, 0x6f, 0x22, 0xbf, 0xfa, 0xdc,
-	0x26, 0xc3, 0x31, 0x66, 0x87, 0xbf, 0x2d, 0xf1, 0x73, 0x01, 0x2c, 0xf1, 0xc8, 0x34, 0x37, 0x01,
-	0xf4, 0x21, 0x4d, 0xb9, 0x78, 0x54, 0xcd, 0xc9, 0x81, 0x40, 0x10, 0x0f, 0x28, 0xed, 0xe4, 0x98,
-	0x24, 0x3d, 0xb1, 0x7c, 0x66, 0xa2, 0x78, 0xe2, 0x35, 0xf2, 0xec, 0x67, 0x64, 0x46, 0x57, 0x5e,
-	0x79, 0xf0, 0x34, 0x2d, 0x3c, 0x7a, 0x9a, 0x16, 0x7e, 0x7b, 0x9a, 0x16, 0xee, 0x3f, 0x4b, 0x4f,
-	0x3d, 0x7a, 0x96, 0x9e, 0xfa, 0xe5, 0x59, 0x7a, 0xea, 0x03, 0xf6, 0x2f, 0x23, 0x6c, 0xdc, 0x51,
-	0x2d, 0xd4, 0xdf, 0x18, 0xe9, 0xd8, 0x2f, 0x74, 0x4b, 0xf5, 0x28, 0x65, 0xc8, 0x8b, 0x7f, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x49, 0x1e, 0xb4, 0x84, 0x88, 0x12, 0x00, 0x00,
+	// 1626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x89, 0x9d, 0x4c, 0xd2, 0xa4, 0xd9, 0xb8, 0xcd, 0xc6, 0xdf, 0xd4, 0x76, 0xf7,
+	0xdb, 0x1f, 0x69, 0xfa, 0xcd, 0xda, 0x4e, 0x93, 0x2f, 0x60, 0x2a, 0xa4, 0x3a, 0x05, 0x5a, 0xa9,
+	0x86, 0xb2, 0xfd, 0x01, 0x42, 0x08, 0x6b, 0x63, 0x0f, 0x9b, 0x55, 0xbc, 0x3b, 0xcb, 0xce, 0xd8,
+	0xc4, 0x37, 0xd4, 0x03, 0x87, 0x9e, 0x7a, 0x06, 0x71, 0xe0, 0x86, 0x10, 0x42, 0x39, 0xe4, 0x5c,
+	0x71, 0x2c, 0x3d, 0x55, 0x11, 0x42, 0x88, 0x43, 0x8b, 0x5a, 0x89, 0x20, 0xfe, 0x0a, 0x34, 0xb3,
+	0xb3, 0x3f, 0xec, 0x5d, 0x3b, 0x69, 0x2b, 0x21, 0x2e, 0xad, 0xe7, 0xcd, 0x7b, 0x6f, 0xde, 0xfb,
+	0xcc, 0x9b, 0xf7, 0x3e, 0x1b, 0x30, 0x5f, 0x47, 0xd8, 0x44, 0xb8, 0x80, 0x3b, 0xe6, 0xdb, 0xa8,
+	0x5d, 0x68, 0x97, 0x0a, 0x64, 0x5b, 0xb1, 0x1d, 0x44, 0x90, 0x78, 0xd4, 0xdd, 0x52, 0xdc, 0x2d,
+	0xa5, 0x5d, 0xca, 0x64, 0xb9, 0xf2, 0x86, 0x86, 0x61, 0xa1, 0x5d, 0xda, 0x80, 0x44, 0x2b, 0x15,
+	0xea, 0xc8, 0xb0, 0x5c, 0x8b, 0x4c, 0x26, 0xe2, 0x4c, 0x47, 0x6d, 0xbe, 0x97, 0xd6, 0x91, 0x8e,
+	0xd8, 0xcf, 0x02, 0xfd, 0xc5, 0xa5, 0xfc, 0xf8, 0x9a, 0xbb, 0xc1, 0x0f, 0xe4, 0x5b, 0x3a, 0x42,
+	0x7a, 0x13, 0x16, 0xd8, 0x6a, 0xa3, 0xf5, 0x49, 0x41, 0xb3, 0x3a, 0x7c, 0x6b, 0x8e, 0x9f, 0x63,
+	0x62, 0x9d, 0x1e, 0x62, 0x62, 0x9d, 0x6f, 0xcc, 0x68, 0xa6, 0x61, 0xa1, 0x02, 0xfb, 0x97, 0x8b,
+	0x72, 0xbd, 0x6e, 0x88, 0x61, 0x42, 0x4c, 0x34, 0xd3, 0x76, 0x15, 0xe4, 0x1f, 0x47, 0xc0, 0x4c,
+	0x15, 0xeb, 0x37, 0x5a, 0x1b, 0xa6, 0x41, 0xae, 0x3b, 0xc8, 0x46, 0x58, 0x6b, 0x8a, 0x45, 0x30,
+	0x66, 0x42, 0x8c, 0x35, 0x1d, 0x62, 0x49, 0xc8, 0x27, 0x16, 0x27, 0x56, 0xd2, 0x8a, 0xeb, 0x49,
+	0xf1, 0x3c, 0x29, 0x97, 0xac, 0x8e, 0xea, 0x6b, 0x89, 0x77, 0x05, 0x30, 0x6d, 0x58, 0x06, 0x31,
+	0xb4, 0x66, 0xad, 0x01, 0x6d, 0x84, 0x0d, 0x22, 0x0d, 0x33, 0xcb, 0x79, 0x85, 0x27, 0x46, 0x71,
+	0x53, 0x38, 0x6e, 0xca, 0x3a, 0x32, 0xac, 0xca, 0x5b, 0x0f, 0x1e, 0xe7, 0x86, 0xbe, 0x7b, 0x92,
+	0x5b, 0xd4, 0x0d, 0xb2, 0xd9, 0xda, 0x50, 0xea, 0xc8, 0xe4, 0x28, 0xf0, 0xff, 0x96, 0x71, 0x63,
+	0xab, 0x40, 0x3a, 0x36, 0xc4, 0xcc, 0x00, 0x7f, 0xb9, 0xbf, 0xb3, 0x34, 0xd9, 0x84, 0xba, 0x56,
+	0xef, 0xd4, 0x28, 0xf2, 0xf8, 0xdb, 0xfd, 0x9d, 0x25, 0x41, 0x9d, 0xe2, 0x27, 0x5f, 0x76, 0x0f,
+	0x16, 0x57, 0xc1, 0x98, 0xcd, 0x52, 0x81, 0x8e, 0x94, 0xc8, 0x0b, 0x8b, 0xe3, 0x15, 0x69, 0x6f,
+	0x77, 0x39, 0xcd, 0xe3, 0xb8, 0xd4, 0x68, 0x38, 0x10, 0xe3, 0x1b, 0xc4, 0x31, 0x2c, 0x5d, 0xf5,
+	0x35, 0xc5, 0x0c, 0x4d, 0x9a, 0x68, 0x0d, 0x8d, 0x68, 0xd2, 0x08, 0xb5, 0x52, 0xfd, 0xb5, 0x78,
+	0x0e, 0x8c, 0x12, 0x83, 0x34, 0xa1, 0x34, 0xca, 0xdc, 0xcd, 0xfe, 0xb6, 0xbb, 0x3c, 0x1d, 0x84,
+	0x98, 0x2f, 0x2a, 0xab, 0xaf, 0xa8, 0xae, 0x86, 0xb8, 0x0c, 0x52, 0xb8, 0x65, 0x9a, 0x9a, 0xd3,
+	0x91, 0x92, 0xfd, 0x95, 0x3d, 0x1d, 0xf1, 0x02, 0x18, 0x87, 0xdb, 0x36, 0x6c, 0x18, 0x04, 0x36,
+	0xa4, 0x54, 0x5e, 0x58, 0x1c, 0xab, 0x1c, 0x8b, 0x18, 0xac, 0x15, 0x25, 0x41, 0x0d, 0xf4, 0xc4,
+	0x0f, 0xc0, 0x11, 0x9b, 0xdf, 0x55, 0x8d, 0xc2, 0x23, 0x8d, 0xe5, 0x85, 0xc5, 0xa9, 0x95, 0xac,
+	0xd2, 0x5b, 0xb4, 0x8a, 0x77, 0xa5, 0x37, 0x3b, 0x36, 0x74, 0x23, 0xd9, 0xe6, 0x05, 0x9a, 0x6f,
+	0x17, 0x95, 0x15, 0xa5, 0xa8, 0x4e, 0xda, 0x21, 0x95, 0x72, 0xe9, 0xce, 0xfe, 0xce, 0x92, 0x8f,
+	0xc9, 0xdd, 0xfd, 0x9d, 0xa5, 0x5c, 0xe8, 0x2a, 0xda, 0xa5, 0x42, 0xa4, 0x58, 0xe4, 0x8b, 0x60,
+	0x3e, 0x22, 0x54, 0x21, 0xb6, 0x91, 0x85, 0xa1, 0x98, 0x03, 0x13, 0x7e, 0xa4, 0x46, 0x43, 0x12,
+	0xf2, 0xc2, 0xe2, 0x88, 0x0a, 0x3c, 0xd1, 0xd5, 0x86, 0x7c, 0x5f, 0x00, 0xe9, 0x2a, 0xd6, 0xdf,
+	0xdc, 0x86, 0xf5, 0x6b, 0xec, 0x62, 0xd7, 0x91, 0x45, 0xa0, 0x45, 0xc4, 0xf7, 0x40, 0xaa, 0xee,
+	0xfe, 0x64, 0x56, 0x7d, 0x4a, 0xb0, 0x72, 0xf2, 0xe1, 0xee, 0xf2, 0x89, 0xde, 0xb4, 0xbd, 0x1a,
+	0x63, 0xe6, 0xaa, 0xe7, 0x47, 0x5c, 0x00, 0xe3, 0x5a, 0x8b, 0x6c, 0x22, 0xc7, 0x20, 0x1d, 0x69,
+	0x98, 0x5d, 0x71, 0x20, 0x28, 0xaf, 0xd1, 0xd4, 0x83, 0x35, 0xcd, 0x5d, 0x8e, 0xe4, 0x1e, 0x89,
+	0x53, 0xce, 0x82, 0x85, 0x38, 0xb9, 0x87, 0x80, 0xfc, 0xa7, 0x00, 0x52, 0x55, 0xac, 0xdf, 0x46,
+	0x04, 0x8a, 0x6b, 0x31, 0x68, 0x54, 0xd2, 0x7f, 0x3d, 0xce, 0x85, 0xc5, 0x6e, 0x4d, 0x87, 0x30,
+	0x12, 0x15, 0x30, 0xda, 0x46, 0x04, 0x3a, 0x6e, 0xcc, 0x03, 0x8a, 0xd9, 0x55, 0x13, 0x57, 0x41,
+	0x12, 0xd9, 0xc4, 0x40, 0x16, 0xab, 0xfe, 0xa9, 0x95, 0x85, 0x68, 0x5d, 0xd0, 0x70, 0xde, 0x65,
+	0x3a, 0x2a, 0xd7, 0x1d, 0x54, 0xff, 0xe5, 0x53, 0x14, 0x1b, 0xd7, 0x3b, 0xc5, 0xe5, 0x58, 0x04,
+	0x17, 0xea, 0x4f, 0x9e, 0x01, 0xd3, 0xfc, 0xa7, 0x9f, 0xfd, 0x9d, 0x61, 0x5f, 0xf6, 0x3e, 0x34,
+	0xf4, 0x4d, 0x5a, 0xbd, 0xff, 0x10, 0x0a, 0x6f, 0x80, 0x94, 0x9b, 0x19, 0x96, 0x12, 0xac, 0x13,
+	0x9d, 0x8a, 0xc2, 0xe0, 0xc5, 0x14, 0x82, 0xc3, 0x33, 0x1a, 0x88, 0xc7, 0xff, 0xba, 0xf1, 0x38,
+	0x11, 0x8b, 0x87, 0xe7, 0x5c, 0x9e, 0x07, 0x73, 0x3d, 0x22, 0x1f, 0x9f, 0x3f, 0x04, 0x00, 0xaa,
+	0x58, 0xf7, 0x3a, 0xd7, 0x0b, 0x42, 0xf3, 0x7f, 0x30, 0xce, 0x9b, 0x2e, 0x3a, 0x18, 0x9e, 0x40,
+	0x55, 0xbc, 0x08, 0x92, 0x9a, 0x89, 0x5a, 0x16, 0xe1, 0x08, 0x0d, 0xe8, 0xd5, 0xe3, 0xb4, 0x57,
+	0xbb, 0x27, 0x73, 0x9b, 0xf2, 0x79, 0xf6, 0x60, 0x7c, 0x6f, 0x14, 0x08, 0x29, 0x02, 0x04, 0xcf,
+	0x4c, 0x4e, 0x03, 0x31, 0x58, 0xf9, 0xe9, 0xff, 0x2c, 0xb0, 0xf2, 0xb8, 0x65, 0x37, 0x34, 0x02,
+	0xaf, 0x6b, 0x8e, 0x66, 0x62, 0x9a, 0x4c, 0xf0, 0x4a, 0x85, 0x83, 0x92, 0xf1, 0x55, 0xc5, 0xd7,
+	0x41, 0xd2, 0x66, 0x1e, 0x18, 0x02, 0x13, 0x2b, 0x52, 0x4c, 0x37, 0x64, 0xfb, 0x5d, 0xb9, 0xb8,
+	0x26, 0xe5, 0x6b, 0x7b, 0xd1, 0x26, 0x1d, 0xed, 0x07, 0x67, 0x42, 0xe9, 0x6d, 0x87, 0x06, 0x7d,
+	0x4f, 0x0a, 0xb2, 0xc2, 0x2e, 0x3c, 0x2c, 0xf2, 0x32, 0x2e, 0xcf, 0xc6, 0x1c, 0x24, 0x7f, 0x2d,
+	0xb0, 0x29, 0xbc, 0xae, 0x59, 0x75, 0xd8, 0x0c, 0x4d, 0xe1, 0x98, 0x62, 0x98, 0xee, 0x29, 0x86,
+	0xae, 0x3a, 0x08, 0x0f, 0xbe, 0xe1, 0xc3, 0x0e, 0xbe, 0x72, 0x7e, 0x2f, 0x3a, 0x6f, 0xba, 0xc6,
+	0x80, 0xfc, 0x8b, 0xc0, 0x7a, 0x7c, 0x77, 0x7c, 0x7e, 0x8f, 0x7f, 0xfe, 0x38, 0xaf, 0x82, 0x23,
+	0x75, 0xe6, 0x0b, 0x36, 0x6a, 0x94, 0x91, 0xf0, 0x1b, 0xcb, 0x44, 0x3a, 0xfc, 0x4d, 0x8f, 0xae,
+	0x54, 0xc6, 0xe8, 0x9d, 0xdd, 0x7b, 0x92, 0x13, 0xd4, 0x49, 0xcf, 0x94, 0x6e, 0x8a, 0x67, 0xc1,
+	0xb4, 0xef, 0x6a, 0x93, 0xbd, 0x2e, 0xd6, 0xf4, 0x46, 0xd4, 0x29, 0x4f, 0x7c, 0x85, 0x49, 0x63,
+	0x80, 0x5f, 0x2b, 0xca, 0x5f, 0x25, 0x40, 0xce, 0x1f, 0x5e, 0xd5, 0x56, 0x93, 0x18, 0x76, 0x13,
+	0xae, 0x6f, 0x22, 0xa3, 0x0e, 0xfd, 0x6b, 0x88, 0xa3, 0x36, 0xc2, 0xbf, 0x81, 0xda, 0x0c, 0xbf,
+	0x10, 0xb5, 0x49, 0xf4, 0x50, 0x9b, 0xb4, 0x47, 0x6d, 0xdc, 0x1e, 0xc7, 0x59, 0x8c, 0x14, 0xb0,
+	0x18, 0x46, 0x79, 0x02, 0xc2, 0x72, 0x05, 0x4c, 0xd2, 0xbe, 0x57, 0xf3, 0x7a, 0x6b, 0x92, 0x5d,
+	0xdd, 0xe9, 0xfe, 0xd4, 0x23, 0xe8, 0xad, 0x58, 0x9d, 0x68, 0x07, 0x8b, 0x72, 0x6e, 0x6f, 0x77,
+	0x79, 0xca, 0xa7, 0x23, 0x25, 0xa5, 0xa8, 0xf4, 0x94, 0xdd, 0xc7, 0xe0, 0xec, 0x01, 0x97, 0x73,
+	0x68, 0x9e, 0x51, 0x16, 0xa3, 0x87, 0xc9, 0x3f, 0x09, 0xe0, 0xb8, 0xff, 0x4e, 0xab, 0x2e, 0x95,
+	0x7d, 0xc9, 0x26, 0x34, 0x07, 0x52, 0x26, 0xd6, 0x6b, 0x2d, 0xa7, 0xc9, 0x09, 0x46, 0xd2, 0xc4,
+	0xfa, 0x2d, 0xa7, 0x49, 0x5b, 0x2d, 0xef, 0x4e, 0x09, 0x06, 0x58, 0xcc, 0x30, 0xe2, 0x11, 0x54,
+	0x34, 0x0c, 0x1b, 0xbc, 0x6b, 0x78, 0xed, 0x29, 0x1f, 0x0f, 0x55, 0x70, 0xb0, 0xbc, 0x0a, 0xb2,
+	0xf1, 0xa9, 0xf8, 0x9d, 0x27, 0x0e, 0x81, 0xef, 0x05, 0x30, 0xc1, 0x20, 0x6e, 0x20, 0xca, 0x60,
+	0x5e, 0x38, 0xed, 0x75, 0x90, 0x30, 0xb1, 0xce, 0x9f, 0x71, 0x3c, 0x51, 0xfb, 0xcf, 0xc3, 0xdd,
+	0xe5, 0xb9, 0xb8, 0xf7, 0x52, 0xc5, 0xba, 0x4a, 0xad, 0x0f, 0x91, 0xe4, 0x25, 0x30, 0x1b, 0x8a,
+	0xd6, 0xbf, 0xfc, 0xe3, 0x20, 0xe9, 0x40, 0xdc, 0x6a, 0xba, 0x4c, 0x71, 0x52, 0xe5, 0xab, 0xd8,
+	0x8c, 0xef, 0x27, 0xc2, 0x24, 0x85, 0x68, 0x5b, 0xd0, 0xa1, 0x59, 0x63, 0x56, 0x64, 0x94, 0x5d,
+	0x1c, 0x98, 0xb5, 0xaf, 0xda, 0x3b, 0xad, 0x87, 0x0f, 0x39, 0xad, 0xd3, 0x1e, 0x91, 0x71, 0x9f,
+	0x22, 0xa7, 0x2b, 0x54, 0xaa, 0xd1, 0x1c, 0xf8, 0x3b, 0x64, 0x0b, 0xf1, 0x1d, 0x30, 0xd3, 0xd6,
+	0x9a, 0x46, 0x43, 0x23, 0xc8, 0xa9, 0x69, 0x6e, 0x20, 0xfc, 0x23, 0xe4, 0xe4, 0x5e, 0xc0, 0x7c,
+	0x6f, 0x7b, 0x3a, 0xdd, 0xb1, 0x1e, 0x6d, 0xf7, 0xc8, 0xc3, 0xa4, 0x28, 0xf9, 0xb2, 0xa4, 0x28,
+	0x15, 0xed, 0x24, 0x16, 0xb2, 0xea, 0xee, 0xd7, 0xc8, 0x88, 0xea, 0x2e, 0x28, 0xe9, 0xc6, 0x86,
+	0x6e, 0x69, 0xa4, 0xe5, 0x40, 0x69, 0x9c, 0xdd, 0x4f, 0x20, 0x28, 0xbb, 0x37, 0xec, 0x43, 0xda,
+	0x9f, 0x4c, 0x79, 0x97, 0x15, 0x22, 0x53, 0x9e, 0xc8, 0xab, 0x83, 0x95, 0x1f, 0xc6, 0x41, 0xa2,
+	0x8a, 0x75, 0x71, 0x03, 0x4c, 0xf5, 0x7c, 0xd0, 0xfe, 0x37, 0xe6, 0xb5, 0xf5, 0x7e, 0xb3, 0x64,
+	0xce, 0x1f, 0x42, 0xc9, 0xaf, 0xb9, 0x2d, 0x30, 0x13, 0xfd, 0x66, 0x39, 0x13, 0xeb, 0x21, 0xa2,
+	0x97, 0x51, 0x0e, 0xa7, 0xe7, 0x1f, 0x76, 0x19, 0x8c, 0xb0, 0xef, 0x87, 0xf9, 0x58, 0x3b, 0xba,
+	0x95, 0x39, 0xd9, 0x77, 0xcb, 0xf7, 0xf2, 0x11, 0x98, 0xec, 0xe2, 0xe1, 0xfd, 0x4d, 0x3c, 0x95,
+	0xcc, 0xb9, 0x03, 0x55, 0x7c, 0xef, 0x55, 0x90, 0xf2, 0x86, 0xd4, 0x42, 0xac, 0x15, 0xdf, 0xcd,
+	0x9c, 0x1a, 0xb4, 0xeb, 0xbb, 0xfb, 0x14, 0x4c, 0x76, 0xb1, 0xc2, 0xf8, 0x60, 0xc3, 0x2a, 0x7d,
+	0x82, 0x8d, 0x63, 0x61, 0xf2, 0xec, 0xc3, 0x28, 0x0b, 0x13, 0x3f, 0x03, 0x53, 0x3d, 0x0c, 0x2c,
+	0xbe, 0x6c, 0xba, 0x95, 0xfa, 0x94, 0x4d, 0x3c, 0x57, 0x8a, 0x39, 0x78, 0xad, 0x28, 0x7e, 0x23,
+	0x80, 0x85, 0x81, 0x14, 0xa4, 0x34, 0xa0, 0x32, 0xe3, 0x4d, 0x32, 0xaf, 0x3d, 0xb7, 0x49, 0x77,
+	0x8c, 0xf9, 0xee, 0xbe, 0x29, 0x7e, 0x21, 0x80, 0xd9, 0xb8, 0x41, 0xb9, 0x38, 0x00, 0xf4, 0x2e,
+	0xcd, 0x4c, 0xf1, 0xb0, 0x9a, 0x83, 0x03, 0x81, 0x60, 0xcc, 0x1f, 0x57, 0x27, 0xfa, 0x24, 0xe9,
+	0x6e, 0x67, 0x4e, 0x0f, 0xdc, 0x1e, 0x7c, 0x0c, 0x7f, 0x2c, 0xfe, 0x8c, 0x18, 0xf8, 0xbe, 0x98,
+	0xca, 0x80, 0xc7, 0xd2, 0xdb, 0xa9, 0x32, 0xa3, 0x9f, 0xd3, 0xa9, 0x50, 0x79, 0xf5, 0xc1, 0xd3,
+	0xac, 0xf0, 0xe8, 0x69, 0x56, 0xf8, 0xfd, 0x69, 0x56, 0xb8, 0xf7, 0x2c, 0x3b, 0xf4, 0xe8, 0x59,
+	0x76, 0xe8, 0xd7, 0x67, 0xd9, 0xa1, 0x0f, 0xf9, 0x1f, 0x1b, 0x71, 0x63, 0x4b, 0x31, 0x50, 0xf0,
+	0xad, 0xc1, 0x08, 0x63, 0xa1, 0x5d, 0xda, 0x48, 0xb2, 0xd9, 0x7a, 0xe1, 0xef, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x39, 0x8c, 0x30, 0x01, 0xc2, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1493,9 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// VoteRestaker defines a method to relay the EIP-712 signed vote of an Ethereum restaker on a
+	// specific proposal.
+	VoteRestaker(ctx context.Context, in *MsgVoteRestaker, opts ...grpc.CallOption) (*MsgVoteRestakerResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1596,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) VoteRestaker(ctx context.Context, in *MsgVoteRestaker, opts ...grpc.CallOption) (*MsgVoteRestakerResponse, error) {
+	out := new(MsgVoteRestakerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symGov.v1.Msg/VoteRestaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1630,9 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// VoteRestaker defines a method to relay the EIP-712 signed vote of an Ethereum restaker on a
+	// specific proposal.
+	VoteRestaker(context.Context, *MsgVoteRestaker) (*MsgVoteRestakerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1669,9 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) VoteRestaker(ctx context.Context, req *MsgVoteRestaker) (*MsgVoteRestakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRestaker not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1857,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteRestaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteRestaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteRestaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symGov.v1.Msg/VoteRestaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteRestaker(ctx, req.(*MsgVoteRestaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symGov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1716,6 +1919,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "VoteRestaker",
+			Handler:    _Msg_VoteRestaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symGov/v1/tx.proto",
@@ -2517,6 +2724,118 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteRestaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteRestaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteRestaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteRestakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteRestakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteRestakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2871,6 +3190,60 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteRestaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteRestakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5109,6 +5482,372 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteRestaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteRestaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteRestaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vault = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteRestakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteRestakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteRestakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
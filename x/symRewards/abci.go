@@ -0,0 +1,33 @@
+package symRewards
+
+import (
+	"context"
+
+	"cosmossdk.io/x/symRewards/keeper"
+	"cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BeginBlocker allocates the fees collected in the previous block and the
+// block inflation to the validators, and batches the Ethereum reward claims
+// every claim batch interval.
+func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
+	if err := k.AllocateRewards(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if params.ClaimBatchInterval > 0 && height%params.ClaimBatchInterval == 0 {
+		return k.BatchEthereumClaims(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package symRewards
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	rewardsv1 "cosmossdk.io/api/cosmos/symRewards/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: rewardsv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current rewards parameters",
+				},
+				{
+					RpcMethod: "OperatorRewards",
+					Use:       "operator-rewards [validator]",
+					Short:     "Query the rewards accrued by a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "AllOperatorRewards",
+					Use:       "all-operator-rewards",
+					Short:     "Query the rewards accrued by all the validators",
+				},
+				{
+					RpcMethod: "ClaimBatch",
+					Use:       "claim-batch [id]",
+					Short:     "Query a batch of Ethereum reward claims",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ClaimBatches",
+					Use:       "claim-batches",
+					Short:     "Query the batches of Ethereum reward claims",
+					Example:   fmt.Sprintf("%s query symRewards claim-batches --pending", version.AppName),
+				},
+				{
+					RpcMethod: "ClaimProof",
+					Use:       "claim-proof [batch-id] [index]",
+					Short:     "Query the merkle proof of a claim of a batch, to submit to the rewards contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "batch_id"},
+						{ProtoField: "index"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              rewardsv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "WithdrawOperatorRewards",
+					Use:       "withdraw-operator-rewards",
+					Short:     "Withdraw the commission of a validator",
+					Example:   fmt.Sprintf("%s tx symRewards withdraw-operator-rewards --recipient [address] --from [validator]", version.AppName),
+				},
+				{
+					RpcMethod: "ConfirmClaimBatch",
+					Skip:      true, // proven by the relay-claim-batches command
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update rewards module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update rewards module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query symRewards params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx symRewards update-params-proposal '{ "inflation_rate": "0.05", ... }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/symRewards/types"
+	stakingcli "cosmossdk.io/x/symStaking/client/cli"
+	"cosmossdk.io/x/symStaking/lightclient"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagEthRPC          = "eth-rpc"
+	FlagEthKeyFile      = "eth-key-file"
+	FlagRewardsContract = "rewards-contract"
+	FlagConfirmations   = "confirmations"
+)
+
+// syncPollInterval is the interval the relayer polls the chain at for a
+// Symbiotic sync reaching the block of a root transaction.
+const syncPollInterval = 5 * time.Second
+
+// NewTxCmd returns a root CLI command handler for the x/symRewards transaction
+// commands not generated by AutoCLI.
+func NewTxCmd() *cobra.Command {
+	rewardsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Rewards transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	rewardsTxCmd.AddCommand(
+		NewRelayClaimBatchesCmd(),
+	)
+
+	return rewardsTxCmd
+}
+
+// NewRelayClaimBatchesCmd returns a CLI command handler that posts the roots of
+// the pending claim batches to the rewards contract and confirms them with the
+// proof of their transactions once those are confirmed.
+func NewRelayClaimBatchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-claim-batches",
+		Short: "Post the roots of the pending claim batches to the rewards contract",
+		Long: strings.TrimSpace(`Post the merkle roots of the pending claim batches to the submitRoot function
+of the rewards contract, signing the Ethereum transactions with the key given
+with --eth-key-file. Once a transaction is confirmed and reached by a Symbiotic
+sync, the batch is marked posted with a MsgConfirmClaimBatch proving the
+transaction, sent by the account given with --from.`),
+		Example: fmt.Sprintf("%s tx symRewards relay-claim-batches --eth-rpc http://localhost:8545 --eth-key-file relayer.key --from relayer", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethRPC, _ := cmd.Flags().GetString(FlagEthRPC)
+			keyFile, _ := cmd.Flags().GetString(FlagEthKeyFile)
+			rewardsContract, _ := cmd.Flags().GetString(FlagRewardsContract)
+			confirmations, _ := cmd.Flags().GetUint64(FlagConfirmations)
+
+			key, err := crypto.LoadECDSA(keyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load the Ethereum key: %w", err)
+			}
+
+			ctx := cmd.Context()
+			queryClient := types.NewQueryClient(clientCtx)
+			stakingQueryClient := stakingtypes.NewQueryClient(clientCtx)
+			if rewardsContract == "" {
+				res, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+				if err != nil {
+					return err
+				}
+				rewardsContract = res.Params.RewardsContract
+			}
+			if !common.IsHexAddress(rewardsContract) {
+				return fmt.Errorf("invalid rewards contract address %q", rewardsContract)
+			}
+
+			pending, err := pendingClaimBatches(ctx, queryClient)
+			if err != nil {
+				return err
+			}
+			if len(pending) == 0 {
+				return clientCtx.PrintString("no pending claim batches\n")
+			}
+
+			ethClient, err := ethclient.DialContext(ctx, ethRPC)
+			if err != nil {
+				return err
+			}
+			defer ethClient.Close()
+
+			relayer, err := newRewardsRelayer(ctx, ethClient, common.HexToAddress(rewardsContract), key)
+			if err != nil {
+				return err
+			}
+
+			relayerAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// the confirmations are broadcast in a single transaction, including
+			// the ones relayed before a failure
+			var (
+				msgs     []sdk.Msg
+				relayErr error
+			)
+			for _, batch := range pending {
+				receipt, err := relayer.submitRoot(ctx, clientCtx, batch, confirmations)
+				if err != nil {
+					relayErr = fmt.Errorf("failed to relay claim batch %d: %w", batch.Id, err)
+					break
+				}
+
+				msg, err := relayer.proveRoot(ctx, stakingQueryClient, relayerAddr, batch, receipt)
+				if err != nil {
+					relayErr = fmt.Errorf("failed to prove the root of claim batch %d: %w", batch.Id, err)
+					break
+				}
+				msgs = append(msgs, msg)
+			}
+
+			if len(msgs) > 0 {
+				if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...); err != nil {
+					return err
+				}
+			}
+
+			return relayErr
+		},
+	}
+
+	cmd.Flags().String(FlagEthRPC, "http://localhost:8545", "Ethereum execution RPC endpoint")
+	cmd.Flags().String(FlagEthKeyFile, "", "File holding the hex encoded private key allowed to submit roots to the rewards contract")
+	cmd.Flags().String(FlagRewardsContract, "", "Address of the rewards contract, defaults to the x/symRewards rewards_contract param")
+	cmd.Flags().Uint64(FlagConfirmations, 12, "Number of blocks the root transaction must be confirmed by before it is proven")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagEthKeyFile)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// pendingClaimBatches queries all the pending claim batches.
+func pendingClaimBatches(ctx context.Context, queryClient types.QueryClient) ([]types.ClaimBatch, error) {
+	var (
+		pending []types.ClaimBatch
+		nextKey []byte
+	)
+	for {
+		res, err := queryClient.ClaimBatches(ctx, &types.QueryClaimBatchesRequest{
+			Pending:    true,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, res.Batches...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return pending, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// rewardsRelayer posts claim batch roots to the rewards contract.
+type rewardsRelayer struct {
+	client   *ethclient.Client
+	contract *bind.BoundContract
+	opts     *bind.TransactOpts
+}
+
+func newRewardsRelayer(ctx context.Context, ethClient *ethclient.Client, rewardsContract common.Address, key *ecdsa.PrivateKey) (*rewardsRelayer, error) {
+	contractABI, err := abi.JSON(strings.NewReader(types.RewardsContractABI))
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+
+	return &rewardsRelayer{
+		client:   ethClient,
+		contract: bind.NewBoundContract(rewardsContract, contractABI, ethClient, ethClient, ethClient),
+		opts:     opts,
+	}, nil
+}
+
+// submitRoot posts the root of a claim batch and waits for its transaction to
+// be confirmed.
+func (r *rewardsRelayer) submitRoot(ctx context.Context, clientCtx client.Context, batch types.ClaimBatch, confirmations uint64) (*ethtypes.Receipt, error) {
+	ethTx, err := r.contract.Transact(r.opts, "submitRoot", new(big.Int).SetUint64(batch.Id), common.BytesToHash(batch.Root))
+	if err != nil {
+		return nil, err
+	}
+	_ = clientCtx.PrintString(fmt.Sprintf("claim batch %d submitted in %s\n", batch.Id, ethTx.Hash().Hex()))
+
+	receipt, err := bind.WaitMined(ctx, r.client, ethTx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("root transaction %s reverted", ethTx.Hash().Hex())
+	}
+
+	if err := r.waitConfirmations(ctx, receipt, confirmations); err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// proveRoot returns the confirmation of a claim batch whose root was posted by
+// the transaction of receipt, proven against the block of the first Symbiotic
+// sync reaching it.
+func (r *rewardsRelayer) proveRoot(ctx context.Context, stakingQueryClient stakingtypes.QueryClient, relayer string, batch types.ClaimBatch, receipt *ethtypes.Receipt) (*types.MsgConfirmClaimBatch, error) {
+	sync, err := stakingcli.WaitSymbioticSync(ctx, stakingQueryClient, receipt.BlockNumber.Uint64(), types.MaxClaimProofHeaders, syncPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := lightclient.ProveInclusion(ctx, r.client.Client(), common.HexToHash(sync.BlockHash), receipt, types.MaxClaimProofHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgConfirmClaimBatch(relayer, batch.Id, receipt.TxHash.Hex(), sync.Height, proof.Headers, proof.TxIndex, proof.TxProof, proof.ReceiptProof), nil
+}
+
+// waitConfirmations waits for a transaction receipt to be confirmed by
+// confirmations blocks and checks it is still canonical.
+func (r *rewardsRelayer) waitConfirmations(ctx context.Context, receipt *ethtypes.Receipt, confirmations uint64) error {
+	target := receipt.BlockNumber.Uint64() + confirmations
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		number, err := r.client.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		if number >= target {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	confirmed, err := r.client.TransactionReceipt(ctx, receipt.TxHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return fmt.Errorf("root transaction %s was reorged out", receipt.TxHash.Hex())
+		}
+		return err
+	}
+	if confirmed.BlockHash != receipt.BlockHash || confirmed.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("root transaction %s was reorged", receipt.TxHash.Hex())
+	}
+
+	return nil
+}
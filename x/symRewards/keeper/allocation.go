@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symRewards/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllocateRewards moves the fees collected in the block to the module account,
+// mints the block inflation and splits them, with the remainder of the previous
+// blocks, across the bonded validators in proportion to their tokens. The
+// commission of each validator is taken out of its share at its commission
+// rate, the rest accrues to the stakers of its Symbiotic operator.
+func (k Keeper) AllocateRewards(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feeCollector := k.ak.GetModuleAddress(k.feeCollectorName)
+	fees := k.bk.GetAllBalances(ctx, feeCollector)
+	if !fees.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+			return err
+		}
+	}
+
+	minted, err := k.mintInflation(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	pool, err := k.RewardPool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	rewards := pool.Remainder.Add(sdk.NewDecCoinsFromCoins(fees.Add(minted...)...)...)
+	if rewards.IsZero() {
+		return nil
+	}
+
+	var validators []stakingtypes.Validator
+	totalTokens := math.ZeroInt()
+	err = k.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.Validator) bool {
+		validators = append(validators, validator)
+		totalTokens = totalTokens.Add(validator.GetTokens())
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// keep the rewards for the next block if there is no one to allocate to
+	if !totalTokens.IsPositive() {
+		return k.RewardPool.Set(ctx, types.RewardPool{Remainder: rewards})
+	}
+
+	allocated := sdk.DecCoins{}
+	for _, validator := range validators {
+		share := rewards.MulDecTruncate(math.LegacyNewDecFromInt(validator.GetTokens())).QuoDecTruncate(math.LegacyNewDecFromInt(totalTokens))
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.allocateToValidator(ctx, validator, share); err != nil {
+			return err
+		}
+		allocated = allocated.Add(share...)
+	}
+
+	if err := k.RewardPool.Set(ctx, types.RewardPool{Remainder: rewards.Sub(allocated)}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRewards,
+		event.NewAttribute(types.AttributeKeyAmount, allocated.String()),
+		event.NewAttribute(types.AttributeKeyMinted, minted.String()),
+	)
+}
+
+// allocateToValidator accrues a share of the block rewards to a validator,
+// splitting it between its commission and its stakers.
+func (k Keeper) allocateToValidator(ctx context.Context, validator stakingtypes.Validator, share sdk.DecCoins) error {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	rewards, err := k.GetOperatorRewards(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	commission := share.MulDecTruncate(validator.Commission.CommissionRates.Rate)
+	rewards.Commission = rewards.Commission.Add(commission...)
+	rewards.StakerRewards = rewards.StakerRewards.Add(share.Sub(commission)...)
+
+	return k.OperatorRewards.Set(ctx, valAddr, rewards)
+}
+
+// mintInflation mints the inflation of the block in the bond denom, as a
+// fraction of the supply of the bond denom, to the module account.
+func (k Keeper) mintInflation(ctx context.Context, params types.Params) (sdk.Coins, error) {
+	if !params.InflationRate.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := k.bk.GetSupply(ctx, bondDenom)
+	amount := params.InflationRate.MulInt(supply.Amount).QuoInt64(int64(params.BlocksPerYear)).TruncateInt()
+	if !amount.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	minted := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+	if err := k.bk.MintCoins(ctx, types.ModuleName, minted); err != nil {
+		return nil, err
+	}
+
+	return minted, nil
+}
+
+// WithdrawOperatorRewards sends the commission of a validator to recipient.
+// The staker rewards are left to the Ethereum claim batches and the decimal
+// dust stays accrued to the validator.
+func (k Keeper) WithdrawOperatorRewards(ctx context.Context, valAddr sdk.ValAddress, recipient sdk.AccAddress) (sdk.Coins, error) {
+	rewards, err := k.OperatorRewards.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrNoOperatorRewards
+		}
+		return nil, err
+	}
+
+	amount, dust := rewards.Commission.TruncateDecimal()
+	if amount.IsZero() {
+		return nil, types.ErrNoOperatorRewards
+	}
+
+	rewards.Commission = dust
+	if err := k.setOperatorRewards(ctx, valAddr, rewards); err != nil {
+		return nil, err
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return amount, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawRewards,
+		event.NewAttribute(types.AttributeKeyValidator, rewards.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
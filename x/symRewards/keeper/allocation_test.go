@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAllocateRewards() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("uatom", 7))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAddr).Return(fees)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", rewardstypes.ModuleName, fees).Return(nil)
+	s.expectBondedValidators(
+		s.newValidator(valAddrs[0], 300, math.LegacyNewDecWithPrec(1, 1)),
+		s.newValidator(valAddrs[1], 100, math.LegacyZeroDec()),
+	)
+
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(s.valStr(valAddrs[0]), rewards.ValidatorAddress)
+	// 3/4 of the fees, 10% of which is commission
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 75), sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.525"))), rewards.Commission)
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 675), sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("4.725"))), rewards.StakerRewards)
+
+	rewards, err = keeper.OperatorRewards.Get(ctx, valAddrs[1])
+	require.NoError(err)
+	require.True(rewards.Commission.IsZero())
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 250), sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("1.75"))), rewards.StakerRewards)
+
+	pool, err := keeper.RewardPool.Get(ctx)
+	require.NoError(err)
+	require.True(pool.Remainder.IsZero())
+}
+
+func (s *KeeperTestSuite) TestAllocateRewards_Inflation() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	params := rewardstypes.DefaultParams()
+	params.InflationRate = math.LegacyNewDecWithPrec(1, 1)
+	params.BlocksPerYear = 100
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	minted := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAddr).Return(sdk.Coins{})
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake", nil)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), "stake").Return(sdk.NewInt64Coin("stake", 30000))
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), rewardstypes.ModuleName, minted).Return(nil)
+	s.expectBondedValidators(
+		s.newValidator(valAddrs[0], 20000, math.LegacyZeroDec()),
+		s.newValidator(valAddrs[1], 10000, math.LegacyZeroDec()),
+	)
+
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 20)), rewards.StakerRewards)
+
+	rewards, err = keeper.OperatorRewards.Get(ctx, valAddrs[1])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)), rewards.StakerRewards)
+}
+
+func (s *KeeperTestSuite) TestAllocateRewards_NoBondedValidators() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAddr).Return(fees)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", rewardstypes.ModuleName, fees).Return(nil)
+	s.expectBondedValidators()
+
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	pool, err := keeper.RewardPool.Get(ctx)
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoinsFromCoins(fees...), pool.Remainder)
+
+	// the remainder is allocated with the fees of the next block
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAddr).Return(sdk.Coins{})
+	s.expectBondedValidators(s.newValidator(valAddrs[0], 1, math.LegacyZeroDec()))
+
+	require.NoError(keeper.AllocateRewards(ctx))
+
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoinsFromCoins(fees...), rewards.StakerRewards)
+}
+
+func (s *KeeperTestSuite) TestWithdrawOperatorRewards() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	_, err := keeper.WithdrawOperatorRewards(ctx, valAddrs[0], sdk.AccAddress(valAddrs[0]))
+	require.ErrorIs(err, rewardstypes.ErrNoOperatorRewards)
+
+	require.NoError(keeper.OperatorRewards.Set(ctx, valAddrs[0], rewardstypes.OperatorRewards{
+		ValidatorAddress: s.valStr(valAddrs[0]),
+		Commission:       sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5"))),
+		StakerRewards:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("20.25"))),
+	}))
+
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), rewardstypes.ModuleName, recipient, amount).Return(nil)
+
+	withdrawn, err := keeper.WithdrawOperatorRewards(ctx, valAddrs[0], recipient)
+	require.NoError(err)
+	require.Equal(amount, withdrawn)
+
+	// the decimal dust stays accrued and the staker rewards are left to the
+	// claim batches
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5"))), rewards.Commission)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("20.25"))), rewards.StakerRewards)
+
+	_, err = keeper.WithdrawOperatorRewards(ctx, valAddrs[0], recipient)
+	require.ErrorIs(err, rewardstypes.ErrNoOperatorRewards)
+}
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/symRewards/types"
+	"cosmossdk.io/x/symStaking/lightclient"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BatchEthereumClaims moves the staker rewards of the validators backed by a
+// Symbiotic operator into a new batch of Ethereum claims, one per operator and
+// denom, whose merkle root the relayer posts to the rewards contract. The
+// commission of a validator registered from the middleware at the address of
+// its operator, which no account can withdraw, is batched too and claimed by
+// the operator. The operators are those of the last applied Symbiotic sync,
+// the rewards of the validators without one keep accruing. The claimed coins stay in the module
+// account until they are bridged. Nothing is batched if there is nothing to
+// claim.
+func (k Keeper) BatchEthereumClaims(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	sync, err := k.sk.GetLastAppliedSymbioticSync(ctx, height)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	operators := make(map[string][]byte, len(sync.Stakes))
+	for _, stake := range sync.Stakes {
+		if stake.ValidatorAddress != "" && len(stake.Operator) == common.AddressLength {
+			operators[stake.ValidatorAddress] = stake.Operator
+		}
+	}
+
+	type batched struct {
+		valAddr sdk.ValAddress
+		rewards types.OperatorRewards
+	}
+	var (
+		claims  []types.EthereumClaim
+		updates []batched
+	)
+	err = k.OperatorRewards.Walk(ctx, nil, func(valAddr sdk.ValAddress, rewards types.OperatorRewards) (bool, error) {
+		operator, ok := operators[rewards.ValidatorAddress]
+		if !ok {
+			return false, nil
+		}
+
+		amount, dust := rewards.StakerRewards.TruncateDecimal()
+		commission, commissionDust := sdk.Coins{}, rewards.Commission
+		if bytes.Equal(valAddr, operator) {
+			commission, commissionDust = rewards.Commission.TruncateDecimal()
+		}
+		if amount.IsZero() && commission.IsZero() {
+			return false, nil
+		}
+
+		for _, coin := range amount {
+			claims = append(claims, types.EthereumClaim{
+				Operator:         operator,
+				ValidatorAddress: rewards.ValidatorAddress,
+				Denom:            coin.Denom,
+				Amount:           coin.Amount,
+			})
+		}
+		for _, coin := range commission {
+			claims = append(claims, types.EthereumClaim{
+				Operator:         operator,
+				ValidatorAddress: rewards.ValidatorAddress,
+				Denom:            coin.Denom,
+				Amount:           coin.Amount,
+				Commission:       true,
+			})
+		}
+
+		rewards.StakerRewards = dust
+		rewards.Commission = commissionDust
+		updates = append(updates, batched{valAddr: valAddr, rewards: rewards})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(claims) == 0 {
+		return nil
+	}
+
+	for _, update := range updates {
+		if err := k.setOperatorRewards(ctx, update.valAddr, update.rewards); err != nil {
+			return err
+		}
+	}
+
+	id, err := k.ClaimBatchSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	leaves, err := claimLeaves(id, claims)
+	if err != nil {
+		return err
+	}
+
+	batch := types.ClaimBatch{
+		Id:     id,
+		Height: height,
+		Root:   types.ClaimMerkleRoot(leaves),
+		Claims: claims,
+		Status: types.ClaimBatchPending,
+	}
+
+	if err := k.ClaimBatches.Set(ctx, id, batch); err != nil {
+		return err
+	}
+
+	if err := k.PendingClaimBatches.Set(ctx, id); err != nil {
+		return err
+	}
+
+	k.Logger.Info(
+		"batched ethereum reward claims",
+		"id", id,
+		"claims", len(claims),
+		"root", common.Bytes2Hex(batch.Root),
+	)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClaimBatch,
+		event.NewAttribute(types.AttributeKeyClaimBatch, fmt.Sprintf("%d", id)),
+		event.NewAttribute(types.AttributeKeyRoot, common.Bytes2Hex(batch.Root)),
+		event.NewAttribute(types.AttributeKeyClaims, fmt.Sprintf("%d", len(claims))),
+	)
+}
+
+// ConfirmClaimBatch marks a pending claim batch posted once msg proves that
+// its Ethereum transaction submitted the root of the batch: the transaction
+// calls submitRoot on the rewards contract with the id and root of the batch,
+// succeeded, and is in a block chained to the execution block of a Symbiotic
+// sync. The calldata binds the batch id, so a transaction confirms a single
+// batch.
+func (k Keeper) ConfirmClaimBatch(ctx context.Context, msg *types.MsgConfirmClaimBatch) error {
+	batch, err := k.ClaimBatches.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrClaimBatchNotFound.Wrapf("id %d", msg.Id)
+		}
+		return err
+	}
+
+	if batch.Status == types.ClaimBatchPosted {
+		return types.ErrClaimBatchPosted.Wrapf("id %d", msg.Id)
+	}
+
+	txHash, err := k.verifyClaimBatchTx(ctx, batch, msg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidClaimBatchProof, "claim batch %d: %s", msg.Id, err)
+	}
+
+	batch.Status = types.ClaimBatchPosted
+	batch.TxHash = txHash
+	batch.PostedHeight = k.HeaderService.HeaderInfo(ctx).Height
+
+	if err := k.ClaimBatches.Set(ctx, msg.Id, batch); err != nil {
+		return err
+	}
+
+	if err := k.PendingClaimBatches.Remove(ctx, msg.Id); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeConfirmClaimBatch,
+		event.NewAttribute(types.AttributeKeyClaimBatch, fmt.Sprintf("%d", msg.Id)),
+		event.NewAttribute(types.AttributeKeyTxHash, txHash),
+	)
+}
+
+// verifyClaimBatchTx checks the proof of msg that its transaction submitted
+// the root of batch and returns the hash of the transaction.
+func (k Keeper) verifyClaimBatchTx(ctx context.Context, batch types.ClaimBatch, msg *types.MsgConfirmClaimBatch) (string, error) {
+	if len(msg.Headers) > types.MaxClaimProofHeaders {
+		return "", fmt.Errorf("%d headers, at most %d are accepted", len(msg.Headers), types.MaxClaimProofHeaders)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if params.RewardsContract == "" {
+		return "", errors.New("no rewards contract")
+	}
+
+	sync, err := k.sk.GetSymbioticSync(ctx, msg.SyncHeight)
+	if err != nil {
+		return "", fmt.Errorf("sync at height %d: %w", msg.SyncHeight, err)
+	}
+	blockHash, err := hex.DecodeString(strings.TrimPrefix(sync.BlockHash, "0x"))
+	if err != nil || len(blockHash) != common.HashLength {
+		return "", fmt.Errorf("sync at height %d has no execution block", msg.SyncHeight)
+	}
+
+	headers, err := lightclient.VerifyHeaderChain(msg.Headers, common.BytesToHash(blockHash))
+	if err != nil {
+		return "", err
+	}
+	header := headers[0]
+
+	tx, err := lightclient.VerifyTransaction(header, msg.TxIndex, msg.TxProof)
+	if err != nil {
+		return "", err
+	}
+	if txHash := tx.Hash(); txHash != common.HexToHash(msg.TxHash) {
+		return "", fmt.Errorf("proven tx %s is not tx %s", txHash, msg.TxHash)
+	}
+
+	if tx.To() == nil || *tx.To() != common.HexToAddress(params.RewardsContract) {
+		return "", fmt.Errorf("tx is not sent to the rewards contract %s", params.RewardsContract)
+	}
+	if err := types.CheckSubmitRootCalldata(tx.Data(), batch); err != nil {
+		return "", err
+	}
+
+	receipt, err := lightclient.VerifyReceipt(header, msg.TxIndex, msg.ReceiptProof)
+	if err != nil {
+		return "", err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return "", errors.New("tx reverted")
+	}
+
+	return tx.Hash().Hex(), nil
+}
+
+// claimLeaves returns the merkle leaves of the claims of the batch id.
+func claimLeaves(id uint64, claims []types.EthereumClaim) ([][]byte, error) {
+	leaves := make([][]byte, 0, len(claims))
+	for _, claim := range claims {
+		leaf, err := types.ClaimLeaf(id, claim)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+	return leaves, nil
+}
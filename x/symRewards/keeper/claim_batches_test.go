@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+	"cosmossdk.io/x/symStaking/lightclient"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var operator = []byte("operator____________")
+
+func (s *KeeperTestSuite) TestBatchEthereumClaims() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	for _, valAddr := range valAddrs {
+		require.NoError(keeper.OperatorRewards.Set(ctx, valAddr, rewardstypes.OperatorRewards{
+			ValidatorAddress: s.valStr(valAddr),
+			Commission:       sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+			StakerRewards: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5")),
+				sdk.NewInt64DecCoin("uatom", 3),
+			),
+		}))
+	}
+
+	// only the first validator is backed by a Symbiotic operator
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), int64(10)).Return(stakingtypes.SymbioticSyncRecord{
+		Stakes: []stakingtypes.SymbioticSyncStake{
+			{ValidatorAddress: s.valStr(valAddrs[0]), Operator: operator, Stake: math.NewInt(100)},
+			{ValidatorAddress: "", Operator: []byte("unmatched___________"), Stake: math.NewInt(100)},
+		},
+	}, nil)
+
+	require.NoError(keeper.BatchEthereumClaims(ctx))
+
+	batch, err := keeper.ClaimBatches.Get(ctx, 1)
+	require.NoError(err)
+	require.Equal(rewardstypes.ClaimBatchPending, batch.Status)
+	require.Equal(int64(10), batch.Height)
+	require.Equal([]rewardstypes.EthereumClaim{
+		{Operator: operator, ValidatorAddress: s.valStr(valAddrs[0]), Denom: "stake", Amount: math.NewInt(10)},
+		{Operator: operator, ValidatorAddress: s.valStr(valAddrs[0]), Denom: "uatom", Amount: math.NewInt(3)},
+	}, batch.Claims)
+
+	pending, err := keeper.PendingClaimBatches.Has(ctx, 1)
+	require.NoError(err)
+	require.True(pending)
+
+	// the batched rewards are removed, the dust and the commission stay
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5"))), rewards.StakerRewards)
+	require.Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)), rewards.Commission)
+
+	rewards, err = keeper.OperatorRewards.Get(ctx, valAddrs[1])
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5")), sdk.NewInt64DecCoin("uatom", 3)), rewards.StakerRewards)
+
+	// the proofs verify against the root
+	for i := range batch.Claims {
+		res, err := s.queryClient.ClaimProof(ctx, &rewardstypes.QueryClaimProofRequest{BatchId: 1, Index: uint64(i)})
+		require.NoError(err)
+		require.Equal(batch.Root, res.Root)
+		require.True(rewardstypes.VerifyClaimMerkleProof(res.Root, res.Leaf, res.Proof))
+	}
+
+	_, err = s.queryClient.ClaimProof(ctx, &rewardstypes.QueryClaimProofRequest{BatchId: 1, Index: 2})
+	require.ErrorContains(err, "claim not found")
+
+	// nothing left to batch
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), int64(10)).Return(stakingtypes.SymbioticSyncRecord{
+		Stakes: []stakingtypes.SymbioticSyncStake{
+			{ValidatorAddress: s.valStr(valAddrs[0]), Operator: operator, Stake: math.NewInt(100)},
+		},
+	}, nil)
+	require.NoError(keeper.BatchEthereumClaims(ctx))
+
+	has, err := keeper.ClaimBatches.Has(ctx, 2)
+	require.NoError(err)
+	require.False(has)
+}
+
+func (s *KeeperTestSuite) TestBatchEthereumClaims_AutoRegistered() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	// a validator registered from the middleware has the address of its
+	// operator, its commission is claimed by the operator
+	autoOperator := []byte("auto_operator_______")
+	valAddr := sdk.ValAddress(autoOperator)
+	require.NoError(keeper.OperatorRewards.Set(ctx, valAddr, rewardstypes.OperatorRewards{
+		ValidatorAddress: s.valStr(valAddr),
+		Commission:       sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("2.5"))),
+		StakerRewards:    sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 4)),
+	}))
+
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), int64(10)).Return(stakingtypes.SymbioticSyncRecord{
+		Stakes: []stakingtypes.SymbioticSyncStake{
+			{ValidatorAddress: s.valStr(valAddr), Operator: autoOperator, Stake: math.NewInt(100)},
+		},
+	}, nil)
+	require.NoError(keeper.BatchEthereumClaims(ctx))
+
+	batch, err := keeper.ClaimBatches.Get(ctx, 1)
+	require.NoError(err)
+	require.Equal([]rewardstypes.EthereumClaim{
+		{Operator: autoOperator, ValidatorAddress: s.valStr(valAddr), Denom: "stake", Amount: math.NewInt(4)},
+		{Operator: autoOperator, ValidatorAddress: s.valStr(valAddr), Denom: "stake", Amount: math.NewInt(2), Commission: true},
+	}, batch.Claims)
+
+	rewards, err := keeper.OperatorRewards.Get(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5"))), rewards.Commission)
+	require.True(rewards.StakerRewards.IsZero())
+}
+
+func (s *KeeperTestSuite) TestBatchEthereumClaims_NoSync() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), int64(10)).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound)
+	require.NoError(keeper.BatchEthereumClaims(ctx))
+
+	res, err := s.queryClient.ClaimBatches(ctx, &rewardstypes.QueryClaimBatchesRequest{})
+	require.NoError(err)
+	require.Empty(res.Batches)
+}
+
+func (s *KeeperTestSuite) TestConfirmClaimBatch() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	params := rewardstypes.DefaultParams()
+	params.RewardsContract = rewardsContract.Hex()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	root1, root2 := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	block := s.newRootBlock(
+		rootCall{to: rewardsContract, data: s.submitRootCalldata(1, root1), status: ethtypes.ReceiptStatusSuccessful},
+		rootCall{to: rewardsContract, data: s.submitRootCalldata(2, root2), status: ethtypes.ReceiptStatusSuccessful},
+	)
+	txHash := block.txs[0].Hash().Hex()
+
+	require.ErrorIs(keeper.ConfirmClaimBatch(ctx, block.confirm(relayerStr, 1, txHash, 0)), rewardstypes.ErrClaimBatchNotFound)
+
+	for id, root := range map[uint64][]byte{1: root1, 2: root2} {
+		require.NoError(keeper.ClaimBatches.Set(ctx, id, rewardstypes.ClaimBatch{Id: id, Root: root, Status: rewardstypes.ClaimBatchPending}))
+		require.NoError(keeper.PendingClaimBatches.Set(ctx, id))
+	}
+
+	// the root of the second batch does not confirm the first one
+	err := keeper.ConfirmClaimBatch(ctx, block.confirm(relayerStr, 1, block.txs[1].Hash().Hex(), 1))
+	require.ErrorIs(err, rewardstypes.ErrInvalidClaimBatchProof)
+	require.ErrorContains(err, "does not match the batch id 1")
+
+	require.NoError(keeper.ConfirmClaimBatch(ctx, block.confirm(relayerStr, 1, txHash, 0)))
+
+	batch, err := keeper.ClaimBatches.Get(ctx, 1)
+	require.NoError(err)
+	require.Equal(rewardstypes.ClaimBatchPosted, batch.Status)
+	require.Equal(txHash, batch.TxHash)
+	require.Equal(int64(10), batch.PostedHeight)
+
+	pending, err := keeper.PendingClaimBatches.Has(ctx, 1)
+	require.NoError(err)
+	require.False(pending)
+
+	require.ErrorIs(keeper.ConfirmClaimBatch(ctx, block.confirm(relayerStr, 1, txHash, 0)), rewardstypes.ErrClaimBatchPosted)
+
+	// the second batch is still pending
+	pending, err = keeper.PendingClaimBatches.Has(ctx, 2)
+	require.NoError(err)
+	require.True(pending)
+}
+
+var (
+	rewardsContract = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	relayerStr      = sdk.MustBech32ifyAddressBytes("cosmos", []byte("relayer_____________"))
+)
+
+// rootCall is a transaction of an execution block and the status of its
+// receipt.
+type rootCall struct {
+	to     common.Address
+	data   []byte
+	status uint64
+}
+
+// rootBlock is an execution block and the proofs of its transactions, chained
+// to the execution block of the Symbiotic sync at height 20.
+type rootBlock struct {
+	headers       [][]byte
+	txs           ethtypes.Transactions
+	txProofs      [][][]byte
+	receiptProofs [][][]byte
+}
+
+// newRootBlock returns the block executing calls and expects the Symbiotic
+// syncs to be read.
+func (s *KeeperTestSuite) newRootBlock(calls ...rootCall) rootBlock {
+	require := s.Require()
+
+	var (
+		block    rootBlock
+		receipts ethtypes.Receipts
+	)
+	for i, call := range calls {
+		to := call.to
+		block.txs = append(block.txs, ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       100000,
+			To:        &to,
+			Data:      call.data,
+		}))
+		receipts = append(receipts, &ethtypes.Receipt{
+			Type:              ethtypes.DynamicFeeTxType,
+			Status:            call.status,
+			CumulativeGasUsed: uint64(50000 * (i + 1)),
+			Logs:              []*ethtypes.Log{},
+		})
+	}
+
+	block.txProofs = make([][][]byte, len(calls))
+	block.receiptProofs = make([][][]byte, len(calls))
+	for i := range calls {
+		var err error
+		block.txProofs[i], err = lightclient.ProveListItem(block.txs, i)
+		require.NoError(err)
+		block.receiptProofs[i], err = lightclient.ProveListItem(receipts, i)
+		require.NoError(err)
+	}
+
+	rootHeader, err := rlp.EncodeToBytes(&ethtypes.Header{
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(101),
+		TxHash:      crypto.Keccak256Hash(block.txProofs[0][0]),
+		ReceiptHash: crypto.Keccak256Hash(block.receiptProofs[0][0]),
+		BaseFee:     big.NewInt(1),
+	})
+	require.NoError(err)
+	syncHeader, err := rlp.EncodeToBytes(&ethtypes.Header{
+		ParentHash: crypto.Keccak256Hash(rootHeader),
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(102),
+		BaseFee:    big.NewInt(1),
+	})
+	require.NoError(err)
+	block.headers = [][]byte{rootHeader, syncHeader}
+
+	s.stakingKeeper.EXPECT().GetSymbioticSync(gomock.Any(), int64(20)).Return(stakingtypes.SymbioticSyncRecord{
+		Height:      20,
+		BlockHash:   crypto.Keccak256Hash(syncHeader).Hex(),
+		BlockNumber: 102,
+	}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetSymbioticSync(gomock.Any(), gomock.Not(int64(20))).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).AnyTimes()
+
+	return block
+}
+
+// confirm returns the confirmation of a claim batch proving the transaction
+// at index.
+func (b rootBlock) confirm(relayer string, id uint64, txHash string, index int) *rewardstypes.MsgConfirmClaimBatch {
+	return rewardstypes.NewMsgConfirmClaimBatch(relayer, id, txHash, 20, b.headers, uint64(index), b.txProofs[index], b.receiptProofs[index])
+}
+
+// submitRootCalldata returns the calldata posting the root of a claim batch to
+// the rewards contract.
+func (s *KeeperTestSuite) submitRootCalldata(id uint64, root []byte) []byte {
+	contractABI, err := abi.JSON(strings.NewReader(rewardstypes.RewardsContractABI))
+	s.Require().NoError(err)
+	data, err := contractABI.Pack("submitRoot", new(big.Int).SetUint64(id), common.BytesToHash(root))
+	s.Require().NoError(err)
+	return data
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the rewards state from the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, rewards := range data.OperatorRewards {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(rewards.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.OperatorRewards.Set(ctx, valAddr, rewards); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RewardPool.Set(ctx, types.RewardPool{Remainder: data.Remainder}); err != nil {
+		return err
+	}
+
+	nextClaimBatchID := max(uint64(1), data.ClaimBatchSequence)
+	for _, batch := range data.ClaimBatches {
+		if err := k.ClaimBatches.Set(ctx, batch.Id, batch); err != nil {
+			return err
+		}
+		if batch.Status == types.ClaimBatchPending {
+			if err := k.PendingClaimBatches.Set(ctx, batch.Id); err != nil {
+				return err
+			}
+		}
+		nextClaimBatchID = max(nextClaimBatchID, batch.Id+1)
+	}
+
+	return k.ClaimBatchSequence.Set(ctx, nextClaimBatchID)
+}
+
+// ExportGenesis returns the rewards state as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorRewards := make([]types.OperatorRewards, 0)
+	err = k.OperatorRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.OperatorRewards) (stop bool, err error) {
+		operatorRewards = append(operatorRewards, rewards)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.RewardPool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	claimBatches := make([]types.ClaimBatch, 0)
+	err = k.ClaimBatches.Walk(ctx, nil, func(_ uint64, batch types.ClaimBatch) (stop bool, err error) {
+		claimBatches = append(claimBatches, batch)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.ClaimBatchSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(params, operatorRewards, pool.Remainder, claimBatches)
+	gs.ClaimBatchSequence = sequence
+	return gs, nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestExportAndInitGenesis() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	params := rewardstypes.DefaultParams()
+	params.InflationRate = math.LegacyNewDecWithPrec(5, 2)
+	gs := rewardstypes.NewGenesisState(
+		params,
+		[]rewardstypes.OperatorRewards{
+			{
+				ValidatorAddress: s.valStr(valAddrs[0]),
+				Commission:       sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+				StakerRewards:    sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2)),
+			},
+		},
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.3"))),
+		[]rewardstypes.ClaimBatch{
+			{Id: 1, Height: 5, Root: []byte{1}, Status: rewardstypes.ClaimBatchPosted, TxHash: "0x01", PostedHeight: 6},
+			{Id: 3, Height: 8, Root: []byte{3}, Status: rewardstypes.ClaimBatchPending},
+		},
+	)
+	require.NoError(rewardstypes.ValidateGenesis(*gs))
+	require.NoError(keeper.InitGenesis(ctx, gs))
+
+	pending, err := keeper.PendingClaimBatches.Has(ctx, 3)
+	require.NoError(err)
+	require.True(pending)
+	pending, err = keeper.PendingClaimBatches.Has(ctx, 1)
+	require.NoError(err)
+	require.False(pending)
+
+	exported, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	gs.ClaimBatchSequence = 4
+	require.Equal(gs, exported)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns parameters of x/symRewards module
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// OperatorRewards returns the rewards accrued by a validator.
+func (k Querier) OperatorRewards(ctx context.Context, req *types.QueryOperatorRewardsRequest) (*types.QueryOperatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	rewards, err := k.GetOperatorRewards(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOperatorRewardsResponse{Rewards: rewards}, nil
+}
+
+// AllOperatorRewards returns the rewards accrued by all the validators.
+func (k Querier) AllOperatorRewards(ctx context.Context, req *types.QueryAllOperatorRewardsRequest) (*types.QueryAllOperatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	rewards, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.OperatorRewards, req.Pagination, func(_ sdk.ValAddress, rewards types.OperatorRewards) (types.OperatorRewards, error) {
+		return rewards, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllOperatorRewardsResponse{Rewards: rewards, Pagination: pageRes}, nil
+}
+
+// ClaimBatch returns a claim batch by id.
+func (k Querier) ClaimBatch(ctx context.Context, req *types.QueryClaimBatchRequest) (*types.QueryClaimBatchResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	batch, err := k.getClaimBatch(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClaimBatchResponse{Batch: batch}, nil
+}
+
+// ClaimBatches returns the claim batches, or the pending ones only.
+func (k Querier) ClaimBatches(ctx context.Context, req *types.QueryClaimBatchesRequest) (*types.QueryClaimBatchesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		batches []types.ClaimBatch
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Pending {
+		batches, pageRes, err = query.CollectionPaginate(ctx, k.PendingClaimBatches, req.Pagination, func(id uint64, _ collections.NoValue) (types.ClaimBatch, error) {
+			return k.Keeper.ClaimBatches.Get(ctx, id)
+		})
+	} else {
+		batches, pageRes, err = query.CollectionPaginate(ctx, k.Keeper.ClaimBatches, req.Pagination, func(_ uint64, batch types.ClaimBatch) (types.ClaimBatch, error) {
+			return batch, nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimBatchesResponse{Batches: batches, Pagination: pageRes}, nil
+}
+
+// ClaimProof returns the merkle proof of a claim of a batch, which the stakers
+// of the operator submit to the rewards contract.
+func (k Querier) ClaimProof(ctx context.Context, req *types.QueryClaimProofRequest) (*types.QueryClaimProofResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	batch, err := k.getClaimBatch(ctx, req.BatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Index >= uint64(len(batch.Claims)) {
+		return nil, status.Errorf(codes.NotFound, "%s: index %d of batch %d", types.ErrClaimNotFound, req.Index, req.BatchId)
+	}
+
+	leaves, err := claimLeaves(batch.Id, batch.Claims)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proof, err := types.ClaimMerkleProof(leaves, int(req.Index))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimProofResponse{
+		Claim: batch.Claims[req.Index],
+		Leaf:  leaves[req.Index],
+		Proof: proof,
+		Root:  batch.Root,
+	}, nil
+}
+
+// getClaimBatch returns a claim batch by id as a gRPC status error.
+func (k Querier) getClaimBatch(ctx context.Context, id uint64) (types.ClaimBatch, error) {
+	batch, err := k.Keeper.ClaimBatches.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ClaimBatch{}, status.Errorf(codes.NotFound, "claim batch %d not found", id)
+		}
+		return types.ClaimBatch{}, status.Error(codes.Internal, err.Error())
+	}
+	return batch, nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/symRewards/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the rewards store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc codec.BinaryCodec
+	ak  types.AccountKeeper
+	bk  types.BankKeeper
+	sk  types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+	// the name of the module account the fees are collected in
+	feeCollectorName string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// OperatorRewards key: ValAddr | value: OperatorRewards
+	OperatorRewards collections.Map[sdk.ValAddress, types.OperatorRewards]
+	// RewardPool holds the rewards not allocated yet
+	RewardPool collections.Item[types.RewardPool]
+	// ClaimBatches key: ID | value: ClaimBatch
+	ClaimBatches collections.Map[uint64, types.ClaimBatch]
+	// PendingClaimBatches key: ID of the claim batches waiting to be posted
+	PendingClaimBatches collections.KeySet[uint64]
+	// ClaimBatchSequence is the ID of the next claim batch
+	ClaimBatchSequence collections.Sequence
+}
+
+// NewKeeper creates a rewards keeper
+func NewKeeper(
+	environment appmodule.Environment, cdc codec.BinaryCodec,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	feeCollectorName, authority string,
+) Keeper {
+	// ensure the rewards module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the x/symRewards module account has not been set")
+	}
+
+	sb := collections.NewSchemaBuilder(environment.KVStoreService)
+	k := Keeper{
+		Environment:         environment,
+		cdc:                 cdc,
+		ak:                  ak,
+		bk:                  bk,
+		sk:                  sk,
+		authority:           authority,
+		feeCollectorName:    feeCollectorName,
+		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		OperatorRewards:     collections.NewMap(sb, types.OperatorRewardsKeyPrefix, "operator_rewards", sdk.ValAddressKey, codec.CollValue[types.OperatorRewards](cdc)),
+		RewardPool:          collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
+		ClaimBatches:        collections.NewMap(sb, types.ClaimBatchesKeyPrefix, "claim_batches", collections.Uint64Key, codec.CollValue[types.ClaimBatch](cdc)),
+		PendingClaimBatches: collections.NewKeySet(sb, types.PendingClaimBatchesKeyPrefix, "pending_claim_batches", collections.Uint64Key),
+		ClaimBatchSequence:  collections.NewSequence(sb, types.ClaimBatchSequenceKey, "claim_batch_sequence"),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/symRewards module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetOperatorRewards returns the rewards accrued by a validator, empty if it
+// has none.
+func (k Keeper) GetOperatorRewards(ctx context.Context, valAddr sdk.ValAddress) (types.OperatorRewards, error) {
+	rewards, err := k.OperatorRewards.Get(ctx, valAddr)
+	if err == nil || !errors.Is(err, collections.ErrNotFound) {
+		return rewards, err
+	}
+
+	valStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return types.OperatorRewards{}, err
+	}
+	return types.OperatorRewards{ValidatorAddress: valStr}, nil
+}
+
+// setOperatorRewards stores the rewards of a validator, removing them once
+// they are all withdrawn or batched.
+func (k Keeper) setOperatorRewards(ctx context.Context, valAddr sdk.ValAddress, rewards types.OperatorRewards) error {
+	if rewards.Commission.IsZero() && rewards.StakerRewards.IsZero() {
+		return k.OperatorRewards.Remove(ctx, valAddr)
+	}
+	return k.OperatorRewards.Set(ctx, valAddr, rewards)
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	rewardskeeper "cosmossdk.io/x/symRewards/keeper"
+	rewardstestutil "cosmossdk.io/x/symRewards/testutil"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	feeCollectorAddr = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	valAddrs         = []sdk.ValAddress{
+		sdk.ValAddress([]byte("val1________________")),
+		sdk.ValAddress([]byte("val2________________")),
+	}
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	authKeeper    *rewardstestutil.MockAccountKeeper
+	bankKeeper    *rewardstestutil.MockBankKeeper
+	stakingKeeper *rewardstestutil.MockStakingKeeper
+	rewardsKeeper rewardskeeper.Keeper
+	queryClient   rewardstypes.QueryClient
+	msgServer     rewardstypes.MsgServer
+	authority     string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(rewardstypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
+	testCtx := sdktestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now().Round(0).UTC()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.authKeeper = rewardstestutil.NewMockAccountKeeper(ctrl)
+	s.authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAddress(rewardstypes.ModuleName).Return(authtypes.NewModuleAddress(rewardstypes.ModuleName)).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+	s.bankKeeper = rewardstestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = rewardstestutil.NewMockStakingKeeper(ctrl)
+	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	authStr, err := address.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(rewardstypes.GovModuleName))
+	s.Require().NoError(err)
+	s.authority = authStr
+
+	s.ctx = ctx
+	s.rewardsKeeper = rewardskeeper.NewKeeper(
+		env,
+		encCfg.Codec,
+		s.authKeeper,
+		s.bankKeeper,
+		s.stakingKeeper,
+		authtypes.FeeCollectorName,
+		authStr,
+	)
+	s.Require().NoError(s.rewardsKeeper.InitGenesis(ctx, rewardstypes.DefaultGenesisState()))
+
+	rewardstypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	rewardstypes.RegisterQueryServer(queryHelper, rewardskeeper.NewQuerier(s.rewardsKeeper))
+
+	s.queryClient = rewardstypes.NewQueryClient(queryHelper)
+	s.msgServer = rewardskeeper.NewMsgServerImpl(s.rewardsKeeper)
+}
+
+// valStr returns the bech32 operator address of a validator.
+func (s *KeeperTestSuite) valStr(valAddr sdk.ValAddress) string {
+	str, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddr)
+	s.Require().NoError(err)
+	return str
+}
+
+// expectBondedValidators expects the bonded validators to be iterated.
+func (s *KeeperTestSuite) expectBondedValidators(validators ...stakingtypes.Validator) {
+	s.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, fn func(int64, stakingtypes.Validator) bool) error {
+			for i, validator := range validators {
+				if fn(int64(i), validator) {
+					break
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// newValidator returns a bonded validator with tokens at the commission rate.
+func (s *KeeperTestSuite) newValidator(valAddr sdk.ValAddress, tokens int64, rate math.LegacyDec) stakingtypes.Validator {
+	return stakingtypes.Validator{
+		OperatorAddress: s.valStr(valAddr),
+		Tokens:          math.NewInt(tokens),
+		Status:          stakingtypes.Bonded,
+		Commission:      stakingtypes.NewCommission(rate, math.LegacyOneDec(), math.LegacyOneDec()),
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the rewards MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// WithdrawOperatorRewards implements MsgServer.WithdrawOperatorRewards method.
+// The commission is sent to the account of the validator operator unless a
+// recipient is given.
+func (k msgServer) WithdrawOperatorRewards(ctx context.Context, msg *types.MsgWithdrawOperatorRewards) (*types.MsgWithdrawOperatorRewardsResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	recipient := sdk.AccAddress(valAddr)
+	if msg.Recipient != "" {
+		recipient, err = k.ak.AddressCodec().StringToBytes(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("recipient address: %s", err)
+		}
+	}
+
+	amount, err := k.Keeper.WithdrawOperatorRewards(ctx, valAddr, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawOperatorRewardsResponse{Amount: amount}, nil
+}
+
+// ConfirmClaimBatch implements MsgServer.ConfirmClaimBatch method.
+// Any account can submit the proof that the root of a claim batch was posted
+// to the rewards contract.
+func (k msgServer) ConfirmClaimBatch(ctx context.Context, msg *types.MsgConfirmClaimBatch) (*types.MsgConfirmClaimBatchResponse, error) {
+	if txHash, err := hex.DecodeString(strings.TrimPrefix(msg.TxHash, "0x")); err != nil || len(txHash) != 32 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid tx hash %q", msg.TxHash)
+	}
+
+	if err := k.Keeper.ConfirmClaimBatch(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConfirmClaimBatchResponse{}, nil
+}
+
+// UpdateParams implements MsgServer.UpdateParams method.
+// It defines a method to update the x/symRewards module parameters.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
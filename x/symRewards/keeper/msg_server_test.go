@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	rewardstypes "cosmossdk.io/x/symRewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgWithdrawOperatorRewards() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	require.NoError(keeper.OperatorRewards.Set(ctx, valAddrs[0], rewardstypes.OperatorRewards{
+		ValidatorAddress: s.valStr(valAddrs[0]),
+		Commission:       sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5)),
+		StakerRewards:    sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 7)),
+	}))
+
+	testCases := []struct {
+		name      string
+		msg       *rewardstypes.MsgWithdrawOperatorRewards
+		malleate  func()
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid validator address",
+			msg:       rewardstypes.NewMsgWithdrawOperatorRewards("invalid", ""),
+			expErr:    true,
+			expErrMsg: "validator input address",
+		},
+		{
+			name:      "invalid recipient",
+			msg:       rewardstypes.NewMsgWithdrawOperatorRewards(s.valStr(valAddrs[0]), "invalid"),
+			expErr:    true,
+			expErrMsg: "recipient address",
+		},
+		{
+			name:      "no rewards",
+			msg:       rewardstypes.NewMsgWithdrawOperatorRewards(s.valStr(valAddrs[1]), ""),
+			expErr:    true,
+			expErrMsg: "no rewards for the validator",
+		},
+		{
+			name: "withdraw to the operator account",
+			msg:  rewardstypes.NewMsgWithdrawOperatorRewards(s.valStr(valAddrs[0]), ""),
+			malleate: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), rewardstypes.ModuleName, sdk.AccAddress(valAddrs[0]), sdk.NewCoins(sdk.NewInt64Coin("stake", 5))).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			res, err := s.msgServer.WithdrawOperatorRewards(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), res.Amount)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgConfirmClaimBatch() {
+	ctx, keeper := s.ctx, s.rewardsKeeper
+	require := s.Require()
+
+	params := rewardstypes.DefaultParams()
+	params.RewardsContract = rewardsContract.Hex()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	root := bytes.Repeat([]byte{1}, 32)
+	require.NoError(keeper.ClaimBatches.Set(ctx, 1, rewardstypes.ClaimBatch{Id: 1, Root: root, Status: rewardstypes.ClaimBatchPending}))
+	require.NoError(keeper.PendingClaimBatches.Set(ctx, 1))
+
+	// a block posting the root of the batch, then posting it to another
+	// contract, in a reverted transaction and with another root
+	other := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	calldata := s.submitRootCalldata(1, root)
+	block := s.newRootBlock(
+		rootCall{to: rewardsContract, data: calldata, status: ethtypes.ReceiptStatusSuccessful},
+		rootCall{to: other, data: calldata, status: ethtypes.ReceiptStatusSuccessful},
+		rootCall{to: rewardsContract, data: calldata, status: ethtypes.ReceiptStatusFailed},
+		rootCall{to: rewardsContract, data: s.submitRootCalldata(1, bytes.Repeat([]byte{2}, 32)), status: ethtypes.ReceiptStatusSuccessful},
+	)
+	txHash := func(index int) string { return block.txs[index].Hash().Hex() }
+
+	withSync := func(msg *rewardstypes.MsgConfirmClaimBatch, syncHeight int64, headers [][]byte) *rewardstypes.MsgConfirmClaimBatch {
+		msg.SyncHeight = syncHeight
+		msg.Headers = headers
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *rewardstypes.MsgConfirmClaimBatch
+		expErrMsg string
+	}{
+		{
+			name:      "invalid tx hash",
+			msg:       block.confirm(relayerStr, 1, "0x01", 0),
+			expErrMsg: "invalid tx hash",
+		},
+		{
+			name:      "unknown batch",
+			msg:       block.confirm(relayerStr, 2, txHash(0), 0),
+			expErrMsg: "claim batch not found",
+		},
+		{
+			name:      "unknown sync",
+			msg:       withSync(block.confirm(relayerStr, 1, txHash(0), 0), 10, block.headers),
+			expErrMsg: "sync at height 10",
+		},
+		{
+			name:      "headers not ending at the sync block",
+			msg:       withSync(block.confirm(relayerStr, 1, txHash(0), 0), 20, block.headers[:1]),
+			expErrMsg: "headers end at block",
+		},
+		{
+			name:      "another tx than the proven one",
+			msg:       block.confirm(relayerStr, 1, txHash(1), 0),
+			expErrMsg: "is not tx",
+		},
+		{
+			name:      "tx not sent to the rewards contract",
+			msg:       block.confirm(relayerStr, 1, txHash(1), 1),
+			expErrMsg: "not sent to the rewards contract",
+		},
+		{
+			name:      "tx reverted",
+			msg:       block.confirm(relayerStr, 1, txHash(2), 2),
+			expErrMsg: "tx reverted",
+		},
+		{
+			name:      "another root posted",
+			msg:       block.confirm(relayerStr, 1, txHash(3), 3),
+			expErrMsg: "does not match the batch root",
+		},
+		{
+			name: "root proven",
+			msg:  block.confirm(relayerStr, 1, txHash(0), 0),
+		},
+		{
+			name:      "already posted",
+			msg:       block.confirm(relayerStr, 1, txHash(0), 0),
+			expErrMsg: "claim batch already posted",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.ConfirmClaimBatch(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ctx := s.ctx
+	require := s.Require()
+
+	invalidParams := rewardstypes.DefaultParams()
+	invalidParams.InflationRate = math.LegacyNewDec(2)
+
+	invalidContract := rewardstypes.DefaultParams()
+	invalidContract.RewardsContract = "0x01"
+
+	testCases := []struct {
+		name      string
+		msg       *rewardstypes.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			msg:       &rewardstypes.MsgUpdateParams{Authority: "invalid", Params: rewardstypes.DefaultParams()},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid inflation rate",
+			msg:       &rewardstypes.MsgUpdateParams{Authority: s.authority, Params: invalidParams},
+			expErr:    true,
+			expErrMsg: "inflation rate too large",
+		},
+		{
+			name:      "invalid rewards contract",
+			msg:       &rewardstypes.MsgUpdateParams{Authority: s.authority, Params: invalidContract},
+			expErr:    true,
+			expErrMsg: "invalid rewards contract address",
+		},
+		{
+			name: "valid params",
+			msg:  &rewardstypes.MsgUpdateParams{Authority: s.authority, Params: rewardstypes.DefaultParams()},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(ctx, tc.msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
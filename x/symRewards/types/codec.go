@@ -0,0 +1,29 @@
+package types
+
+import (
+	corelegacy "cosmossdk.io/core/legacy"
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/symRewards/Params")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawOperatorRewards{}, "cosmos-sdk/MsgWithdrawOperatorRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgConfirmClaimBatch{}, "cosmos-sdk/MsgConfirmClaimBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/symRewards/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the Interface Registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
+		&MsgWithdrawOperatorRewards{},
+		&MsgConfirmClaimBatch{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
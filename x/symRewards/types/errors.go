@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/symRewards module sentinel errors
+var (
+	ErrInvalidSigner          = errors.Register(ModuleName, 2, "expected authority account as only signer for proposal message")
+	ErrNoOperatorRewards      = errors.Register(ModuleName, 3, "no rewards for the validator")
+	ErrClaimBatchNotFound     = errors.Register(ModuleName, 4, "claim batch not found")
+	ErrClaimBatchPosted       = errors.Register(ModuleName, 5, "claim batch already posted")
+	ErrInvalidClaimBatchProof = errors.Register(ModuleName, 6, "invalid claim batch confirmation proof")
+	ErrClaimNotFound          = errors.Register(ModuleName, 7, "claim not found")
+)
@@ -0,0 +1,18 @@
+package types
+
+// Rewards module event types
+const (
+	EventTypeRewards           = "rewards"
+	EventTypeWithdrawRewards   = "withdraw_rewards"
+	EventTypeClaimBatch        = "claim_batch"
+	EventTypeConfirmClaimBatch = "confirm_claim_batch"
+
+	AttributeKeyValidator  = "validator"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyMinted     = "minted"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyClaimBatch = "claim_batch_id"
+	AttributeKeyRoot       = "root"
+	AttributeKeyClaims     = "claims"
+	AttributeKeyTxHash     = "tx_hash"
+)
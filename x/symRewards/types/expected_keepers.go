@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper expected account keeper
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to move the rewards.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// StakingKeeper expected staking keeper
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+
+	// iterate through bonded validators by operator address, execute func for each validator
+	IterateBondedValidatorsByPower(context.Context,
+		func(index int64, validator stakingtypes.Validator) (stop bool)) error
+
+	Validator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error) // get a particular validator by operator address
+
+	// BondDenom returns the denom of the bonded tokens.
+	BondDenom(context.Context) (string, error)
+
+	// GetLastAppliedSymbioticSync returns the record of the last sync at or
+	// before height that updated the validator set.
+	GetLastAppliedSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error)
+	// GetSymbioticSync returns the record of the sync at height.
+	GetSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error)
+}
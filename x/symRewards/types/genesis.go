@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, operatorRewards []OperatorRewards, remainder sdk.DecCoins, claimBatches []ClaimBatch) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		OperatorRewards: operatorRewards,
+		Remainder:       remainder,
+		ClaimBatches:    claimBatches,
+	}
+}
+
+// DefaultGenesisState returns the default rewards genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:          DefaultParams(),
+		OperatorRewards: []OperatorRewards{},
+		ClaimBatches:    []ClaimBatch{},
+	}
+}
+
+// ValidateGenesis validates the rewards genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	validators := make(map[string]struct{}, len(data.OperatorRewards))
+	for _, rewards := range data.OperatorRewards {
+		if _, ok := validators[rewards.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate rewards of validator %s", rewards.ValidatorAddress)
+		}
+		validators[rewards.ValidatorAddress] = struct{}{}
+
+		if err := rewards.Commission.Validate(); err != nil {
+			return fmt.Errorf("invalid commission of validator %s: %w", rewards.ValidatorAddress, err)
+		}
+		if err := rewards.StakerRewards.Validate(); err != nil {
+			return fmt.Errorf("invalid staker rewards of validator %s: %w", rewards.ValidatorAddress, err)
+		}
+	}
+
+	if err := data.Remainder.Validate(); err != nil {
+		return fmt.Errorf("invalid remainder: %w", err)
+	}
+
+	ids := make(map[uint64]struct{}, len(data.ClaimBatches))
+	for _, batch := range data.ClaimBatches {
+		if batch.Id == 0 {
+			return fmt.Errorf("claim batch id must be positive")
+		}
+		if _, ok := ids[batch.Id]; ok {
+			return fmt.Errorf("duplicate claim batch id %d", batch.Id)
+		}
+		ids[batch.Id] = struct{}{}
+
+		if batch.Status != ClaimBatchPending && batch.Status != ClaimBatchPosted {
+			return fmt.Errorf("invalid status of claim batch %d: %s", batch.Id, batch.Status)
+		}
+		if data.ClaimBatchSequence != 0 && batch.Id >= data.ClaimBatchSequence {
+			return fmt.Errorf("claim batch id %d not below the sequence %d", batch.Id, data.ClaimBatchSequence)
+		}
+	}
+
+	return nil
+}
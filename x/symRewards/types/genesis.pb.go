@@ -0,0 +1,564 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symRewards/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the rewards module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// operator_rewards are the rewards accrued by the validators.
+	OperatorRewards []OperatorRewards `protobuf:"bytes,2,rep,name=operator_rewards,json=operatorRewards,proto3" json:"operator_rewards"`
+	// remainder is the part of the rewards not allocated yet.
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder"`
+	// claim_batches are the batches of Ethereum reward claims.
+	ClaimBatches []ClaimBatch `protobuf:"bytes,4,rep,name=claim_batches,json=claimBatches,proto3" json:"claim_batches"`
+	// claim_batch_sequence is the id of the next claim batch.
+	ClaimBatchSequence uint64 `protobuf:"varint,5,opt,name=claim_batch_sequence,json=claimBatchSequence,proto3" json:"claim_batch_sequence,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ea4a19bc6b6c280, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetOperatorRewards() []OperatorRewards {
+	if m != nil {
+		return m.OperatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimBatches() []ClaimBatch {
+	if m != nil {
+		return m.ClaimBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimBatchSequence() uint64 {
+	if m != nil {
+		return m.ClaimBatchSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.symRewards.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symRewards/v1/genesis.proto", fileDescriptor_6ea4a19bc6b6c280)
+}
+
+var fileDescriptor_6ea4a19bc6b6c280 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0xca, 0xd3, 0x40,
+	0x14, 0x85, 0x13, 0x5b, 0x0b, 0x4d, 0x2b, 0x6a, 0xe8, 0x22, 0x14, 0x49, 0x43, 0x41, 0x28, 0x8a,
+	0x33, 0xa6, 0x6e, 0x74, 0x25, 0xa4, 0x82, 0x4b, 0x4b, 0xbb, 0xd3, 0x45, 0x99, 0x4c, 0x2e, 0xe9,
+	0x50, 0x93, 0x89, 0x33, 0xd3, 0x6a, 0xdf, 0xc2, 0xa7, 0x10, 0x71, 0xe5, 0x63, 0x74, 0xd9, 0xa5,
+	0x2b, 0x95, 0x76, 0xe1, 0x6b, 0x48, 0x32, 0xf3, 0xb7, 0xf9, 0x21, 0x9b, 0x24, 0xdc, 0xfb, 0xcd,
+	0x39, 0xe7, 0xde, 0x8c, 0x33, 0xa6, 0x5c, 0x66, 0x5c, 0x62, 0xb9, 0xcf, 0x16, 0xf0, 0x99, 0x88,
+	0x44, 0xe2, 0x5d, 0x88, 0x53, 0xc8, 0x41, 0x32, 0x89, 0x0a, 0xc1, 0x15, 0x77, 0x07, 0x9a, 0x41,
+	0x57, 0x06, 0xed, 0xc2, 0xe1, 0x20, 0xe5, 0x29, 0xaf, 0x00, 0x5c, 0x7e, 0x69, 0x76, 0xe8, 0x1b,
+	0xbd, 0x98, 0x48, 0xc0, 0xbb, 0x30, 0x06, 0x45, 0x42, 0x4c, 0x39, 0xcb, 0x4d, 0xbf, 0xd9, 0x4f,
+	0x18, 0x59, 0xcd, 0x3c, 0x24, 0x19, 0xcb, 0x39, 0xae, 0x9e, 0xba, 0x34, 0xfe, 0xd6, 0x72, 0xfa,
+	0x6f, 0x75, 0xa8, 0xa5, 0x22, 0x0a, 0xdc, 0xd7, 0x4e, 0xa7, 0x20, 0x82, 0x64, 0xd2, 0xb3, 0x03,
+	0x7b, 0xd2, 0x9b, 0x3e, 0x42, 0x4d, 0x21, 0xd1, 0xbc, 0x62, 0xa2, 0xee, 0xe1, 0xf7, 0xc8, 0xfa,
+	0xfe, 0xef, 0xe7, 0x13, 0x7b, 0x61, 0x8e, 0xb9, 0x1f, 0x9c, 0x07, 0xbc, 0x00, 0x41, 0x14, 0x17,
+	0x2b, 0x63, 0xef, 0xdd, 0x09, 0x5a, 0x93, 0xde, 0xf4, 0x71, 0xb3, 0xd4, 0x3b, 0x43, 0x9b, 0x52,
+	0x5d, 0xf3, 0x3e, 0xbf, 0xdd, 0x73, 0x95, 0xd3, 0x15, 0x90, 0x11, 0x96, 0x27, 0x20, 0xbc, 0x56,
+	0xa5, 0x7a, 0x09, 0x58, 0x6e, 0x06, 0x99, 0xcd, 0xa0, 0x37, 0x40, 0x67, 0x9c, 0xe5, 0xd1, 0xcb,
+	0x52, 0xec, 0xc7, 0x9f, 0xd1, 0xd3, 0x94, 0xa9, 0xf5, 0x36, 0x46, 0x94, 0x67, 0xd8, 0x6c, 0x4a,
+	0xbf, 0x9e, 0xc9, 0x64, 0x83, 0xd5, 0xbe, 0x00, 0x79, 0x73, 0x46, 0x6a, 0xef, 0xab, 0x91, 0x3b,
+	0x77, 0xee, 0xd1, 0x8f, 0x84, 0x65, 0xab, 0x98, 0x28, 0xba, 0x06, 0xe9, 0xb5, 0x2b, 0xe7, 0xa0,
+	0x79, 0x9e, 0x59, 0x89, 0x46, 0x25, 0x59, 0x1f, 0xa5, 0x4f, 0x2f, 0x65, 0x90, 0xee, 0x73, 0x67,
+	0x50, 0x53, 0x5c, 0x49, 0xf8, 0xb4, 0x85, 0x9c, 0x82, 0x77, 0x37, 0xb0, 0x27, 0xed, 0x85, 0x7b,
+	0x65, 0x97, 0xa6, 0x13, 0xbd, 0x3a, 0x9c, 0x7c, 0xfb, 0x78, 0xf2, 0xed, 0xbf, 0x27, 0xdf, 0xfe,
+	0x7a, 0xf6, 0xad, 0xe3, 0xd9, 0xb7, 0x7e, 0x9d, 0x7d, 0xeb, 0xfd, 0x48, 0xa7, 0x90, 0xc9, 0x06,
+	0x31, 0x8e, 0xbf, 0xd4, 0x6f, 0x40, 0x35, 0x57, 0xdc, 0xa9, 0x7e, 0xf5, 0x8b, 0xff, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x45, 0xc3, 0x43, 0x62, 0x93, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimBatchSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimBatchSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ClaimBatches) > 0 {
+		for iNdEx := len(m.ClaimBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OperatorRewards) > 0 {
+		for iNdEx := len(m.OperatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OperatorRewards) > 0 {
+		for _, e := range m.OperatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimBatches) > 0 {
+		for _, e := range m.ClaimBatches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ClaimBatchSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ClaimBatchSequence))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorRewards = append(m.OperatorRewards, OperatorRewards{})
+			if err := m.OperatorRewards[len(m.OperatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimBatches = append(m.ClaimBatches, ClaimBatch{})
+			if err := m.ClaimBatches[len(m.ClaimBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBatchSequence", wireType)
+			}
+			m.ClaimBatchSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimBatchSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "symRewards"
+
+	// StoreKey is the store key string for rewards
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for rewards
+	RouterKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	GovModuleName = "symGov"
+)
+
+// Keys for rewards store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01<valAddr_Bytes>: OperatorRewards
+//
+// - 0x02: RewardPool
+//
+// - 0x03<id_Bytes>: ClaimBatch
+//
+// - 0x04<id_Bytes>: pending ClaimBatch id
+//
+// - 0x05: ClaimBatch id sequence
+
+var (
+	ParamsKey                    = collections.NewPrefix(0) // Prefix for params key
+	OperatorRewardsKeyPrefix     = collections.NewPrefix(1) // Prefix for operator rewards
+	RewardPoolKey                = collections.NewPrefix(2) // Key for the reward pool
+	ClaimBatchesKeyPrefix        = collections.NewPrefix(3) // Prefix for claim batches
+	PendingClaimBatchesKeyPrefix = collections.NewPrefix(4) // Prefix for pending claim batch ids
+	ClaimBatchSequenceKey        = collections.NewPrefix(5) // Key for the claim batch id sequence
+)
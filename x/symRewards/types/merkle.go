@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ClaimLeaf returns the merkle leaf of a claim of the batch id, as verified by
+// the rewards contract:
+//
+//	keccak256(bytes.concat(keccak256(abi.encode(uint256 batchId, address operator, bytes32 keccak256(denom), uint256 amount, bool commission))))
+//
+// The leaf is hashed twice so that it can't be mistaken for an inner node.
+func ClaimLeaf(batchID uint64, claim EthereumClaim) ([]byte, error) {
+	if len(claim.Operator) != common.AddressLength {
+		return nil, fmt.Errorf("invalid operator address length %d", len(claim.Operator))
+	}
+	if claim.Amount.IsNil() || claim.Amount.IsNegative() || claim.Amount.BigInt().BitLen() > 256 {
+		return nil, fmt.Errorf("invalid claim amount %s", claim.Amount)
+	}
+
+	return crypto.Keccak256(crypto.Keccak256(
+		uint256Word(batchID),
+		common.LeftPadBytes(claim.Operator, 32),
+		crypto.Keccak256([]byte(claim.Denom)),
+		common.LeftPadBytes(claim.Amount.BigInt().Bytes(), 32),
+		boolWord(claim.Commission),
+	)), nil
+}
+
+// ClaimMerkleTree returns the layers of the merkle tree of leaves, from the
+// leaves to the root. Pairs are hashed sorted, as the OpenZeppelin MerkleProof
+// library expects, and the last node of a layer with an odd number of nodes is
+// promoted to the next layer.
+func ClaimMerkleTree(leaves [][]byte) [][][]byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	layers := [][][]byte{leaves}
+	for layer := leaves; len(layer) > 1; {
+		next := make([][]byte, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 == len(layer) {
+				next = append(next, layer[i])
+				continue
+			}
+			next = append(next, hashPair(layer[i], layer[i+1]))
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+
+	return layers
+}
+
+// ClaimMerkleRoot returns the root of the merkle tree of leaves, or nil if there
+// are no leaves.
+func ClaimMerkleRoot(leaves [][]byte) []byte {
+	layers := ClaimMerkleTree(leaves)
+	if len(layers) == 0 {
+		return nil
+	}
+	return layers[len(layers)-1][0]
+}
+
+// ClaimMerkleProof returns the sibling hashes from the leaf at index to the
+// root of the merkle tree of leaves.
+func ClaimMerkleProof(leaves [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("leaf index %d out of range [0, %d)", index, len(leaves))
+	}
+
+	proof := make([][]byte, 0)
+	layers := ClaimMerkleTree(leaves)
+	for _, layer := range layers[:len(layers)-1] {
+		sibling := index ^ 1
+		if sibling < len(layer) {
+			proof = append(proof, layer[sibling])
+		}
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyClaimMerkleProof returns whether proof proves leaf is part of the
+// merkle tree of root.
+func VerifyClaimMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashPair(computed, sibling)
+	}
+	return bytes.Equal(computed, root)
+}
+
+// hashPair returns the keccak256 hash of the sorted concatenation of a and b.
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
+
+// uint256Word returns the 32 bytes big endian ABI encoding of v.
+func uint256Word(v uint64) []byte {
+	return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)
+}
+
+// boolWord returns the 32 bytes ABI encoding of b.
+func boolWord(b bool) []byte {
+	if b {
+		return uint256Word(1)
+	}
+	return uint256Word(0)
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symRewards/types"
+)
+
+func TestClaimMerkleProof(t *testing.T) {
+	for n := 1; n <= 7; n++ {
+		leaves := make([][]byte, 0, n)
+		for i := 0; i < n; i++ {
+			leaf, err := types.ClaimLeaf(1, types.EthereumClaim{
+				Operator: []byte("operator____________"),
+				Denom:    "stake",
+				Amount:   math.NewInt(int64(i + 1)),
+			})
+			require.NoError(t, err)
+			leaves = append(leaves, leaf)
+		}
+
+		root := types.ClaimMerkleRoot(leaves)
+		for i, leaf := range leaves {
+			proof, err := types.ClaimMerkleProof(leaves, i)
+			require.NoError(t, err)
+			require.True(t, types.VerifyClaimMerkleProof(root, leaf, proof), "leaf %d of %d", i, n)
+			require.False(t, types.VerifyClaimMerkleProof(root, leaves[(i+1)%n][:31], proof))
+		}
+
+		_, err := types.ClaimMerkleProof(leaves, n)
+		require.Error(t, err)
+	}
+
+	require.Nil(t, types.ClaimMerkleRoot(nil))
+}
+
+func TestClaimLeaf(t *testing.T) {
+	_, err := types.ClaimLeaf(1, types.EthereumClaim{Operator: []byte{1}, Denom: "stake", Amount: math.NewInt(1)})
+	require.ErrorContains(t, err, "invalid operator address length")
+
+	_, err = types.ClaimLeaf(1, types.EthereumClaim{Operator: []byte("operator____________"), Denom: "stake", Amount: math.NewInt(-1)})
+	require.ErrorContains(t, err, "invalid claim amount")
+
+	// the leaf commits to the batch id
+	claim := types.EthereumClaim{Operator: []byte("operator____________"), Denom: "stake", Amount: math.NewInt(1)}
+	leaf1, err := types.ClaimLeaf(1, claim)
+	require.NoError(t, err)
+	leaf2, err := types.ClaimLeaf(2, claim)
+	require.NoError(t, err)
+	require.NotEqual(t, leaf1, leaf2)
+
+	// and to whether it is a commission
+	claim.Commission = true
+	leaf3, err := types.ClaimLeaf(1, claim)
+	require.NoError(t, err)
+	require.NotEqual(t, leaf1, leaf3)
+}
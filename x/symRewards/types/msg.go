@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// verify interface at compile time
+var (
+	_ sdk.Msg = &MsgWithdrawOperatorRewards{}
+	_ sdk.Msg = &MsgConfirmClaimBatch{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgWithdrawOperatorRewards creates a new MsgWithdrawOperatorRewards instance
+func NewMsgWithdrawOperatorRewards(validatorAddr, recipient string) *MsgWithdrawOperatorRewards {
+	return &MsgWithdrawOperatorRewards{
+		ValidatorAddress: validatorAddr,
+		Recipient:        recipient,
+	}
+}
+
+// NewMsgConfirmClaimBatch creates a new MsgConfirmClaimBatch instance
+func NewMsgConfirmClaimBatch(relayer string, id uint64, txHash string, syncHeight int64, headers [][]byte, txIndex uint64, txProof, receiptProof [][]byte) *MsgConfirmClaimBatch {
+	return &MsgConfirmClaimBatch{
+		Relayer:      relayer,
+		Id:           id,
+		TxHash:       txHash,
+		SyncHeight:   syncHeight,
+		Headers:      headers,
+		TxIndex:      txIndex,
+		TxProof:      txProof,
+		ReceiptProof: receiptProof,
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// Default parameter values
+const (
+	DefaultBlocksPerYear      = uint64(60 * 60 * 8766 / 5) // assuming 5 second block times
+	DefaultClaimBatchInterval = int64(14400)
+)
+
+// DefaultInflationRate is the default yearly inflation minted as rewards.
+var DefaultInflationRate = math.LegacyZeroDec()
+
+// NewParams creates a new Params object
+func NewParams(
+	inflationRate math.LegacyDec, blocksPerYear uint64, claimBatchInterval int64,
+	rewardsContract string,
+) Params {
+	return Params{
+		InflationRate:      inflationRate,
+		BlocksPerYear:      blocksPerYear,
+		ClaimBatchInterval: claimBatchInterval,
+		RewardsContract:    rewardsContract,
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(
+		DefaultInflationRate,
+		DefaultBlocksPerYear,
+		DefaultClaimBatchInterval,
+		"",
+	)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateInflationRate(p.InflationRate); err != nil {
+		return err
+	}
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validateClaimBatchInterval(p.ClaimBatchInterval); err != nil {
+		return err
+	}
+	if err := validateRewardsContract(p.RewardsContract); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateInflationRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inflation rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("inflation rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per year must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateClaimBatchInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("claim batch interval cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateRewardsContract(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	if bz, err := hex.DecodeString(strings.TrimPrefix(v, "0x")); err != nil || len(bz) != 20 {
+		return fmt.Errorf("invalid rewards contract address: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,2005 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symRewards/v1/rewards.proto
+
+package types
+
+import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimBatchStatus is the status of a claim batch.
+type ClaimBatchStatus int32
+
+const (
+	// CLAIM_BATCH_STATUS_UNSPECIFIED defines an invalid status.
+	ClaimBatchUnspecified ClaimBatchStatus = 0
+	// CLAIM_BATCH_STATUS_PENDING defines a batch waiting to be posted.
+	ClaimBatchPending ClaimBatchStatus = 1
+	// CLAIM_BATCH_STATUS_POSTED defines a batch whose root was posted to the
+	// rewards contract.
+	ClaimBatchPosted ClaimBatchStatus = 2
+)
+
+var ClaimBatchStatus_name = map[int32]string{
+	0: "CLAIM_BATCH_STATUS_UNSPECIFIED",
+	1: "CLAIM_BATCH_STATUS_PENDING",
+	2: "CLAIM_BATCH_STATUS_POSTED",
+}
+
+var ClaimBatchStatus_value = map[string]int32{
+	"CLAIM_BATCH_STATUS_UNSPECIFIED": 0,
+	"CLAIM_BATCH_STATUS_PENDING":     1,
+	"CLAIM_BATCH_STATUS_POSTED":      2,
+}
+
+func (x ClaimBatchStatus) String() string {
+	return proto.EnumName(ClaimBatchStatus_name, int32(x))
+}
+
+func (ClaimBatchStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{0}
+}
+
+// Params represents the parameters used for by the rewards module.
+type Params struct {
+	// inflation_rate is the yearly inflation of the bond denom minted as rewards
+	// on top of the collected fees, as a fraction of the supply of the bond
+	// denom.
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// blocks_per_year is the expected number of blocks per year the inflation is
+	// minted over.
+	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// claim_batch_interval is the number of blocks between two batches of
+	// Ethereum reward claims, 0 disables the batching.
+	ClaimBatchInterval int64 `protobuf:"varint,3,opt,name=claim_batch_interval,json=claimBatchInterval,proto3" json:"claim_batch_interval,omitempty"`
+	// rewards_contract is the Ethereum address of the rewards contract the claim
+	// batches are posted to.
+	RewardsContract string `protobuf:"bytes,5,opt,name=rewards_contract,json=rewardsContract,proto3" json:"rewards_contract,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetClaimBatchInterval() int64 {
+	if m != nil {
+		return m.ClaimBatchInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardsContract() string {
+	if m != nil {
+		return m.RewardsContract
+	}
+	return ""
+}
+
+// OperatorRewards are the rewards accrued by a validator.
+type OperatorRewards struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// commission is the commission of the validator, withdrawn to a Cosmos
+	// address.
+	Commission github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"commission"`
+	// staker_rewards are the rewards of the stakers of the Symbiotic operator of
+	// the validator not batched yet.
+	StakerRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=staker_rewards,json=stakerRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"staker_rewards"`
+}
+
+func (m *OperatorRewards) Reset()         { *m = OperatorRewards{} }
+func (m *OperatorRewards) String() string { return proto.CompactTextString(m) }
+func (*OperatorRewards) ProtoMessage()    {}
+func (*OperatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{1}
+}
+func (m *OperatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorRewards.Merge(m, src)
+}
+func (m *OperatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorRewards proto.InternalMessageInfo
+
+func (m *OperatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorRewards) GetCommission() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *OperatorRewards) GetStakerRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.StakerRewards
+	}
+	return nil
+}
+
+// RewardPool holds the rewards not allocated to the validators yet.
+type RewardPool struct {
+	// remainder is the dust left by the truncation of the validator shares,
+	// allocated with the rewards of the next block.
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder"`
+}
+
+func (m *RewardPool) Reset()         { *m = RewardPool{} }
+func (m *RewardPool) String() string { return proto.CompactTextString(m) }
+func (*RewardPool) ProtoMessage()    {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{2}
+}
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
+}
+func (m *RewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
+
+func (m *RewardPool) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+// EthereumClaim is the reward of the stakers of a Symbiotic operator, or the
+// commission of the operator itself, in a claim batch.
+type EthereumClaim struct {
+	// operator is the Symbiotic operator the reward is claimed for.
+	Operator []byte `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// validator_address is the operator address of the validator of the
+	// Symbiotic operator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denom is the denom of the reward.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the reward.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// commission is set if the reward is the commission of a validator registered
+	// from the middleware, whose operator address no account controls. It is
+	// paid to the operator rather than its stakers.
+	Commission bool `protobuf:"varint,5,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (m *EthereumClaim) Reset()         { *m = EthereumClaim{} }
+func (m *EthereumClaim) String() string { return proto.CompactTextString(m) }
+func (*EthereumClaim) ProtoMessage()    {}
+func (*EthereumClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{3}
+}
+func (m *EthereumClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumClaim.Merge(m, src)
+}
+func (m *EthereumClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumClaim proto.InternalMessageInfo
+
+func (m *EthereumClaim) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *EthereumClaim) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EthereumClaim) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EthereumClaim) GetCommission() bool {
+	if m != nil {
+		return m.Commission
+	}
+	return false
+}
+
+// ClaimBatch is a batch of Ethereum reward claims, committed to by the merkle
+// root posted to the rewards contract.
+type ClaimBatch struct {
+	// id is the sequence number of the batch.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the height the batch was created at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// root is the merkle root of the claims.
+	Root []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	// claims are the claims of the batch, in leaf order.
+	Claims []EthereumClaim `protobuf:"bytes,4,rep,name=claims,proto3" json:"claims"`
+	// status is the status of the batch.
+	Status ClaimBatchStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.symRewards.v1.ClaimBatchStatus" json:"status,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction that posted the root.
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// posted_height is the height the batch was confirmed posted at.
+	PostedHeight int64 `protobuf:"varint,7,opt,name=posted_height,json=postedHeight,proto3" json:"posted_height,omitempty"`
+}
+
+func (m *ClaimBatch) Reset()         { *m = ClaimBatch{} }
+func (m *ClaimBatch) String() string { return proto.CompactTextString(m) }
+func (*ClaimBatch) ProtoMessage()    {}
+func (*ClaimBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d05bf81bf0104df8, []int{4}
+}
+func (m *ClaimBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimBatch.Merge(m, src)
+}
+func (m *ClaimBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimBatch proto.InternalMessageInfo
+
+func (m *ClaimBatch) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ClaimBatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ClaimBatch) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ClaimBatch) GetClaims() []EthereumClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+func (m *ClaimBatch) GetStatus() ClaimBatchStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ClaimBatchUnspecified
+}
+
+func (m *ClaimBatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ClaimBatch) GetPostedHeight() int64 {
+	if m != nil {
+		return m.PostedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.symRewards.v1.ClaimBatchStatus", ClaimBatchStatus_name, ClaimBatchStatus_value)
+	proto.RegisterType((*Params)(nil), "cosmos.symRewards.v1.Params")
+	proto.RegisterType((*OperatorRewards)(nil), "cosmos.symRewards.v1.OperatorRewards")
+	proto.RegisterType((*RewardPool)(nil), "cosmos.symRewards.v1.RewardPool")
+	proto.RegisterType((*EthereumClaim)(nil), "cosmos.symRewards.v1.EthereumClaim")
+	proto.RegisterType((*ClaimBatch)(nil), "cosmos.symRewards.v1.ClaimBatch")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symRewards/v1/rewards.proto", fileDescriptor_d05bf81bf0104df8)
+}
+
+var fileDescriptor_d05bf81bf0104df8 = []byte{
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x8e, 0x9b, 0x0c, 0x71, 0xe2, 0x8c, 0x1c, 0xd8, 0x18, 0xd8, 0x18, 0x47, 0xaa,
+	0x42, 0xaa, 0xec, 0x92, 0x56, 0x20, 0x84, 0x54, 0xa4, 0xf8, 0x4f, 0x89, 0x51, 0x49, 0xad, 0x75,
+	0x82, 0x04, 0x12, 0x5a, 0x8d, 0x77, 0xa7, 0xde, 0x51, 0xbc, 0x33, 0xd6, 0xcc, 0xc4, 0xc4, 0x07,
+	0x2e, 0x9c, 0x50, 0x0e, 0x88, 0x2f, 0x90, 0x13, 0x97, 0x88, 0x53, 0x0f, 0xfd, 0x06, 0x5c, 0x7a,
+	0xac, 0x7a, 0xaa, 0x38, 0x14, 0x94, 0x1c, 0xfa, 0x0d, 0x38, 0xa3, 0x9d, 0x99, 0xc6, 0x4e, 0xf1,
+	0xa9, 0x52, 0x2f, 0xd6, 0xcc, 0x7b, 0xef, 0xf7, 0xe6, 0xbd, 0xdf, 0xef, 0xbd, 0x35, 0xa8, 0x85,
+	0x4c, 0x24, 0x4c, 0x78, 0x62, 0x9c, 0xf8, 0xf8, 0x47, 0xc4, 0x23, 0xe1, 0x8d, 0x76, 0x3c, 0xae,
+	0x8f, 0xee, 0x90, 0x33, 0xc9, 0x60, 0x59, 0xc7, 0xb8, 0x93, 0x18, 0x77, 0xb4, 0x53, 0x29, 0xf7,
+	0x59, 0x9f, 0xa9, 0x00, 0x2f, 0x3d, 0xe9, 0xd8, 0xca, 0x9a, 0x8e, 0x0d, 0xb4, 0xc3, 0x00, 0xb5,
+	0xcb, 0x31, 0x4f, 0xf5, 0x90, 0xc0, 0xde, 0x68, 0xa7, 0x87, 0x25, 0xda, 0xf1, 0x42, 0x46, 0xa8,
+	0xf1, 0xaf, 0xa0, 0x84, 0x50, 0xe6, 0xa9, 0x5f, 0x6d, 0xaa, 0x9d, 0x67, 0x41, 0xa1, 0x83, 0x38,
+	0x4a, 0x04, 0xfc, 0x01, 0x2c, 0x11, 0xfa, 0x70, 0x80, 0x24, 0x61, 0x34, 0xe0, 0x48, 0x62, 0xdb,
+	0xaa, 0x5a, 0x9b, 0x0b, 0xf5, 0xcf, 0x9e, 0xbc, 0x58, 0xcf, 0xfc, 0xf5, 0x62, 0xfd, 0x7d, 0x9d,
+	0x5d, 0x44, 0x47, 0x2e, 0x61, 0x5e, 0x82, 0x64, 0xec, 0xde, 0xc7, 0x7d, 0x14, 0x8e, 0x9b, 0x38,
+	0x7c, 0xf6, 0x78, 0x1b, 0x98, 0x52, 0x9a, 0x38, 0x3c, 0x7f, 0xf9, 0x68, 0xcb, 0xf2, 0x8b, 0x57,
+	0xd9, 0x7c, 0x24, 0x31, 0xbc, 0x09, 0x96, 0x7b, 0x03, 0x16, 0x1e, 0x89, 0x60, 0x88, 0x79, 0x30,
+	0xc6, 0x88, 0xdb, 0xd9, 0xaa, 0xb5, 0x99, 0xf7, 0x8b, 0xda, 0xdc, 0xc1, 0xfc, 0x3b, 0x8c, 0x38,
+	0xfc, 0x04, 0x94, 0xc3, 0x01, 0x22, 0x49, 0xd0, 0x43, 0x32, 0x8c, 0x03, 0x42, 0x25, 0xe6, 0x23,
+	0x34, 0xb0, 0x73, 0x55, 0x6b, 0x33, 0xe7, 0x43, 0xe5, 0xab, 0xa7, 0xae, 0xb6, 0xf1, 0xc0, 0x8f,
+	0x41, 0xc9, 0xd0, 0x19, 0x84, 0x8c, 0x4a, 0x8e, 0x42, 0x69, 0xcf, 0xa5, 0xa5, 0xfb, 0xcb, 0xc6,
+	0xde, 0x30, 0xe6, 0x2f, 0x36, 0x4e, 0x5f, 0x3e, 0xda, 0x32, 0x34, 0x6d, 0x8b, 0xe8, 0xc8, 0x3b,
+	0x99, 0xd6, 0x45, 0x13, 0xf1, 0x75, 0x7e, 0x3e, 0x5f, 0x9a, 0xbb, 0xc2, 0x06, 0x1c, 0x0f, 0xd0,
+	0x18, 0xf3, 0xda, 0xf3, 0x2c, 0x58, 0x7e, 0x30, 0xc4, 0x1c, 0x49, 0xc6, 0x0d, 0x02, 0xee, 0x83,
+	0x95, 0x11, 0x1a, 0x90, 0x28, 0xb5, 0x05, 0x28, 0x8a, 0x38, 0x16, 0xc2, 0xd0, 0xf6, 0xd1, 0xb3,
+	0xc7, 0xdb, 0x1f, 0x1a, 0x4e, 0xbe, 0x7d, 0x15, 0xb3, 0xab, 0x43, 0xba, 0x92, 0x13, 0xda, 0xf7,
+	0x4b, 0xa3, 0xd7, 0xec, 0x70, 0x04, 0x40, 0xc8, 0x92, 0x84, 0x08, 0x41, 0x18, 0xb5, 0xb3, 0xd5,
+	0xdc, 0xe6, 0x3b, 0xb7, 0x3f, 0x70, 0x4d, 0x96, 0x54, 0x56, 0xd7, 0xc8, 0x9a, 0xd2, 0xdc, 0x60,
+	0x84, 0xd6, 0x3f, 0x4f, 0xd5, 0xf9, 0xe3, 0xef, 0xf5, 0x5b, 0x7d, 0x22, 0xe3, 0xe3, 0x9e, 0x1b,
+	0xb2, 0xc4, 0x0c, 0x85, 0x37, 0xd5, 0xa6, 0x1c, 0x0f, 0xb1, 0x78, 0x85, 0x11, 0x5a, 0x9f, 0xa9,
+	0x97, 0xe0, 0x4f, 0x60, 0x49, 0x48, 0x74, 0x84, 0x79, 0x60, 0xba, 0xb6, 0x73, 0x6f, 0xf5, 0xed,
+	0xa2, 0x7e, 0xcd, 0xd0, 0x58, 0xfb, 0xd9, 0x02, 0x40, 0x9f, 0x3b, 0x8c, 0x0d, 0xa0, 0x04, 0x0b,
+	0x1c, 0x27, 0x88, 0xd0, 0x08, 0x73, 0xdb, 0x7a, 0xab, 0x85, 0x4c, 0x1e, 0xaa, 0xfd, 0x6b, 0x81,
+	0x62, 0x4b, 0xc6, 0x98, 0xe3, 0xe3, 0xa4, 0x91, 0x4e, 0x19, 0xac, 0x80, 0x79, 0x66, 0x04, 0x57,
+	0xa2, 0x2e, 0xfa, 0x57, 0xf7, 0xd9, 0xca, 0x67, 0xdf, 0x5c, 0xf9, 0x32, 0x98, 0x8b, 0x30, 0x65,
+	0x89, 0x9a, 0xf3, 0x05, 0x5f, 0x5f, 0x60, 0x03, 0x14, 0x50, 0xc2, 0x8e, 0xa9, 0xb4, 0xf3, 0x2a,
+	0xf5, 0x2d, 0xb3, 0x8b, 0xab, 0xff, 0xdf, 0xc5, 0x36, 0x95, 0x53, 0x5b, 0xd8, 0xa6, 0xd2, 0x37,
+	0x50, 0xe8, 0x5c, 0x1b, 0xaa, 0x74, 0x33, 0xe6, 0xa7, 0xc5, 0xaf, 0xfd, 0x9a, 0x05, 0xa0, 0x71,
+	0xb5, 0x56, 0x70, 0x09, 0x64, 0x49, 0xa4, 0xfa, 0xcd, 0xfb, 0x59, 0x12, 0xc1, 0x77, 0x41, 0x21,
+	0xc6, 0xa4, 0x1f, 0x4b, 0xd5, 0x5e, 0xce, 0x37, 0x37, 0x08, 0x41, 0x9e, 0x33, 0x26, 0x55, 0xc1,
+	0x8b, 0xbe, 0x3a, 0xc3, 0x7b, 0xa0, 0xa0, 0x16, 0x54, 0xd8, 0x79, 0x25, 0xdb, 0x86, 0x3b, 0xeb,
+	0xcb, 0xe6, 0x5e, 0xa3, 0xb9, 0xbe, 0x90, 0x36, 0xa5, 0xe5, 0x30, 0x68, 0xf8, 0x25, 0x28, 0x08,
+	0x89, 0xe4, 0xb1, 0x50, 0xe5, 0x2e, 0xdd, 0xbe, 0x39, 0x3b, 0xcf, 0xa4, 0xea, 0xae, 0x8a, 0xf6,
+	0x0d, 0x0a, 0xbe, 0x07, 0x6e, 0xc8, 0x93, 0x20, 0x46, 0x22, 0xb6, 0x0b, 0x8a, 0xcf, 0x82, 0x3c,
+	0xd9, 0x43, 0x22, 0x86, 0x1b, 0xa0, 0x38, 0x64, 0x42, 0xe2, 0x28, 0x30, 0x3d, 0xdd, 0x50, 0x3d,
+	0x2d, 0x6a, 0xe3, 0x9e, 0xb2, 0x6d, 0xfd, 0x69, 0x81, 0xd2, 0xeb, 0xa9, 0xe1, 0x5d, 0xe0, 0x34,
+	0xee, 0xef, 0xb6, 0xbf, 0x09, 0xea, 0xbb, 0x07, 0x8d, 0xbd, 0xa0, 0x7b, 0xb0, 0x7b, 0x70, 0xd8,
+	0x0d, 0x0e, 0xf7, 0xbb, 0x9d, 0x56, 0xa3, 0x7d, 0xaf, 0xdd, 0x6a, 0x96, 0x32, 0x95, 0xb5, 0xd3,
+	0xb3, 0xea, 0xea, 0x04, 0x79, 0x48, 0xc5, 0x10, 0x87, 0xe4, 0x21, 0xc1, 0x11, 0xfc, 0x14, 0x54,
+	0x66, 0xc0, 0x3b, 0xad, 0xfd, 0x66, 0x7b, 0xff, 0xab, 0x92, 0x55, 0x59, 0x3d, 0x3d, 0xab, 0xae,
+	0x4c, 0xa0, 0x1d, 0x4c, 0x23, 0x42, 0xfb, 0xf0, 0x0e, 0x58, 0x9b, 0x05, 0x7b, 0xd0, 0x3d, 0x68,
+	0x35, 0x4b, 0xd9, 0x4a, 0xf9, 0xf4, 0xac, 0x3a, 0x55, 0x6a, 0x47, 0x75, 0x51, 0xc9, 0xff, 0xf2,
+	0xbb, 0x93, 0xa9, 0xdf, 0x3d, 0xbf, 0x70, 0xac, 0x27, 0x17, 0x8e, 0xf5, 0xf4, 0xc2, 0xb1, 0xfe,
+	0xb9, 0x70, 0xac, 0xdf, 0x2e, 0x9d, 0xcc, 0xd3, 0x4b, 0x27, 0xf3, 0xfc, 0xd2, 0xc9, 0x7c, 0xbf,
+	0x7e, 0x6d, 0x82, 0xae, 0x7d, 0x06, 0xd5, 0x9e, 0xf4, 0x0a, 0xea, 0x0f, 0xe2, 0xce, 0x7f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0xfd, 0x13, 0x4f, 0xf9, 0xc0, 0x06, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InflationRate.Equal(that1.InflationRate) {
+		return false
+	}
+	if this.BlocksPerYear != that1.BlocksPerYear {
+		return false
+	}
+	if this.ClaimBatchInterval != that1.ClaimBatchInterval {
+		return false
+	}
+	if this.RewardsContract != that1.RewardsContract {
+		return false
+	}
+	return true
+}
+func (this *OperatorRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OperatorRewards)
+	if !ok {
+		that2, ok := that.(OperatorRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Commission) != len(that1.Commission) {
+		return false
+	}
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
+			return false
+		}
+	}
+	if len(this.StakerRewards) != len(that1.StakerRewards) {
+		return false
+	}
+	for i := range this.StakerRewards {
+		if !this.StakerRewards[i].Equal(&that1.StakerRewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardPool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardPool)
+	if !ok {
+		that2, ok := that.(RewardPool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Remainder) != len(that1.Remainder) {
+		return false
+	}
+	for i := range this.Remainder {
+		if !this.Remainder[i].Equal(&that1.Remainder[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EthereumClaim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EthereumClaim)
+	if !ok {
+		that2, ok := that.(EthereumClaim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Operator, that1.Operator) {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Commission != that1.Commission {
+		return false
+	}
+	return true
+}
+func (this *ClaimBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimBatch)
+	if !ok {
+		that2, ok := that.(ClaimBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !bytes.Equal(this.Root, that1.Root) {
+		return false
+	}
+	if len(this.Claims) != len(that1.Claims) {
+		return false
+	}
+	for i := range this.Claims {
+		if !this.Claims[i].Equal(&that1.Claims[i]) {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	if this.PostedHeight != that1.PostedHeight {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsContract) > 0 {
+		i -= len(m.RewardsContract)
+		copy(dAtA[i:], m.RewardsContract)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClaimBatchInterval != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.ClaimBatchInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerRewards) > 0 {
+		for iNdEx := len(m.StakerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commission {
+		i--
+		if m.Commission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostedHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.PostedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovRewards(uint64(m.BlocksPerYear))
+	}
+	if m.ClaimBatchInterval != 0 {
+		n += 1 + sovRewards(uint64(m.ClaimBatchInterval))
+	}
+	l = len(m.RewardsContract)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.StakerRewards) > 0 {
+		for _, e := range m.StakerRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthereumClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.Commission {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClaimBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRewards(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovRewards(uint64(m.Status))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.PostedHeight != 0 {
+		n += 1 + sovRewards(uint64(m.PostedHeight))
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBatchInterval", wireType)
+			}
+			m.ClaimBatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimBatchInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerRewards = append(m.StakerRewards, types.DecCoin{})
+			if err := m.StakerRewards[len(m.StakerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Commission = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, EthereumClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClaimBatchStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedHeight", wireType)
+			}
+			m.PostedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
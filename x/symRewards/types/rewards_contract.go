@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxClaimProofHeaders bounds the execution headers of a claim batch
+// confirmation, from the block of the root transaction to the block of a
+// Symbiotic sync.
+const MaxClaimProofHeaders = 256
+
+// RewardsContractABI is the ABI of the rewards contract method the claim batch
+// roots are posted with.
+const RewardsContractABI = `[
+	{
+		"type": "function",
+		"name": "submitRoot",
+		"inputs": [
+			{"name": "batchId", "type": "uint256", "internalType": "uint256"},
+			{"name": "root", "type": "bytes32", "internalType": "bytes32"}
+		],
+		"outputs": [],
+		"stateMutability": "nonpayable"
+	}
+]`
+
+var submitRootMethod = func() abi.Method {
+	contractABI, err := abi.JSON(strings.NewReader(RewardsContractABI))
+	if err != nil {
+		panic(err)
+	}
+	return contractABI.Methods["submitRoot"]
+}()
+
+// CheckSubmitRootCalldata checks that data calls the submitRoot method of the
+// rewards contract with the id and root of batch.
+func CheckSubmitRootCalldata(data []byte, batch ClaimBatch) error {
+	if len(data) < 4 || !bytes.Equal(data[:4], submitRootMethod.ID) {
+		return fmt.Errorf("not a call of %s", submitRootMethod.Sig)
+	}
+
+	args, err := submitRootMethod.Inputs.Unpack(data[4:])
+	if err != nil {
+		return fmt.Errorf("invalid %s calldata: %w", submitRootMethod.Name, err)
+	}
+
+	if id, ok := args[0].(*big.Int); !ok || !id.IsUint64() || id.Uint64() != batch.Id {
+		return fmt.Errorf("submitted batch id %v does not match the batch id %d", args[0], batch.Id)
+	}
+	if root, ok := args[1].([32]byte); !ok || common.Hash(root) != common.BytesToHash(batch.Root) {
+		return fmt.Errorf("submitted root %x does not match the batch root %x", args[1], batch.Root)
+	}
+
+	return nil
+}
@@ -0,0 +1,1739 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symRewards/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgWithdrawOperatorRewards is the Msg/WithdrawOperatorRewards request type.
+type MsgWithdrawOperatorRewards struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// recipient is the account the rewards are sent to, defaults to the account
+	// of the validator operator.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgWithdrawOperatorRewards) Reset()         { *m = MsgWithdrawOperatorRewards{} }
+func (m *MsgWithdrawOperatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawOperatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawOperatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{0}
+}
+func (m *MsgWithdrawOperatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawOperatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawOperatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawOperatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawOperatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawOperatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawOperatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawOperatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawOperatorRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawOperatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawOperatorRewards) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgWithdrawOperatorRewardsResponse defines the Msg/WithdrawOperatorRewards
+// response type.
+type MsgWithdrawOperatorRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawOperatorRewardsResponse) Reset()         { *m = MsgWithdrawOperatorRewardsResponse{} }
+func (m *MsgWithdrawOperatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawOperatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawOperatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{1}
+}
+func (m *MsgWithdrawOperatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawOperatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawOperatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawOperatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawOperatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawOperatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawOperatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawOperatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawOperatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawOperatorRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgConfirmClaimBatch is the Msg/ConfirmClaimBatch request type. It proves
+// that the Ethereum transaction tx_hash submitted the root of the batch to the
+// rewards contract: the transaction and its receipt are proven against a
+// header chained to the execution block of a Symbiotic sync.
+type MsgConfirmClaimBatch struct {
+	// relayer is the account submitting the proof.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// id is the id of the claim batch.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction that posted the root.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// sync_height is the height of the Symbiotic sync whose execution block
+	// the headers end at.
+	SyncHeight int64 `protobuf:"varint,4,opt,name=sync_height,json=syncHeight,proto3" json:"sync_height,omitempty"`
+	// headers are the consensus encoded execution headers from the block of
+	// the transaction to the block of the sync, each the parent of the next one.
+	Headers [][]byte `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// tx_index is the index of the transaction in its block.
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// tx_proof is the proof of the transaction against the transactions root
+	// of the first header.
+	TxProof [][]byte `protobuf:"bytes,7,rep,name=tx_proof,json=txProof,proto3" json:"tx_proof,omitempty"`
+	// receipt_proof is the proof of the receipt of the transaction against the
+	// receipts root of the first header.
+	ReceiptProof [][]byte `protobuf:"bytes,8,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *MsgConfirmClaimBatch) Reset()         { *m = MsgConfirmClaimBatch{} }
+func (m *MsgConfirmClaimBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmClaimBatch) ProtoMessage()    {}
+func (*MsgConfirmClaimBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{2}
+}
+func (m *MsgConfirmClaimBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmClaimBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmClaimBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmClaimBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmClaimBatch.Merge(m, src)
+}
+func (m *MsgConfirmClaimBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmClaimBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmClaimBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmClaimBatch proto.InternalMessageInfo
+
+func (m *MsgConfirmClaimBatch) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *MsgConfirmClaimBatch) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgConfirmClaimBatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgConfirmClaimBatch) GetSyncHeight() int64 {
+	if m != nil {
+		return m.SyncHeight
+	}
+	return 0
+}
+
+func (m *MsgConfirmClaimBatch) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *MsgConfirmClaimBatch) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgConfirmClaimBatch) GetTxProof() [][]byte {
+	if m != nil {
+		return m.TxProof
+	}
+	return nil
+}
+
+func (m *MsgConfirmClaimBatch) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+// MsgConfirmClaimBatchResponse defines the Msg/ConfirmClaimBatch response type.
+type MsgConfirmClaimBatchResponse struct {
+}
+
+func (m *MsgConfirmClaimBatchResponse) Reset()         { *m = MsgConfirmClaimBatchResponse{} }
+func (m *MsgConfirmClaimBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmClaimBatchResponse) ProtoMessage()    {}
+func (*MsgConfirmClaimBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{3}
+}
+func (m *MsgConfirmClaimBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmClaimBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmClaimBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfirmClaimBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmClaimBatchResponse.Merge(m, src)
+}
+func (m *MsgConfirmClaimBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmClaimBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmClaimBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfirmClaimBatchResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/symRewards parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da12da14078c2a38, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgWithdrawOperatorRewards)(nil), "cosmos.symRewards.v1.MsgWithdrawOperatorRewards")
+	proto.RegisterType((*MsgWithdrawOperatorRewardsResponse)(nil), "cosmos.symRewards.v1.MsgWithdrawOperatorRewardsResponse")
+	proto.RegisterType((*MsgConfirmClaimBatch)(nil), "cosmos.symRewards.v1.MsgConfirmClaimBatch")
+	proto.RegisterType((*MsgConfirmClaimBatchResponse)(nil), "cosmos.symRewards.v1.MsgConfirmClaimBatchResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symRewards.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symRewards.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/symRewards/v1/tx.proto", fileDescriptor_da12da14078c2a38) }
+
+var fileDescriptor_da12da14078c2a38 = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0x8e, 0x93, 0x36, 0x69, 0xaf, 0xfd, 0x7e, 0xd4, 0xaa, 0x14, 0x27, 0xea, 0xe7, 0xe4, 0x33,
+	0xaa, 0x88, 0x22, 0xc5, 0x6e, 0x82, 0x40, 0x90, 0x05, 0x48, 0x97, 0x32, 0x04, 0x2a, 0x23, 0x40,
+	0x62, 0x89, 0x2e, 0xf1, 0xd5, 0x3e, 0xb5, 0xf6, 0x59, 0x77, 0xd7, 0xd4, 0xd9, 0x10, 0x12, 0x0b,
+	0x03, 0xe2, 0xcf, 0x40, 0x4c, 0x1d, 0xba, 0xb1, 0xa3, 0x8e, 0x55, 0x27, 0x26, 0x40, 0xad, 0x44,
+	0x47, 0xfe, 0x05, 0x64, 0xfb, 0xdc, 0x24, 0x4d, 0x22, 0xca, 0x12, 0xe5, 0xde, 0xe7, 0x79, 0x9f,
+	0xf7, 0xde, 0xf7, 0xb9, 0xd7, 0xe0, 0xbf, 0x1e, 0x61, 0x2e, 0x61, 0x06, 0x1b, 0xb8, 0x26, 0x3a,
+	0x80, 0xd4, 0x62, 0x46, 0xbf, 0x6e, 0xf0, 0x40, 0xf7, 0x29, 0xe1, 0x44, 0x5e, 0x8d, 0x61, 0x7d,
+	0x08, 0xeb, 0xfd, 0x7a, 0x71, 0xd5, 0x26, 0x36, 0x89, 0x08, 0x46, 0xf8, 0x2f, 0xe6, 0x16, 0x55,
+	0x21, 0xd5, 0x85, 0x0c, 0x19, 0xfd, 0x7a, 0x17, 0x71, 0x58, 0x37, 0x7a, 0x04, 0x7b, 0x02, 0xd7,
+	0xa6, 0x96, 0xa2, 0x42, 0x36, 0xe6, 0x14, 0x62, 0x4e, 0x27, 0x16, 0x17, 0xc5, 0x63, 0x28, 0x2f,
+	0xd2, 0x5d, 0x66, 0x87, 0x79, 0x2e, 0xb3, 0x05, 0xb0, 0x02, 0x5d, 0xec, 0x11, 0x23, 0xfa, 0x8d,
+	0x43, 0xda, 0x0f, 0x09, 0x14, 0xdb, 0xcc, 0x7e, 0x81, 0xb9, 0x63, 0x51, 0x78, 0xf0, 0xc4, 0x47,
+	0x14, 0x72, 0x42, 0x45, 0x59, 0xf9, 0x31, 0x58, 0xe9, 0xc3, 0x3d, 0x6c, 0x85, 0xb1, 0x0e, 0xb4,
+	0x2c, 0x8a, 0x18, 0x53, 0xa4, 0xb2, 0x54, 0x59, 0x6c, 0xfd, 0x7f, 0x7a, 0x54, 0x13, 0x33, 0xd1,
+	0x9f, 0x27, 0x9c, 0x87, 0x31, 0xe5, 0x29, 0xa7, 0xd8, 0xb3, 0xcd, 0x7f, 0xfb, 0x57, 0xe2, 0xf2,
+	0x1d, 0xb0, 0x48, 0x51, 0x0f, 0xfb, 0x18, 0x79, 0x5c, 0x49, 0x47, 0x3a, 0xca, 0xe9, 0x51, 0x2d,
+	0x19, 0xde, 0x78, 0xfa, 0x90, 0xda, 0x7c, 0xf0, 0xfa, 0xe2, 0xb0, 0x3a, 0x79, 0x95, 0xb7, 0x17,
+	0x87, 0xd5, 0xf5, 0x38, 0xb7, 0xc6, 0xac, 0x5d, 0x63, 0x76, 0x27, 0xda, 0x3b, 0x09, 0x68, 0xb3,
+	0x61, 0x13, 0x31, 0x9f, 0x78, 0x0c, 0xc9, 0x0e, 0xc8, 0x42, 0x97, 0xec, 0x7b, 0x5c, 0x91, 0xca,
+	0x99, 0xca, 0x52, 0xa3, 0xa0, 0x8b, 0xab, 0x85, 0x5e, 0xe9, 0xc2, 0x2b, 0x7d, 0x93, 0x60, 0xaf,
+	0x75, 0xfb, 0xf8, 0x6b, 0x29, 0xf5, 0xf1, 0x5b, 0xa9, 0x62, 0x63, 0xee, 0xec, 0x77, 0xf5, 0x1e,
+	0x71, 0x85, 0x0f, 0xc6, 0xc8, 0x95, 0xf8, 0xc0, 0x47, 0x2c, 0x4a, 0x60, 0x1f, 0x2e, 0x0e, 0xab,
+	0x92, 0x29, 0xf4, 0xb5, 0x4f, 0x69, 0xb0, 0xda, 0x66, 0xf6, 0x26, 0xf1, 0x76, 0x30, 0x75, 0x37,
+	0xf7, 0x20, 0x76, 0x5b, 0x90, 0xf7, 0x1c, 0xb9, 0x01, 0x72, 0x14, 0xed, 0xc1, 0x01, 0xa2, 0x62,
+	0xd2, 0xb3, 0x27, 0x94, 0x10, 0xe5, 0xbf, 0x41, 0x1a, 0x5b, 0xd1, 0x40, 0xe7, 0xcc, 0x34, 0xb6,
+	0xe4, 0x3c, 0xc8, 0xf1, 0xa0, 0xe3, 0x40, 0xe6, 0x28, 0x99, 0x50, 0xc3, 0xcc, 0xf2, 0x60, 0x0b,
+	0x32, 0x47, 0x2e, 0x81, 0x25, 0x36, 0xf0, 0x7a, 0x1d, 0x07, 0x61, 0xdb, 0xe1, 0xca, 0x5c, 0x59,
+	0xaa, 0x64, 0x4c, 0x10, 0x86, 0xb6, 0xa2, 0x88, 0xac, 0x80, 0x9c, 0x83, 0xa0, 0x85, 0x28, 0x53,
+	0xe6, 0xcb, 0x99, 0xca, 0xb2, 0x99, 0x1c, 0xe5, 0x02, 0x58, 0xe0, 0x41, 0x07, 0x7b, 0x16, 0x0a,
+	0x94, 0x6c, 0x54, 0x29, 0xc7, 0x83, 0x47, 0xe1, 0x51, 0x40, 0x3e, 0x25, 0x64, 0x47, 0xc9, 0xc5,
+	0x59, 0x3c, 0xd8, 0x0e, 0x8f, 0xf2, 0x0d, 0xf0, 0x17, 0x45, 0x3d, 0x84, 0x7d, 0x2e, 0xf0, 0x85,
+	0x08, 0x5f, 0x16, 0xc1, 0x88, 0xd4, 0xdc, 0x08, 0xed, 0x4d, 0x9a, 0x09, 0x4d, 0x2d, 0x8d, 0x9b,
+	0x3a, 0x31, 0x24, 0x4d, 0x05, 0x6b, 0xd3, 0xe2, 0x89, 0x8f, 0xda, 0x67, 0x09, 0xfc, 0xd3, 0x66,
+	0xf6, 0x33, 0xdf, 0x82, 0x1c, 0x6d, 0x43, 0x0a, 0xdd, 0xe8, 0xf1, 0xc1, 0x7d, 0xee, 0x10, 0x8a,
+	0xf9, 0xe0, 0xb7, 0xa3, 0x1d, 0x52, 0xe5, 0xfb, 0x20, 0xeb, 0x47, 0x0a, 0xd1, 0x80, 0x97, 0x1a,
+	0x6b, 0xfa, 0xb4, 0x5d, 0xd7, 0xe3, 0x2a, 0xad, 0xc5, 0xf0, 0x59, 0x08, 0xab, 0xe3, 0xb4, 0x66,
+	0x33, 0x6c, 0x6f, 0x28, 0x18, 0x36, 0x78, 0x73, 0xa4, 0xc1, 0x60, 0x74, 0xd1, 0xaf, 0x5c, 0x5a,
+	0x2b, 0x80, 0xfc, 0x95, 0x50, 0xd2, 0x63, 0xe3, 0x67, 0x1a, 0x64, 0xda, 0xcc, 0x96, 0xdf, 0x48,
+	0x20, 0x3f, 0x6b, 0x81, 0x37, 0xa6, 0xdf, 0x75, 0xf6, 0x26, 0x14, 0xef, 0xfe, 0x69, 0xc6, 0xe5,
+	0xee, 0x30, 0xb0, 0x32, 0xf9, 0x9a, 0xab, 0x33, 0xe5, 0x26, 0xb8, 0xc5, 0xc6, 0xf5, 0xb9, 0x97,
+	0x45, 0x2d, 0xb0, 0x3c, 0x66, 0xf2, 0xfa, 0x4c, 0x8d, 0x51, 0x5a, 0xb1, 0x76, 0x2d, 0x5a, 0x52,
+	0xa5, 0x38, 0xff, 0x2a, 0x34, 0xb4, 0x75, 0xef, 0xf8, 0x4c, 0x95, 0x4e, 0xce, 0x54, 0xe9, 0xfb,
+	0x99, 0x2a, 0xbd, 0x3f, 0x57, 0x53, 0x27, 0xe7, 0x6a, 0xea, 0xcb, 0xb9, 0x9a, 0x7a, 0x29, 0x1e,
+	0x2c, 0xb3, 0x76, 0x75, 0x4c, 0xc6, 0x1d, 0x8d, 0xbe, 0x00, 0xdd, 0x6c, 0xf4, 0xbd, 0xbd, 0xf5,
+	0x2b, 0x00, 0x00, 0xff, 0xff, 0x11, 0x2e, 0x40, 0x82, 0x47, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WithdrawOperatorRewards withdraws the commission of a validator to a
+	// Cosmos address. The staker rewards are only claimed on Ethereum.
+	WithdrawOperatorRewards(ctx context.Context, in *MsgWithdrawOperatorRewards, opts ...grpc.CallOption) (*MsgWithdrawOperatorRewardsResponse, error)
+	// ConfirmClaimBatch marks a claim batch posted with the proof that its root
+	// was submitted to the rewards contract.
+	ConfirmClaimBatch(ctx context.Context, in *MsgConfirmClaimBatch, opts ...grpc.CallOption) (*MsgConfirmClaimBatchResponse, error)
+	// UpdateParams defines a governance operation for updating the x/symRewards
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WithdrawOperatorRewards(ctx context.Context, in *MsgWithdrawOperatorRewards, opts ...grpc.CallOption) (*MsgWithdrawOperatorRewardsResponse, error) {
+	out := new(MsgWithdrawOperatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symRewards.v1.Msg/WithdrawOperatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmClaimBatch(ctx context.Context, in *MsgConfirmClaimBatch, opts ...grpc.CallOption) (*MsgConfirmClaimBatchResponse, error) {
+	out := new(MsgConfirmClaimBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symRewards.v1.Msg/ConfirmClaimBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symRewards.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WithdrawOperatorRewards withdraws the commission of a validator to a
+	// Cosmos address. The staker rewards are only claimed on Ethereum.
+	WithdrawOperatorRewards(context.Context, *MsgWithdrawOperatorRewards) (*MsgWithdrawOperatorRewardsResponse, error)
+	// ConfirmClaimBatch marks a claim batch posted with the proof that its root
+	// was submitted to the rewards contract.
+	ConfirmClaimBatch(context.Context, *MsgConfirmClaimBatch) (*MsgConfirmClaimBatchResponse, error)
+	// UpdateParams defines a governance operation for updating the x/symRewards
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) WithdrawOperatorRewards(ctx context.Context, req *MsgWithdrawOperatorRewards) (*MsgWithdrawOperatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOperatorRewards not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmClaimBatch(ctx context.Context, req *MsgConfirmClaimBatch) (*MsgConfirmClaimBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmClaimBatch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_WithdrawOperatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawOperatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawOperatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symRewards.v1.Msg/WithdrawOperatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawOperatorRewards(ctx, req.(*MsgWithdrawOperatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmClaimBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmClaimBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmClaimBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symRewards.v1.Msg/ConfirmClaimBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmClaimBatch(ctx, req.(*MsgConfirmClaimBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symRewards.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.symRewards.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WithdrawOperatorRewards",
+			Handler:    _Msg_WithdrawOperatorRewards_Handler,
+		},
+		{
+			MethodName: "ConfirmClaimBatch",
+			Handler:    _Msg_ConfirmClaimBatch_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/symRewards/v1/tx.proto",
+}
+
+func (m *MsgWithdrawOperatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawOperatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawOperatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawOperatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawOperatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawOperatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmClaimBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmClaimBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmClaimBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TxProof) > 0 {
+		for iNdEx := len(m.TxProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxProof[iNdEx])
+			copy(dAtA[i:], m.TxProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxProof[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SyncHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SyncHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmClaimBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmClaimBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmClaimBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWithdrawOperatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawOperatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConfirmClaimBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SyncHeight != 0 {
+		n += 1 + sovTx(uint64(m.SyncHeight))
+	}
+	if len(m.Headers) > 0 {
+		for _, b := range m.Headers {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if len(m.TxProof) > 0 {
+		for _, b := range m.TxProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConfirmClaimBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWithdrawOperatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawOperatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawOperatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmClaimBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmClaimBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmClaimBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncHeight", wireType)
+			}
+			m.SyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, make([]byte, postIndex-iNdEx))
+			copy(m.Headers[len(m.Headers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxProof = append(m.TxProof, make([]byte, postIndex-iNdEx))
+			copy(m.TxProof[len(m.TxProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmClaimBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmClaimBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmClaimBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,11 +23,31 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, cometService comet.Servi
 		return err
 	}
 	ci := cometService.CometInfo(ctx)
+
+	// punish the validators that double signed, as reported by CometBFT
+	for _, evidence := range ci.Evidence {
+		switch evidence.Type {
+		case comet.DuplicateVote, comet.LightClientAttack:
+			if err := k.HandleEquivocationEvidence(ctx, evidence); err != nil {
+				return err
+			}
+		default:
+			k.Logger.Error("ignored unknown evidence type", "type", evidence.Type)
+		}
+	}
+
 	for _, vote := range ci.LastCommit.Votes {
 		err := k.HandleValidatorSignatureWithParams(ctx, params, vote.Validator.Address, vote.Validator.Power, vote.BlockIDFlag)
 		if err != nil {
 			return err
 		}
 	}
+
+	// unjail the validators whose jail period is over, if enabled
+	if params.AutoUnjail {
+		if err := k.AutoUnjailValidators(ctx); err != nil {
+			return err
+		}
+	}
 	return nil
 }
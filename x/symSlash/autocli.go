@@ -34,10 +34,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "SlashRequest",
+					Use:       "slash-request [id]",
+					Short:     "Query a slash request to relay to the Symbiotic middleware",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "SlashRequests",
+					Use:       "slash-requests",
+					Short:     "Query the slash requests to relay to the Symbiotic middleware",
+					Example:   fmt.Sprintf("%s query symSlash slash-requests --pending", version.AppName),
+				},
+				{
+					RpcMethod: "UnjailEligibility",
+					Use:       "unjail-eligibility [validator-addr]",
+					Short:     "Query whether a validator can be unjailed, and the reasons it cannot",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service: slashingv1beta.Msg_ServiceDesc.ServiceName,
+			Service:              slashingv1beta.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Unjail",
@@ -54,6 +77,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod: "SignSlashRequest",
+					Skip:      true, // signed by the sign-slash-requests command
+				},
+				{
+					RpcMethod: "FulfillSlashRequest",
+					Skip:      true, // proven by the relay-slash-requests command
+				},
 			},
 		},
 	}
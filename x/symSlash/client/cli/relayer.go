@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"cosmossdk.io/x/symSlash/types"
+	stakingcli "cosmossdk.io/x/symStaking/client/cli"
+	"cosmossdk.io/x/symStaking/lightclient"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// syncPollInterval is the interval the relayer polls the chain at for a
+// Symbiotic sync reaching the block of a slash transaction.
+const syncPollInterval = 5 * time.Second
+
+// slashJournal holds the signed slash transactions by slash request id. It is
+// written before a transaction is sent, so that a relayer never signs a second
+// transaction for a request whose first one may have been sent.
+type slashJournal struct {
+	path string
+	txs  map[uint64]hexutil.Bytes
+}
+
+// loadJournal reads the journal at path, empty if the file does not exist.
+func loadJournal(path string) (*slashJournal, error) {
+	j := &slashJournal{path: path, txs: make(map[uint64]hexutil.Bytes)}
+
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return j, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, &j.txs); err != nil {
+		return nil, fmt.Errorf("invalid slash journal %s: %w", path, err)
+	}
+	return j, nil
+}
+
+// get returns the journaled transaction of a request, nil if there is none.
+func (j *slashJournal) get(id uint64) (*ethtypes.Transaction, error) {
+	raw, ok := j.txs[id]
+	if !ok {
+		return nil, nil
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid journaled transaction of slash request %d: %w", id, err)
+	}
+	return tx, nil
+}
+
+// put journals the transaction of a request, nil to forget it.
+func (j *slashJournal) put(id uint64, tx *ethtypes.Transaction) error {
+	if tx == nil {
+		delete(j.txs, id)
+	} else {
+		raw, err := tx.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		j.txs[id] = raw
+	}
+
+	bz, err := json.MarshalIndent(j.txs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(j.path), 0o700); err != nil {
+		return err
+	}
+
+	// the journal is replaced at once so that a crash cannot corrupt it
+	tmp := j.path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, j.path)
+}
+
+// middlewareRelayer submits slash requests to the middleware and proves their
+// execution.
+type middlewareRelayer struct {
+	client     *ethclient.Client
+	middleware common.Address
+	slasher    *types.MiddlewareSlasher
+	contract   *bind.BoundContract
+	opts       *bind.TransactOpts
+	journal    *slashJournal
+}
+
+func newMiddlewareRelayer(ctx context.Context, ethClient *ethclient.Client, middleware common.Address, slasher *types.MiddlewareSlasher, key *ecdsa.PrivateKey, journal *slashJournal) (*middlewareRelayer, error) {
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+	// the transactions are journaled before they are sent
+	opts.NoSend = true
+
+	return &middlewareRelayer{
+		client:     ethClient,
+		middleware: middleware,
+		slasher:    slasher,
+		// the calldata is packed by the slasher, the contract only signs it
+		contract: bind.NewBoundContract(middleware, abi.ABI{}, ethClient, ethClient, ethClient),
+		opts:     opts,
+		journal:  journal,
+	}, nil
+}
+
+// slash submits a slash request, or resumes the submission of its journaled
+// transaction, and waits for the transaction to be confirmed.
+func (r *middlewareRelayer) slash(ctx context.Context, clientCtx client.Context, req types.SlashRequest, confirmations uint64) (*ethtypes.Receipt, error) {
+	ethTx, err := r.journal.get(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ethTx == nil {
+		if ethTx, err = r.newSlashTx(ctx, req); err != nil {
+			return nil, err
+		}
+		if err := r.journal.put(req.Id, ethTx); err != nil {
+			return nil, fmt.Errorf("failed to journal the slash transaction: %w", err)
+		}
+		if err := r.client.SendTransaction(ctx, ethTx); err != nil {
+			return nil, err
+		}
+		_ = clientCtx.PrintString(fmt.Sprintf("slash request %d submitted in %s\n", req.Id, ethTx.Hash().Hex()))
+	} else if err := r.resume(ctx, ethTx); err != nil {
+		return nil, err
+	} else {
+		_ = clientCtx.PrintString(fmt.Sprintf("slash request %d resumed in %s\n", req.Id, ethTx.Hash().Hex()))
+	}
+
+	receipt, err := bind.WaitMined(ctx, r.client, ethTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.waitConfirmations(ctx, receipt, confirmations); err != nil {
+		return nil, err
+	}
+
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		// nothing was slashed, the request can be submitted again
+		if err := r.journal.put(req.Id, nil); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("slash transaction %s reverted", ethTx.Hash().Hex())
+	}
+
+	return receipt, nil
+}
+
+// newSlashTx returns the signed transaction submitting a slash request.
+func (r *middlewareRelayer) newSlashTx(ctx context.Context, req types.SlashRequest) (*ethtypes.Transaction, error) {
+	timestamp := req.BlockTimestamp
+	if timestamp == 0 {
+		timestamp = uint64(req.Time.Unix())
+	}
+
+	var epoch *big.Int
+	epochCalldata, err := r.slasher.EpochCalldata(timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if epochCalldata != nil {
+		res, err := r.client.CallContract(ctx, ethereum.CallMsg{To: &r.middleware, Data: epochCalldata}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the middleware epoch: %w", err)
+		}
+		if epoch, err = r.slasher.UnpackEpoch(res); err != nil {
+			return nil, err
+		}
+	}
+
+	calldata, err := r.slasher.SlashCalldata(req, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.contract.RawTransact(r.opts, calldata)
+}
+
+// resume sends a journaled transaction again unless it is already known. It
+// fails if the nonce of the transaction was used by another one.
+func (r *middlewareRelayer) resume(ctx context.Context, ethTx *ethtypes.Transaction) error {
+	if _, _, err := r.client.TransactionByHash(ctx, ethTx.Hash()); err == nil {
+		return nil
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return err
+	}
+
+	nonce, err := r.client.NonceAt(ctx, r.opts.From, nil)
+	if err != nil {
+		return err
+	}
+	if nonce > ethTx.Nonce() {
+		return fmt.Errorf("nonce %d of slash transaction %s was used by another transaction, check the middleware before removing it from the journal", ethTx.Nonce(), ethTx.Hash().Hex())
+	}
+
+	return r.client.SendTransaction(ctx, ethTx)
+}
+
+// waitConfirmations waits for a transaction receipt to be confirmed by
+// confirmations blocks and checks it is still canonical.
+func (r *middlewareRelayer) waitConfirmations(ctx context.Context, receipt *ethtypes.Receipt, confirmations uint64) error {
+	target := receipt.BlockNumber.Uint64() + confirmations
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		number, err := r.client.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		if number >= target {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	confirmed, err := r.client.TransactionReceipt(ctx, receipt.TxHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return fmt.Errorf("slash transaction %s was reorged out", receipt.TxHash.Hex())
+		}
+		return err
+	}
+	if confirmed.BlockHash != receipt.BlockHash || confirmed.Status != receipt.Status {
+		return fmt.Errorf("slash transaction %s was reorged", receipt.TxHash.Hex())
+	}
+
+	return nil
+}
+
+// proveSlash returns the fulfillment of a slash request executed by the
+// transaction of receipt, proven against the block of the first Symbiotic
+// sync reaching it.
+func (r *middlewareRelayer) proveSlash(ctx context.Context, stakingQueryClient stakingtypes.QueryClient, relayer string, req types.SlashRequest, receipt *ethtypes.Receipt) (*types.MsgFulfillSlashRequest, error) {
+	sync, err := stakingcli.WaitSymbioticSync(ctx, stakingQueryClient, receipt.BlockNumber.Uint64(), types.MaxSlashProofHeaders, syncPollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := lightclient.ProveInclusion(ctx, r.client.Client(), common.HexToHash(sync.BlockHash), receipt, types.MaxSlashProofHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgFulfillSlashRequest(relayer, req.Id, receipt.TxHash.Hex(), sync.Height, proof.Headers, proof.TxIndex, proof.TxProof, proof.ReceiptProof), nil
+}
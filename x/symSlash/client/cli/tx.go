@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagEthRPC            = "eth-rpc"
+	FlagEthKeyFile        = "eth-key-file"
+	FlagMiddlewareAddress = "middleware-address"
+	FlagConfirmations     = "confirmations"
+	FlagJournal           = "journal"
+	FlagValidator         = "validator"
+)
+
+// NewTxCmd returns a root CLI command handler for the x/symSlash transaction
+// commands not generated by AutoCLI.
+func NewTxCmd() *cobra.Command {
+	slashingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Slashing transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	slashingTxCmd.AddCommand(
+		NewSignSlashRequestsCmd(),
+		NewRelaySlashRequestsCmd(),
+	)
+
+	return slashingTxCmd
+}
+
+// NewSignSlashRequestsCmd returns a CLI command handler that signs the
+// pending slash requests with the Ethereum key of the operator of a
+// validator.
+func NewSignSlashRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-slash-requests",
+		Short: "Sign the pending slash requests as the operator of a validator",
+		Long: strings.TrimSpace(`Sign the EIP-712 digest of the slash requests not signed yet by the validator
+with the Ethereum key of its operator, the one the validator is bound to or else
+the one the middleware reports for it. The signatures are submitted in a single
+transaction sent by --from, which can be any account.
+
+A slash request is relayed once its signers hold more than 2/3 of the voting
+power.`),
+		Example: fmt.Sprintf("%s tx symSlash sign-slash-requests --eth-key-file operator.key --from validator", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keyFile, _ := cmd.Flags().GetString(FlagEthKeyFile)
+			key, err := crypto.LoadECDSA(keyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load the Ethereum key: %w", err)
+			}
+
+			validator, _ := cmd.Flags().GetString(FlagValidator)
+			if validator == "" {
+				if validator, err = clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress()); err != nil {
+					return err
+				}
+			}
+
+			signer, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			pending, err := pendingSlashRequests(cmd.Context(), types.NewQueryClient(clientCtx))
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for _, req := range pending {
+				if req.SignedBy(validator) {
+					continue
+				}
+				signature, err := crypto.Sign(types.SlashRequestSignBytes(clientCtx.ChainID, req), key)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, types.NewMsgSignSlashRequest(signer, req.Id, validator, signature))
+			}
+
+			if len(msgs) == 0 {
+				return clientCtx.PrintString("no slash requests to sign\n")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(FlagEthKeyFile, "", "File holding the hex encoded private key of the operator")
+	cmd.Flags().String(FlagValidator, "", "Operator address of the validator to sign for, defaults to the validator of --from")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagEthKeyFile)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// NewRelaySlashRequestsCmd returns a CLI command handler that submits the
+// signed slash requests to the Symbiotic middleware and proves their
+// execution once their transactions are confirmed.
+func NewRelaySlashRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-slash-requests",
+		Short: "Submit the signed slash requests to the Symbiotic middleware",
+		Long: strings.TrimSpace(`Submit the signed slash requests to the slash method of the Symbiotic
+middleware described by the slash_abi param, signing the Ethereum transactions
+with the key of the middleware owner. Once a transaction is confirmed and a
+Symbiotic sync reached its block, the request is marked fulfilled with a
+MsgFulfillSlashRequest holding the proof of the transaction and its receipt,
+sent by --from, which can be any account.
+
+The middleware epoch of a request is read with the epoch method at the timestamp
+of the execution block of the Symbiotic sync applied at the infraction, and the
+key is the one the middleware identifies the validator by. The request id and
+the operator signatures are passed if the slash method takes them.
+
+Every signed Ethereum transaction is written to the journal before it is sent.
+A request found in the journal is never signed again: its transaction is
+looked up, and sent again as is if it is unknown, so that a relayer restarted
+after a failure cannot slash twice.`),
+		Example: fmt.Sprintf("%s tx symSlash relay-slash-requests --eth-rpc http://localhost:8545 --eth-key-file owner.key --from relayer", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ethRPC, _ := cmd.Flags().GetString(FlagEthRPC)
+			keyFile, _ := cmd.Flags().GetString(FlagEthKeyFile)
+			middlewareAddress, _ := cmd.Flags().GetString(FlagMiddlewareAddress)
+			confirmations, _ := cmd.Flags().GetUint64(FlagConfirmations)
+			journalFile, _ := cmd.Flags().GetString(FlagJournal)
+			if journalFile == "" {
+				journalFile = filepath.Join(clientCtx.HomeDir, "data", "slash-relayer-journal.json")
+			}
+
+			key, err := crypto.LoadECDSA(keyFile)
+			if err != nil {
+				return fmt.Errorf("failed to load the Ethereum key: %w", err)
+			}
+
+			ctx := cmd.Context()
+			queryClient := types.NewQueryClient(clientCtx)
+			stakingQueryClient := stakingtypes.NewQueryClient(clientCtx)
+
+			if middlewareAddress == "" {
+				res, err := stakingQueryClient.Params(ctx, &stakingtypes.QueryParamsRequest{})
+				if err != nil {
+					return err
+				}
+				middlewareAddress = res.Params.MiddlewareAddress
+			}
+			if !common.IsHexAddress(middlewareAddress) {
+				return fmt.Errorf("invalid middleware address %q", middlewareAddress)
+			}
+
+			params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			slasher, err := types.NewMiddlewareSlasher(params.Params.SlashAbi)
+			if err != nil {
+				return err
+			}
+
+			pending, err := pendingSlashRequests(ctx, queryClient)
+			if err != nil {
+				return err
+			}
+			var signed []types.SlashRequest
+			for _, req := range pending {
+				if req.Status == types.SlashRequestSigned {
+					signed = append(signed, req)
+				}
+			}
+			if len(signed) == 0 {
+				return clientCtx.PrintString("no signed slash requests\n")
+			}
+
+			journal, err := loadJournal(journalFile)
+			if err != nil {
+				return err
+			}
+
+			ethClient, err := ethclient.DialContext(ctx, ethRPC)
+			if err != nil {
+				return err
+			}
+			defer ethClient.Close()
+
+			relayer, err := newMiddlewareRelayer(ctx, ethClient, common.HexToAddress(middlewareAddress), slasher, key, journal)
+			if err != nil {
+				return err
+			}
+
+			relayerAddr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// the fulfillments are broadcast in a single transaction, including
+			// the ones relayed before a failure
+			var (
+				msgs     []sdk.Msg
+				relayErr error
+			)
+			for _, req := range signed {
+				receipt, err := relayer.slash(ctx, clientCtx, req, confirmations)
+				if err != nil {
+					relayErr = fmt.Errorf("failed to relay slash request %d: %w", req.Id, err)
+					break
+				}
+
+				msg, err := relayer.proveSlash(ctx, stakingQueryClient, relayerAddr, req, receipt)
+				if err != nil {
+					relayErr = fmt.Errorf("failed to prove slash request %d: %w", req.Id, err)
+					break
+				}
+				msgs = append(msgs, msg)
+			}
+
+			if len(msgs) > 0 {
+				if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...); err != nil {
+					return err
+				}
+			}
+
+			return relayErr
+		},
+	}
+
+	cmd.Flags().String(FlagEthRPC, "http://localhost:8545", "Ethereum execution RPC endpoint")
+	cmd.Flags().String(FlagEthKeyFile, "", "File holding the hex encoded private key of the middleware owner")
+	cmd.Flags().String(FlagMiddlewareAddress, "", "Address of the Symbiotic middleware, defaults to the x/symStaking middleware_address param")
+	cmd.Flags().Uint64(FlagConfirmations, 12, "Number of blocks the slash transaction must be confirmed by")
+	cmd.Flags().String(FlagJournal, "", "File the signed slash transactions are journaled in, defaults to data/slash-relayer-journal.json in the home directory")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagEthKeyFile)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// pendingSlashRequests queries all the slash requests not fulfilled yet.
+func pendingSlashRequests(ctx context.Context, queryClient types.QueryClient) ([]types.SlashRequest, error) {
+	var (
+		pending []types.SlashRequest
+		nextKey []byte
+	)
+	for {
+		res, err := queryClient.SlashRequests(ctx, &types.QuerySlashRequestsRequest{
+			Pending:    true,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, res.SlashRequests...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return pending, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HandleEquivocationEvidence handles the double sign evidence of a validator
+// reported by CometBFT. The validator is jailed forever and tombstoned, and
+// the slash_fraction_double_sign of its stake is queued to be slashed on the
+// Symbiotic middleware.
+//
+// The evidence must be of a height the x/symStaking historical info is kept
+// for, and of the same block time, otherwise it is ignored.
+func (k Keeper) HandleEquivocationEvidence(ctx context.Context, evidence comet.Evidence) error {
+	consAddr := sdk.ConsAddress(evidence.Validator.Address)
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger.With("validator", consStr, "infraction_height", evidence.Height)
+
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			logger.Info("ignored equivocation; validator not found")
+			return nil
+		}
+		return err
+	}
+
+	// read the cons address again because the validator may have rotated its
+	// key since the infraction
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	consAddr = sdk.ConsAddress(valConsAddr)
+
+	// the evidence of an unbonded validator is stale, its stake has left the
+	// validator set
+	if validator.IsUnbonded() {
+		logger.Info("ignored equivocation; validator is unbonded")
+		return nil
+	}
+
+	record, err := k.sk.GetHistoricalInfo(ctx, evidence.Height)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			logger.Info("ignored equivocation; no historical info at the infraction height")
+			return nil
+		}
+		return err
+	}
+
+	if record.Time == nil || !record.Time.Equal(evidence.Time) {
+		logger.Info("ignored equivocation; evidence time does not match the historical info", "time", evidence.Time)
+		return nil
+	}
+
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		logger.Info("ignored equivocation; validator has no signing info")
+		return nil
+	}
+
+	// a tombstoned validator was already punished for a double sign
+	if k.IsTombstoned(ctx, consAddr) {
+		logger.Info("ignored equivocation; validator is already tombstoned")
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSlash,
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+		event.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", evidence.Validator.Power)),
+		event.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+	); err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		if err := k.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime); err != nil {
+		return err
+	}
+
+	if err := k.Tombstone(ctx, consAddr); err != nil {
+		return err
+	}
+
+	// stake lives on Ethereum, the slash is relayed to the middleware
+	if err := k.QueueSlashRequest(ctx, validator, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, evidence.Height, params.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+
+	logger.Info(
+		"slashing and tombstoning validator due to equivocation",
+		"slash_fraction", params.SlashFractionDoubleSign,
+		"power", evidence.Validator.Power,
+	)
+
+	return nil
+}
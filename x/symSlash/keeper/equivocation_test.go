@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/symSlash/types"
+	"cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestHandleEquivocationEvidence() {
+	require := s.Require()
+
+	infractionTime := time.Unix(1700000000, 0).UTC()
+	testCases := []struct {
+		name      string
+		malleate  func(val types.Validator, consAddr sdk.ConsAddress)
+		punished  bool
+		tombstone bool
+	}{
+		{
+			name: "validator not found",
+			malleate: func(_ types.Validator, consAddr sdk.ConsAddress) {
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(types.Validator{}, types.ErrNoValidatorFound)
+			},
+		},
+		{
+			name: "evidence older than the historical info",
+			malleate: func(val types.Validator, consAddr sdk.ConsAddress) {
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(types.HistoricalRecord{}, collections.ErrNotFound)
+			},
+		},
+		{
+			name: "evidence time does not match the historical info",
+			malleate: func(val types.Validator, consAddr sdk.ConsAddress) {
+				other := infractionTime.Add(time.Second)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(types.HistoricalRecord{Time: &other}, nil)
+			},
+		},
+		{
+			name: "validator already tombstoned",
+			malleate: func(val types.Validator, consAddr sdk.ConsAddress) {
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(types.HistoricalRecord{Time: &infractionTime}, nil)
+				require.NoError(s.slashingKeeper.Tombstone(s.ctx, consAddr))
+			},
+			tombstone: true,
+		},
+		{
+			name: "validator punished",
+			malleate: func(val types.Validator, consAddr sdk.ConsAddress) {
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(types.HistoricalRecord{Time: &infractionTime}, nil)
+				s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), int64(5)).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
+			},
+			punished:  true,
+			tombstone: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx, keeper := s.ctx.WithBlockHeight(10), s.slashingKeeper
+
+			val, consStr := s.slashRequestValidator(1000)
+			val.Status = types.Bonded
+			consAddr, err := val.GetConsAddr()
+			require.NoError(err)
+			require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, 0)))
+
+			tc.malleate(val, consAddr)
+			require.NoError(keeper.HandleEquivocationEvidence(ctx, comet.Evidence{
+				Type:      comet.DuplicateVote,
+				Validator: comet.Validator{Address: consAddr, Power: 10},
+				Height:    5,
+				Time:      infractionTime,
+			}))
+
+			require.Equal(tc.tombstone, keeper.IsTombstoned(ctx, consAddr))
+
+			has, err := keeper.SlashRequestQueue.Has(ctx, 0)
+			require.NoError(err)
+			require.Equal(tc.punished, has)
+			if !tc.punished {
+				return
+			}
+
+			info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+			require.NoError(err)
+			require.True(info.JailedUntil.Equal(slashingtypes.DoubleSignJailEndTime))
+
+			req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+			require.NoError(err)
+			require.Equal(types.Infraction_INFRACTION_DOUBLE_SIGN, req.Infraction)
+			require.Equal(int64(5), req.Height)
+			require.Equal(slashingtypes.DefaultSlashFractionDoubleSign, req.Fraction)
+			require.Equal(sdkmath.NewInt(50), req.Amount)
+		})
+	}
+}
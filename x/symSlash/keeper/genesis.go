@@ -60,6 +60,27 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	nextSlashRequestID := uint64(1)
+	for _, req := range data.SlashRequests {
+		if err := keeper.SlashRequestQueue.Set(ctx, req.Id, req); err != nil {
+			return err
+		}
+		if req.Status != types.SlashRequestFulfilled {
+			if err := keeper.PendingSlashRequests.Set(ctx, req.Id); err != nil {
+				return err
+			}
+		} else if req.TxHash != "" {
+			if err := keeper.FulfilledSlashTxs.Set(ctx, req.TxHash, req.Id); err != nil {
+				return err
+			}
+		}
+		nextSlashRequestID = max(nextSlashRequestID, req.Id+1)
+	}
+
+	if err := keeper.SlashRequestSequence.Set(ctx, nextSlashRequestID); err != nil {
+		return err
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -101,5 +122,17 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	slashRequests := make([]types.SlashRequest, 0)
+	err = keeper.SlashRequestQueue.Walk(ctx, nil, func(_ uint64, req types.SlashRequest) (stop bool, err error) {
+		slashRequests = append(slashRequests, req)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs := types.NewGenesisState(params, signingInfos, missedBlocks)
+	gs.SlashRequests = slashRequests
+	return gs, nil
 }
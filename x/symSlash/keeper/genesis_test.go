@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"strings"
 	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/symSlash/testutil"
 	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -63,3 +66,45 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
 }
+
+func (s *KeeperTestSuite) TestExportAndInitGenesisSlashRequests() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	val, _ := s.slashRequestValidator(1000)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).Times(2)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, stakingtypes.Infraction_INFRACTION_DOWNTIME, 1, math.LegacyNewDecWithPrec(1, 1)))
+	require.NoError(keeper.QueueSlashRequest(ctx, val, stakingtypes.Infraction_INFRACTION_DOWNTIME, 2, math.LegacyNewDecWithPrec(1, 1)))
+
+	txHash := "0x" + strings.Repeat("ab", 32)
+	req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+	require.NoError(err)
+	req.Status = types.SlashRequestFulfilled
+	req.TxHash = txHash
+	require.NoError(keeper.SlashRequestQueue.Set(ctx, 0, req))
+	require.NoError(keeper.PendingSlashRequests.Remove(ctx, 0))
+
+	genesisState, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesisState.SlashRequests, 2)
+
+	s.SetupTest()
+	ctx, keeper = s.ctx, s.slashingKeeper
+	s.stakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).Return(nil)
+	require.NoError(keeper.InitGenesis(ctx, s.stakingKeeper, genesisState))
+
+	pending, err := keeper.PendingSlashRequests.Has(ctx, 0)
+	require.NoError(err)
+	require.False(pending)
+	id, err := keeper.FulfilledSlashTxs.Get(ctx, txHash)
+	require.NoError(err)
+	require.Equal(uint64(0), id)
+	pending, err = keeper.PendingSlashRequests.Has(ctx, 1)
+	require.NoError(err)
+	require.True(pending)
+
+	// new requests follow the imported ones
+	next, err := keeper.SlashRequestSequence.Peek(ctx)
+	require.NoError(err)
+	require.Equal(uint64(2), next)
+}
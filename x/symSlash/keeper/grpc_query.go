@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -80,3 +83,79 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// SlashRequest returns a slash request by id.
+func (k Keeper) SlashRequest(ctx context.Context, req *types.QuerySlashRequestRequest) (*types.QuerySlashRequestResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	slashRequest, err := k.SlashRequestQueue.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "slash request %d not found", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRequestResponse{SlashRequest: slashRequest}, nil
+}
+
+// SlashRequests returns the slash requests, or the pending ones only.
+func (k Keeper) SlashRequests(ctx context.Context, req *types.QuerySlashRequestsRequest) (*types.QuerySlashRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		slashRequests []types.SlashRequest
+		pageRes       *query.PageResponse
+		err           error
+	)
+	if req.Pending {
+		slashRequests, pageRes, err = query.CollectionPaginate(ctx, k.PendingSlashRequests, req.Pagination, func(id uint64, _ collections.NoValue) (types.SlashRequest, error) {
+			return k.SlashRequestQueue.Get(ctx, id)
+		})
+	} else {
+		slashRequests, pageRes, err = query.CollectionPaginate(ctx, k.SlashRequestQueue, req.Pagination, func(_ uint64, slashRequest types.SlashRequest) (types.SlashRequest, error) {
+			return slashRequest, nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRequestsResponse{SlashRequests: slashRequests, Pagination: pageRes}, nil
+}
+
+// UnjailEligibility returns whether a validator can be unjailed at this block
+// and the reasons it cannot.
+func (k Keeper) UnjailEligibility(ctx context.Context, req *types.QueryUnjailEligibilityRequest) (*types.QueryUnjailEligibilityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := k.unjailEligibility(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &res, nil
+}
@@ -4,9 +4,14 @@ import (
 	gocontext "context"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/symSlash/testutil"
 	slashingtypes "cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -94,3 +99,100 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCSlashRequests() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.SlashRequest(gocontext.Background(), &slashingtypes.QuerySlashRequestRequest{Id: 0})
+	require.ErrorContains(err, "slash request 0 not found")
+
+	val, _ := s.slashRequestValidator(1000)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound).Times(3)
+	for i := 0; i < 3; i++ {
+		require.NoError(keeper.QueueSlashRequest(ctx, val, stakingtypes.Infraction_INFRACTION_DOWNTIME, int64(i), math.LegacyNewDecWithPrec(1, 1)))
+	}
+	req, err := keeper.SlashRequestQueue.Get(ctx, 1)
+	require.NoError(err)
+	req.Status = slashingtypes.SlashRequestFulfilled
+	require.NoError(keeper.SlashRequestQueue.Set(ctx, 1, req))
+	require.NoError(keeper.PendingSlashRequests.Remove(ctx, 1))
+
+	res, err := queryClient.SlashRequest(gocontext.Background(), &slashingtypes.QuerySlashRequestRequest{Id: 1})
+	require.NoError(err)
+	require.Equal(slashingtypes.SlashRequestFulfilled, res.SlashRequest.Status)
+
+	all, err := queryClient.SlashRequests(gocontext.Background(), &slashingtypes.QuerySlashRequestsRequest{})
+	require.NoError(err)
+	require.Len(all.SlashRequests, 3)
+
+	pending, err := queryClient.SlashRequests(gocontext.Background(), &slashingtypes.QuerySlashRequestsRequest{
+		Pending:    true,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(pending.SlashRequests, 1)
+	require.Equal(uint64(0), pending.SlashRequests[0].Id)
+
+	pending, err = queryClient.SlashRequests(gocontext.Background(), &slashingtypes.QuerySlashRequestsRequest{
+		Pending:    true,
+		Pagination: &query.PageRequest{Key: pending.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(pending.SlashRequests, 1)
+	require.Equal(uint64(2), pending.SlashRequests[0].Id)
+}
+
+func (s *KeeperTestSuite) TestGRPCUnjailEligibility() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.UnjailEligibility(gocontext.Background(), &slashingtypes.QueryUnjailEligibilityRequest{ValidatorAddr: ""})
+	require.ErrorContains(err, "invalid request")
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(pubKey.Address())
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+	_, err = queryClient.UnjailEligibility(gocontext.Background(), &slashingtypes.QueryUnjailEligibilityRequest{ValidatorAddr: valStr})
+	require.ErrorContains(err, "not found")
+
+	val, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Jailed = true
+	val.Tokens = slashingtypes.DefaultMinOperatorStake
+
+	jailedUntil := ctx.HeaderInfo().Time.Add(time.Hour)
+	info := slashingtypes.NewValidatorSigningInfo(consStr, 0, jailedUntil, false, 0)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(pubKey.Address()), info))
+
+	// the stake of the last sync is used over the validator tokens
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{
+		Height: 10,
+		Stakes: []stakingtypes.SymbioticSyncStake{{ValidatorAddress: valStr, Stake: math.NewInt(10)}},
+	}, nil)
+	res, err := queryClient.UnjailEligibility(gocontext.Background(), &slashingtypes.QueryUnjailEligibilityRequest{ValidatorAddr: valStr})
+	require.NoError(err)
+	require.False(res.Eligible)
+	require.Equal([]string{slashingtypes.UnjailReasonJailPeriod, slashingtypes.UnjailReasonMinOperatorStake}, res.Reasons)
+	require.Equal(math.NewInt(10), res.Stake)
+	require.Equal(slashingtypes.DefaultMinOperatorStake, res.MinOperatorStake)
+	require.Equal(int64(10), res.SyncHeight)
+	require.True(jailedUntil.Equal(res.JailedUntil))
+
+	// the validator tokens are used until a sync is applied
+	info.JailedUntil = time.Unix(2, 0)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, sdk.ConsAddress(pubKey.Address()), info))
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(gomock.Any(), gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound)
+	res, err = queryClient.UnjailEligibility(gocontext.Background(), &slashingtypes.QueryUnjailEligibilityRequest{ValidatorAddr: valStr})
+	require.NoError(err)
+	require.True(res.Eligible)
+	require.Empty(res.Reasons)
+	require.Equal(val.Tokens, res.Stake)
+}
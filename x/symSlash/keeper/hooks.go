@@ -6,7 +6,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -65,6 +67,16 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress
 	return h.k.AddrPubkeyRelation.Set(ctx, consPk.Address(), consPk)
 }
 
+// AfterConsensusPubKeyUpdate moves the signing info of a validator to its new
+// consensus address and deletes the address-pubkey relation of the old one.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := h.k.performConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey); err != nil {
+		return err
+	}
+
+	return h.k.AddrPubkeyRelation.Remove(ctx, oldPubKey.Address())
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
@@ -80,3 +92,11 @@ func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdk
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) AfterSymbioticStakeChanged(_ context.Context, _ sdk.ValAddress, _, _ sdkmath.Int) error {
+	return nil
+}
+
+func (h Hooks) AfterSymbioticSync(_ context.Context, _ stakingtypes.SymbioticSyncRecord) error {
+	return nil
+}
@@ -44,3 +44,45 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, oldAddr := testdata.KeyTestPubAddr()
+	_, newPubKey, newAddr := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldAddr), sdk.ConsAddress(newAddr)
+
+	require.NoError(keeper.AddrPubkeyRelation.Set(ctx, oldPubKey.Address(), oldPubKey))
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, oldConsAddr, sdk.ValAddress(oldAddr)))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 3, true))
+
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey))
+
+	// the signing info and missed blocks follow the new consensus address
+	require.False(keeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, newConsAddr)
+	require.NoError(err)
+	newConsStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(newConsAddr)
+	require.NoError(err)
+	require.Equal(newConsStr, info.Address)
+
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 3)
+	require.NoError(err)
+	require.True(missed)
+	missed, err = keeper.GetMissedBlockBitmapValue(ctx, oldConsAddr, 3)
+	require.NoError(err)
+	require.False(missed)
+
+	_, err = keeper.GetPubkey(ctx, oldPubKey.Address())
+	require.Error(err)
+	pubKey, err := keeper.GetPubkey(ctx, newPubKey.Address())
+	require.NoError(err)
+	require.Equal(newPubKey, pubKey)
+
+	// a validator which was never bonded has no signing info to move
+	_, unbondedPubKey, _ := testdata.KeyTestPubAddr()
+	_, otherPubKey, otherAddr := testdata.KeyTestPubAddr()
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, unbondedPubKey, otherPubKey))
+	require.False(keeper.HasValidatorSigningInfo(ctx, sdk.ConsAddress(otherAddr)))
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -156,6 +157,12 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
+
+			// stake lives on Ethereum, the slash is relayed to the middleware
+			err = k.QueueSlashRequest(ctx, validator, stakingtypes.Infraction_INFRACTION_DOWNTIME, height, slashFractionDowntime)
+			if err != nil {
+				return err
+			}
 			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
 			if err != nil {
 				return err
@@ -33,6 +33,14 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// SlashRequestQueue key: ID | value: SlashRequest
+	SlashRequestQueue collections.Map[uint64, types.SlashRequest]
+	// PendingSlashRequests key: ID of the slash requests waiting to be relayed
+	PendingSlashRequests collections.KeySet[uint64]
+	// SlashRequestSequence is the ID of the next slash request
+	SlashRequestSequence collections.Sequence
+	// FulfilledSlashTxs key: Ethereum tx hash | value: ID of the slash request it fulfilled
+	FulfilledSlashTxs collections.Map[string, uint64]
 }
 
 // NewKeeper creates a slashing keeper
@@ -66,6 +74,10 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		SlashRequestQueue:    collections.NewMap(sb, types.SlashRequestsKeyPrefix, "slash_requests", collections.Uint64Key, codec.CollValue[types.SlashRequest](cdc)),
+		PendingSlashRequests: collections.NewKeySet(sb, types.PendingSlashRequestsKeyPrefix, "pending_slash_requests", collections.Uint64Key),
+		SlashRequestSequence: collections.NewSequence(sb, types.SlashRequestSequenceKey, "slash_request_sequence"),
+		FulfilledSlashTxs:    collections.NewMap(sb, types.FulfilledSlashTxsKeyPrefix, "fulfilled_slash_txs", collections.StringKey, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -15,6 +15,7 @@ import (
 	slashingkeeper "cosmossdk.io/x/symSlash/keeper"
 	slashingtestutil "cosmossdk.io/x/symSlash/testutil"
 	slashingtypes "cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -54,6 +55,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
+	s.stakingKeeper.EXPECT().MiddlewareKeyType(gomock.Any()).Return(stakingtypes.KeyTypeConsAddress, nil).AnyTimes()
 
 	authStr, err := address.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(slashingtypes.GovModuleName))
 	s.Require().NoError(err)
@@ -116,7 +118,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"fc567518ff7fee7da2df0e7c6897b285e544567fee0cd7217dabc516fa54d8d8",
 	)
 	s.Require().NoError(err)
 
@@ -128,7 +130,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"fc567518ff7fee7da2df0e7c6897b285e544567fee0cd7217dabc516fa54d8d8",
 	)
 	s.Require().NoError(err)
 }
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/symSlash/types"
@@ -39,6 +43,40 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SignSlashRequest implements MsgServer.SignSlashRequest method.
+// Any account can submit the signature of the operator of a validator.
+func (k msgServer) SignSlashRequest(ctx context.Context, msg *types.MsgSignSlashRequest) (*types.MsgSignSlashRequestResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if len(msg.Signature) != crypto.SignatureLength {
+		return nil, errors.Wrapf(types.ErrInvalidSlashSignature, "invalid signature length %d, expected %d", len(msg.Signature), crypto.SignatureLength)
+	}
+
+	if err := k.Keeper.SignSlashRequest(ctx, msg.Id, valAddr, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignSlashRequestResponse{}, nil
+}
+
+// FulfillSlashRequest implements MsgServer.FulfillSlashRequest method.
+// Any account can submit the proof that the slash of a signed request was
+// executed on Ethereum.
+func (k msgServer) FulfillSlashRequest(ctx context.Context, msg *types.MsgFulfillSlashRequest) (*types.MsgFulfillSlashRequestResponse, error) {
+	if txHash, err := hex.DecodeString(strings.TrimPrefix(msg.TxHash, "0x")); err != nil || len(txHash) != 32 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid tx hash %q", msg.TxHash)
+	}
+
+	if err := k.Keeper.FulfillSlashRequest(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillSlashRequestResponse{}, nil
+}
+
 // Unjail implements MsgServer.Unjail method.
 // Validators must submit a transaction to unjail itself after
 // having been jailed (and thus unbonded) for downtime
@@ -1,10 +1,20 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
+	"math/big"
 	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	slashingtypes "cosmossdk.io/x/symSlash/types"
+	"cosmossdk.io/x/symStaking/lightclient"
 	"cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -115,6 +125,22 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid min operator stake",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					MinOperatorStake:        sdkmath.NewInt(-1),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "min operator stake cannot be negative",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
@@ -125,6 +151,8 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					DowntimeJailDuration:    time.Duration(34800000000000),
 					SlashFractionDoubleSign: slashFractionDoubleSign,
 					SlashFractionDowntime:   slashFractionDowntime,
+					MinOperatorStake:        slashingtypes.DefaultMinOperatorStake,
+					AutoUnjail:              true,
 				},
 			},
 			expectErr: false,
@@ -200,6 +228,7 @@ func (s *KeeperTestSuite) TestUnjail() {
 
 				s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
 				s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(s.ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
 
 				return &slashingtypes.MsgUnjail{
 					ValidatorAddr: valStr,
@@ -229,6 +258,7 @@ func (s *KeeperTestSuite) TestUnjail() {
 
 				s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
 				s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(s.ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
 
 				return &slashingtypes.MsgUnjail{
 					ValidatorAddr: valStr,
@@ -258,6 +288,7 @@ func (s *KeeperTestSuite) TestUnjail() {
 
 				s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
 				s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(s.ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
 
 				return &slashingtypes.MsgUnjail{
 					ValidatorAddr: valStr,
@@ -266,6 +297,41 @@ func (s *KeeperTestSuite) TestUnjail() {
 			expErr:    true,
 			expErrMsg: "validator still jailed; cannot be unjailed",
 		},
+		{
+			name: "operator stake too low: invalid request",
+			malleate: func() *slashingtypes.MsgUnjail {
+				_, pubKey, addr := testdata.KeyTestPubAddr()
+				valAddr := sdk.ValAddress(addr)
+				valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+				s.Require().NoError(err)
+				consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(addr)
+				s.Require().NoError(err)
+
+				// the validator tokens are above the minimum but not its stake
+				// at the last sync
+				val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+				val.Tokens = sdkmath.NewInt(10000000)
+				val.Jailed = true
+
+				s.Require().NoError(err)
+
+				info := slashingtypes.NewValidatorSigningInfo(consStr, int64(4),
+					time.Unix(2, 0), false, int64(10))
+
+				s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
+				s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(s.ctx, gomock.Any()).Return(types.SymbioticSyncRecord{
+					Height: 10,
+					Stakes: []types.SymbioticSyncStake{{ValidatorAddress: valStr, Stake: sdkmath.NewInt(10)}},
+				}, nil)
+
+				return &slashingtypes.MsgUnjail{
+					ValidatorAddr: valStr,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "validator's operator stake less than minimum",
+		},
 		{
 			name: "valid request",
 			malleate: func() *slashingtypes.MsgUnjail {
@@ -287,6 +353,10 @@ func (s *KeeperTestSuite) TestUnjail() {
 
 				s.Require().NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, sdk.ConsAddress(addr), info))
 				s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+				s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(s.ctx, gomock.Any()).Return(types.SymbioticSyncRecord{
+					Height: 10,
+					Stakes: []types.SymbioticSyncStake{{ValidatorAddress: valStr, Stake: slashingtypes.DefaultMinOperatorStake}},
+				}, nil)
 
 				s.stakingKeeper.EXPECT().Unjail(s.ctx, sdk.ConsAddress(addr)).Return(nil).AnyTimes()
 
@@ -313,3 +383,274 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignSlashRequest() {
+	ctx := s.ctx.WithHeaderInfo(header.Info{ChainID: "test-chain", Time: s.ctx.HeaderInfo().Time})
+	keeper := s.slashingKeeper
+	require := s.Require()
+
+	signerStr := sdk.MustBech32ifyAddressBytes("cosmos", []byte("signer______________"))
+
+	val, _ := s.slashRequestValidator(1000)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOWNTIME, 1, sdkmath.LegacyNewDecWithPrec(1, 1)))
+	req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+	require.NoError(err)
+
+	// two bound validators holding 40% of the power each and an unbonded one
+	var (
+		valStrs [3]string
+		keys    [3]*ecdsa.PrivateKey
+	)
+	for i := range valStrs {
+		_, _, addr := testdata.KeyTestPubAddr()
+		valAddr := sdk.ValAddress(addr)
+		valStrs[i], err = s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		require.NoError(err)
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(err)
+
+		power := int64(40)
+		if i == 2 {
+			power = 0
+		}
+		s.stakingKeeper.EXPECT().GetLastValidatorPower(ctx, valAddr).Return(power, nil).AnyTimes()
+		s.stakingKeeper.EXPECT().GetOperatorBinding(ctx, valAddr).Return(types.OperatorBinding{
+			ValidatorAddress: valStrs[i],
+			Operator:         crypto.PubkeyToAddress(keys[i].PublicKey).Hex(),
+		}, true, nil).AnyTimes()
+	}
+	s.stakingKeeper.EXPECT().GetLastTotalPower(ctx).Return(sdkmath.NewInt(100), nil).AnyTimes()
+
+	sign := func(key *ecdsa.PrivateKey, chainID string) []byte {
+		signature, err := crypto.Sign(slashingtypes.SlashRequestSignBytes(chainID, req), key)
+		require.NoError(err)
+		return signature
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *slashingtypes.MsgSignSlashRequest
+		expSigned bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid signature length",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[0], []byte{0x01}),
+			expErrMsg: "invalid signature length",
+		},
+		{
+			name:      "unknown request",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 7, valStrs[0], sign(keys[0], "test-chain")),
+			expErrMsg: "slash request not found",
+		},
+		{
+			name:      "validator not bonded",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[2], sign(keys[2], "test-chain")),
+			expErrMsg: "is not bonded",
+		},
+		{
+			name:      "not signed by the operator",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[0], sign(keys[1], "test-chain")),
+			expErrMsg: "invalid slash request signature",
+		},
+		{
+			name:      "signed for another chain",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[0], sign(keys[0], "other-chain")),
+			expErrMsg: "invalid slash request signature",
+		},
+		{
+			name: "signed by 40% of the power",
+			msg:  slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[0], sign(keys[0], "test-chain")),
+		},
+		{
+			name:      "already signed by the validator",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[0], sign(keys[0], "test-chain")),
+			expErrMsg: "slash request already signed by validator",
+		},
+		{
+			name:      "signed by 80% of the power",
+			msg:       slashingtypes.NewMsgSignSlashRequest(signerStr, 0, valStrs[1], sign(keys[1], "test-chain")),
+			expSigned: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SignSlashRequest(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+
+			req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+			require.NoError(err)
+			require.True(req.SignedBy(tc.msg.ValidatorAddress))
+			require.Equal(tc.expSigned, req.Status == slashingtypes.SlashRequestSigned)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFulfillSlashRequest() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	relayerStr := sdk.MustBech32ifyAddressBytes("cosmos", []byte("relayer_____________"))
+	middleware := ethcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	val, _ := s.slashRequestValidator(1000)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound).Times(2)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOWNTIME, 1, sdkmath.LegacyNewDecWithPrec(1, 1)))
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOWNTIME, 2, sdkmath.LegacyNewDecWithPrec(1, 1)))
+
+	// the first request is signed, the second one is not
+	req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+	require.NoError(err)
+	req.Status = slashingtypes.SlashRequestSigned
+	require.NoError(keeper.SlashRequestQueue.Set(ctx, 0, req))
+
+	// a block executing the slash of the request and a transfer
+	slasher, err := slashingtypes.NewMiddlewareSlasher(slashingtypes.DefaultMiddlewareSlashABI())
+	require.NoError(err)
+	calldata, err := slasher.SlashCalldata(req, big.NewInt(3))
+	require.NoError(err)
+
+	other := ethcommon.HexToAddress("0x00000000000000000000000000000000000000bb")
+	var (
+		txs      ethtypes.Transactions
+		receipts ethtypes.Receipts
+	)
+	for i, to := range []ethcommon.Address{middleware, other} {
+		txs = append(txs, ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       100000,
+			To:        &to,
+			Data:      calldata,
+		}))
+		receipts = append(receipts, &ethtypes.Receipt{
+			Type:              ethtypes.DynamicFeeTxType,
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(50000 * (i + 1)),
+			Logs:              []*ethtypes.Log{},
+		})
+	}
+
+	txProofs := make([][][]byte, len(txs))
+	receiptProofs := make([][][]byte, len(txs))
+	for i := range txs {
+		txProofs[i], err = lightclient.ProveListItem(txs, i)
+		require.NoError(err)
+		receiptProofs[i], err = lightclient.ProveListItem(receipts, i)
+		require.NoError(err)
+	}
+
+	slashHeader, err := rlp.EncodeToBytes(&ethtypes.Header{
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(101),
+		TxHash:      crypto.Keccak256Hash(txProofs[0][0]),
+		ReceiptHash: crypto.Keccak256Hash(receiptProofs[0][0]),
+		BaseFee:     big.NewInt(1),
+	})
+	require.NoError(err)
+	syncHeader, err := rlp.EncodeToBytes(&ethtypes.Header{
+		ParentHash: crypto.Keccak256Hash(slashHeader),
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(102),
+		BaseFee:    big.NewInt(1),
+	})
+	require.NoError(err)
+	headers := [][]byte{slashHeader, syncHeader}
+
+	s.stakingKeeper.EXPECT().GetSymbioticSync(ctx, int64(20)).Return(types.SymbioticSyncRecord{
+		Height:      20,
+		BlockHash:   crypto.Keccak256Hash(syncHeader).Hex(),
+		BlockNumber: 102,
+	}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetSymbioticSync(ctx, int64(10)).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound).AnyTimes()
+	s.stakingKeeper.EXPECT().MiddlewareAddress(ctx).Return(middleware.Hex(), nil).AnyTimes()
+
+	fulfill := func(id uint64, txHash string, syncHeight int64, headers [][]byte, index int) *slashingtypes.MsgFulfillSlashRequest {
+		return slashingtypes.NewMsgFulfillSlashRequest(relayerStr, id, txHash, syncHeight, headers, uint64(index), txProofs[index], receiptProofs[index])
+	}
+	txHash := txs[0].Hash().Hex()
+
+	testCases := []struct {
+		name      string
+		msg       *slashingtypes.MsgFulfillSlashRequest
+		expErrMsg string
+	}{
+		{
+			name:      "invalid tx hash",
+			msg:       fulfill(0, "0x1234", 20, headers, 0),
+			expErrMsg: "invalid tx hash",
+		},
+		{
+			name:      "unknown request",
+			msg:       fulfill(7, txHash, 20, headers, 0),
+			expErrMsg: "slash request not found",
+		},
+		{
+			name:      "request not signed",
+			msg:       fulfill(1, txHash, 20, headers, 0),
+			expErrMsg: "slash request not signed",
+		},
+		{
+			name:      "unknown sync",
+			msg:       fulfill(0, txHash, 10, headers, 0),
+			expErrMsg: "sync at height 10",
+		},
+		{
+			name:      "headers not ending at the sync block",
+			msg:       fulfill(0, txHash, 20, headers[:1], 0),
+			expErrMsg: "headers end at block",
+		},
+		{
+			name:      "another tx than the proven one",
+			msg:       fulfill(0, txs[1].Hash().Hex(), 20, headers, 0),
+			expErrMsg: "is not tx",
+		},
+		{
+			name:      "tx not sent to the middleware",
+			msg:       fulfill(0, txs[1].Hash().Hex(), 20, headers, 1),
+			expErrMsg: "not sent to the middleware",
+		},
+		{
+			name: "slash proven",
+			msg:  fulfill(0, txHash, 20, headers, 0),
+		},
+		{
+			name:      "already fulfilled",
+			msg:       fulfill(0, txHash, 20, headers, 0),
+			expErrMsg: "slash request already fulfilled",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.FulfillSlashRequest(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	req, err = keeper.SlashRequestQueue.Get(ctx, 0)
+	require.NoError(err)
+	require.Equal(slashingtypes.SlashRequestFulfilled, req.Status)
+	require.Equal(txHash, req.TxHash)
+
+	pending, err := keeper.PendingSlashRequests.Has(ctx, 0)
+	require.NoError(err)
+	require.False(pending)
+
+	// the tx cannot fulfill another request
+	id, err := keeper.FulfilledSlashTxs.Get(ctx, txHash)
+	require.NoError(err)
+	require.Equal(uint64(0), id)
+}
@@ -40,3 +40,18 @@ func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, e
 	params, err := k.Params.Get(ctx)
 	return params.SlashFractionDowntime, err
 }
+
+// MinOperatorStake - minimum middleware stake of a validator to be unjailed
+func (k Keeper) MinOperatorStake(ctx context.Context) (sdkmath.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	// params stored before the min_operator_stake param have none
+	if params.MinOperatorStake.IsNil() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	return params.MinOperatorStake, nil
+}
@@ -212,12 +212,37 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
-	// Migrate ValidatorSigningInfo from oldPubKey to newPubKey
-	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	// Migrate ValidatorSigningInfo from oldPubKey to newPubKey, a validator
+	// which was never bonded has none
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, oldConsAddr)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
 		return types.ErrInvalidConsPubKey.Wrap("failed to get signing info for old public key")
 	}
 
+	// Migrate the missed block bitmap the signing info counts the missed
+	// blocks of
+	var chunks []collections.KeyValue[collections.Pair[[]byte, uint64], []byte]
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](oldConsAddr.Bytes())
+	err = k.ValidatorMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], chunk []byte) (bool, error) {
+		chunks = append(chunks, collections.KeyValue[collections.Pair[[]byte, uint64], []byte]{Key: key, Value: chunk})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks {
+		if err := k.SetMissedBlockBitmapChunk(ctx, sdk.ConsAddress(newPubKey.Address()), int64(chunk.Key.K2()), chunk.Value); err != nil {
+			return err
+		}
+	}
+	if err := k.DeleteMissedBlockBitmap(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
 	consAddr, err := k.sk.ConsensusAddressCodec().BytesToString(newPubKey.Address())
 	if err != nil {
 		return err
@@ -228,5 +253,5 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
-	return k.ValidatorSigningInfo.Remove(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	return k.ValidatorSigningInfo.Remove(ctx, oldConsAddr)
 }
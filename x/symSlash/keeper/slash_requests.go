@@ -0,0 +1,382 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symSlash/types"
+	"cosmossdk.io/x/symStaking/lightclient"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueueSlashRequest persists the slash request of an infraction, which the
+// operators sign and the relayer submits to the Symbiotic middleware as stake
+// lives on Ethereum. The stake, operator and execution block are those of the
+// last applied Symbiotic sync at the infraction height, the key the one the
+// middleware identifies the validator by. Nothing is queued if there is
+// nothing to slash.
+func (k Keeper) QueueSlashRequest(ctx context.Context, validator stakingtypes.Validator, infraction stakingtypes.Infraction, height int64, fraction math.LegacyDec) error {
+	consPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	consAddr := sdk.ConsAddress(consPubKey.Address())
+
+	keyType, err := k.sk.MiddlewareKeyType(ctx)
+	if err != nil {
+		return err
+	}
+	key, err := stakingtypes.MiddlewareKey(keyType, consPubKey)
+	if err != nil {
+		return err
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	req := types.SlashRequest{
+		ValidatorAddress: validator.GetOperator(),
+		ConsAddress:      consStr,
+		Infraction:       infraction,
+		Height:           height,
+		Time:             k.HeaderService.HeaderInfo(ctx).Time,
+		Fraction:         fraction,
+		Stake:            validator.GetTokens(),
+		Status:           types.SlashRequestPending,
+		Key:              key,
+	}
+
+	sync, err := k.sk.GetLastAppliedSymbioticSync(ctx, height)
+	switch {
+	case err == nil:
+		req.BlockNumber = sync.BlockNumber
+		req.BlockTimestamp = sync.BlockTimestamp
+		req.Epoch = sync.Epoch
+		for _, stake := range sync.Stakes {
+			if stake.ValidatorAddress == req.ValidatorAddress {
+				req.Operator = stake.Operator
+				req.Stake = stake.Stake
+				break
+			}
+		}
+	case !errors.Is(err, stakingtypes.ErrSymbioticNotFound):
+		return err
+	}
+
+	req.Amount = fraction.MulInt(req.Stake).TruncateInt()
+	if !req.Amount.IsPositive() {
+		return nil
+	}
+
+	req.Id, err = k.SlashRequestSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SlashRequestQueue.Set(ctx, req.Id, req); err != nil {
+		return err
+	}
+
+	if err := k.PendingSlashRequests.Set(ctx, req.Id); err != nil {
+		return err
+	}
+
+	k.Logger.Info(
+		"queued symbiotic slash request",
+		"id", req.Id,
+		"validator", consStr,
+		"infraction", infraction,
+		"amount", req.Amount,
+	)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSlashRequest,
+		event.NewAttribute(types.AttributeKeySlashRequest, fmt.Sprintf("%d", req.Id)),
+		event.NewAttribute(types.AttributeKeyAddress, consStr),
+		event.NewAttribute(types.AttributeKeyReason, infractionReason(infraction)),
+		event.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+		event.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+		event.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+	)
+}
+
+// SignSlashRequest adds the signature of the operator of a bonded validator
+// to a slash request. The request is signed, and can be relayed, once its
+// signers hold more than 2/3 of the last total power.
+func (k Keeper) SignSlashRequest(ctx context.Context, id uint64, valAddr sdk.ValAddress, signature []byte) error {
+	req, err := k.getSlashRequest(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if req.Status == types.SlashRequestFulfilled {
+		return types.ErrSlashRequestFulfilled.Wrapf("id %d", id)
+	}
+
+	valStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+	if req.SignedBy(valStr) {
+		return types.ErrSlashRequestAlreadySigned.Wrapf("id %d, validator %s", id, valStr)
+	}
+
+	power, err := k.lastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if power == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidSlashSignature, "validator %s is not bonded", valStr)
+	}
+
+	operator, err := k.validatorOperator(ctx, valAddr, valStr)
+	if err != nil {
+		return err
+	}
+
+	digest := types.SlashRequestSignBytes(k.HeaderService.HeaderInfo(ctx).ChainID, req)
+	signer, err := stakingtypes.RecoverOperatorSigner(digest, signature)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSlashSignature, err.Error())
+	}
+	if signer != operator {
+		return errorsmod.Wrapf(types.ErrInvalidSlashSignature, "signed by %s, expected operator %s", signer, operator)
+	}
+
+	req.Signatures = append(req.Signatures, types.SlashRequestSignature{
+		ValidatorAddress: valStr,
+		Operator:         operator.Bytes(),
+		Signature:        signature,
+	})
+
+	signed, err := k.isSignedByTwoThirds(ctx, req)
+	if err != nil {
+		return err
+	}
+	if signed {
+		req.Status = types.SlashRequestSigned
+	}
+
+	if err := k.SlashRequestQueue.Set(ctx, id, req); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSignSlashRequest,
+		event.NewAttribute(types.AttributeKeySlashRequest, fmt.Sprintf("%d", id)),
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(types.AttributeKeyOperator, operator.Hex()),
+		event.NewAttribute(types.AttributeKeySigned, fmt.Sprintf("%t", signed)),
+	)
+}
+
+// FulfillSlashRequest marks a signed slash request fulfilled once msg proves
+// that its Ethereum transaction executed the slash: the transaction calls the
+// slash method of the middleware with the key, amount and id of the request,
+// succeeded, and is in a block chained to the execution block of a Symbiotic
+// sync. A transaction fulfills a single request.
+func (k Keeper) FulfillSlashRequest(ctx context.Context, msg *types.MsgFulfillSlashRequest) error {
+	req, err := k.getSlashRequest(ctx, msg.Id)
+	if err != nil {
+		return err
+	}
+
+	switch req.Status {
+	case types.SlashRequestFulfilled:
+		return types.ErrSlashRequestFulfilled.Wrapf("id %d", msg.Id)
+	case types.SlashRequestSigned:
+	default:
+		return types.ErrSlashRequestNotSigned.Wrapf("id %d", msg.Id)
+	}
+
+	txHash, err := k.verifySlashTx(ctx, req, msg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSlashFulfillment, "slash request %d: %s", msg.Id, err)
+	}
+
+	if fulfilled, err := k.FulfilledSlashTxs.Get(ctx, txHash); err == nil {
+		return errorsmod.Wrapf(types.ErrInvalidSlashFulfillment, "tx %s already fulfilled slash request %d", txHash, fulfilled)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	req.Status = types.SlashRequestFulfilled
+	req.TxHash = txHash
+	req.FulfilledHeight = k.HeaderService.HeaderInfo(ctx).Height
+
+	if err := k.SlashRequestQueue.Set(ctx, msg.Id, req); err != nil {
+		return err
+	}
+
+	if err := k.FulfilledSlashTxs.Set(ctx, txHash, msg.Id); err != nil {
+		return err
+	}
+
+	if err := k.PendingSlashRequests.Remove(ctx, msg.Id); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeFulfillSlashRequest,
+		event.NewAttribute(types.AttributeKeySlashRequest, fmt.Sprintf("%d", msg.Id)),
+		event.NewAttribute(types.AttributeKeyTxHash, txHash),
+	)
+}
+
+// verifySlashTx checks the proof of msg that its transaction executed the
+// slash of req and returns the hash of the transaction.
+func (k Keeper) verifySlashTx(ctx context.Context, req types.SlashRequest, msg *types.MsgFulfillSlashRequest) (string, error) {
+	if len(msg.Headers) > types.MaxSlashProofHeaders {
+		return "", fmt.Errorf("%d headers, at most %d are accepted", len(msg.Headers), types.MaxSlashProofHeaders)
+	}
+
+	sync, err := k.sk.GetSymbioticSync(ctx, msg.SyncHeight)
+	if err != nil {
+		return "", fmt.Errorf("sync at height %d: %w", msg.SyncHeight, err)
+	}
+	blockHash, err := hex.DecodeString(strings.TrimPrefix(sync.BlockHash, "0x"))
+	if err != nil || len(blockHash) != ethcommon.HashLength {
+		return "", fmt.Errorf("sync at height %d has no execution block", msg.SyncHeight)
+	}
+
+	headers, err := lightclient.VerifyHeaderChain(msg.Headers, ethcommon.BytesToHash(blockHash))
+	if err != nil {
+		return "", err
+	}
+	header := headers[0]
+	if req.BlockNumber != 0 && header.Number.Cmp(new(big.Int).SetUint64(req.BlockNumber)) <= 0 {
+		return "", fmt.Errorf("tx block %s is not after the block %d of the request", header.Number, req.BlockNumber)
+	}
+
+	tx, err := lightclient.VerifyTransaction(header, msg.TxIndex, msg.TxProof)
+	if err != nil {
+		return "", err
+	}
+	if txHash := tx.Hash(); txHash != ethcommon.HexToHash(msg.TxHash) {
+		return "", fmt.Errorf("proven tx %s is not tx %s", txHash, msg.TxHash)
+	}
+
+	middleware, err := k.sk.MiddlewareAddress(ctx)
+	if err != nil {
+		return "", err
+	}
+	if tx.To() == nil || *tx.To() != ethcommon.HexToAddress(middleware) {
+		return "", fmt.Errorf("tx is not sent to the middleware %s", middleware)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	slasher, err := types.NewMiddlewareSlasher(params.SlashAbi)
+	if err != nil {
+		return "", err
+	}
+	if err := slasher.CheckSlashCalldata(tx.Data(), req); err != nil {
+		return "", err
+	}
+
+	receipt, err := lightclient.VerifyReceipt(header, msg.TxIndex, msg.ReceiptProof)
+	if err != nil {
+		return "", err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return "", errors.New("tx reverted")
+	}
+
+	return tx.Hash().Hex(), nil
+}
+
+// getSlashRequest returns the slash request with the given id.
+func (k Keeper) getSlashRequest(ctx context.Context, id uint64) (types.SlashRequest, error) {
+	req, err := k.SlashRequestQueue.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return req, types.ErrSlashRequestNotFound.Wrapf("id %d", id)
+	}
+	return req, err
+}
+
+// validatorOperator returns the Ethereum operator of a validator: the one it is
+// bound to, or else the one the last applied Symbiotic sync reports for it.
+func (k Keeper) validatorOperator(ctx context.Context, valAddr sdk.ValAddress, valStr string) (ethcommon.Address, error) {
+	binding, found, err := k.sk.GetOperatorBinding(ctx, valAddr)
+	if err != nil {
+		return ethcommon.Address{}, err
+	}
+	if found {
+		return ethcommon.HexToAddress(binding.Operator), nil
+	}
+
+	sync, err := k.sk.GetLastAppliedSymbioticSync(ctx, k.HeaderService.HeaderInfo(ctx).Height)
+	if err != nil && !errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+		return ethcommon.Address{}, err
+	}
+	for _, stake := range sync.Stakes {
+		if stake.ValidatorAddress == valStr && len(stake.Operator) == ethcommon.AddressLength {
+			return ethcommon.BytesToAddress(stake.Operator), nil
+		}
+	}
+
+	return ethcommon.Address{}, errorsmod.Wrapf(types.ErrInvalidSlashSignature, "validator %s has no known operator", valStr)
+}
+
+// lastValidatorPower returns the last power of a validator, zero if it is not
+// bonded.
+func (k Keeper) lastValidatorPower(ctx context.Context, valAddr sdk.ValAddress) (int64, error) {
+	power, err := k.sk.GetLastValidatorPower(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return power, err
+}
+
+// isSignedByTwoThirds reports whether the bonded validators that signed req
+// hold more than 2/3 of the last total power.
+func (k Keeper) isSignedByTwoThirds(ctx context.Context, req types.SlashRequest) (bool, error) {
+	totalPower, err := k.sk.GetLastTotalPower(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	signedPower := math.ZeroInt()
+	for _, sig := range req.Signatures {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(sig.ValidatorAddress)
+		if err != nil {
+			return false, err
+		}
+		power, err := k.lastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return false, err
+		}
+		signedPower = signedPower.AddRaw(power)
+	}
+
+	return signedPower.MulRaw(3).GT(totalPower.MulRaw(2)), nil
+}
+
+// infractionReason returns the slash event reason of an infraction.
+func infractionReason(infraction stakingtypes.Infraction) string {
+	switch infraction {
+	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
+		return types.AttributeValueDoubleSign
+	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
+		return types.AttributeValueMissingSignature
+	default:
+		return types.AttributeValueUnspecified
+	}
+}
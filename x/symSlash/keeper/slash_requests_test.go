@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/symSlash/types"
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// slashRequestValidator returns a validator with tokens and its consensus
+// address string.
+func (s *KeeperTestSuite) slashRequestValidator(tokens int64) (types.Validator, string) {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	s.Require().NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(pubKey.Address())
+	s.Require().NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	s.Require().NoError(err)
+	val.Tokens = sdkmath.NewInt(tokens)
+
+	return val, consStr
+}
+
+func (s *KeeperTestSuite) TestQueueSlashRequest() {
+	ctx, keeper := s.ctx.WithBlockHeight(10), s.slashingKeeper
+	require := s.Require()
+
+	val, consStr := s.slashRequestValidator(1000)
+	operator := []byte{0xaa}
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, int64(10)).Return(types.SymbioticSyncRecord{
+		BlockNumber:    100,
+		BlockTimestamp: 1700000000,
+		Epoch:          42,
+		Stakes: []types.SymbioticSyncStake{
+			{ValidatorAddress: val.OperatorAddress, Operator: operator, Stake: sdkmath.NewInt(5000)},
+		},
+	}, nil)
+
+	fraction := sdkmath.LegacyNewDecWithPrec(1, 2)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOWNTIME, 10, fraction))
+
+	req, err := keeper.SlashRequestQueue.Get(ctx, 0)
+	require.NoError(err)
+	require.Equal(val.OperatorAddress, req.ValidatorAddress)
+	require.Equal(consStr, req.ConsAddress)
+	require.Equal(operator, req.Operator)
+	// the middleware identifies the validator by its consensus address
+	consPubKey, err := val.ConsPubKey()
+	require.NoError(err)
+	require.Equal(consPubKey.Address().Bytes(), req.Key)
+	require.Equal(types.Infraction_INFRACTION_DOWNTIME, req.Infraction)
+	require.Equal(int64(10), req.Height)
+	require.Equal(fraction, req.Fraction)
+	// the stake of the sync is slashed, not the tokens of the validator
+	require.Equal(sdkmath.NewInt(5000), req.Stake)
+	require.Equal(sdkmath.NewInt(50), req.Amount)
+	require.Equal(uint64(100), req.BlockNumber)
+	require.Equal(uint64(1700000000), req.BlockTimestamp)
+	require.Equal(uint64(42), req.Epoch)
+	require.Equal(slashingtypes.SlashRequestPending, req.Status)
+
+	pending, err := keeper.PendingSlashRequests.Has(ctx, 0)
+	require.NoError(err)
+	require.True(pending)
+
+	// without a sync, the tokens of the validator are slashed
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOUBLE_SIGN, 10, fraction))
+
+	req, err = keeper.SlashRequestQueue.Get(ctx, 1)
+	require.NoError(err)
+	require.Empty(req.Operator)
+	require.Equal(sdkmath.NewInt(10), req.Amount)
+	require.Zero(req.BlockNumber)
+
+	// nothing to slash, nothing queued
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(types.SymbioticSyncRecord{}, types.ErrSymbioticNotFound)
+	require.NoError(keeper.QueueSlashRequest(ctx, val, types.Infraction_INFRACTION_DOWNTIME, 10, sdkmath.LegacyZeroDec()))
+
+	has, err := keeper.SlashRequestQueue.Has(ctx, 2)
+	require.NoError(err)
+	require.False(has)
+}
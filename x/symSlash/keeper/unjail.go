@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
-	"cosmossdk.io/errors"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,41 +22,146 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 		return err
 	}
 
-	tokens := validator.GetTokens()
-	if tokens.LT(sdk.DefaultPowerReduction) {
-		return errors.Wrapf(
-			types.ErrSelfDelegationTooLowToUnjail, "%s less than %s", tokens, sdk.DefaultPowerReduction,
-		)
+	eligibility, err := k.unjailEligibility(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	if len(eligibility.Reasons) > 0 {
+		switch eligibility.Reasons[0] {
+		case types.UnjailReasonNotJailed:
+			return types.ErrValidatorNotJailed
+		case types.UnjailReasonMinOperatorStake:
+			return errorsmod.Wrapf(
+				types.ErrOperatorStakeTooLowToUnjail, "%s less than %s", eligibility.Stake, eligibility.MinOperatorStake,
+			)
+		default:
+			return types.ErrValidatorJailed
+		}
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	return k.sk.Unjail(ctx, consAddr)
+}
+
+// AutoUnjailValidators unjails the jailed validators eligible to it, once
+// their jail period is over.
+func (k Keeper) AutoUnjailValidators(ctx context.Context) error {
+	validators, err := k.sk.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if !validator.IsJailed() {
+			continue
+		}
+
+		eligibility, err := k.unjailEligibility(ctx, validator)
+		if err != nil {
+			return err
+		}
+		if !eligibility.Eligible {
+			continue
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if err := k.sk.Unjail(ctx, consAddr); err != nil {
+			return err
+		}
+
+		consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+		if err != nil {
+			return err
+		}
+		k.Logger.Info("auto unjailed validator", "validator", consStr, "stake", eligibility.Stake)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoUnjail,
+			event.NewAttribute(types.AttributeKeyAddress, consStr),
+			event.NewAttribute(types.AttributeKeyStake, eligibility.Stake.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unjailEligibility returns whether a validator can be unjailed at this block
+// and the reasons it cannot. The stake of the validator is its middleware
+// stake at the last applied Symbiotic sync, or its tokens if no sync was
+// applied yet.
+func (k Keeper) unjailEligibility(ctx context.Context, validator stakingtypes.Validator) (types.QueryUnjailEligibilityResponse, error) {
+	minOperatorStake, err := k.MinOperatorStake(ctx)
+	if err != nil {
+		return types.QueryUnjailEligibilityResponse{}, err
+	}
+
+	res := types.QueryUnjailEligibilityResponse{
+		Stake:            validator.GetTokens(),
+		MinOperatorStake: minOperatorStake,
 	}
 
 	// cannot be unjailed if not jailed
 	if !validator.IsJailed() {
-		return types.ErrValidatorNotJailed
+		res.Reasons = append(res.Reasons, types.UnjailReasonNotJailed)
 	}
 
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
-		return err
+		return types.QueryUnjailEligibilityResponse{}, err
 	}
 	// If the validator has a ValidatorSigningInfo object that signals that the
 	// validator was bonded and so we must check that the validator is not tombstoned
 	// and can be unjailed at the current block.
 	//
 	// A validator that is jailed but has no ValidatorSigningInfo object signals
-	// that the validator was never bonded and must've been jailed due to falling
-	// below their minimum self-delegation. The validator can unjail at any point
-	// assuming they've now bonded above their minimum self-delegation.
+	// that the validator was never bonded. The validator can unjail at any point
+	// assuming its operator stake is now above the minimum.
 	info, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
-	if err == nil {
+	switch {
+	case err == nil:
+		res.JailedUntil = info.JailedUntil
+
 		// cannot be unjailed if tombstoned
 		if info.Tombstoned {
-			return types.ErrValidatorJailed
+			res.Reasons = append(res.Reasons, types.UnjailReasonTombstoned)
+		} else if k.HeaderService.HeaderInfo(ctx).Time.Before(info.JailedUntil) {
+			res.Reasons = append(res.Reasons, types.UnjailReasonJailPeriod)
 		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return types.QueryUnjailEligibilityResponse{}, err
+	}
 
-		if k.HeaderService.HeaderInfo(ctx).Time.Before(info.JailedUntil) {
-			return types.ErrValidatorJailed
+	sync, err := k.sk.GetLastAppliedSymbioticSync(ctx, k.HeaderService.HeaderInfo(ctx).Height)
+	switch {
+	case err == nil:
+		// a validator missing from the sync has no stake
+		res.SyncHeight = sync.Height
+		res.Stake = math.ZeroInt()
+		for _, stake := range sync.Stakes {
+			if stake.ValidatorAddress == validator.OperatorAddress {
+				res.Stake = stake.Stake
+				break
+			}
 		}
+	case !errors.Is(err, stakingtypes.ErrSymbioticNotFound):
+		return types.QueryUnjailEligibilityResponse{}, err
 	}
 
-	return k.sk.Unjail(ctx, consAddr)
+	if res.Stake.LT(minOperatorStake) {
+		res.Reasons = append(res.Reasons, types.UnjailReasonMinOperatorStake)
+	}
+
+	res.Eligible = len(res.Reasons) == 0
+
+	return res, nil
 }
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/symSlash/types"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestAutoUnjailValidators() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	var (
+		validators []stakingtypes.Validator
+		stakes     []stakingtypes.SymbioticSyncStake
+	)
+	newValidator := func(jailed bool, jailedUntil time.Time, stake math.Int) sdk.ConsAddress {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(sdk.ValAddress(addr))
+		require.NoError(err)
+		consAddr := sdk.ConsAddress(pubKey.Address())
+		consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+		require.NoError(err)
+
+		val, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{Moniker: "test"})
+		require.NoError(err)
+		val.Jailed = jailed
+		val.Tokens = stake
+		validators = append(validators, val)
+		stakes = append(stakes, stakingtypes.SymbioticSyncStake{ValidatorAddress: valStr, Stake: stake})
+
+		info := slashingtypes.NewValidatorSigningInfo(consStr, 0, jailedUntil, false, 0)
+		require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, info))
+		return consAddr
+	}
+
+	past, future := time.Unix(2, 0), ctx.HeaderInfo().Time.Add(time.Hour)
+	eligible := newValidator(true, past, slashingtypes.DefaultMinOperatorStake)
+	newValidator(true, future, slashingtypes.DefaultMinOperatorStake)
+	newValidator(true, past, math.NewInt(10))
+	newValidator(false, past, slashingtypes.DefaultMinOperatorStake)
+
+	s.stakingKeeper.EXPECT().GetAllValidators(ctx).Return(validators, nil)
+	s.stakingKeeper.EXPECT().GetLastAppliedSymbioticSync(ctx, gomock.Any()).Return(stakingtypes.SymbioticSyncRecord{
+		Height: 10,
+		Stakes: stakes,
+	}, nil).Times(3)
+	s.stakingKeeper.EXPECT().Unjail(ctx, eligible).Return(nil)
+
+	require.NoError(keeper.AutoUnjailValidators(ctx))
+
+	events := ctx.EventManager().ABCIEvents()
+	var unjailed int
+	for _, e := range events {
+		if e.Type == slashingtypes.EventTypeAutoUnjail {
+			unjailed++
+		}
+	}
+	require.Equal(1, unjailed)
+}
@@ -38,6 +38,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.SlashRequestsKeyPrefix):
+			var reqA, reqB types.SlashRequest
+			cdc.MustUnmarshal(kvA.Value, &reqA)
+			cdc.MustUnmarshal(kvB.Value, &reqB)
+			return fmt.Sprintf("%v\n%v", reqA, reqB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
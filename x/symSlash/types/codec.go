@@ -14,6 +14,8 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/symSlash/Params")
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/symSlash/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSignSlashRequest{}, "cosmos-sdk/MsgSignSlashRequest")
+	legacy.RegisterAminoMsg(cdc, &MsgFulfillSlashRequest{}, "cosmos-sdk/MsgFulfillSlashRequest")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -21,6 +23,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUnjail{},
 		&MsgUpdateParams{},
+		&MsgSignSlashRequest{},
+		&MsgFulfillSlashRequest{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -14,4 +14,11 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrSlashRequestNotFound         = errors.Register(ModuleName, 12, "slash request not found")
+	ErrSlashRequestFulfilled        = errors.Register(ModuleName, 13, "slash request already fulfilled")
+	ErrInvalidSlashFulfillment      = errors.Register(ModuleName, 14, "invalid slash request fulfillment proof")
+	ErrOperatorStakeTooLowToUnjail  = errors.Register(ModuleName, 15, "validator's operator stake less than minimum; cannot be unjailed")
+	ErrInvalidSlashSignature        = errors.Register(ModuleName, 16, "invalid slash request signature")
+	ErrSlashRequestNotSigned        = errors.Register(ModuleName, 17, "slash request not signed by enough voting power")
+	ErrSlashRequestAlreadySigned    = errors.Register(ModuleName, 18, "slash request already signed by validator")
 )
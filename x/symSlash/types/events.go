@@ -5,6 +5,11 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeSlashRequest        = "slash_request"
+	EventTypeSignSlashRequest    = "sign_slash_request"
+	EventTypeFulfillSlashRequest = "fulfill_slash_request"
+	EventTypeAutoUnjail          = "auto_unjail"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -12,6 +17,14 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeySlashRequest = "slash_request_id"
+	AttributeKeyFraction     = "fraction"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyTxHash       = "tx_hash"
+	AttributeKeyStake        = "stake"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyOperator     = "operator"
+	AttributeKeySigned       = "signed"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
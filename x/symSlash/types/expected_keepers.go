@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	stakingtypes "cosmossdk.io/x/symStaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -44,6 +46,28 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
+
+	// GetHistoricalInfo returns the historical record of a height.
+	GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalRecord, error)
+
+	// GetLastAppliedSymbioticSync returns the record of the last sync at or
+	// before height that updated the validator set.
+	GetLastAppliedSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error)
+	// GetSymbioticSync returns the record of the sync at height.
+	GetSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error)
+
+	// MiddlewareAddress returns the address of the Symbiotic middleware.
+	MiddlewareAddress(ctx context.Context) (string, error)
+	// MiddlewareKeyType returns the type of the keys the middleware identifies
+	// validators by.
+	MiddlewareKeyType(ctx context.Context) (string, error)
+	// GetOperatorBinding returns the Ethereum operator a validator is bound to.
+	GetOperatorBinding(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.OperatorBinding, bool, error)
+
+	// GetLastValidatorPower returns the last power of a bonded validator.
+	GetLastValidatorPower(ctx context.Context, operator sdk.ValAddress) (int64, error)
+	// GetLastTotalPower returns the last total power of the bonded validators.
+	GetLastTotalPower(ctx context.Context) (math.Int, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -54,4 +78,6 @@ type StakingHooks interface {
 
 	AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator begins unbonding
+
+	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error // Must be called when a validator rotates its consensus key
 }
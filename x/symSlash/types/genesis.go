@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:        DefaultParams(),
+		SigningInfos:  []SigningInfo{},
+		MissedBlocks:  []ValidatorMissedBlocks{},
+		SlashRequests: []SlashRequest{},
 	}
 }
 
@@ -62,5 +63,29 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	minOperatorStake := data.Params.MinOperatorStake
+	if !minOperatorStake.IsNil() && minOperatorStake.IsNegative() {
+		return fmt.Errorf("min operator stake cannot be negative, is %s", minOperatorStake)
+	}
+
+	if err := data.Params.SlashAbi.Validate(); err != nil {
+		return fmt.Errorf("invalid slash abi: %w", err)
+	}
+
+	ids := make(map[uint64]struct{}, len(data.SlashRequests))
+	for _, req := range data.SlashRequests {
+		if req.Id == 0 {
+			return fmt.Errorf("slash request id must be positive")
+		}
+		if _, ok := ids[req.Id]; ok {
+			return fmt.Errorf("duplicate slash request id %d", req.Id)
+		}
+		ids[req.Id] = struct{}{}
+
+		if req.Status != SlashRequestPending && req.Status != SlashRequestSigned && req.Status != SlashRequestFulfilled {
+			return fmt.Errorf("invalid status of slash request %d: %s", req.Id, req.Status)
+		}
+	}
+
 	return nil
 }
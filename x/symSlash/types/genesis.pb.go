@@ -35,6 +35,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// slash_requests are the slash requests to relay to the Symbiotic
+	// middleware, pending or fulfilled.
+	SlashRequests []SlashRequest `protobuf:"bytes,4,rep,name=slash_requests,json=slashRequests,proto3" json:"slash_requests"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +94,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRequests() []SlashRequest {
+	if m != nil {
+		return m.SlashRequests
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +279,37 @@ func init() {
 }
 
 var fileDescriptor_d0bfc46017576ea8 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0xcf, 0xd2, 0x40,
-	0x18, 0xc7, 0x7b, 0x10, 0xd1, 0xf7, 0xfa, 0xbe, 0x83, 0x17, 0x7c, 0xad, 0x6f, 0x62, 0x41, 0xa2,
-	0x86, 0x98, 0xd0, 0x06, 0x1c, 0x1c, 0x98, 0xac, 0x83, 0x71, 0x30, 0x31, 0x2d, 0x71, 0x70, 0xb0,
-	0x39, 0xe8, 0x51, 0x2f, 0xd0, 0x3b, 0xd2, 0xa7, 0x12, 0xf8, 0x06, 0x8e, 0x7e, 0x0c, 0xe3, 0xe4,
-	0xe0, 0xe4, 0x27, 0x60, 0x24, 0x4e, 0x4e, 0xc6, 0xc0, 0xe0, 0xd7, 0x30, 0xdc, 0x95, 0x50, 0x0d,
-	0x0d, 0x83, 0x0b, 0xe1, 0xb9, 0xfb, 0x3d, 0xff, 0xe7, 0xff, 0xfc, 0xdb, 0xe2, 0x07, 0x23, 0x09,
-	0x89, 0x04, 0x17, 0x96, 0x49, 0x30, 0xa5, 0xf0, 0xce, 0x9d, 0x77, 0x87, 0x2c, 0xa3, 0x5d, 0x37,
-	0x66, 0x82, 0x01, 0x07, 0x67, 0x96, 0xca, 0x4c, 0x92, 0xdb, 0x1a, 0x73, 0xf6, 0x98, 0x93, 0x63,
-	0x57, 0xf5, 0x58, 0xc6, 0x52, 0x31, 0xee, 0xee, 0x9f, 0xc6, 0xaf, 0x1e, 0x96, 0xa9, 0xc2, 0xae,
-	0xe2, 0x22, 0xce, 0xb9, 0x3b, 0x9a, 0x0b, 0xb5, 0x40, 0x3e, 0x43, 0x5f, 0xdd, 0xa4, 0x09, 0x17,
-	0xd2, 0x55, 0xbf, 0xfa, 0xa8, 0xf5, 0xa1, 0x82, 0xcf, 0x9f, 0x6b, 0x5b, 0x41, 0x46, 0x33, 0x46,
-	0x3c, 0x5c, 0x9b, 0xd1, 0x94, 0x26, 0x60, 0xa1, 0x26, 0x6a, 0x9b, 0xbd, 0x86, 0x53, 0x62, 0xd3,
-	0x79, 0xa5, 0x30, 0xef, 0x6c, 0xf5, 0xb3, 0x61, 0x7c, 0xfa, 0xfd, 0xe5, 0x11, 0xf2, 0xf3, 0x4e,
-	0x32, 0xc0, 0x17, 0xc0, 0x63, 0xc1, 0x45, 0x1c, 0x72, 0x31, 0x96, 0x60, 0x55, 0x9a, 0xd5, 0xb6,
-	0xd9, 0xbb, 0x5f, 0x2a, 0x15, 0x68, 0xfa, 0x85, 0x18, 0xcb, 0xa2, 0xde, 0x39, 0x1c, 0xce, 0x81,
-	0xbc, 0xc5, 0x17, 0x09, 0x07, 0x60, 0x51, 0x38, 0x9c, 0xca, 0xd1, 0x04, 0xac, 0xaa, 0x52, 0x75,
-	0x4a, 0x55, 0x5f, 0xd3, 0x29, 0x8f, 0x68, 0x26, 0xd3, 0x97, 0xaa, 0xcd, 0x53, 0x5d, 0x7f, 0xe9,
-	0x27, 0x85, 0x8b, 0xd6, 0x37, 0x84, 0xcd, 0x82, 0x11, 0xd2, 0xc7, 0xd7, 0x69, 0x14, 0xa5, 0x0c,
-	0x74, 0x14, 0x67, 0xde, 0xbd, 0xef, 0x5f, 0x3b, 0x77, 0xf3, 0x61, 0xcf, 0xa4, 0x00, 0x26, 0xe0,
-	0x3d, 0x3c, 0xd5, 0x48, 0x90, 0xa5, 0x5c, 0xc4, 0xfe, 0xbe, 0x83, 0x08, 0x7c, 0x39, 0xdf, 0x8f,
-	0x0f, 0x8b, 0x61, 0x58, 0x15, 0x15, 0x6b, 0xe7, 0xb4, 0xeb, 0x92, 0x50, 0xea, 0xf3, 0x23, 0x40,
-	0xeb, 0x33, 0xc2, 0xb7, 0x8e, 0xee, 0xfb, 0x7f, 0x6b, 0x0c, 0xfe, 0xcd, 0xfc, 0xd4, 0x93, 0x2c,
-	0x8c, 0x2e, 0x4f, 0xba, 0x8f, 0xcd, 0x02, 0x47, 0xea, 0xf8, 0x1a, 0x17, 0x11, 0x5b, 0x28, 0x7f,
-	0x55, 0x5f, 0x17, 0xe4, 0x12, 0xd7, 0x74, 0x93, 0x4a, 0xec, 0x86, 0x9f, 0x57, 0xde, 0x93, 0xd5,
-	0xc6, 0x46, 0xeb, 0x8d, 0x8d, 0x7e, 0x6d, 0x6c, 0xf4, 0x71, 0x6b, 0x1b, 0xeb, 0xad, 0x6d, 0xfc,
-	0xd8, 0xda, 0xc6, 0x9b, 0x7c, 0x29, 0x88, 0x26, 0x0e, 0x97, 0xee, 0xe2, 0xf0, 0xa5, 0x64, 0xcb,
-	0x19, 0x83, 0x61, 0x4d, 0xbd, 0xf1, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xb9, 0xf3,
-	0x2f, 0x9f, 0x03, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x09, 0x04, 0x7a, 0x6e, 0x90, 0x38, 0x85, 0x62, 0x2a, 0xe1, 0x16, 0x8b, 0xa2,
+	0x0a, 0xa9, 0xb6, 0x5a, 0x06, 0x86, 0x4e, 0x98, 0x01, 0x31, 0x20, 0x21, 0xbb, 0x02, 0x89, 0x01,
+	0xeb, 0x52, 0x5f, 0xcd, 0xa9, 0xf1, 0x5d, 0xf0, 0xbb, 0x46, 0xed, 0xb7, 0xe0, 0x63, 0x20, 0x26,
+	0x06, 0x26, 0x3e, 0x41, 0xc7, 0x88, 0x89, 0x09, 0x41, 0x32, 0xf0, 0x35, 0x50, 0xee, 0x1c, 0xe5,
+	0x40, 0xb1, 0x32, 0xb0, 0x44, 0x79, 0x77, 0xbf, 0xf7, 0x7f, 0xef, 0xfd, 0x9f, 0x0f, 0xef, 0x1c,
+	0x4b, 0x28, 0x25, 0x44, 0x70, 0x51, 0xa6, 0x03, 0x0a, 0xef, 0xa2, 0xd1, 0x7e, 0x9f, 0x29, 0xba,
+	0x1f, 0x15, 0x4c, 0x30, 0xe0, 0x10, 0x0e, 0x2b, 0xa9, 0x24, 0xb9, 0x6d, 0xb0, 0x70, 0x8e, 0x85,
+	0x35, 0xb6, 0xd9, 0x2b, 0x64, 0x21, 0x35, 0x13, 0xcd, 0xfe, 0x19, 0x7c, 0xf3, 0x41, 0x93, 0x2a,
+	0xcc, 0x22, 0x2e, 0x8a, 0x9a, 0xbb, 0x63, 0xb8, 0xcc, 0x08, 0xd4, 0x35, 0xcc, 0xd5, 0x4d, 0x5a,
+	0x72, 0x21, 0x23, 0xfd, 0x6b, 0x8e, 0x82, 0x5f, 0x2d, 0xbc, 0xfe, 0xcc, 0xb4, 0x95, 0x2a, 0xaa,
+	0x18, 0x89, 0x71, 0x67, 0x48, 0x2b, 0x5a, 0x82, 0x87, 0xb6, 0xd1, 0xae, 0x7b, 0xb0, 0x15, 0x36,
+	0xb4, 0x19, 0xbe, 0xd4, 0x58, 0xbc, 0x76, 0xf9, 0x63, 0xcb, 0xf9, 0xf8, 0xfb, 0xf3, 0x43, 0x94,
+	0xd4, 0x99, 0xe4, 0x08, 0x77, 0x81, 0x17, 0x82, 0x8b, 0x22, 0xe3, 0xe2, 0x44, 0x82, 0xd7, 0xda,
+	0x6e, 0xef, 0xba, 0x07, 0xf7, 0x1b, 0xa5, 0x52, 0x43, 0x3f, 0x17, 0x27, 0xd2, 0xd6, 0x5b, 0x87,
+	0xc5, 0x39, 0x90, 0xb7, 0xb8, 0x5b, 0x72, 0x00, 0x96, 0x67, 0xfd, 0x81, 0x3c, 0x3e, 0x05, 0xaf,
+	0xad, 0x55, 0xc3, 0x46, 0xd5, 0x57, 0x74, 0xc0, 0x73, 0xaa, 0x64, 0xf5, 0x42, 0xa7, 0xc5, 0x3a,
+	0xeb, 0x2f, 0xfd, 0xd2, 0xba, 0x20, 0xaf, 0xf1, 0x0d, 0x6d, 0x65, 0x56, 0xb1, 0xf7, 0x67, 0x0c,
+	0x14, 0x78, 0x57, 0x74, 0x81, 0x9d, 0xe6, 0xb6, 0x67, 0x51, 0x62, 0x68, 0x5b, 0xb7, 0x0b, 0xd6,
+	0x05, 0x04, 0x5f, 0x11, 0x76, 0xad, 0x09, 0xc9, 0x21, 0xbe, 0x46, 0xf3, 0xbc, 0x62, 0x60, 0x3c,
+	0x5e, 0x8b, 0xef, 0x7d, 0xfb, 0xb2, 0x77, 0xb7, 0x2e, 0xf2, 0x54, 0x0a, 0x60, 0x02, 0xce, 0xe0,
+	0x89, 0x41, 0x52, 0x55, 0x71, 0x51, 0x24, 0xf3, 0x0c, 0x22, 0xf0, 0xc6, 0x68, 0x3e, 0x57, 0x66,
+	0xbb, 0xec, 0xb5, 0xf4, 0xbe, 0xf6, 0x56, 0xdb, 0xd1, 0xe0, 0x76, 0x6f, 0xb4, 0x04, 0x08, 0x3e,
+	0x21, 0x7c, 0x6b, 0xa9, 0x91, 0xff, 0x37, 0xc6, 0xd1, 0xbf, 0xcb, 0x5c, 0xf5, 0x89, 0x58, 0xa5,
+	0x1b, 0x57, 0x18, 0x1c, 0x62, 0xd7, 0xe2, 0x48, 0x0f, 0x5f, 0xe5, 0x22, 0x67, 0xe7, 0xba, 0xbf,
+	0x76, 0x62, 0x02, 0xb2, 0x81, 0x3b, 0x26, 0x49, 0x3b, 0x76, 0x3d, 0xa9, 0xa3, 0xf8, 0xf1, 0xe5,
+	0xc4, 0x47, 0xe3, 0x89, 0x8f, 0x7e, 0x4e, 0x7c, 0xf4, 0x61, 0xea, 0x3b, 0xe3, 0xa9, 0xef, 0x7c,
+	0x9f, 0xfa, 0xce, 0x9b, 0x7a, 0x28, 0xc8, 0x4f, 0x43, 0x2e, 0xa3, 0xf3, 0xc5, 0x13, 0x54, 0x17,
+	0x43, 0x06, 0xfd, 0x8e, 0x7e, 0x4a, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x56, 0x5c, 0x62,
+	0xf5, 0xf8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +332,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRequests) > 0 {
+		for iNdEx := len(m.SlashRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +540,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRequests) > 0 {
+		for _, e := range m.SlashRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -702,6 +734,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRequests = append(m.SlashRequests, SlashRequest{})
+			if err := m.SlashRequests[len(m.SlashRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
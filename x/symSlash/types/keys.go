@@ -50,12 +50,24 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<id_Bytes>: SlashRequest
+//
+// - 0x05<id_Bytes>: pending SlashRequest id
+//
+// - 0x06: SlashRequest id sequence
+//
+// - 0x07<txHash_Bytes>: id of the SlashRequest fulfilled by an Ethereum transaction
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	SlashRequestsKeyPrefix              = collections.NewPrefix(4) // Prefix for slash requests
+	PendingSlashRequestsKeyPrefix       = collections.NewPrefix(5) // Prefix for pending slash request ids
+	SlashRequestSequenceKey             = collections.NewPrefix(6) // Key for the slash request id sequence
+	FulfilledSlashTxsKeyPrefix          = collections.NewPrefix(7) // Prefix for the slash request ids by fulfilling tx hash
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
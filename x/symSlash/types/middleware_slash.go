@@ -0,0 +1,334 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SimpleMiddlewareSlashABI is the ABI of the SimpleMiddleware methods the slash
+// requests are submitted with by default.
+const SimpleMiddlewareSlashABI = `[
+	{
+		"type": "function",
+		"name": "getEpochAtTs",
+		"inputs": [{"name": "timestamp", "type": "uint48", "internalType": "uint48"}],
+		"outputs": [{"name": "epoch", "type": "uint48", "internalType": "uint48"}],
+		"stateMutability": "view"
+	},
+	{
+		"type": "function",
+		"name": "slash",
+		"inputs": [
+			{"name": "epoch", "type": "uint48", "internalType": "uint48"},
+			{"name": "key", "type": "bytes32", "internalType": "bytes32"},
+			{"name": "amount", "type": "uint256", "internalType": "uint256"},
+			{"name": "stakeHints", "type": "bytes[][]", "internalType": "bytes[][]"},
+			{"name": "slashHints", "type": "bytes[]", "internalType": "bytes[]"}
+		],
+		"outputs": [],
+		"stateMutability": "nonpayable"
+	}
+]`
+
+// DefaultMiddlewareSlashABI returns the mapping of the SimpleMiddleware slash
+// function, used when the slash_abi param is not set.
+func DefaultMiddlewareSlashABI() MiddlewareSlashABI {
+	return MiddlewareSlashABI{
+		Abi:         SimpleMiddlewareSlashABI,
+		SlashMethod: "slash",
+		EpochMethod: "getEpochAtTs",
+		EpochInput:  "epoch",
+		KeyInput:    "key",
+		AmountInput: "amount",
+	}
+}
+
+// IsEmpty reports whether the mapping is unset.
+func (m MiddlewareSlashABI) IsEmpty() bool {
+	return m.Equal(MiddlewareSlashABI{})
+}
+
+// Validate checks that the ABI holds the methods and inputs of the mapping.
+// An empty mapping is valid.
+func (m MiddlewareSlashABI) Validate() error {
+	if m.IsEmpty() {
+		return nil
+	}
+	_, err := NewMiddlewareSlasher(m)
+	return err
+}
+
+// MiddlewareSlasher encodes and decodes the calls slash requests are submitted
+// with to the middleware.
+type MiddlewareSlasher struct {
+	slashMethod abi.Method
+	epochMethod *abi.Method
+	// inputs are the indexes of the slash method inputs by mapping field.
+	epochInput, keyInput, amountInput, requestIDInput, signaturesInput int
+}
+
+// NewMiddlewareSlasher returns the slasher of a mapping, the SimpleMiddleware
+// one if the mapping is empty.
+func NewMiddlewareSlasher(m MiddlewareSlashABI) (*MiddlewareSlasher, error) {
+	if m.IsEmpty() {
+		m = DefaultMiddlewareSlashABI()
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(m.Abi))
+	if err != nil {
+		return nil, fmt.Errorf("invalid middleware slash abi: %w", err)
+	}
+
+	slashMethod, ok := contractABI.Methods[m.SlashMethod]
+	if !ok {
+		return nil, fmt.Errorf("slash method %q not found in the abi", m.SlashMethod)
+	}
+	s := &MiddlewareSlasher{slashMethod: slashMethod}
+
+	input := func(name string, optional bool, valid func(abi.Type) bool, typeName string) (int, error) {
+		if name == "" {
+			if optional {
+				return -1, nil
+			}
+			return 0, fmt.Errorf("no %s input of method %s", typeName, m.SlashMethod)
+		}
+		for i, arg := range slashMethod.Inputs {
+			if arg.Name == name {
+				if !valid(arg.Type) {
+					return 0, fmt.Errorf("input %s of method %s is a %s, expected a %s", name, m.SlashMethod, arg.Type, typeName)
+				}
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("input %s not found in method %s", name, m.SlashMethod)
+	}
+
+	isUint := func(t abi.Type) bool { return t.T == abi.UintTy }
+	isKey := func(t abi.Type) bool {
+		return t.T == abi.BytesTy || t.T == abi.AddressTy || (t.T == abi.FixedBytesTy && t.Size == 32)
+	}
+	isBytesArray := func(t abi.Type) bool { return t.T == abi.SliceTy && t.Elem.T == abi.BytesTy }
+
+	if s.keyInput, err = input(m.KeyInput, false, isKey, "key"); err != nil {
+		return nil, err
+	}
+	if s.amountInput, err = input(m.AmountInput, false, isUint, "uint"); err != nil {
+		return nil, err
+	}
+	if s.requestIDInput, err = input(m.RequestIdInput, true, isUint, "uint"); err != nil {
+		return nil, err
+	}
+	if s.signaturesInput, err = input(m.SignaturesInput, true, isBytesArray, "bytes[]"); err != nil {
+		return nil, err
+	}
+	if s.epochInput, err = input(m.EpochInput, true, isUint, "uint"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case m.EpochMethod == "" && s.epochInput >= 0:
+		return nil, fmt.Errorf("epoch input %s without epoch method", m.EpochInput)
+
+	case m.EpochMethod != "":
+		if s.epochInput < 0 {
+			return nil, fmt.Errorf("epoch method %s without epoch input", m.EpochMethod)
+		}
+		epochMethod, ok := contractABI.Methods[m.EpochMethod]
+		if !ok {
+			return nil, fmt.Errorf("epoch method %q not found in the abi", m.EpochMethod)
+		}
+		if len(epochMethod.Inputs) != 1 || !isUint(epochMethod.Inputs[0].Type) ||
+			len(epochMethod.Outputs) == 0 || !isUint(epochMethod.Outputs[0].Type) {
+			return nil, fmt.Errorf("epoch method %s must take a uint timestamp and return a uint epoch", m.EpochMethod)
+		}
+		s.epochMethod = &epochMethod
+	}
+
+	return s, nil
+}
+
+// HasRequestID reports whether the id of a request is passed to the slash
+// method, which the middleware can dedupe slashes with.
+func (s *MiddlewareSlasher) HasRequestID() bool {
+	return s.requestIDInput >= 0
+}
+
+// EpochCalldata returns the calldata of the epoch method at timestamp, nil if
+// the slash method takes no epoch.
+func (s *MiddlewareSlasher) EpochCalldata(timestamp uint64) ([]byte, error) {
+	if s.epochMethod == nil {
+		return nil, nil
+	}
+
+	arg, err := uintArg(s.epochMethod.Inputs[0].Type, new(big.Int).SetUint64(timestamp))
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.epochMethod.Inputs.Pack(arg)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.CopyBytes(s.epochMethod.ID), data...), nil
+}
+
+// UnpackEpoch decodes the result of the epoch method.
+func (s *MiddlewareSlasher) UnpackEpoch(res []byte) (*big.Int, error) {
+	out, err := s.epochMethod.Outputs.Unpack(res)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s result: %w", s.epochMethod.Name, err)
+	}
+	return abi.ConvertType(out[0], new(big.Int)).(*big.Int), nil
+}
+
+// SlashCalldata returns the calldata of the slash method submitting req at
+// the middleware epoch, ignored if the slash method takes no epoch.
+func (s *MiddlewareSlasher) SlashCalldata(req SlashRequest, epoch *big.Int) ([]byte, error) {
+	inputs := s.slashMethod.Inputs
+	args := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		if t := input.Type.GetType(); t == reflect.TypeOf((*big.Int)(nil)) {
+			args[i] = new(big.Int)
+		} else {
+			args[i] = reflect.Zero(t).Interface()
+		}
+	}
+
+	var err error
+	if args[s.keyInput], err = keyArg(inputs[s.keyInput].Type, req.Key); err != nil {
+		return nil, err
+	}
+	if args[s.amountInput], err = uintArg(inputs[s.amountInput].Type, req.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+	if s.epochInput >= 0 {
+		if args[s.epochInput], err = uintArg(inputs[s.epochInput].Type, epoch); err != nil {
+			return nil, err
+		}
+	}
+	if s.requestIDInput >= 0 {
+		if args[s.requestIDInput], err = uintArg(inputs[s.requestIDInput].Type, new(big.Int).SetUint64(req.Id)); err != nil {
+			return nil, err
+		}
+	}
+	if s.signaturesInput >= 0 {
+		signatures := make([][]byte, len(req.Signatures))
+		for i, sig := range req.Signatures {
+			signatures[i] = sig.Signature
+		}
+		args[s.signaturesInput] = signatures
+	}
+
+	data, err := inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.CopyBytes(s.slashMethod.ID), data...), nil
+}
+
+// CheckSlashCalldata checks that data calls the slash method with the key and
+// amount of req, and its id if the slash method takes it.
+func (s *MiddlewareSlasher) CheckSlashCalldata(data []byte, req SlashRequest) error {
+	if len(data) < 4 || !bytes.Equal(data[:4], s.slashMethod.ID) {
+		return fmt.Errorf("not a call of %s", s.slashMethod.Sig)
+	}
+
+	args, err := s.slashMethod.Inputs.Unpack(data[4:])
+	if err != nil {
+		return fmt.Errorf("invalid %s calldata: %w", s.slashMethod.Name, err)
+	}
+
+	key, err := keyArg(s.slashMethod.Inputs[s.keyInput].Type, req.Key)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(args[s.keyInput], key) {
+		return errors.New("slashed key does not match the request")
+	}
+
+	amount, err := uintValue(args[s.amountInput])
+	if err != nil {
+		return err
+	}
+	if amount.Cmp(req.Amount.BigInt()) != 0 {
+		return fmt.Errorf("slashed amount %s does not match the request amount %s", amount, req.Amount)
+	}
+
+	if s.requestIDInput >= 0 {
+		id, err := uintValue(args[s.requestIDInput])
+		if err != nil {
+			return err
+		}
+		if !id.IsUint64() || id.Uint64() != req.Id {
+			return fmt.Errorf("slashed request id %s does not match the request id %d", id, req.Id)
+		}
+	}
+
+	return nil
+}
+
+// keyArg returns the slash method argument of type t for a middleware key: a
+// consensus address left aligned in a bytes32 or given as an address, or the
+// key as is.
+func keyArg(t abi.Type, key []byte) (interface{}, error) {
+	switch t.T {
+	case abi.FixedBytesTy:
+		if len(key) == 0 || len(key) > 32 {
+			return nil, fmt.Errorf("key of length %d does not fit a bytes32", len(key))
+		}
+		var arg [32]byte
+		copy(arg[:], key)
+		return arg, nil
+
+	case abi.AddressTy:
+		if len(key) != common.AddressLength {
+			return nil, fmt.Errorf("key of length %d is not an address", len(key))
+		}
+		return common.BytesToAddress(key), nil
+
+	default:
+		if len(key) == 0 {
+			return nil, errors.New("empty key")
+		}
+		return key, nil
+	}
+}
+
+// uintArg converts n to the Go type the abi package packs a uint of type t
+// from.
+func uintArg(t abi.Type, n *big.Int) (interface{}, error) {
+	if n.Sign() < 0 || n.BitLen() > t.Size {
+		return nil, fmt.Errorf("%s overflows %s", n, t)
+	}
+
+	v := reflect.New(t.GetType()).Elem()
+	if v.Kind() == reflect.Ptr {
+		return new(big.Int).Set(n), nil
+	}
+	v.SetUint(n.Uint64())
+	return v.Interface(), nil
+}
+
+// uintValue converts a uint decoded by the abi package to a big.Int.
+func uintValue(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		return n, nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	default:
+		return nil, fmt.Errorf("unexpected uint value of type %T", v)
+	}
+}
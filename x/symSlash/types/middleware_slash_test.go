@@ -0,0 +1,144 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+// requestMiddlewareABI is a middleware slashing a bytes key with the id of
+// the request and the signatures of the operators.
+const requestMiddlewareABI = `[
+	{
+		"type": "function",
+		"name": "slashRequest",
+		"inputs": [
+			{"name": "requestId", "type": "uint64"},
+			{"name": "key", "type": "bytes"},
+			{"name": "amount", "type": "uint256"},
+			{"name": "signatures", "type": "bytes[]"}
+		],
+		"outputs": [],
+		"stateMutability": "nonpayable"
+	}
+]`
+
+func TestMiddlewareSlasher(t *testing.T) {
+	req := SlashRequest{
+		Id:     3,
+		Key:    common.FromHex("0x0102030405060708090a0b0c0d0e0f1011121314"),
+		Amount: math.NewInt(1000),
+		Signatures: []SlashRequestSignature{
+			{Signature: []byte{0x01}},
+			{Signature: []byte{0x02}},
+		},
+	}
+
+	// SimpleMiddleware by default
+	slasher, err := NewMiddlewareSlasher(MiddlewareSlashABI{})
+	require.NoError(t, err)
+	require.False(t, slasher.HasRequestID())
+
+	epochCalldata, err := slasher.EpochCalldata(1700000000)
+	require.NoError(t, err)
+	require.NotEmpty(t, epochCalldata)
+
+	calldata, err := slasher.SlashCalldata(req, big.NewInt(7))
+	require.NoError(t, err)
+	require.NoError(t, slasher.CheckSlashCalldata(calldata, req))
+
+	other := req
+	other.Amount = math.NewInt(999)
+	require.ErrorContains(t, slasher.CheckSlashCalldata(calldata, other), "slashed amount")
+	other = req
+	other.Key = common.FromHex("0x01")
+	require.ErrorContains(t, slasher.CheckSlashCalldata(calldata, other), "slashed key")
+	require.Error(t, slasher.CheckSlashCalldata(calldata[:3], req))
+
+	// a middleware without epochs, taking the request id
+	slasher, err = NewMiddlewareSlasher(MiddlewareSlashABI{
+		Abi:             requestMiddlewareABI,
+		SlashMethod:     "slashRequest",
+		KeyInput:        "key",
+		AmountInput:     "amount",
+		RequestIdInput:  "requestId",
+		SignaturesInput: "signatures",
+	})
+	require.NoError(t, err)
+	require.True(t, slasher.HasRequestID())
+
+	epochCalldata, err = slasher.EpochCalldata(1700000000)
+	require.NoError(t, err)
+	require.Nil(t, epochCalldata)
+
+	calldata, err = slasher.SlashCalldata(req, nil)
+	require.NoError(t, err)
+	require.NoError(t, slasher.CheckSlashCalldata(calldata, req))
+
+	other = req
+	other.Id = 4
+	require.ErrorContains(t, slasher.CheckSlashCalldata(calldata, other), "slashed request id")
+}
+
+func TestMiddlewareSlashABIValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		abi    MiddlewareSlashABI
+		expErr string
+	}{
+		{"empty", MiddlewareSlashABI{}, ""},
+		{"default", DefaultMiddlewareSlashABI(), ""},
+		{"invalid abi", MiddlewareSlashABI{Abi: "{", SlashMethod: "slash"}, "invalid middleware slash abi"},
+		{"unknown method", MiddlewareSlashABI{Abi: requestMiddlewareABI, SlashMethod: "slash"}, "not found in the abi"},
+		{
+			"no key input",
+			MiddlewareSlashABI{Abi: requestMiddlewareABI, SlashMethod: "slashRequest", AmountInput: "amount"},
+			"no key input",
+		},
+		{
+			"amount not a uint",
+			MiddlewareSlashABI{Abi: requestMiddlewareABI, SlashMethod: "slashRequest", KeyInput: "key", AmountInput: "signatures"},
+			"expected a uint",
+		},
+		{
+			"epoch input without epoch method",
+			MiddlewareSlashABI{Abi: requestMiddlewareABI, SlashMethod: "slashRequest", KeyInput: "key", AmountInput: "amount", EpochInput: "requestId"},
+			"without epoch method",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.abi.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSlashRequestSignBytes(t *testing.T) {
+	req := SlashRequest{Id: 1, Key: []byte{0x01}, Amount: math.NewInt(10), Height: 5}
+
+	signBytes := SlashRequestSignBytes("chain-a", req)
+	require.Len(t, signBytes, 32)
+	require.Equal(t, signBytes, SlashRequestSignBytes("chain-a", req))
+	require.NotEqual(t, signBytes, SlashRequestSignBytes("chain-b", req))
+
+	// the signatures do not change what is signed
+	signed := req
+	signed.Signatures = []SlashRequestSignature{{ValidatorAddress: "val", Signature: []byte{0x01}}}
+	require.Equal(t, signBytes, SlashRequestSignBytes("chain-a", signed))
+	require.True(t, signed.SignedBy("val"))
+	require.False(t, req.SignedBy("val"))
+
+	other := req
+	other.Amount = math.NewInt(11)
+	require.NotEqual(t, signBytes, SlashRequestSignBytes("chain-a", other))
+}
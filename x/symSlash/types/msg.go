@@ -8,6 +8,8 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSignSlashRequest{}
+	_ sdk.Msg = &MsgFulfillSlashRequest{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +18,27 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgSignSlashRequest creates a new MsgSignSlashRequest instance
+func NewMsgSignSlashRequest(signer string, id uint64, validator string, signature []byte) *MsgSignSlashRequest {
+	return &MsgSignSlashRequest{
+		Signer:           signer,
+		Id:               id,
+		ValidatorAddress: validator,
+		Signature:        signature,
+	}
+}
+
+// NewMsgFulfillSlashRequest creates a new MsgFulfillSlashRequest instance
+func NewMsgFulfillSlashRequest(relayer string, id uint64, txHash string, syncHeight int64, headers [][]byte, txIndex uint64, txProof, receiptProof [][]byte) *MsgFulfillSlashRequest {
+	return &MsgFulfillSlashRequest{
+		Relayer:      relayer,
+		Id:           id,
+		TxHash:       txHash,
+		SyncHeight:   syncHeight,
+		Headers:      headers,
+		TxIndex:      txIndex,
+		TxProof:      txProof,
+		ReceiptProof: receiptProof,
+	}
+}
@@ -17,6 +17,8 @@ var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	// DefaultMinOperatorStake is the stake of one unit of consensus power.
+	DefaultMinOperatorStake = math.NewIntFromUint64(1000000)
 )
 
 // NewParams creates a new Params object
@@ -30,6 +32,7 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		MinOperatorStake:        DefaultMinOperatorStake,
 	}
 }
 
@@ -61,6 +64,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateMinOperatorStake(p.MinOperatorStake); err != nil {
+		return err
+	}
+	if err := p.SlashAbi.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +156,22 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateMinOperatorStake(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min operator stake cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min operator stake cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
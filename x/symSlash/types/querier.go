@@ -7,6 +7,23 @@ const (
 	QuerySigningInfos = "signingInfos"
 )
 
+// Reasons a validator cannot be unjailed, as reported by the
+// Query/UnjailEligibility RPC method
+const (
+	// UnjailReasonNotJailed is the reason of a validator which is not jailed.
+	UnjailReasonNotJailed = "not_jailed"
+	// UnjailReasonTombstoned is the reason of a validator tombstoned for a
+	// double sign.
+	UnjailReasonTombstoned = "tombstoned"
+	// UnjailReasonJailPeriod is the reason of a validator whose jail period is
+	// not over.
+	UnjailReasonJailPeriod = "jail_period"
+	// UnjailReasonMinOperatorStake is the reason of a validator whose
+	// middleware stake at the last applied Symbiotic sync is below the
+	// min_operator_stake param.
+	UnjailReasonMinOperatorStake = "min_operator_stake"
+)
+
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/symSlash/signingInfos'
 type QuerySigningInfosParams struct {
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -12,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -309,6 +314,335 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySlashRequestRequest is the request type for the Query/SlashRequest RPC
+// method
+type QuerySlashRequestRequest struct {
+	// id is the id of the slash request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QuerySlashRequestRequest) Reset()         { *m = QuerySlashRequestRequest{} }
+func (m *QuerySlashRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestRequest) ProtoMessage()    {}
+func (*QuerySlashRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{6}
+}
+func (m *QuerySlashRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestRequest.Merge(m, src)
+}
+func (m *QuerySlashRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRequestRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QuerySlashRequestResponse is the response type for the Query/SlashRequest
+// RPC method
+type QuerySlashRequestResponse struct {
+	SlashRequest SlashRequest `protobuf:"bytes,1,opt,name=slash_request,json=slashRequest,proto3" json:"slash_request"`
+}
+
+func (m *QuerySlashRequestResponse) Reset()         { *m = QuerySlashRequestResponse{} }
+func (m *QuerySlashRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestResponse) ProtoMessage()    {}
+func (*QuerySlashRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{7}
+}
+func (m *QuerySlashRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestResponse.Merge(m, src)
+}
+func (m *QuerySlashRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRequestResponse) GetSlashRequest() SlashRequest {
+	if m != nil {
+		return m.SlashRequest
+	}
+	return SlashRequest{}
+}
+
+// QuerySlashRequestsRequest is the request type for the Query/SlashRequests
+// RPC method
+type QuerySlashRequestsRequest struct {
+	// pending selects the requests waiting to be relayed only.
+	Pending    bool               `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRequestsRequest) Reset()         { *m = QuerySlashRequestsRequest{} }
+func (m *QuerySlashRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestsRequest) ProtoMessage()    {}
+func (*QuerySlashRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{8}
+}
+func (m *QuerySlashRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestsRequest.Merge(m, src)
+}
+func (m *QuerySlashRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRequestsRequest) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *QuerySlashRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashRequestsResponse is the response type for the Query/SlashRequests
+// RPC method
+type QuerySlashRequestsResponse struct {
+	SlashRequests []SlashRequest      `protobuf:"bytes,1,rep,name=slash_requests,json=slashRequests,proto3" json:"slash_requests"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashRequestsResponse) Reset()         { *m = QuerySlashRequestsResponse{} }
+func (m *QuerySlashRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRequestsResponse) ProtoMessage()    {}
+func (*QuerySlashRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{9}
+}
+func (m *QuerySlashRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRequestsResponse.Merge(m, src)
+}
+func (m *QuerySlashRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRequestsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRequestsResponse) GetSlashRequests() []SlashRequest {
+	if m != nil {
+		return m.SlashRequests
+	}
+	return nil
+}
+
+func (m *QuerySlashRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUnjailEligibilityRequest is the request type for the
+// Query/UnjailEligibility RPC method
+type QueryUnjailEligibilityRequest struct {
+	// validator_addr is the operator address of the validator.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryUnjailEligibilityRequest) Reset()         { *m = QueryUnjailEligibilityRequest{} }
+func (m *QueryUnjailEligibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnjailEligibilityRequest) ProtoMessage()    {}
+func (*QueryUnjailEligibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{10}
+}
+func (m *QueryUnjailEligibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnjailEligibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnjailEligibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnjailEligibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnjailEligibilityRequest.Merge(m, src)
+}
+func (m *QueryUnjailEligibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnjailEligibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnjailEligibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnjailEligibilityRequest proto.InternalMessageInfo
+
+func (m *QueryUnjailEligibilityRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryUnjailEligibilityResponse is the response type for the
+// Query/UnjailEligibility RPC method
+type QueryUnjailEligibilityResponse struct {
+	// eligible is true if the validator can be unjailed at this block.
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// reasons are the reasons the validator cannot be unjailed.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// stake is the middleware stake of the validator at the last applied
+	// Symbiotic sync.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	// min_operator_stake is the stake required to be unjailed.
+	MinOperatorStake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_operator_stake,json=minOperatorStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_operator_stake"`
+	// sync_height is the height of the last applied Symbiotic sync, zero if
+	// none was applied and the stake is the validator tokens.
+	SyncHeight int64 `protobuf:"varint,5,opt,name=sync_height,json=syncHeight,proto3" json:"sync_height,omitempty"`
+	// jailed_until is the time the jail period of the validator ends.
+	JailedUntil time.Time `protobuf:"bytes,6,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *QueryUnjailEligibilityResponse) Reset()         { *m = QueryUnjailEligibilityResponse{} }
+func (m *QueryUnjailEligibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnjailEligibilityResponse) ProtoMessage()    {}
+func (*QueryUnjailEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd3f64af9241ce8, []int{11}
+}
+func (m *QueryUnjailEligibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnjailEligibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnjailEligibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnjailEligibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnjailEligibilityResponse.Merge(m, src)
+}
+func (m *QueryUnjailEligibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnjailEligibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnjailEligibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnjailEligibilityResponse proto.InternalMessageInfo
+
+func (m *QueryUnjailEligibilityResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *QueryUnjailEligibilityResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *QueryUnjailEligibilityResponse) GetSyncHeight() int64 {
+	if m != nil {
+		return m.SyncHeight
+	}
+	return 0
+}
+
+func (m *QueryUnjailEligibilityResponse) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.symSlash.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.symSlash.v1beta1.QueryParamsResponse")
@@ -316,6 +650,12 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.symSlash.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QuerySlashRequestRequest)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestRequest")
+	proto.RegisterType((*QuerySlashRequestResponse)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestResponse")
+	proto.RegisterType((*QuerySlashRequestsRequest)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestsRequest")
+	proto.RegisterType((*QuerySlashRequestsResponse)(nil), "cosmos.symSlash.v1beta1.QuerySlashRequestsResponse")
+	proto.RegisterType((*QueryUnjailEligibilityRequest)(nil), "cosmos.symSlash.v1beta1.QueryUnjailEligibilityRequest")
+	proto.RegisterType((*QueryUnjailEligibilityResponse)(nil), "cosmos.symSlash.v1beta1.QueryUnjailEligibilityResponse")
 }
 
 func init() {
@@ -323,43 +663,70 @@ func init() {
 }
 
 var fileDescriptor_dbd3f64af9241ce8 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x13, 0x10, 0xf4, 0xb2, 0xcc,
-	0x36, 0xd3, 0x71, 0x70, 0x33, 0xb3, 0xdd, 0xd9, 0x04, 0x83, 0xe8, 0xc1, 0xb3, 0x07, 0xc1, 0xcf,
-	0x20, 0x78, 0x54, 0xf1, 0x43, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xbc, 0xfb, 0x09, 0x64,
-	0x67, 0x26, 0xcd, 0x96, 0xb8, 0x9a, 0xd0, 0x4b, 0x98, 0xbc, 0x79, 0xff, 0xf7, 0xff, 0xbd, 0x97,
-	0x37, 0x81, 0x17, 0x77, 0xa4, 0xea, 0x48, 0x85, 0x55, 0xbf, 0xd3, 0x0a, 0x89, 0x7a, 0x82, 0x7b,
-	0xb5, 0x80, 0x26, 0xa4, 0x86, 0xf7, 0xba, 0x34, 0xee, 0x7b, 0x51, 0x2c, 0x13, 0x89, 0x96, 0x4d,
-	0x92, 0x37, 0x4a, 0xf2, 0x6c, 0x52, 0x79, 0xd3, 0xaa, 0x03, 0xa2, 0xa8, 0x51, 0x1c, 0xea, 0x23,
-	0xc2, 0xb8, 0x20, 0x09, 0x97, 0xc2, 0x14, 0x29, 0x2f, 0x31, 0xc9, 0xa4, 0x3e, 0xe2, 0xf4, 0x64,
-	0xa3, 0xe7, 0x98, 0x94, 0x2c, 0xa4, 0x98, 0x44, 0x1c, 0x13, 0x21, 0x64, 0xa2, 0x25, 0xca, 0xde,
-	0x56, 0xf3, 0xe8, 0x54, 0xfa, 0x8d, 0x0b, 0x66, 0xf3, 0x56, 0x4d, 0x9e, 0x6f, 0xca, 0x5b, 0x5a,
-	0x73, 0x75, 0x86, 0x74, 0xb8, 0x90, 0x58, 0x7f, 0x9a, 0x90, 0xbb, 0x04, 0xd1, 0x83, 0x94, 0xf5,
-	0x3e, 0x89, 0x49, 0x47, 0x35, 0xe9, 0x5e, 0x97, 0xaa, 0xc4, 0x7d, 0x04, 0xcf, 0x1e, 0x89, 0xaa,
-	0x48, 0x0a, 0x45, 0x51, 0x03, 0x16, 0x23, 0x1d, 0x59, 0x01, 0x17, 0xc0, 0x46, 0xa9, 0x5e, 0xf1,
-	0x72, 0x86, 0xe1, 0x19, 0x61, 0x63, 0x61, 0xff, 0x7b, 0xa5, 0xf0, 0xfe, 0xd7, 0x87, 0x4d, 0xd0,
-	0xb4, 0x4a, 0xd7, 0x87, 0xcb, 0xba, 0x74, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa5, 0x75,
-	0x45, 0xb7, 0xe0, 0xe2, 0x8e, 0x14, 0xca, 0x27, 0xed, 0x76, 0x4c, 0x95, 0x31, 0x59, 0x68, 0xac,
-	0x7d, 0xf9, 0xbc, 0x75, 0xde, 0xfa, 0xdc, 0x4c, 0x31, 0x84, 0xea, 0xaa, 0x1b, 0x26, 0xa5, 0x95,
-	0xc4, 0x5c, 0xb0, 0x66, 0x29, 0x95, 0xd9, 0x90, 0xfb, 0x12, 0xae, 0x4c, 0x1a, 0xd8, 0x06, 0x02,
-	0x78, 0xba, 0x47, 0x42, 0x5f, 0x99, 0x2b, 0x9f, 0x8b, 0x5d, 0x69, 0x5b, 0xd9, 0xca, 0x6d, 0xe5,
-	0x21, 0x09, 0x79, 0x9b, 0x24, 0x32, 0xce, 0x14, 0xcc, 0x36, 0x76, 0xaa, 0x47, 0xc2, 0xcc, 0x95,
-	0x1b, 0x4c, 0xfa, 0x8f, 0xe6, 0x8a, 0x6e, 0x43, 0x38, 0xde, 0x05, 0xeb, 0x5c, 0x1d, 0x39, 0xa7,
-	0x8b, 0xe3, 0x99, 0x55, 0x1b, 0x8f, 0x91, 0x51, 0xab, 0x6d, 0x66, 0x94, 0xee, 0x27, 0x00, 0x57,
-	0xff, 0x62, 0x62, 0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0xe6, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee,
-	0x1c, 0x61, 0x9e, 0xd3, 0xcc, 0xeb, 0xff, 0x65, 0x36, 0x28, 0x59, 0xe8, 0xfa, 0xef, 0x79, 0x78,
-	0x52, 0x43, 0xa3, 0xd7, 0x00, 0x16, 0xcd, 0x86, 0xa0, 0x4b, 0xb9, 0x74, 0x93, 0x6b, 0x59, 0xbe,
-	0x3c, 0x5d, 0xb2, 0xf1, 0x76, 0xd7, 0x5f, 0x7d, 0xfd, 0xf9, 0x76, 0x6e, 0x0d, 0x55, 0x70, 0xde,
-	0xcb, 0x31, 0x2b, 0x89, 0x3e, 0x02, 0x58, 0xca, 0x8c, 0x00, 0x5d, 0xf9, 0xb7, 0xcd, 0xe4, 0xe6,
-	0x96, 0x6b, 0x33, 0x28, 0x2c, 0xdd, 0x75, 0x4d, 0xb7, 0x8d, 0xae, 0xe5, 0xd2, 0x65, 0xb7, 0x54,
-	0xe1, 0xe7, 0xd9, 0xa7, 0xf1, 0x02, 0xbd, 0x03, 0x70, 0x31, 0xfb, 0xe3, 0xa3, 0xe9, 0x11, 0x0e,
-	0xc7, 0x59, 0x9f, 0x45, 0x62, 0xb1, 0x3d, 0x8d, 0xbd, 0x81, 0xaa, 0xd3, 0x61, 0x37, 0xb6, 0xf7,
-	0x07, 0x0e, 0x38, 0x18, 0x38, 0xe0, 0xc7, 0xc0, 0x01, 0x6f, 0x86, 0x4e, 0xe1, 0x60, 0xe8, 0x14,
-	0xbe, 0x0d, 0x9d, 0xc2, 0x63, 0xfb, 0xa6, 0x55, 0xfb, 0xa9, 0xc7, 0x25, 0x7e, 0x36, 0x2e, 0x94,
-	0xf4, 0x23, 0xaa, 0x82, 0xa2, 0xfe, 0x7f, 0xba, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x59, 0x67,
-	0xb1, 0x90, 0x95, 0x05, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x3f, 0x48, 0x66, 0xb3, 0x51, 0x3b, 0x14, 0x75, 0x6b, 0x91, 0xdd, 0xd4, 0x88,
+	0x24, 0xa4, 0x8d, 0x4d, 0x52, 0x20, 0x17, 0x40, 0xea, 0x52, 0xa0, 0x91, 0x2a, 0x01, 0x0e, 0x01,
+	0x95, 0x8b, 0x35, 0x1b, 0x4f, 0x9c, 0xa1, 0xf6, 0x8c, 0xeb, 0x99, 0x8d, 0x58, 0x45, 0xe1, 0xc0,
+	0x99, 0x43, 0x25, 0x4e, 0xfc, 0x01, 0x48, 0x20, 0x2e, 0x80, 0x7a, 0xe6, 0x5c, 0x71, 0xaa, 0xca,
+	0x05, 0x71, 0x08, 0x28, 0x41, 0xe2, 0xdf, 0x40, 0x9e, 0x19, 0x6f, 0x6c, 0x76, 0x9d, 0xec, 0x42,
+	0x2f, 0xd1, 0xfa, 0xcd, 0xfb, 0xde, 0xfb, 0xbe, 0xf7, 0x66, 0xde, 0x0b, 0x78, 0x61, 0x87, 0xf1,
+	0x88, 0x71, 0x87, 0x77, 0xa3, 0xad, 0x10, 0xf1, 0x3d, 0x67, 0x7f, 0xad, 0x8d, 0x05, 0x5a, 0x73,
+	0xee, 0x77, 0x70, 0xd2, 0xb5, 0xe3, 0x84, 0x09, 0x06, 0x2f, 0x2b, 0x27, 0x3b, 0x73, 0xb2, 0xb5,
+	0x93, 0xb9, 0xa2, 0xd1, 0x6d, 0xc4, 0xb1, 0x42, 0xf4, 0xf0, 0x31, 0x0a, 0x08, 0x45, 0x82, 0x30,
+	0xaa, 0x82, 0x98, 0x97, 0x02, 0x16, 0x30, 0xf9, 0xd3, 0x49, 0x7f, 0x69, 0xeb, 0xf3, 0x01, 0x63,
+	0x41, 0x88, 0x1d, 0x14, 0x13, 0x07, 0x51, 0xca, 0x84, 0x84, 0x70, 0x7d, 0xba, 0x58, 0xc6, 0x8e,
+	0xa7, 0x5f, 0x84, 0x06, 0xda, 0xef, 0x8a, 0xf2, 0xf3, 0x54, 0x78, 0xcd, 0x56, 0x1d, 0x5d, 0x44,
+	0x11, 0xa1, 0xcc, 0x91, 0x7f, 0xb5, 0xa9, 0xa9, 0x73, 0xca, 0xaf, 0x76, 0x67, 0xd7, 0x11, 0x24,
+	0xc2, 0x5c, 0xa0, 0x28, 0x56, 0x0e, 0xd6, 0x25, 0x00, 0x3f, 0x48, 0xc5, 0xbc, 0x8f, 0x12, 0x14,
+	0x71, 0x17, 0xdf, 0xef, 0x60, 0x2e, 0xac, 0xbb, 0xe0, 0xd9, 0x82, 0x95, 0xc7, 0x8c, 0x72, 0x0c,
+	0x5b, 0x60, 0x2a, 0x96, 0x96, 0xba, 0xb1, 0x60, 0x2c, 0x57, 0xd7, 0x9b, 0x76, 0x49, 0xb5, 0x6c,
+	0x05, 0x6c, 0xcd, 0x3c, 0x3a, 0x6a, 0x8e, 0x7d, 0xfb, 0xf7, 0x0f, 0x2b, 0x86, 0xab, 0x91, 0x96,
+	0x07, 0x2e, 0xcb, 0xd0, 0x5b, 0x24, 0xa0, 0x84, 0x06, 0x9b, 0x74, 0x97, 0xe9, 0xac, 0xf0, 0x16,
+	0x98, 0xdd, 0x61, 0x94, 0x7b, 0xc8, 0xf7, 0x13, 0xcc, 0x55, 0x92, 0x99, 0xd6, 0xd5, 0x27, 0x0f,
+	0x57, 0xe7, 0x75, 0x9e, 0xb7, 0x52, 0x1a, 0x94, 0x77, 0xf8, 0x4d, 0xe5, 0xb2, 0x25, 0x12, 0x42,
+	0x03, 0xb7, 0x9a, 0xc2, 0xb4, 0xc9, 0xfa, 0x1c, 0xd4, 0xfb, 0x13, 0x68, 0x01, 0x6d, 0x70, 0x61,
+	0x1f, 0x85, 0x1e, 0x57, 0x47, 0x1e, 0xa1, 0xbb, 0x4c, 0x4b, 0x59, 0x2d, 0x95, 0xf2, 0x11, 0x0a,
+	0x89, 0x8f, 0x04, 0x4b, 0x72, 0x01, 0xf3, 0xc2, 0xe6, 0xf6, 0x51, 0x98, 0x3b, 0xb2, 0xda, 0xfd,
+	0xf9, 0xb3, 0xba, 0xc2, 0x77, 0x00, 0x38, 0xbd, 0x2c, 0x3a, 0xf3, 0x62, 0x96, 0x39, 0xbd, 0x59,
+	0xb6, 0xba, 0x8b, 0xa7, 0x65, 0x0c, 0xb0, 0xc6, 0xba, 0x39, 0xa4, 0xf5, 0x93, 0x01, 0xae, 0x0c,
+	0x48, 0xa2, 0x55, 0xde, 0x01, 0x13, 0x5a, 0xd9, 0xf8, 0xff, 0x52, 0x26, 0xa3, 0xc0, 0x77, 0x0b,
+	0x9c, 0x2b, 0x92, 0xf3, 0xd2, 0xb9, 0x9c, 0x15, 0x95, 0x02, 0xe9, 0x95, 0xac, 0x30, 0x29, 0x89,
+	0x4c, 0x95, 0x2e, 0xcc, 0x1c, 0xa8, 0x10, 0x5f, 0x16, 0x64, 0xc2, 0xad, 0x10, 0xdf, 0x4a, 0x32,
+	0x7d, 0x05, 0x5f, 0xad, 0x6f, 0x1b, 0xd4, 0xe4, 0xa3, 0xf0, 0x12, 0x75, 0xa0, 0x0b, 0xf9, 0x62,
+	0xa9, 0xd0, 0x7c, 0x94, 0xbc, 0xc0, 0x59, 0x9e, 0x3b, 0xb0, 0x0e, 0x07, 0xe4, 0xec, 0x75, 0xae,
+	0x0e, 0x9e, 0x89, 0x31, 0xf5, 0x09, 0x0d, 0x64, 0xb6, 0x69, 0x37, 0xfb, 0xfc, 0x57, 0x4f, 0x2b,
+	0xff, 0xb9, 0xa7, 0x3f, 0x1b, 0xc0, 0x1c, 0x94, 0x5f, 0x8b, 0xfe, 0x18, 0xcc, 0x15, 0x44, 0x73,
+	0xdd, 0xde, 0xd1, 0x55, 0xd7, 0xf2, 0xaa, 0xf9, 0xd3, 0xeb, 0x2f, 0x01, 0xf3, 0x92, 0xff, 0x36,
+	0xfd, 0x14, 0x91, 0xf0, 0xed, 0x90, 0x04, 0xa4, 0x4d, 0x42, 0x22, 0xba, 0x59, 0x0d, 0x6f, 0x83,
+	0xf4, 0xad, 0xa8, 0x2b, 0x27, 0x1f, 0xf9, 0x80, 0x17, 0xde, 0xbb, 0x93, 0xc5, 0x17, 0x5e, 0xdb,
+	0xcf, 0xdb, 0xad, 0x93, 0x0a, 0x68, 0x94, 0xe5, 0xd2, 0xf5, 0x32, 0xc1, 0x34, 0x96, 0xe6, 0x10,
+	0xeb, 0x8e, 0xf5, 0xbe, 0xd3, 0x66, 0x26, 0x18, 0x71, 0x46, 0x79, 0xbd, 0xb2, 0x30, 0xbe, 0x3c,
+	0xe3, 0x66, 0x9f, 0xf0, 0x26, 0x98, 0xe4, 0x02, 0xdd, 0xc3, 0xf5, 0x71, 0xc9, 0xec, 0x5a, 0x5a,
+	0xb5, 0xdf, 0x8f, 0x9a, 0xcf, 0x29, 0x76, 0xdc, 0xbf, 0x67, 0x13, 0xe6, 0x44, 0x48, 0xec, 0xd9,
+	0x9b, 0x54, 0x3c, 0x79, 0xb8, 0x0a, 0x34, 0xed, 0x4d, 0x2a, 0x5c, 0x85, 0x84, 0x77, 0x01, 0x8c,
+	0x08, 0xf5, 0x58, 0x8c, 0x13, 0x29, 0x54, 0xc5, 0x9b, 0x18, 0x3d, 0xde, 0x85, 0x88, 0xd0, 0xf7,
+	0x74, 0x94, 0x2d, 0x19, 0xba, 0x09, 0xaa, 0xbc, 0x4b, 0x77, 0xbc, 0x3d, 0x4c, 0x82, 0x3d, 0x51,
+	0x9f, 0x5c, 0x30, 0x96, 0xc7, 0x5d, 0x90, 0x9a, 0x6e, 0x4b, 0x0b, 0xbc, 0x03, 0x66, 0xd3, 0x7a,
+	0x60, 0xdf, 0xeb, 0x50, 0x41, 0xc2, 0xfa, 0x94, 0xec, 0xa6, 0x69, 0xab, 0x2d, 0x60, 0x67, 0x5b,
+	0xc0, 0xfe, 0x30, 0xdb, 0x02, 0xad, 0x5a, 0xca, 0xe8, 0xc1, 0x1f, 0x4d, 0x43, 0xdd, 0x8d, 0xaa,
+	0x82, 0x6f, 0xa7, 0xe8, 0xf5, 0xaf, 0xa7, 0xc1, 0xa4, 0xac, 0x32, 0xfc, 0xd2, 0x00, 0x53, 0x6a,
+	0xa4, 0xc3, 0x6b, 0xa5, 0xf7, 0xad, 0x7f, 0x8f, 0x98, 0xd7, 0x87, 0x73, 0x56, 0x2d, 0xb3, 0x96,
+	0xbe, 0xf8, 0xf5, 0xaf, 0xaf, 0x2a, 0x57, 0x61, 0xd3, 0x29, 0xdb, 0x85, 0x6a, 0x87, 0xc0, 0x1f,
+	0x0d, 0x50, 0xcd, 0xcd, 0x2c, 0xf8, 0xf2, 0xd9, 0x69, 0xfa, 0x57, 0x8d, 0xb9, 0x36, 0x02, 0x42,
+	0xb3, 0x7b, 0x43, 0xb2, 0xdb, 0x80, 0xaf, 0x96, 0xb2, 0xcb, 0xaf, 0x15, 0xee, 0x1c, 0xe4, 0x77,
+	0xd9, 0x21, 0xfc, 0xc6, 0x00, 0xb3, 0xf9, 0x69, 0x0d, 0x87, 0xa7, 0xd0, 0x2b, 0xe7, 0xfa, 0x28,
+	0x10, 0x4d, 0xdb, 0x96, 0xb4, 0x97, 0xe1, 0xe2, 0x70, 0xb4, 0xe1, 0xf7, 0x29, 0xcf, 0xdc, 0x80,
+	0x38, 0x97, 0x67, 0xff, 0x34, 0x3f, 0x97, 0xe7, 0x80, 0xa1, 0x6e, 0xbd, 0x22, 0x79, 0xda, 0xf0,
+	0xba, 0x73, 0xe6, 0x3f, 0x42, 0xbd, 0xf1, 0xe7, 0x1c, 0x10, 0xff, 0x10, 0x7e, 0x67, 0x80, 0x5a,
+	0x61, 0x5e, 0xc2, 0x11, 0x72, 0xf7, 0xea, 0x7a, 0x63, 0x24, 0x8c, 0x26, 0xec, 0x48, 0xc2, 0x2f,
+	0xc1, 0xa5, 0x21, 0x09, 0xc3, 0x5f, 0x0c, 0x70, 0xb1, 0x6f, 0x5e, 0xc1, 0xd7, 0xce, 0xce, 0x5d,
+	0x36, 0x4c, 0xcd, 0x8d, 0x91, 0x71, 0x9a, 0xf7, 0x2d, 0xc9, 0xfb, 0x4d, 0xf8, 0x7a, 0x29, 0xef,
+	0x8e, 0xc4, 0x7a, 0xf8, 0x14, 0xec, 0x1c, 0x14, 0x07, 0xf7, 0x61, 0x6b, 0xe3, 0xd1, 0x71, 0xc3,
+	0x78, 0x7c, 0xdc, 0x30, 0xfe, 0x3c, 0x6e, 0x18, 0x0f, 0x4e, 0x1a, 0x63, 0x8f, 0x4f, 0x1a, 0x63,
+	0xbf, 0x9d, 0x34, 0xc6, 0x3e, 0x99, 0x2f, 0xcc, 0xb6, 0xcf, 0x4e, 0xc3, 0x8b, 0x6e, 0x8c, 0x79,
+	0x7b, 0x4a, 0x0e, 0xa1, 0x1b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xbb, 0x9b, 0x2a, 0x8e,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +747,14 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request by id.
+	SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests, optionally only the pending
+	// ones.
+	SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error)
+	// UnjailEligibility queries whether a validator can be unjailed, and why
+	// not.
+	UnjailEligibility(ctx context.Context, in *QueryUnjailEligibilityRequest, opts ...grpc.CallOption) (*QueryUnjailEligibilityResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +792,33 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SlashRequest(ctx context.Context, in *QuerySlashRequestRequest, opts ...grpc.CallOption) (*QuerySlashRequestResponse, error) {
+	out := new(QuerySlashRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Query/SlashRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRequests(ctx context.Context, in *QuerySlashRequestsRequest, opts ...grpc.CallOption) (*QuerySlashRequestsResponse, error) {
+	out := new(QuerySlashRequestsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Query/SlashRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnjailEligibility(ctx context.Context, in *QueryUnjailEligibilityRequest, opts ...grpc.CallOption) (*QueryUnjailEligibilityResponse, error) {
+	out := new(QueryUnjailEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Query/UnjailEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +827,14 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashRequest queries a slash request by id.
+	SlashRequest(context.Context, *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error)
+	// SlashRequests queries the slash requests, optionally only the pending
+	// ones.
+	SlashRequests(context.Context, *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error)
+	// UnjailEligibility queries whether a validator can be unjailed, and why
+	// not.
+	UnjailEligibility(context.Context, *QueryUnjailEligibilityRequest) (*QueryUnjailEligibilityResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +850,15 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) SlashRequest(ctx context.Context, req *QuerySlashRequestRequest) (*QuerySlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequest not implemented")
+}
+func (*UnimplementedQueryServer) SlashRequests(ctx context.Context, req *QuerySlashRequestsRequest) (*QuerySlashRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRequests not implemented")
+}
+func (*UnimplementedQueryServer) UnjailEligibility(ctx context.Context, req *QueryUnjailEligibilityRequest) (*QueryUnjailEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailEligibility not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +918,60 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Query/SlashRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequest(ctx, req.(*QuerySlashRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Query/SlashRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRequests(ctx, req.(*QuerySlashRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnjailEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnjailEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnjailEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Query/UnjailEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnjailEligibility(ctx, req.(*QueryUnjailEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symSlash.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +988,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashRequest",
+			Handler:    _Query_SlashRequest_Handler,
+		},
+		{
+			MethodName: "SlashRequests",
+			Handler:    _Query_SlashRequests_Handler,
+		},
+		{
+			MethodName: "UnjailEligibility",
+			Handler:    _Query_UnjailEligibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/query.proto",
@@ -723,16 +1208,276 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySlashRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashRequests) > 0 {
+		for iNdEx := len(m.SlashRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnjailEligibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnjailEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnjailEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnjailEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnjailEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnjailEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	if m.SyncHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SyncHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinOperatorStake.Size()
+		i -= size
+		if _, err := m.MinOperatorStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -803,20 +1548,622 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRequests) > 0 {
+		for _, e := range m.SlashRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnjailEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnjailEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinOperatorStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SyncHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SyncHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,12 +2186,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -866,7 +2232,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,15 +2255,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,7 +2290,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -949,7 +2315,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,17 +2338,37 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -992,23 +2378,27 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1031,7 +2421,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +2444,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,7 +2479,44 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashRequests = append(m.SlashRequests, SlashRequest{})
+			if err := m.SlashRequests[len(m.SlashRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1114,7 +2541,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnjailEligibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,17 +2564,17 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnjailEligibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnjailEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1157,27 +2584,23 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1200,7 +2623,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnjailEligibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +2646,37 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnjailEligibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnjailEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,29 +2686,114 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOperatorStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOperatorStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncHeight", wireType)
+			}
+			m.SyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1292,10 +2820,7 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,6 +4,10 @@ import (
 	"time"
 )
 
+// DoubleSignJailEndTime is the JailedUntil of validators tombstoned for
+// double signing, in effect jailing them forever.
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
 func NewValidatorSigningInfo(
 	consAddr string, startHeight int64,
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxSlashProofHeaders bounds the execution headers of a slash request
+// fulfillment, from the block of the slash transaction to the block of a
+// Symbiotic sync.
+const MaxSlashProofHeaders = 256
+
+// EIP-712 domain of the slash request signatures.
+const (
+	SlashRequestDomainName    = "Symbiotic Slashing"
+	SlashRequestDomainVersion = "1"
+)
+
+var (
+	eip712DomainTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,string version)"))
+	slashRequestTypeHash = crypto.Keccak256([]byte("SlashRequest(string chainId,uint64 id,bytes key,uint256 amount,uint64 height,uint8 infraction)"))
+)
+
+// SlashRequestSignBytes returns the digest the operators sign to approve the
+// slash request of the chain with the given id, the EIP-712 typed data:
+//
+//	SlashRequest(string chainId,uint64 id,bytes key,uint256 amount,uint64 height,uint8 infraction)
+//
+// in the domain EIP712Domain(string name,string version) of
+// SlashRequestDomainName and SlashRequestDomainVersion.
+func SlashRequestSignBytes(chainID string, req SlashRequest) []byte {
+	domainSeparator := crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(SlashRequestDomainName)),
+		crypto.Keccak256([]byte(SlashRequestDomainVersion)),
+	)
+
+	structHash := crypto.Keccak256(
+		slashRequestTypeHash,
+		crypto.Keccak256([]byte(chainID)),
+		uint64Word(req.Id),
+		crypto.Keccak256(req.Key),
+		common.LeftPadBytes(req.Amount.BigInt().Bytes(), 32),
+		uint64Word(uint64(req.Height)),
+		uint64Word(uint64(req.Infraction)),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// uint64Word returns the 32 bytes ABI word of v.
+func uint64Word(v uint64) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], v)
+	return word
+}
+
+// SignedBy reports whether the validator signed the request.
+func (r SlashRequest) SignedBy(validator string) bool {
+	for _, sig := range r.Signatures {
+		if sig.ValidatorAddress == validator {
+			return true
+		}
+	}
+	return false
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	bytes "bytes"
 	cosmossdk_io_math "cosmossdk.io/math"
+	types "cosmossdk.io/x/symStaking/types"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -31,6 +33,45 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashRequestStatus is the relay status of a slash request.
+type SlashRequestStatus int32
+
+const (
+	// SLASH_REQUEST_STATUS_UNSPECIFIED defines an invalid status.
+	SlashRequestUnspecified SlashRequestStatus = 0
+	// SLASH_REQUEST_STATUS_PENDING defines a request waiting for the
+	// signatures of the operators.
+	SlashRequestPending SlashRequestStatus = 1
+	// SLASH_REQUEST_STATUS_FULFILLED defines a request whose slash was proven
+	// on Ethereum.
+	SlashRequestFulfilled SlashRequestStatus = 2
+	// SLASH_REQUEST_STATUS_SIGNED defines a request signed by the operators of
+	// more than 2/3 of the voting power, waiting to be relayed.
+	SlashRequestSigned SlashRequestStatus = 3
+)
+
+var SlashRequestStatus_name = map[int32]string{
+	0: "SLASH_REQUEST_STATUS_UNSPECIFIED",
+	1: "SLASH_REQUEST_STATUS_PENDING",
+	2: "SLASH_REQUEST_STATUS_FULFILLED",
+	3: "SLASH_REQUEST_STATUS_SIGNED",
+}
+
+var SlashRequestStatus_value = map[string]int32{
+	"SLASH_REQUEST_STATUS_UNSPECIFIED": 0,
+	"SLASH_REQUEST_STATUS_PENDING":     1,
+	"SLASH_REQUEST_STATUS_FULFILLED":   2,
+	"SLASH_REQUEST_STATUS_SIGNED":      3,
+}
+
+func (x SlashRequestStatus) String() string {
+	return proto.EnumName(SlashRequestStatus_name, int32(x))
+}
+
+func (SlashRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -135,6 +176,15 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// min_operator_stake is the middleware stake a jailed validator must have
+	// at the last applied Symbiotic sync to be unjailed.
+	MinOperatorStake cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=min_operator_stake,json=minOperatorStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_operator_stake"`
+	// auto_unjail unjails the validators eligible to it once their jail period
+	// is over, without a MsgUnjail.
+	AutoUnjail bool `protobuf:"varint,8,opt,name=auto_unjail,json=autoUnjail,proto3" json:"auto_unjail,omitempty"`
+	// slash_abi describes how slash requests are submitted to the middleware.
+	// The SimpleMiddleware slash function is used if it is not set.
+	SlashAbi MiddlewareSlashABI `protobuf:"bytes,9,opt,name=slash_abi,json=slashAbi,proto3" json:"slash_abi"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +234,390 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetAutoUnjail() bool {
+	if m != nil {
+		return m.AutoUnjail
+	}
+	return false
+}
+
+func (m *Params) GetSlashAbi() MiddlewareSlashABI {
+	if m != nil {
+		return m.SlashAbi
+	}
+	return MiddlewareSlashABI{}
+}
+
+// MiddlewareSlashABI describes the middleware function slash requests are
+// submitted with. The inputs of the slash method not named by the mapping are
+// passed as zero values.
+type MiddlewareSlashABI struct {
+	// abi is the JSON ABI holding the methods of the mapping.
+	Abi string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	// slash_method is the name of the method slashing the stake of a validator.
+	SlashMethod string `protobuf:"bytes,2,opt,name=slash_method,json=slashMethod,proto3" json:"slash_method,omitempty"`
+	// epoch_method is the name of the view method returning the middleware
+	// epoch at a timestamp, empty if the slash method takes no epoch.
+	EpochMethod string `protobuf:"bytes,3,opt,name=epoch_method,json=epochMethod,proto3" json:"epoch_method,omitempty"`
+	// epoch_input is the name of the slash method input taking the epoch.
+	EpochInput string `protobuf:"bytes,4,opt,name=epoch_input,json=epochInput,proto3" json:"epoch_input,omitempty"`
+	// key_input is the name of the slash method input taking the key of the
+	// validator, a bytes32, address or bytes.
+	KeyInput string `protobuf:"bytes,5,opt,name=key_input,json=keyInput,proto3" json:"key_input,omitempty"`
+	// amount_input is the name of the slash method input taking the amount.
+	AmountInput string `protobuf:"bytes,6,opt,name=amount_input,json=amountInput,proto3" json:"amount_input,omitempty"`
+	// request_id_input is the name of the slash method input taking the id of
+	// the slash request, empty if the middleware does not dedupe requests.
+	RequestIdInput string `protobuf:"bytes,7,opt,name=request_id_input,json=requestIdInput,proto3" json:"request_id_input,omitempty"`
+	// signatures_input is the name of the slash method input taking the
+	// operator signatures of the slash request as a bytes[], empty if the
+	// middleware does not check them.
+	SignaturesInput string `protobuf:"bytes,8,opt,name=signatures_input,json=signaturesInput,proto3" json:"signatures_input,omitempty"`
+}
+
+func (m *MiddlewareSlashABI) Reset()         { *m = MiddlewareSlashABI{} }
+func (m *MiddlewareSlashABI) String() string { return proto.CompactTextString(m) }
+func (*MiddlewareSlashABI) ProtoMessage()    {}
+func (*MiddlewareSlashABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{2}
+}
+func (m *MiddlewareSlashABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiddlewareSlashABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MiddlewareSlashABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MiddlewareSlashABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiddlewareSlashABI.Merge(m, src)
+}
+func (m *MiddlewareSlashABI) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiddlewareSlashABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiddlewareSlashABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiddlewareSlashABI proto.InternalMessageInfo
+
+func (m *MiddlewareSlashABI) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetSlashMethod() string {
+	if m != nil {
+		return m.SlashMethod
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetEpochMethod() string {
+	if m != nil {
+		return m.EpochMethod
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetEpochInput() string {
+	if m != nil {
+		return m.EpochInput
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetKeyInput() string {
+	if m != nil {
+		return m.KeyInput
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetAmountInput() string {
+	if m != nil {
+		return m.AmountInput
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetRequestIdInput() string {
+	if m != nil {
+		return m.RequestIdInput
+	}
+	return ""
+}
+
+func (m *MiddlewareSlashABI) GetSignaturesInput() string {
+	if m != nil {
+		return m.SignaturesInput
+	}
+	return ""
+}
+
+// SlashRequest is an infraction to be slashed on the Symbiotic middleware, as
+// stake lives on Ethereum.
+type SlashRequest struct {
+	// id is the sequence number of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the operator address of the infringing validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// cons_address is the consensus address of the infringing validator.
+	ConsAddress string `protobuf:"bytes,3,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// operator is the middleware operator of the validator, if reported.
+	Operator []byte `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	// infraction is the infraction the validator committed.
+	Infraction types.Infraction `protobuf:"varint,5,opt,name=infraction,proto3,enum=cosmos.symStaking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// height is the height of the infraction.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the infraction block.
+	Time time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the fraction of the stake to slash.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// stake is the middleware stake of the validator at the infraction.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	// amount is the stake amount to slash.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// block_number is the number of the execution block of the last applied
+	// Symbiotic sync at the infraction.
+	BlockNumber uint64 `protobuf:"varint,11,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix time of that execution block.
+	BlockTimestamp uint64 `protobuf:"varint,12,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// epoch is the beacon chain epoch of that execution block.
+	Epoch uint64 `protobuf:"varint,13,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// status is the relay status of the request.
+	Status SlashRequestStatus `protobuf:"varint,14,opt,name=status,proto3,enum=cosmos.symSlash.v1beta1.SlashRequestStatus" json:"status,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction that slashed the stake.
+	TxHash string `protobuf:"bytes,15,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// fulfilled_height is the height the request was fulfilled at.
+	FulfilledHeight int64 `protobuf:"varint,16,opt,name=fulfilled_height,json=fulfilledHeight,proto3" json:"fulfilled_height,omitempty"`
+	// key is the key the middleware identifies the validator by, of the key
+	// type of the x/symStaking middleware_abi param.
+	Key []byte `protobuf:"bytes,17,opt,name=key,proto3" json:"key,omitempty"`
+	// signatures are the operator signatures of the request.
+	Signatures []SlashRequestSignature `protobuf:"bytes,18,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *SlashRequest) Reset()         { *m = SlashRequest{} }
+func (m *SlashRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashRequest) ProtoMessage()    {}
+func (*SlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{3}
+}
+func (m *SlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRequest.Merge(m, src)
+}
+func (m *SlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRequest proto.InternalMessageInfo
+
+func (m *SlashRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *SlashRequest) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *SlashRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SlashRequest) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetStatus() SlashRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashRequestUnspecified
+}
+
+func (m *SlashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *SlashRequest) GetFulfilledHeight() int64 {
+	if m != nil {
+		return m.FulfilledHeight
+	}
+	return 0
+}
+
+func (m *SlashRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SlashRequest) GetSignatures() []SlashRequestSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// SlashRequestSignature is the signature of a slash request by the operator
+// of a validator.
+type SlashRequestSignature struct {
+	// validator_address is the operator address of the signing validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the Ethereum address of the operator of the validator.
+	Operator []byte `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// signature is the 65 bytes secp256k1 signature of the EIP-712 digest of
+	// the request.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SlashRequestSignature) Reset()         { *m = SlashRequestSignature{} }
+func (m *SlashRequestSignature) String() string { return proto.CompactTextString(m) }
+func (*SlashRequestSignature) ProtoMessage()    {}
+func (*SlashRequestSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d2851e005d65ca8, []int{4}
+}
+func (m *SlashRequestSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRequestSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRequestSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRequestSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRequestSignature.Merge(m, src)
+}
+func (m *SlashRequestSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRequestSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRequestSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRequestSignature proto.InternalMessageInfo
+
+func (m *SlashRequestSignature) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRequestSignature) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *SlashRequestSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.symSlash.v1beta1.SlashRequestStatus", SlashRequestStatus_name, SlashRequestStatus_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.symSlash.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.symSlash.v1beta1.Params")
+	proto.RegisterType((*MiddlewareSlashABI)(nil), "cosmos.symSlash.v1beta1.MiddlewareSlashABI")
+	proto.RegisterType((*SlashRequest)(nil), "cosmos.symSlash.v1beta1.SlashRequest")
+	proto.RegisterType((*SlashRequestSignature)(nil), "cosmos.symSlash.v1beta1.SlashRequestSignature")
 }
 
 func init() {
@@ -194,47 +625,93 @@ func init() {
 }
 
 var fileDescriptor_3d2851e005d65ca8 = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x00, 0xa2, 0x4e, 0xeb, 0xc1, 0xb1, 0xc8, 0x52, 0x65, 0x5b, 0x48, 0x34, 0x0d, 0x09,
-	0xbb, 0x82, 0x89, 0x07, 0x38, 0x59, 0x1a, 0xa3, 0x86, 0x44, 0xd2, 0xfa, 0x23, 0xf1, 0xe0, 0x66,
-	0x76, 0x67, 0xba, 0x1d, 0xd9, 0x9d, 0x69, 0x76, 0x66, 0x05, 0xfe, 0x05, 0x13, 0x13, 0x8e, 0x1e,
-	0x3d, 0x72, 0xe4, 0xc0, 0xbf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0xb4, 0x07, 0xfc, 0x33,
-	0xcc, 0xce, 0xec, 0x0a, 0xc2, 0x8d, 0x4b, 0xd3, 0x7d, 0xdf, 0xf7, 0xbe, 0x6f, 0xdf, 0xf7, 0x5e,
-	0x16, 0x3e, 0x0c, 0x84, 0x8c, 0x85, 0x74, 0xe5, 0x4e, 0xdc, 0x8d, 0xb0, 0xec, 0xbb, 0x9f, 0x96,
-	0x7c, 0xaa, 0xf0, 0x92, 0x2b, 0xb3, 0x27, 0xc6, 0x43, 0x67, 0x90, 0x08, 0x25, 0xd0, 0xb4, 0xe1,
-	0x39, 0x05, 0xcf, 0xc9, 0x79, 0xb5, 0x6a, 0x28, 0x42, 0xa1, 0x39, 0x6e, 0xf6, 0xcf, 0xd0, 0x6b,
-	0x76, 0x28, 0x44, 0x18, 0x51, 0x57, 0x3f, 0xf9, 0x69, 0xcf, 0x25, 0x69, 0x82, 0x15, 0x13, 0x3c,
-	0xc7, 0xeb, 0x17, 0x71, 0xc5, 0x62, 0x2a, 0x15, 0x8e, 0x07, 0x39, 0x61, 0xc6, 0xf8, 0x79, 0x46,
-	0x39, 0x37, 0x37, 0xd0, 0x6d, 0x1c, 0x33, 0x2e, 0x5c, 0xfd, 0x6b, 0x4a, 0xf3, 0xdf, 0xc7, 0x60,
-	0xf5, 0x2d, 0x8e, 0x18, 0xc1, 0x4a, 0x24, 0x5d, 0x16, 0x72, 0xc6, 0xc3, 0x17, 0xbc, 0x27, 0xd0,
-	0x2a, 0xbc, 0x8e, 0x09, 0x49, 0xa8, 0x94, 0x16, 0x68, 0x80, 0xe6, 0xcd, 0xd6, 0xdc, 0xf1, 0xc1,
-	0xe2, 0x6c, 0x2e, 0xb7, 0x26, 0xb8, 0xa4, 0x5c, 0xa6, 0xf2, 0xa9, 0xa1, 0x74, 0x55, 0xc2, 0x78,
-	0xd8, 0x29, 0x3a, 0xd0, 0x1c, 0xac, 0x48, 0x85, 0x13, 0xe5, 0xf5, 0x29, 0x0b, 0xfb, 0xca, 0x1a,
-	0x6b, 0x80, 0xe6, 0x78, 0xa7, 0xac, 0x6b, 0xcf, 0x75, 0x09, 0x3d, 0x80, 0x15, 0xc6, 0x09, 0xdd,
-	0xf6, 0x44, 0xaf, 0x27, 0xa9, 0xb2, 0xc6, 0x33, 0x4a, 0x6b, 0xcc, 0x02, 0x9d, 0xb2, 0xae, 0xbf,
-	0xd2, 0x65, 0xb4, 0x0e, 0x2b, 0x1f, 0x31, 0x8b, 0x28, 0xf1, 0x52, 0xae, 0x58, 0x64, 0x4d, 0x34,
-	0x40, 0xb3, 0xbc, 0x5c, 0x73, 0x4c, 0x0a, 0x4e, 0x91, 0x82, 0xf3, 0xba, 0x48, 0xa1, 0x75, 0xeb,
-	0xf0, 0xa4, 0x5e, 0xda, 0xfd, 0x55, 0x07, 0x7b, 0xa7, 0xfb, 0x0b, 0xa0, 0x53, 0x36, 0xed, 0x6f,
-	0xb2, 0x6e, 0x64, 0x43, 0xa8, 0x44, 0xec, 0x4b, 0x25, 0x38, 0x25, 0xd6, 0xb5, 0x06, 0x68, 0xde,
-	0xe8, 0x9c, 0xab, 0xa0, 0x65, 0x38, 0x15, 0x33, 0x29, 0x29, 0xf1, 0xfc, 0x48, 0x04, 0x9b, 0xd2,
-	0x0b, 0x44, 0xca, 0x15, 0x4d, 0xac, 0x49, 0x3d, 0xc0, 0x1d, 0x03, 0xb6, 0x34, 0xb6, 0x66, 0xa0,
-	0x95, 0x89, 0x3f, 0xdf, 0xea, 0x60, 0xfe, 0xcb, 0x04, 0x9c, 0xdc, 0xc0, 0x09, 0x8e, 0x25, 0x7a,
-	0x04, 0xab, 0x92, 0x85, 0xfc, 0x4c, 0x64, 0x8b, 0x71, 0x22, 0xb6, 0x74, 0x8c, 0xe3, 0x1d, 0x64,
-	0x30, 0xa3, 0xf1, 0x4e, 0x23, 0x88, 0x65, 0xb6, 0xdc, 0xcb, 0xbb, 0x06, 0x34, 0x29, 0x5a, 0xb2,
-	0xdc, 0x2a, 0xad, 0x27, 0xd9, 0x44, 0x3f, 0x4f, 0xea, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x3a, 0x4c,
-	0xb8, 0x31, 0x56, 0x7d, 0x67, 0x9d, 0x86, 0x38, 0xd8, 0x69, 0xd3, 0xe0, 0xf8, 0x60, 0x11, 0xe6,
-	0xcb, 0x69, 0xd3, 0xc0, 0x8c, 0x8e, 0x62, 0xc6, 0xbb, 0x5a, 0x73, 0x83, 0x26, 0xb9, 0xd5, 0x07,
-	0x78, 0x97, 0x88, 0x2d, 0x9e, 0x1d, 0x8d, 0x97, 0x25, 0xe3, 0x15, 0xe7, 0xa5, 0x17, 0x50, 0x5e,
-	0x9e, 0xb9, 0x94, 0x6c, 0x3b, 0x27, 0x98, 0x60, 0xbf, 0xfe, 0x0b, 0xb6, 0x5a, 0xe8, 0xbc, 0xc4,
-	0x2c, 0x2a, 0x48, 0x48, 0xc2, 0x9a, 0xbe, 0x7f, 0xaf, 0x97, 0xe0, 0x20, 0xab, 0x78, 0x44, 0xa4,
-	0x7e, 0x44, 0xf5, 0x70, 0x7a, 0x7b, 0x57, 0x9f, 0x67, 0x5a, 0x2b, 0x3f, 0xcb, 0x85, 0xdb, 0x5a,
-	0x37, 0x9b, 0x0f, 0x71, 0x38, 0x7d, 0xc9, 0xd4, 0xbc, 0x9b, 0xde, 0xf1, 0xd5, 0x1d, 0xa7, 0x2e,
-	0x38, 0x1a, 0xd1, 0x95, 0xb9, 0xcf, 0xa7, 0xfb, 0x0b, 0xf7, 0x0d, 0x79, 0x51, 0x92, 0x4d, 0x77,
-	0xfb, 0xec, 0x2b, 0x60, 0x8e, 0xa0, 0xb5, 0xba, 0x37, 0xb4, 0xc1, 0xe1, 0xd0, 0x06, 0x47, 0x43,
-	0x1b, 0xfc, 0x1e, 0xda, 0x60, 0x77, 0x64, 0x97, 0x8e, 0x46, 0x76, 0xe9, 0xc7, 0xc8, 0x2e, 0xbd,
-	0x9f, 0xfd, 0xef, 0x3d, 0xce, 0x75, 0xab, 0x9d, 0x01, 0x95, 0xfe, 0xa4, 0x0e, 0xff, 0xf1, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x13, 0x3f, 0xb7, 0x63, 0x04, 0x00, 0x00,
+	// 1366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xda, 0x89, 0x63, 0x8f, 0x4d, 0x62, 0x86, 0x84, 0x2c, 0x0e, 0xd8, 0x4e, 0x24, 0xfe,
+	0xe4, 0x0f, 0xc2, 0x86, 0x54, 0x6a, 0x55, 0x10, 0x07, 0x3b, 0x76, 0xc0, 0x28, 0x84, 0x74, 0x1d,
+	0xb7, 0x6a, 0x0f, 0xac, 0xd6, 0xde, 0xb1, 0x3d, 0xb5, 0x77, 0xd6, 0xdd, 0x99, 0x25, 0xf1, 0x37,
+	0xa8, 0x72, 0xe2, 0xd8, 0x4b, 0xa4, 0x96, 0x5e, 0x38, 0x72, 0xe0, 0x2b, 0x54, 0xe2, 0x88, 0x38,
+	0x55, 0x3d, 0xd0, 0x2a, 0x1c, 0xe8, 0x47, 0xe8, 0xb1, 0x9a, 0x37, 0xbb, 0x8e, 0x49, 0x82, 0x04,
+	0xe9, 0x25, 0xf2, 0xfc, 0xde, 0xef, 0xbd, 0x37, 0xf3, 0xde, 0xfb, 0xbd, 0x0d, 0xfa, 0x5f, 0xcb,
+	0xe5, 0x8e, 0xcb, 0x8b, 0x7c, 0xe8, 0xd4, 0xfb, 0x16, 0xef, 0x16, 0x1f, 0xdf, 0x6c, 0x12, 0x61,
+	0xdd, 0x2c, 0x72, 0x79, 0xa2, 0xac, 0x53, 0x18, 0x78, 0xae, 0x70, 0xf1, 0x82, 0xe2, 0x15, 0x42,
+	0x5e, 0x21, 0xe0, 0x65, 0xe6, 0x3a, 0x6e, 0xc7, 0x05, 0x4e, 0x51, 0xfe, 0x52, 0xf4, 0x4c, 0xb6,
+	0xe3, 0xba, 0x9d, 0x3e, 0x29, 0xc2, 0xa9, 0xe9, 0xb7, 0x8b, 0xb6, 0xef, 0x59, 0x82, 0xba, 0x2c,
+	0xb0, 0xe7, 0x8e, 0xda, 0x05, 0x75, 0x08, 0x17, 0x96, 0x33, 0x08, 0x08, 0x17, 0x54, 0x3e, 0x53,
+	0x45, 0x0e, 0x92, 0x2b, 0xd3, 0x59, 0xcb, 0xa1, 0xcc, 0x2d, 0xc2, 0xdf, 0x00, 0xba, 0x32, 0xf6,
+	0x0a, 0x61, 0xf5, 0x28, 0xeb, 0x1c, 0xbe, 0x43, 0x9d, 0x15, 0x71, 0xf9, 0xb7, 0x08, 0x9a, 0xfb,
+	0xda, 0xea, 0x53, 0xdb, 0x12, 0xae, 0x57, 0xa7, 0x1d, 0x46, 0x59, 0xa7, 0xc6, 0xda, 0x2e, 0xbe,
+	0x8d, 0xa6, 0x2d, 0xdb, 0xf6, 0x08, 0xe7, 0xba, 0x96, 0xd7, 0x56, 0x12, 0xe5, 0xa5, 0xd7, 0x2f,
+	0xae, 0x5f, 0x0a, 0xf2, 0xae, 0xb9, 0x8c, 0x13, 0xc6, 0x7d, 0x5e, 0x52, 0x94, 0xba, 0xf0, 0x28,
+	0xeb, 0x18, 0xa1, 0x07, 0x5e, 0x42, 0x29, 0x2e, 0x2c, 0x4f, 0x98, 0x5d, 0x42, 0x3b, 0x5d, 0xa1,
+	0x47, 0xf2, 0xda, 0x4a, 0xd4, 0x48, 0x02, 0x76, 0x0f, 0x20, 0x7c, 0x19, 0xa5, 0x28, 0xb3, 0xc9,
+	0xae, 0xe9, 0xb6, 0xdb, 0x9c, 0x08, 0x3d, 0x2a, 0x29, 0xe5, 0x88, 0xae, 0x19, 0x49, 0xc0, 0x1f,
+	0x02, 0x8c, 0x37, 0x50, 0xea, 0x7b, 0x8b, 0xf6, 0x89, 0x6d, 0xfa, 0x4c, 0xd0, 0xbe, 0x3e, 0x99,
+	0xd7, 0x56, 0x92, 0xab, 0x99, 0x82, 0x2a, 0x57, 0x21, 0x2c, 0x57, 0x61, 0x3b, 0x2c, 0x57, 0xf9,
+	0xcc, 0xcb, 0x37, 0xb9, 0x89, 0x27, 0x7f, 0xe6, 0xb4, 0x67, 0xef, 0x9e, 0x5f, 0xd5, 0x8c, 0xa4,
+	0x72, 0x6f, 0x48, 0x6f, 0x9c, 0x45, 0x48, 0xb8, 0x4e, 0x93, 0x0b, 0x97, 0x11, 0x5b, 0x9f, 0xca,
+	0x6b, 0x2b, 0x71, 0x63, 0x0c, 0xc1, 0xab, 0x68, 0xde, 0xa1, 0x9c, 0x13, 0xdb, 0x6c, 0xf6, 0xdd,
+	0x56, 0x8f, 0x9b, 0x2d, 0xd7, 0x67, 0x82, 0x78, 0x7a, 0x0c, 0x1e, 0x70, 0x4e, 0x19, 0xcb, 0x60,
+	0x5b, 0x53, 0xa6, 0x5b, 0x93, 0x7f, 0xff, 0x9c, 0xd3, 0x96, 0xff, 0x99, 0x42, 0xb1, 0x2d, 0xcb,
+	0xb3, 0x1c, 0x8e, 0x6f, 0xa0, 0x39, 0x4e, 0x3b, 0xec, 0x30, 0xc8, 0x0e, 0x65, 0xb6, 0xbb, 0x03,
+	0x65, 0x8c, 0x1a, 0x58, 0xd9, 0x54, 0x8c, 0x6f, 0xc0, 0x82, 0xa9, 0x4c, 0xcb, 0xcc, 0xc0, 0x6b,
+	0x40, 0xbc, 0xd0, 0x45, 0xd6, 0x2d, 0x55, 0xfe, 0x5c, 0xbe, 0xe8, 0x8f, 0x37, 0xb9, 0x45, 0x55,
+	0x7d, 0x6e, 0xf7, 0x0a, 0xd4, 0x2d, 0x3a, 0x96, 0xe8, 0x16, 0x36, 0x48, 0xc7, 0x6a, 0x0d, 0x2b,
+	0xa4, 0xf5, 0xfa, 0xc5, 0x75, 0x14, 0x34, 0xa7, 0x42, 0x5a, 0xea, 0xe9, 0xd8, 0xa1, 0xac, 0x0e,
+	0x31, 0xb7, 0x88, 0x17, 0xa4, 0x7a, 0x84, 0xce, 0xdb, 0xee, 0x0e, 0x93, 0xd3, 0x65, 0xca, 0xca,
+	0x98, 0xe1, 0x1c, 0x42, 0x03, 0x92, 0xab, 0x17, 0x8e, 0x55, 0xb6, 0x12, 0x10, 0x54, 0x61, 0x7f,
+	0x1a, 0x15, 0x76, 0x2e, 0x8c, 0x73, 0xdf, 0xa2, 0xfd, 0x90, 0x84, 0x39, 0xca, 0x80, 0x50, 0xcc,
+	0xb6, 0x67, 0xb5, 0x24, 0x62, 0xda, 0xae, 0xdf, 0xec, 0x13, 0x78, 0x1c, 0x74, 0xef, 0xf4, 0xef,
+	0x59, 0x80, 0xc8, 0xeb, 0x41, 0xe0, 0x0a, 0xc4, 0x95, 0xef, 0xc3, 0x0c, 0x2d, 0x1c, 0x4b, 0xaa,
+	0xee, 0x06, 0x3d, 0x3e, 0x7d, 0xc6, 0xf9, 0x23, 0x19, 0x55, 0x50, 0xfc, 0x08, 0xc9, 0xd2, 0x9a,
+	0xee, 0x80, 0x78, 0x52, 0x36, 0xa6, 0x94, 0x14, 0xd1, 0xa7, 0x41, 0x26, 0x37, 0x82, 0x54, 0xf3,
+	0xc7, 0x53, 0xd5, 0x98, 0x18, 0x4b, 0x52, 0x63, 0x42, 0x25, 0x49, 0x3b, 0x94, 0x3d, 0x0c, 0x42,
+	0x49, 0xb1, 0x12, 0x9c, 0x43, 0x49, 0xcb, 0x17, 0xae, 0xe9, 0x33, 0xd9, 0x22, 0x3d, 0xae, 0xe6,
+	0x54, 0x42, 0x0d, 0x40, 0x70, 0x1d, 0x25, 0xd4, 0x83, 0xad, 0x26, 0xd5, 0x13, 0xd0, 0xb8, 0x6b,
+	0x85, 0x0f, 0x2c, 0xa4, 0xc2, 0x03, 0x6a, 0xdb, 0x7d, 0xb2, 0x63, 0x79, 0x04, 0xf0, 0x52, 0xb9,
+	0x56, 0x4e, 0xc8, 0x4b, 0xaa, 0xec, 0x71, 0x08, 0x54, 0x6a, 0xd2, 0x5b, 0x4b, 0x7b, 0xef, 0x9e,
+	0x5f, 0xbd, 0xa8, 0xa2, 0x5c, 0xe7, 0x76, 0xaf, 0xb8, 0x7b, 0xb8, 0x04, 0xd5, 0x68, 0xdf, 0x9f,
+	0x8c, 0xc7, 0xd2, 0xd3, 0xc6, 0x19, 0x95, 0xdb, 0x23, 0x7d, 0x6b, 0x48, 0xbc, 0xe5, 0xa7, 0x11,
+	0x84, 0x8f, 0xe7, 0xc0, 0x69, 0x14, 0x95, 0xb7, 0x83, 0xe5, 0x61, 0xc8, 0x9f, 0xb0, 0x15, 0xc0,
+	0xd3, 0x21, 0xa2, 0xeb, 0xda, 0x30, 0xdd, 0x09, 0x23, 0x09, 0xd8, 0x03, 0x80, 0x24, 0x85, 0x0c,
+	0xdc, 0xd6, 0x88, 0x12, 0x55, 0x14, 0xc0, 0x02, 0x4a, 0x0e, 0xa9, 0xa3, 0x49, 0xd9, 0xc0, 0x17,
+	0x30, 0x52, 0x09, 0x03, 0x01, 0x54, 0x93, 0x08, 0x5e, 0x44, 0x89, 0x1e, 0x19, 0x06, 0xe6, 0x29,
+	0x30, 0xc7, 0x7b, 0x64, 0xa8, 0x8c, 0x4b, 0x28, 0x65, 0x39, 0x52, 0xb9, 0x81, 0x3d, 0xa6, 0x12,
+	0x28, 0x4c, 0x51, 0x56, 0x50, 0xda, 0x23, 0x3f, 0xf8, 0x84, 0x0b, 0x93, 0xda, 0x01, 0x0d, 0x7a,
+	0x6b, 0xcc, 0x04, 0x78, 0xcd, 0x56, 0xcc, 0xff, 0xa3, 0xb4, 0x1c, 0x6b, 0x4b, 0xf8, 0x1e, 0xe1,
+	0x01, 0x33, 0x0e, 0xcc, 0xd9, 0x43, 0x1c, 0xa8, 0xcb, 0x4f, 0xa7, 0x51, 0x0a, 0x4a, 0x63, 0xa8,
+	0x10, 0x78, 0x06, 0x45, 0xa8, 0x0d, 0xd5, 0x99, 0x34, 0x22, 0xd4, 0xc6, 0x9b, 0xe8, 0xec, 0xe3,
+	0x70, 0x0f, 0x9b, 0xe1, 0xe6, 0x8d, 0x1c, 0xdb, 0xbc, 0xa3, 0x5d, 0xfd, 0xfe, 0xe6, 0x4d, 0x3f,
+	0x3e, 0x82, 0xe3, 0x0a, 0x4a, 0xb5, 0x5c, 0xc6, 0x47, 0xa1, 0xa2, 0x1f, 0xbb, 0xc4, 0x93, 0xd2,
+	0x2d, 0x8c, 0x92, 0x41, 0xf1, 0x70, 0xca, 0x95, 0x78, 0x8d, 0xd1, 0x19, 0x57, 0x11, 0xa2, 0x2c,
+	0x54, 0x1c, 0x14, 0x7a, 0x66, 0xf5, 0xf2, 0xf8, 0x14, 0x06, 0x1f, 0x9a, 0x70, 0x0e, 0x6b, 0x23,
+	0xb2, 0x31, 0xe6, 0x88, 0xcf, 0xa3, 0x58, 0xf0, 0x95, 0x50, 0x4b, 0x36, 0x38, 0xe1, 0x3b, 0x68,
+	0x12, 0x14, 0x3c, 0xfd, 0xa9, 0x1b, 0x1f, 0xdc, 0xb0, 0x81, 0xe2, 0xa3, 0xbb, 0xc5, 0xff, 0xd3,
+	0x12, 0x18, 0xc5, 0xc1, 0x25, 0x34, 0xa5, 0xa4, 0x9e, 0x80, 0x62, 0x5e, 0xfb, 0x04, 0xa9, 0x1b,
+	0xca, 0x13, 0xaf, 0xa1, 0x98, 0x9a, 0x35, 0x1d, 0x7d, 0x7a, 0x8c, 0xc0, 0x55, 0x0e, 0x31, 0x7c,
+	0x5a, 0x4c, 0xe6, 0x3b, 0x4d, 0xe2, 0xe9, 0x49, 0x98, 0xa2, 0x24, 0x60, 0x9b, 0x00, 0xe1, 0x2b,
+	0x68, 0x56, 0x51, 0x46, 0xff, 0x47, 0xe8, 0x29, 0x60, 0xcd, 0x00, 0x3c, 0x2a, 0x1e, 0x9e, 0x43,
+	0x53, 0xa0, 0x1d, 0xfd, 0x0c, 0x98, 0xd5, 0x41, 0x5e, 0x93, 0x0b, 0x4b, 0xf8, 0x5c, 0x9f, 0x81,
+	0xbe, 0x7e, 0x78, 0xbb, 0x8c, 0x0f, 0x75, 0x1d, 0x5c, 0x8c, 0xc0, 0x15, 0x2f, 0xa0, 0x69, 0xb1,
+	0x6b, 0x76, 0x2d, 0xde, 0xd5, 0x67, 0x41, 0x15, 0x31, 0xb1, 0x7b, 0xcf, 0xe2, 0x5d, 0xa9, 0x9b,
+	0xb6, 0xdf, 0x6f, 0xd3, 0xbe, 0xfc, 0xae, 0x07, 0xcd, 0x4f, 0x43, 0xf3, 0x67, 0x47, 0x78, 0xf0,
+	0x6f, 0x42, 0x1a, 0x45, 0x7b, 0x64, 0xa8, 0x9f, 0x85, 0xd9, 0x93, 0x3f, 0xf1, 0xb7, 0x08, 0x1d,
+	0x8a, 0x4b, 0xc7, 0xf9, 0xe8, 0x4a, 0x72, 0xb5, 0xf0, 0x71, 0xd7, 0x0b, 0xdd, 0xc6, 0xf7, 0xdf,
+	0x58, 0xb0, 0xe5, 0x5f, 0x34, 0x34, 0x7f, 0xa2, 0xc3, 0xc9, 0xea, 0xd4, 0x4e, 0xaf, 0xce, 0x71,
+	0x5d, 0x45, 0x8e, 0xe8, 0xea, 0x22, 0x4a, 0x8c, 0xee, 0x04, 0xb2, 0x4d, 0x19, 0x87, 0xc0, 0xd5,
+	0x27, 0x11, 0x84, 0x8f, 0xd7, 0x1c, 0x97, 0x50, 0xbe, 0xbe, 0x51, 0xaa, 0xdf, 0x33, 0x8d, 0xea,
+	0x57, 0x8d, 0x6a, 0x7d, 0xdb, 0xac, 0x6f, 0x97, 0xb6, 0x1b, 0x75, 0xb3, 0xb1, 0x59, 0xdf, 0xaa,
+	0xae, 0xd5, 0xd6, 0x6b, 0xd5, 0x4a, 0x7a, 0x22, 0xb3, 0xb8, 0xb7, 0x9f, 0x5f, 0x18, 0xf7, 0x6e,
+	0x30, 0x3e, 0x20, 0x2d, 0xda, 0xa6, 0xc4, 0xc6, 0x5f, 0xa2, 0x8b, 0x27, 0x86, 0xd8, 0xaa, 0x6e,
+	0x56, 0x6a, 0x9b, 0x77, 0xd3, 0x5a, 0x66, 0x61, 0x6f, 0x3f, 0x7f, 0x6e, 0xdc, 0x7d, 0x8b, 0x30,
+	0x9b, 0xb2, 0x0e, 0xbe, 0x83, 0xb2, 0x27, 0xba, 0xae, 0x37, 0x36, 0xd6, 0x6b, 0x1b, 0x1b, 0xd5,
+	0x4a, 0x3a, 0x92, 0xb9, 0xb0, 0xb7, 0x9f, 0x7f, 0xaf, 0xba, 0xeb, 0x61, 0xab, 0xf1, 0x17, 0x68,
+	0xf1, 0x44, 0xf7, 0x7a, 0xed, 0xee, 0x66, 0xb5, 0x92, 0x8e, 0x66, 0xce, 0xef, 0xed, 0xe7, 0xf1,
+	0xd1, 0xce, 0x10, 0x3b, 0x33, 0xf9, 0xe3, 0xaf, 0xd9, 0x89, 0xf2, 0xed, 0x67, 0x07, 0x59, 0xed,
+	0xe5, 0x41, 0x56, 0x7b, 0x75, 0x90, 0xd5, 0xfe, 0x3a, 0xc8, 0x6a, 0x4f, 0xde, 0x66, 0x27, 0x5e,
+	0xbd, 0xcd, 0x4e, 0xfc, 0xfe, 0x36, 0x3b, 0xf1, 0xdd, 0xa5, 0xf7, 0x94, 0x35, 0xf6, 0x4d, 0x13,
+	0xc3, 0x01, 0xe1, 0xcd, 0x18, 0x2c, 0x94, 0xcf, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x00,
+	0x39, 0xbb, 0xf8, 0x0b, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -310,45 +787,209 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.MinOperatorStake.Equal(that1.MinOperatorStake) {
+		return false
+	}
+	if this.AutoUnjail != that1.AutoUnjail {
+		return false
+	}
+	if !this.SlashAbi.Equal(&that1.SlashAbi) {
+		return false
+	}
 	return true
 }
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MiddlewareSlashABI) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
+	that1, ok := that.(*MiddlewareSlashABI)
+	if !ok {
+		that2, ok := that.(MiddlewareSlashABI)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Abi != that1.Abi {
+		return false
+	}
+	if this.SlashMethod != that1.SlashMethod {
+		return false
+	}
+	if this.EpochMethod != that1.EpochMethod {
+		return false
+	}
+	if this.EpochInput != that1.EpochInput {
+		return false
+	}
+	if this.KeyInput != that1.KeyInput {
+		return false
+	}
+	if this.AmountInput != that1.AmountInput {
+		return false
+	}
+	if this.RequestIdInput != that1.RequestIdInput {
+		return false
+	}
+	if this.SignaturesInput != that1.SignaturesInput {
+		return false
+	}
+	return true
+}
+func (this *SlashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRequest)
+	if !ok {
+		that2, ok := that.(SlashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if !bytes.Equal(this.Operator, that1.Operator) {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.BlockNumber != that1.BlockNumber {
+		return false
+	}
+	if this.BlockTimestamp != that1.BlockTimestamp {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	if this.FulfilledHeight != that1.FulfilledHeight {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if len(this.Signatures) != len(that1.Signatures) {
+		return false
+	}
+	for i := range this.Signatures {
+		if !this.Signatures[i].Equal(&that1.Signatures[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SlashRequestSignature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashRequestSignature)
+	if !ok {
+		that2, ok := that.(SlashRequestSignature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !bytes.Equal(this.Operator, that1.Operator) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
@@ -395,6 +1036,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SlashAbi.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.AutoUnjail {
+		i--
+		if m.AutoUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinOperatorStake.Size()
+		i -= size
+		if _, err := m.MinOperatorStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +1086,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -441,102 +1112,1524 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MiddlewareSlashABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MiddlewareSlashABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiddlewareSlashABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+	if len(m.SignaturesInput) > 0 {
+		i -= len(m.SignaturesInput)
+		copy(dAtA[i:], m.SignaturesInput)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SignaturesInput)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+	if len(m.RequestIdInput) > 0 {
+		i -= len(m.RequestIdInput)
+		copy(dAtA[i:], m.RequestIdInput)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.RequestIdInput)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	if len(m.AmountInput) > 0 {
+		i -= len(m.AmountInput)
+		copy(dAtA[i:], m.AmountInput)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.AmountInput)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
-	if m.Tombstoned {
-		n += 2
+	if len(m.KeyInput) > 0 {
+		i -= len(m.KeyInput)
+		copy(dAtA[i:], m.KeyInput)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.KeyInput)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	if len(m.EpochInput) > 0 {
+		i -= len(m.EpochInput)
+		copy(dAtA[i:], m.EpochInput)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochInput)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.EpochMethod) > 0 {
+		i -= len(m.EpochMethod)
+		copy(dAtA[i:], m.EpochMethod)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochMethod)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if len(m.SlashMethod) > 0 {
+		i -= len(m.SlashMethod)
+		copy(dAtA[i:], m.SlashMethod)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SlashMethod)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
+
+func (m *SlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FulfilledHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FulfilledHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRequestSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRequestSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRequestSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MinOperatorStake.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.AutoUnjail {
+		n += 2
+	}
+	l = m.SlashAbi.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *MiddlewareSlashABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.SlashMethod)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.EpochMethod)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.EpochInput)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.KeyInput)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.AmountInput)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.RequestIdInput)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.SignaturesInput)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlashing(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Stake.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovSlashing(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSlashing(uint64(m.BlockTimestamp))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSlashing(uint64(m.Epoch))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.FulfilledHeight != 0 {
+		n += 2 + sovSlashing(uint64(m.FulfilledHeight))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 2 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 2 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashRequestSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOperatorStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOperatorStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoUnjail = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAbi", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAbi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiddlewareSlashABI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiddlewareSlashABI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiddlewareSlashABI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIdInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestIdInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturesInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignaturesInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,13 +2657,49 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.StartHeight = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -580,16 +2709,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
-			m.IndexOffset = 0
+			m.BlockTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -599,16 +2728,54 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -618,30 +2785,29 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfilledHeight", wireType)
 			}
-			var v int
+			m.FulfilledHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -651,17 +2817,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FulfilledHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -671,11 +2836,60 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SlashRequestSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -697,7 +2911,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SlashRequestSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,69 +2934,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRequestSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRequestSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
-			}
-			m.SignedBlocksWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -792,28 +2954,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -840,13 +3001,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -873,8 +3035,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
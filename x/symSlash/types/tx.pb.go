@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -201,48 +202,335 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSignSlashRequest is the Msg/SignSlashRequest request type.
+type MsgSignSlashRequest struct {
+	// signer is the account submitting the signature.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// id is the id of the slash request.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the operator address of the signing validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// signature is the signature of the EIP-712 digest of the request by the
+	// Ethereum operator of the validator.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSignSlashRequest) Reset()         { *m = MsgSignSlashRequest{} }
+func (m *MsgSignSlashRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSignSlashRequest) ProtoMessage()    {}
+func (*MsgSignSlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{4}
+}
+func (m *MsgSignSlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignSlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignSlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignSlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignSlashRequest.Merge(m, src)
+}
+func (m *MsgSignSlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignSlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignSlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignSlashRequest proto.InternalMessageInfo
+
+func (m *MsgSignSlashRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSignSlashRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgSignSlashRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignSlashRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgSignSlashRequestResponse defines the Msg/SignSlashRequest response type.
+type MsgSignSlashRequestResponse struct {
+}
+
+func (m *MsgSignSlashRequestResponse) Reset()         { *m = MsgSignSlashRequestResponse{} }
+func (m *MsgSignSlashRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignSlashRequestResponse) ProtoMessage()    {}
+func (*MsgSignSlashRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{5}
+}
+func (m *MsgSignSlashRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignSlashRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignSlashRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignSlashRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignSlashRequestResponse.Merge(m, src)
+}
+func (m *MsgSignSlashRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignSlashRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignSlashRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignSlashRequestResponse proto.InternalMessageInfo
+
+// MsgFulfillSlashRequest is the Msg/FulfillSlashRequest request type. It
+// proves that the Ethereum transaction tx_hash executed the slash of the
+// request: the transaction and its receipt are proven against a header chained
+// to the execution block of a Symbiotic sync.
+type MsgFulfillSlashRequest struct {
+	// relayer is the account submitting the proof.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// id is the id of the slash request.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// tx_hash is the hash of the Ethereum transaction that slashed the stake.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// sync_height is the height of the Symbiotic sync whose execution block
+	// the headers end at.
+	SyncHeight int64 `protobuf:"varint,4,opt,name=sync_height,json=syncHeight,proto3" json:"sync_height,omitempty"`
+	// headers are the consensus encoded execution headers from the block of
+	// the transaction to the block of the sync, each the parent of the next one.
+	Headers [][]byte `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// tx_index is the index of the transaction in its block.
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// tx_proof is the proof of the transaction against the transactions root
+	// of the first header.
+	TxProof [][]byte `protobuf:"bytes,7,rep,name=tx_proof,json=txProof,proto3" json:"tx_proof,omitempty"`
+	// receipt_proof is the proof of the receipt of the transaction against the
+	// receipts root of the first header.
+	ReceiptProof [][]byte `protobuf:"bytes,8,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *MsgFulfillSlashRequest) Reset()         { *m = MsgFulfillSlashRequest{} }
+func (m *MsgFulfillSlashRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillSlashRequest) ProtoMessage()    {}
+func (*MsgFulfillSlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{6}
+}
+func (m *MsgFulfillSlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillSlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillSlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillSlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillSlashRequest.Merge(m, src)
+}
+func (m *MsgFulfillSlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillSlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillSlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillSlashRequest proto.InternalMessageInfo
+
+func (m *MsgFulfillSlashRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *MsgFulfillSlashRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgFulfillSlashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgFulfillSlashRequest) GetSyncHeight() int64 {
+	if m != nil {
+		return m.SyncHeight
+	}
+	return 0
+}
+
+func (m *MsgFulfillSlashRequest) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *MsgFulfillSlashRequest) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgFulfillSlashRequest) GetTxProof() [][]byte {
+	if m != nil {
+		return m.TxProof
+	}
+	return nil
+}
+
+func (m *MsgFulfillSlashRequest) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+// MsgFulfillSlashRequestResponse defines the Msg/FulfillSlashRequest response
+// type.
+type MsgFulfillSlashRequestResponse struct {
+}
+
+func (m *MsgFulfillSlashRequestResponse) Reset()         { *m = MsgFulfillSlashRequestResponse{} }
+func (m *MsgFulfillSlashRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillSlashRequestResponse) ProtoMessage()    {}
+func (*MsgFulfillSlashRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355fee11fee5766f, []int{7}
+}
+func (m *MsgFulfillSlashRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillSlashRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillSlashRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillSlashRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillSlashRequestResponse.Merge(m, src)
+}
+func (m *MsgFulfillSlashRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillSlashRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillSlashRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillSlashRequestResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.symSlash.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.symSlash.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symSlash.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symSlash.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSignSlashRequest)(nil), "cosmos.symSlash.v1beta1.MsgSignSlashRequest")
+	proto.RegisterType((*MsgSignSlashRequestResponse)(nil), "cosmos.symSlash.v1beta1.MsgSignSlashRequestResponse")
+	proto.RegisterType((*MsgFulfillSlashRequest)(nil), "cosmos.symSlash.v1beta1.MsgFulfillSlashRequest")
+	proto.RegisterType((*MsgFulfillSlashRequestResponse)(nil), "cosmos.symSlash.v1beta1.MsgFulfillSlashRequestResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/symSlash/v1beta1/tx.proto", fileDescriptor_355fee11fee5766f) }
 
 var fileDescriptor_355fee11fee5766f = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xae, 0xcc, 0x0d, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x58, 0x0c, 0xe2, 0x65, 0xe6, 0xa5, 0x43, 0xd5, 0x49,
-	0x42, 0xd4, 0xc5, 0x43, 0x0c, 0x80, 0xda, 0x01, 0x91, 0x82, 0xda, 0xa8, 0x9f, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x22,
-	0xa4, 0xb4, 0x98, 0x91, 0x8b, 0xd3, 0xb7, 0x38, 0x3d, 0x34, 0x2f, 0x2b, 0x31, 0x33, 0x47, 0x28,
-	0x85, 0x8b, 0xaf, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0x28, 0x3e, 0x31, 0x25, 0xa5,
-	0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf6, 0xd5, 0x3d, 0x79, 0x76, 0x10, 0x3f, 0xb5,
-	0xb8, 0xf8, 0xd2, 0x16, 0x5d, 0x59, 0xa8, 0x75, 0x61, 0x30, 0xb5, 0x8e, 0x10, 0xa9, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x45, 0xcf, 0x37, 0x68, 0xc1, 0x14, 0xaf, 0x78, 0xbe, 0x41, 0x8b, 0x31,
-	0x88, 0xb7, 0x0c, 0x59, 0xa1, 0x95, 0x41, 0xc7, 0x02, 0x79, 0x86, 0xa6, 0xe7, 0x1b, 0xb4, 0xd0,
-	0x2c, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x04, 0x62, 0xb4, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0xdc, 0x5d,
-	0x4a, 0xc2, 0x5c, 0x82, 0x70, 0x4e, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0xd2, 0x2d,
-	0x46, 0x2e, 0x7e, 0x90, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0x25, 0xd4, 0xed, 0x12,
-	0x97, 0xb6, 0xe8, 0x42, 0x4d, 0xd5, 0x43, 0x71, 0x67, 0x10, 0x42, 0xa9, 0x90, 0x13, 0x17, 0x5b,
-	0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0xb1, 0xa6, 0x07,
-	0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x06, 0x88, 0xef, 0xa0, 0x3a, 0xad, 0xbc, 0x2f, 0x6d,
-	0xd1, 0xe5, 0x47, 0x38, 0x5f, 0xc1, 0x40, 0xcf, 0xc4, 0x1c, 0xe4, 0x4b, 0x84, 0x35, 0x20, 0x0f,
-	0xaa, 0x22, 0x79, 0xb0, 0x02, 0x11, 0xcb, 0x68, 0x1e, 0x51, 0xd2, 0xe3, 0x12, 0x47, 0x13, 0x82,
-	0xf9, 0xdb, 0x4a, 0x18, 0x8b, 0x3d, 0x46, 0xcf, 0x19, 0xb9, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x22,
-	0xb8, 0xd8, 0xa0, 0x71, 0xa9, 0x84, 0xd3, 0x0b, 0xf0, 0xa0, 0x94, 0xd2, 0x22, 0xac, 0x06, 0x66,
-	0xad, 0x50, 0x3d, 0x17, 0x0f, 0x4a, 0x50, 0x6b, 0xe0, 0xd5, 0x8b, 0xa4, 0x52, 0xca, 0x80, 0x58,
-	0x95, 0xf0, 0xa8, 0x15, 0x3e, 0x85, 0xe9, 0x45, 0x29, 0xd6, 0x06, 0x50, 0x70, 0x3b, 0x59, 0xaf,
-	0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xd0, 0xa4,
-	0x59, 0x9c, 0x92, 0xad, 0x97, 0x99, 0x8f, 0x1c, 0xc0, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0xe0, 0x54, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x29, 0x3b, 0x33, 0xb7, 0x03, 0x00,
-	0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6f, 0xda, 0x48,
+	0x14, 0xc7, 0x90, 0x40, 0x98, 0x90, 0x2f, 0x13, 0x6d, 0x1c, 0x76, 0x63, 0x88, 0x57, 0xbb, 0x42,
+	0x68, 0xb1, 0x09, 0x1b, 0x6d, 0x24, 0x56, 0x7b, 0x58, 0x0e, 0x55, 0xaa, 0x8a, 0x2a, 0x72, 0xd4,
+	0xaa, 0xea, 0x05, 0x4d, 0xe2, 0x89, 0xed, 0x06, 0x6c, 0xea, 0x19, 0x90, 0x39, 0xb5, 0xea, 0xa9,
+	0xea, 0xa9, 0x7f, 0x42, 0xd5, 0x5e, 0x72, 0xe4, 0x90, 0x7b, 0xaf, 0x51, 0x4f, 0x51, 0x4e, 0x55,
+	0x0f, 0x51, 0x44, 0x0e, 0x48, 0xbd, 0xf7, 0x5e, 0x8d, 0x3d, 0xe6, 0x2b, 0xe4, 0xeb, 0x02, 0xbc,
+	0xf7, 0x7e, 0xf3, 0x7e, 0xef, 0xf7, 0x9b, 0x0f, 0x40, 0x66, 0xdf, 0xc6, 0x75, 0x1b, 0x2b, 0xb8,
+	0x5d, 0xdf, 0xad, 0x41, 0x6c, 0x28, 0xad, 0x8d, 0x3d, 0x44, 0xe0, 0x86, 0x42, 0x5c, 0xb9, 0xe1,
+	0xd8, 0xc4, 0xe6, 0x57, 0x7c, 0x84, 0x1c, 0x20, 0x64, 0x86, 0x48, 0x2d, 0xeb, 0xb6, 0x6e, 0x7b,
+	0x18, 0x85, 0xfe, 0xf2, 0xe1, 0xa9, 0x3f, 0xaf, 0x6b, 0x88, 0x69, 0x64, 0x5a, 0x3a, 0xc3, 0xad,
+	0xfa, 0xb8, 0xaa, 0xdf, 0x80, 0x71, 0xf8, 0x25, 0xc6, 0xa8, 0xd4, 0xb1, 0xae, 0xb4, 0x36, 0xe8,
+	0x17, 0x2b, 0x2c, 0xc1, 0xba, 0x69, 0xd9, 0x8a, 0xf7, 0xe9, 0xa7, 0xa4, 0x4f, 0x1c, 0x88, 0x57,
+	0xb0, 0xfe, 0xc4, 0x7a, 0x01, 0xcd, 0x1a, 0xaf, 0x81, 0xf9, 0x16, 0xac, 0x99, 0x1a, 0x24, 0xb6,
+	0x53, 0x85, 0x9a, 0xe6, 0x08, 0x5c, 0x86, 0xcb, 0xc6, 0xcb, 0xff, 0x7d, 0x3f, 0x4f, 0xc7, 0x68,
+	0x8c, 0x30, 0x3e, 0x3b, 0xce, 0xaf, 0x31, 0xba, 0xa7, 0x01, 0xf6, 0x7f, 0xbf, 0xb4, 0x4b, 0x1c,
+	0xd3, 0xd2, 0x3f, 0xf6, 0x3a, 0xb9, 0x00, 0x7c, 0xd4, 0xeb, 0xe4, 0x38, 0x75, 0xae, 0x35, 0x0c,
+	0x2c, 0x15, 0xde, 0x7e, 0x48, 0x87, 0xde, 0xf4, 0x3a, 0xb9, 0x31, 0xb2, 0x77, 0xbd, 0x4e, 0x6e,
+	0xd9, 0x6f, 0x9d, 0xc7, 0xda, 0xa1, 0xd2, 0x9f, 0x4b, 0x4a, 0x82, 0xa5, 0x7e, 0xa0, 0x22, 0xdc,
+	0xb0, 0x2d, 0x8c, 0xa4, 0x6f, 0x1c, 0x58, 0xa0, 0xd9, 0x86, 0x06, 0x09, 0xda, 0x81, 0x0e, 0xac,
+	0x63, 0xfe, 0x1f, 0x10, 0x87, 0x4d, 0x62, 0xd8, 0x8e, 0x49, 0xda, 0x6c, 0x76, 0xe1, 0xec, 0x38,
+	0xcf, 0xba, 0xca, 0x23, 0x73, 0xaa, 0x03, 0x28, 0x5f, 0x06, 0xd1, 0x86, 0xd7, 0x41, 0x08, 0x67,
+	0xb8, 0xec, 0x6c, 0x31, 0x2d, 0x5f, 0xb3, 0x6b, 0xb2, 0x4f, 0x54, 0x8e, 0x9f, 0x9c, 0xa7, 0x43,
+	0xbe, 0x3a, 0xb6, 0xb2, 0xf4, 0xe8, 0xec, 0x38, 0xbf, 0x30, 0x18, 0x3f, 0x53, 0x90, 0x37, 0xb7,
+	0xa8, 0xca, 0x01, 0x0d, 0x15, 0xf8, 0xc7, 0x90, 0x40, 0x77, 0xb0, 0xcb, 0x63, 0x42, 0x24, 0x19,
+	0xac, 0x8c, 0xa5, 0x02, 0xdd, 0xa5, 0xe4, 0x04, 0x1e, 0xe9, 0x07, 0x07, 0x92, 0x15, 0xac, 0xef,
+	0x9a, 0xba, 0xe5, 0x35, 0x54, 0xd1, 0xcb, 0x26, 0xc2, 0x84, 0x2f, 0x80, 0x28, 0x36, 0x75, 0x0b,
+	0x39, 0xb7, 0xba, 0xc1, 0x70, 0xfc, 0x3c, 0x08, 0x9b, 0x9a, 0x67, 0xc3, 0x94, 0x1a, 0x36, 0x35,
+	0xfe, 0x31, 0x58, 0x1a, 0xdd, 0x26, 0x84, 0xb1, 0x10, 0xf1, 0x9a, 0xad, 0xdf, 0x7a, 0x16, 0xd4,
+	0xc5, 0xd6, 0x58, 0x9e, 0xff, 0x0d, 0xc4, 0x29, 0x13, 0x24, 0x4d, 0x07, 0x09, 0x53, 0x19, 0x2e,
+	0x9b, 0x50, 0x07, 0x89, 0x92, 0x4c, 0x1d, 0x63, 0xa3, 0x50, 0xbb, 0xc4, 0xd1, 0xf3, 0x30, 0xae,
+	0x4f, 0x5a, 0x03, 0xbf, 0x4e, 0x48, 0xf7, 0xcf, 0xc8, 0xe7, 0x30, 0xf8, 0xa5, 0x82, 0xf5, 0x07,
+	0xcd, 0xda, 0x81, 0x59, 0xab, 0x8d, 0x38, 0x53, 0x04, 0x31, 0x07, 0xd5, 0x60, 0xfb, 0x0e, 0xd6,
+	0x04, 0xc0, 0x2b, 0xde, 0xac, 0x80, 0x18, 0x71, 0xab, 0x06, 0xc4, 0x86, 0xef, 0x88, 0x1a, 0x25,
+	0xee, 0x36, 0xc4, 0x06, 0x9f, 0x06, 0xb3, 0xb8, 0x6d, 0xed, 0x57, 0x0d, 0x64, 0xea, 0x06, 0xf1,
+	0x64, 0x46, 0x54, 0x40, 0x53, 0xdb, 0x5e, 0x86, 0x17, 0x40, 0xcc, 0x40, 0x50, 0x43, 0x0e, 0x16,
+	0xa6, 0x33, 0x91, 0x6c, 0x42, 0x0d, 0x42, 0x7e, 0x15, 0xcc, 0x10, 0xb7, 0x6a, 0x5a, 0x1a, 0x72,
+	0x85, 0xa8, 0xc7, 0x14, 0x23, 0xee, 0x43, 0x1a, 0xb2, 0x52, 0xc3, 0xb1, 0xed, 0x03, 0x21, 0xe6,
+	0xaf, 0x22, 0xee, 0x0e, 0x0d, 0xf9, 0xdf, 0xc1, 0x9c, 0x83, 0xf6, 0x91, 0xd9, 0x20, 0xac, 0x3e,
+	0xe3, 0xd5, 0x13, 0x2c, 0xe9, 0x81, 0x4a, 0x45, 0x6a, 0x6e, 0x20, 0x86, 0xba, 0xbb, 0x3e, 0xea,
+	0xee, 0x04, 0x9b, 0xa4, 0x0c, 0x10, 0x27, 0x57, 0x02, 0x8f, 0x8b, 0x17, 0x11, 0x10, 0xa9, 0x60,
+	0x9d, 0x7f, 0x06, 0xa2, 0xec, 0x19, 0x91, 0xae, 0xbd, 0x3d, 0xfd, 0x5b, 0x9c, 0xca, 0xdd, 0x8e,
+	0x09, 0x18, 0xf8, 0x57, 0x20, 0x31, 0x72, 0xcb, 0xb3, 0x37, 0xae, 0x1d, 0x42, 0xa6, 0x0a, 0x77,
+	0x45, 0xf6, 0x4f, 0x4c, 0xf2, 0xcb, 0xd5, 0xdb, 0xc5, 0xb7, 0xc0, 0xe2, 0x95, 0x9b, 0xf5, 0xd7,
+	0x4d, 0xad, 0xc7, 0xd1, 0xa9, 0xcd, 0xfb, 0xa0, 0x87, 0x84, 0x27, 0x27, 0x1d, 0x5d, 0xe5, 0xa6,
+	0x66, 0x13, 0x16, 0xa4, 0xb6, 0xee, 0xb9, 0x20, 0x18, 0x20, 0x35, 0xfd, 0x9a, 0x3e, 0x71, 0xe5,
+	0x7f, 0x8f, 0xba, 0x22, 0x77, 0xd2, 0x15, 0xb9, 0xd3, 0xae, 0xc8, 0x5d, 0x74, 0x45, 0xee, 0xfd,
+	0xa5, 0x18, 0x3a, 0xbd, 0x14, 0x43, 0x5f, 0x2f, 0xc5, 0xd0, 0x73, 0xf6, 0x04, 0x60, 0xed, 0x50,
+	0x36, 0xed, 0xe1, 0x47, 0x8d, 0xb4, 0x1b, 0x08, 0xef, 0x45, 0xbd, 0x7f, 0x9a, 0xbf, 0x7f, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0x47, 0x88, 0x30, 0x89, 0x2b, 0x07, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -338,6 +626,141 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSignSlashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSignSlashRequest)
+	if !ok {
+		that2, ok := that.(MsgSignSlashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Signer != that1.Signer {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *MsgSignSlashRequestResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSignSlashRequestResponse)
+	if !ok {
+		that2, ok := that.(MsgSignSlashRequestResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgFulfillSlashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFulfillSlashRequest)
+	if !ok {
+		that2, ok := that.(MsgFulfillSlashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Relayer != that1.Relayer {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	if this.SyncHeight != that1.SyncHeight {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return false
+		}
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	if len(this.TxProof) != len(that1.TxProof) {
+		return false
+	}
+	for i := range this.TxProof {
+		if !bytes.Equal(this.TxProof[i], that1.TxProof[i]) {
+			return false
+		}
+	}
+	if len(this.ReceiptProof) != len(that1.ReceiptProof) {
+		return false
+	}
+	for i := range this.ReceiptProof {
+		if !bytes.Equal(this.ReceiptProof[i], that1.ReceiptProof[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFulfillSlashRequestResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFulfillSlashRequestResponse)
+	if !ok {
+		that2, ok := that.(MsgFulfillSlashRequestResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -358,6 +781,12 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/symSlash module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SignSlashRequest adds the signature of the operator of a validator to a
+	// slash request.
+	SignSlashRequest(ctx context.Context, in *MsgSignSlashRequest, opts ...grpc.CallOption) (*MsgSignSlashRequestResponse, error)
+	// FulfillSlashRequest marks a signed slash request fulfilled with the proof
+	// that its slash was executed on Ethereum.
+	FulfillSlashRequest(ctx context.Context, in *MsgFulfillSlashRequest, opts ...grpc.CallOption) (*MsgFulfillSlashRequestResponse, error)
 }
 
 type msgClient struct {
@@ -386,17 +815,41 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Unjail defines a method for unjailing a jailed validator, thus returning
-	// them into the bonded validator set, so they can begin receiving provisions
-	// and rewards again.
-	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
-	// UpdateParams defines a governance operation for updating the x/symSlash module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-}
-
+func (c *msgClient) SignSlashRequest(ctx context.Context, in *MsgSignSlashRequest, opts ...grpc.CallOption) (*MsgSignSlashRequestResponse, error) {
+	out := new(MsgSignSlashRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Msg/SignSlashRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillSlashRequest(ctx context.Context, in *MsgFulfillSlashRequest, opts ...grpc.CallOption) (*MsgFulfillSlashRequestResponse, error) {
+	out := new(MsgFulfillSlashRequestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symSlash.v1beta1.Msg/FulfillSlashRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Unjail defines a method for unjailing a jailed validator, thus returning
+	// them into the bonded validator set, so they can begin receiving provisions
+	// and rewards again.
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// UpdateParams defines a governance operation for updating the x/symSlash module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SignSlashRequest adds the signature of the operator of a validator to a
+	// slash request.
+	SignSlashRequest(context.Context, *MsgSignSlashRequest) (*MsgSignSlashRequestResponse, error)
+	// FulfillSlashRequest marks a signed slash request fulfilled with the proof
+	// that its slash was executed on Ethereum.
+	FulfillSlashRequest(context.Context, *MsgFulfillSlashRequest) (*MsgFulfillSlashRequestResponse, error)
+}
+
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
@@ -407,6 +860,12 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SignSlashRequest(ctx context.Context, req *MsgSignSlashRequest) (*MsgSignSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSlashRequest not implemented")
+}
+func (*UnimplementedMsgServer) FulfillSlashRequest(ctx context.Context, req *MsgFulfillSlashRequest) (*MsgFulfillSlashRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillSlashRequest not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -448,6 +907,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Msg/SignSlashRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignSlashRequest(ctx, req.(*MsgSignSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillSlashRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillSlashRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symSlash.v1beta1.Msg/FulfillSlashRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillSlashRequest(ctx, req.(*MsgFulfillSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.symSlash.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -460,6 +955,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SignSlashRequest",
+			Handler:    _Msg_SignSlashRequest_Handler,
+		},
+		{
+			MethodName: "FulfillSlashRequest",
+			Handler:    _Msg_FulfillSlashRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/symSlash/v1beta1/tx.proto",
@@ -581,6 +1084,180 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignSlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignSlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignSlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignSlashRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignSlashRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignSlashRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillSlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillSlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillSlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TxProof) > 0 {
+		for iNdEx := len(m.TxProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxProof[iNdEx])
+			copy(dAtA[i:], m.TxProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxProof[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SyncHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SyncHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillSlashRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillSlashRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillSlashRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -624,27 +1301,410 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignSlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignSlashRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillSlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SyncHeight != 0 {
+		n += 1 + sovTx(uint64(m.SyncHeight))
+	}
+	if len(m.Headers) > 0 {
+		for _, b := range m.Headers {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if len(m.TxProof) > 0 {
+		for _, b := range m.TxProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFulfillSlashRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+func (m *MsgSignSlashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -667,15 +1727,15 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignSlashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignSlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -703,7 +1763,92 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -726,7 +1871,7 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSignSlashRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -749,10 +1894,10 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignSlashRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignSlashRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -776,7 +1921,7 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillSlashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -799,15 +1944,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillSlashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillSlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -835,13 +1980,32 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -851,24 +2015,157 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncHeight", wireType)
+			}
+			m.SyncHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, make([]byte, postIndex-iNdEx))
+			copy(m.Headers[len(m.Headers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxProof = append(m.TxProof, make([]byte, postIndex-iNdEx))
+			copy(m.TxProof[len(m.TxProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -891,7 +2188,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillSlashRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,10 +2211,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillSlashRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillSlashRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,21 +1,26 @@
 package abci
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	"cosmossdk.io/log"
 	keeper2 "cosmossdk.io/x/symStaking/keeper"
 	stakingtypes "cosmossdk.io/x/symStaking/types"
-	"encoding/json"
-	"errors"
-	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum"
-	"os"
 )
 
 type ProposalHandler struct {
-	logger        log.Logger
-	keeper        *keeper2.Keeper
-	prevBlockTime uint64
+	logger log.Logger
+	keeper *keeper2.Keeper
 }
 
 func NewProposalHandler(logger log.Logger, keeper *keeper2.Keeper) *ProposalHandler {
@@ -25,32 +30,54 @@ func NewProposalHandler(logger log.Logger, keeper *keeper2.Keeper) *ProposalHand
 	}
 }
 
+// PrepareProposal injects a stakingtypes.InjectedTx at Symbiotic sync heights.
+// It carries the block hash and validator set attested by more than 2/3 of the
+// voting power in the vote extensions of the last commit, or INVALID_BLOCKHASH
+// if no agreement was reached, and the voter stakes attested by more than 2/3
+// of the voting power. The last commit is carried either way for the other
+// validators to check the agreement. The injected tx counts against
+// MaxTxBytes, trailing txs are dropped until the proposal fits.
 func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
-		proposalTxs := req.Txs
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return nil, err
+		}
 
-		if req.Height%keeper2.SYMBIOTIC_SYNC_PERIOD != 0 {
+		if !isSyncHeight {
 			return &abci.PrepareProposalResponse{
-				Txs: proposalTxs,
+				Txs: req.Txs,
 			}, nil
 		}
 
-		blockHash, err := h.keeper.GetFinalizedBlockHash(ctx)
+		blockHash, syncData := h.buildSyncData(ctx, req.Height, req.LocalLastCommit)
+		bz, err := encodeSyncTx(req.Height, blockHash, syncData)
 		if err != nil {
-			// anyway recovers in baseapp.abci so just skip
-			blockHash = keeper2.INVALID_BLOCKHASH
+			return nil, err
 		}
 
-		// NOTE: We use stdlib JSON encoding, but an application may choose to use
-		// a performant mechanism. This is for demo purposes only.
-		bz, err := json.Marshal(blockHash)
-		if err != nil {
-			return nil, errors.New("failed to encode injected vote extension tx")
+		size := txSize(bz)
+		if size > req.MaxTxBytes {
+			// the proposal is rejected if the block hash was agreed, validators
+			// do not attest a validator set too large for a block
+			h.logger.Error("PrepareProposal: symbiotic sync tx exceeds the max tx bytes", "height", req.Height, "size", size)
+			skip := stakingtypes.SymbioticSyncData{ExtendedCommitInfo: syncData.ExtendedCommitInfo, VoterStakes: syncData.VoterStakes}
+			if bz, err = encodeSyncTx(req.Height, keeper2.INVALID_BLOCKHASH, skip); err != nil {
+				return nil, err
+			}
+			size = txSize(bz)
 		}
 
 		// Inject a "fake" tx into the proposal s.t. validators can decode, verify,
-		// and store the canonical stake-weighted average prices.
-		proposalTxs = append([][]byte{bz}, proposalTxs...)
+		// and store the agreed validator set.
+		proposalTxs := [][]byte{bz}
+		for _, tx := range req.Txs {
+			size += txSize(tx)
+			if size > req.MaxTxBytes {
+				break
+			}
+			proposalTxs = append(proposalTxs, tx)
+		}
 
 		return &abci.PrepareProposalResponse{
 			Txs: proposalTxs,
@@ -58,58 +85,331 @@ func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	}
 }
 
+// ProcessProposal rejects proposals at Symbiotic sync heights whose injected
+// tx is missing or malformed, or whose vote extensions do not prove the
+// agreement on the block hash and validator set it carries. An injected tx
+// anywhere else is rejected too.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, tx := range req.Txs {
+			if sdk.IsInjectedTx(tx) && (i > 0 || !isSyncHeight) {
+				h.logger.Error("ProcessProposal: unexpected injected tx", "height", req.Height, "index", i)
+				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+			}
+		}
+
+		if !isSyncHeight {
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+		}
+
+		if len(req.Txs) == 0 {
+			h.logger.Error("ProcessProposal: missing symbiotic sync tx", "height", req.Height)
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+
+		blockHash, syncData, err := decodeSyncTx(req.Txs[0], req.Height)
+		if err != nil {
+			h.logger.Error("ProcessProposal: failed to decode symbiotic sync tx", "height", req.Height, "err", err)
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+
+		if err := h.verifySyncData(ctx, req.Height, blockHash, syncData); err != nil {
+			h.logger.Error("ProcessProposal: invalid symbiotic sync tx", "height", req.Height, "err", err)
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+		}
+
+		return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+	}
+}
+
+// PreBlocker caches the block hash, validator set and voter stakes of the
+// injected tx so that EndBlock applies them. It makes no Ethereum request, the tx was checked
+// in ProcessProposal.
 func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
-		if req.Height%keeper2.SYMBIOTIC_SYNC_PERIOD != 0 || len(req.Txs) == 0 {
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, req.Height)
+		if err != nil {
+			return err
+		}
+
+		if !isSyncHeight {
 			return nil
 		}
 
-		skipBlockHash := stakingtypes.CachedBlockHash{BlockHash: keeper2.INVALID_BLOCKHASH, Height: req.Height}
+		// the voter stakes are applied even if the sync is skipped
+		skip := func(voterStakes []stakingtypes.SymbioticVoterStake) error {
+			h.keeper.IncrSyncCounter(keeper2.SyncOutcomeSkip)
+			return h.keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+				BlockHash:   keeper2.INVALID_BLOCKHASH,
+				Height:      req.Height,
+				VoterStakes: voterStakes,
+			})
+		}
 
-		var blockHash string
-		if err := json.Unmarshal(req.Txs[0], &blockHash); err != nil {
-			return err
+		if len(req.Txs) == 0 {
+			return skip(nil)
+		}
+
+		blockHash, syncData, err := decodeSyncTx(req.Txs[0], req.Height)
+		if err != nil {
+			h.logger.Error("PreBlocker: failed to decode symbiotic sync tx", "height", req.Height, "err", err)
+			return skip(nil)
 		}
 
 		if blockHash == keeper2.INVALID_BLOCKHASH {
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+			return skip(syncData.VoterStakes)
 		}
 
-		block, err := h.keeper.GetBlockByHash(ctx, blockHash)
-		if errors.Is(err, ethereum.NotFound) {
-			h.logger.Error("Preblock: Block not found", "hash", blockHash)
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+		if _, ok := syncData.SymbioticValidators(); !ok {
+			h.logger.Error("PreBlocker: malformed symbiotic validator set", "height", req.Height)
+			return skip(syncData.VoterStakes)
 		}
-		if err != nil {
-			h.logger.Error("PreBlocker error get block by hash error", "err", err)
-			os.Exit(0) // panic recovers
+
+		h.keeper.IncrSyncCounter(keeper2.SyncOutcomeSuccess)
+		return h.keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+			BlockHash:      blockHash,
+			Height:         req.Height,
+			Attested:       true,
+			Validators:     syncData.Validators,
+			BlockNumber:    syncData.BlockNumber,
+			BlockTimestamp: syncData.BlockTimestamp,
+			VoterStakes:    syncData.VoterStakes,
+		})
+	}
+}
+
+// buildSyncData returns the block hash and sync data proposed at height. The
+// proposer reads the validator set at the agreed block hash and checks it
+// against the agreed digest, any failure results in INVALID_BLOCKHASH. The
+// commit and the agreed voter stakes are carried either way.
+func (h *ProposalHandler) buildSyncData(ctx sdk.Context, height int64, commit abci.ExtendedCommitInfo) (string, stakingtypes.SymbioticSyncData) {
+	if err := baseapp.ValidateVoteExtensions(ctx, h.keeper, commit); err != nil {
+		h.logger.Error("PrepareProposal: invalid vote extensions", "height", height, "err", err)
+		return keeper2.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{}
+	}
+
+	skip := stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit, VoterStakes: tallyVoterStakes(commit, height)}
+
+	agreed := tallyVoteExtensions(commit, height)
+	blockHash := agreed.BlockHash
+	if blockHash == keeper2.INVALID_BLOCKHASH {
+		h.logger.Info("PrepareProposal: no symbiotic block hash agreement", "height", height)
+		return keeper2.INVALID_BLOCKHASH, skip
+	}
+
+	validators, err := h.keeper.GetSymbioticValidatorSet(ctx, blockHash)
+	if err != nil {
+		h.logger.Error("PrepareProposal: failed to get validator set", "hash", blockHash, "err", err)
+		h.keeper.IncrSyncCounter(keeper2.SyncOutcomeFailure)
+		return keeper2.INVALID_BLOCKHASH, skip
+	}
+
+	if !bytes.Equal(stakingtypes.ValidatorSetDigest(validators), agreed.ValidatorSetDigest) {
+		h.logger.Error("PrepareProposal: validator set digest mismatch", "hash", blockHash)
+		return keeper2.INVALID_BLOCKHASH, skip
+	}
+
+	return blockHash, stakingtypes.SymbioticSyncData{
+		ExtendedCommitInfo: commit,
+		Validators:         stakingtypes.NewSymbioticValidatorStakes(validators),
+		BlockNumber:        agreed.BlockNumber,
+		BlockTimestamp:     agreed.BlockTimestamp,
+		VoterStakes:        skip.VoterStakes,
+	}
+}
+
+// verifySyncData checks the block hash and sync data proposed at height. The
+// vote extension signatures are verified and the voter stakes, and the block
+// hash, block and validator set, must match the attestations of more than 2/3
+// of the voting power. A skip is only valid without such an agreement on a
+// block hash, so that a proposer cannot skip an agreed sync.
+func (h *ProposalHandler) verifySyncData(ctx sdk.Context, height int64, blockHash string, syncData stakingtypes.SymbioticSyncData) error {
+	if blockHash == keeper2.INVALID_BLOCKHASH && (len(syncData.Validators) != 0 || syncData.BlockNumber != 0 || syncData.BlockTimestamp != 0) {
+		return errors.New("unexpected validator set for an invalid block hash")
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.keeper, syncData.ExtendedCommitInfo); err != nil {
+		return err
+	}
+
+	if !equalVoterStakes(tallyVoterStakes(syncData.ExtendedCommitInfo, height), syncData.VoterStakes) {
+		return errors.New("voter stakes were not attested by 2/3 of the voting power")
+	}
+
+	agreed := tallyVoteExtensions(syncData.ExtendedCommitInfo, height)
+	if blockHash == keeper2.INVALID_BLOCKHASH {
+		if agreed.BlockHash != keeper2.INVALID_BLOCKHASH {
+			return fmt.Errorf("block hash %q attested by 2/3 of the voting power was skipped", agreed.BlockHash)
 		}
+		return nil
+	}
 
-		block, err = h.keeper.GetBlockByNumber(ctx, block.Number())
-		if err != nil {
-			h.logger.Error("PreBlocker error get block by hash error", "err", err)
-			os.Exit(0) // panic recovers
+	if agreed.BlockHash != blockHash {
+		return fmt.Errorf("block hash %q was not attested by 2/3 of the voting power", blockHash)
+	}
+
+	if agreed.BlockNumber != syncData.BlockNumber || agreed.BlockTimestamp != syncData.BlockTimestamp {
+		return errors.New("block number or timestamp mismatch")
+	}
+
+	validators, ok := syncData.SymbioticValidators()
+	if !ok {
+		return errors.New("malformed validator set")
+	}
+
+	if !bytes.Equal(stakingtypes.ValidatorSetDigest(validators), agreed.ValidatorSetDigest) {
+		return errors.New("validator set digest mismatch")
+	}
+
+	return nil
+}
+
+// encodeSyncTx encodes the injected tx of a sync height.
+func encodeSyncTx(height int64, blockHash string, syncData stakingtypes.SymbioticSyncData) ([]byte, error) {
+	payload, err := syncData.Marshal()
+	if err != nil {
+		return nil, errors.New("failed to encode injected vote extension tx")
+	}
+
+	bz, err := stakingtypes.InjectedTx{
+		Version:   stakingtypes.InjectedTxVersion,
+		Type:      stakingtypes.InjectedTxTypeSymbioticSync,
+		Height:    height,
+		BlockHash: blockHash,
+		Payload:   payload,
+	}.Bytes()
+	if err != nil {
+		return nil, errors.New("failed to encode injected vote extension tx")
+	}
+
+	return bz, nil
+}
+
+// txSize returns the size tx takes in the MaxTxBytes of a block.
+func txSize(tx []byte) int64 {
+	return cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+}
+
+// decodeSyncTx decodes the injected tx of a sync height and returns its block
+// hash and sync data.
+func decodeSyncTx(bz []byte, height int64) (string, stakingtypes.SymbioticSyncData, error) {
+	var syncData stakingtypes.SymbioticSyncData
+
+	injectedTx, err := stakingtypes.DecodeInjectedTx(bz)
+	if err != nil {
+		return "", syncData, err
+	}
+
+	if injectedTx.Type != stakingtypes.InjectedTxTypeSymbioticSync {
+		return "", syncData, fmt.Errorf("unexpected injected tx type %s", injectedTx.Type)
+	}
+
+	if injectedTx.Height != height {
+		return "", syncData, fmt.Errorf("injected tx height %d, expected %d", injectedTx.Height, height)
+	}
+
+	if err := syncData.Unmarshal(injectedTx.Payload); err != nil {
+		return "", syncData, err
+	}
+
+	return injectedTx.BlockHash, syncData, nil
+}
+
+// tallyVoteExtensions returns the vote extension attested by more than 2/3 of
+// the total voting power of commit, or one with INVALID_BLOCKHASH if there is
+// none. Undecodable or invalid extensions count as absent.
+func tallyVoteExtensions(commit abci.ExtendedCommitInfo, height int64) stakingtypes.SymbioticVoteExtension {
+	var totalVP int64
+	for _, vote := range commit.Votes {
+		totalVP += vote.Validator.Power
+	}
+
+	votes := make(map[string]int64)
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
 		}
-		// very specific error caused by finalized check bug, ideally this check shouldn't exist
-		if block.Hash().String() != blockHash {
-			h.logger.Error("Preblock: Block is not finalized", "hash", blockHash)
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+
+		var voteExt stakingtypes.SymbioticVoteExtension
+		if err := voteExt.Unmarshal(vote.VoteExtension); err != nil {
+			continue
 		}
 
-		if block.Time() < h.prevBlockTime || int64(block.Time()) >= ctx.HeaderInfo().Time.Unix() || block.Time() < h.keeper.GetMinBlockTimestamp(ctx) {
-			err := h.keeper.CacheBlockHash(ctx, skipBlockHash)
-			return err
+		if validateVoteExtension(voteExt, height) != nil || voteExt.BlockHash == keeper2.INVALID_BLOCKHASH {
+			continue
 		}
 
-		if err := h.keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: req.Height}); err != nil {
-			return err
+		key := fmt.Sprintf("%s/%x/%d/%d", voteExt.BlockHash, voteExt.ValidatorSetDigest, voteExt.BlockNumber, voteExt.BlockTimestamp)
+		votes[key] += vote.Validator.Power
+		if votes[key]*3 > totalVP*2 {
+			return voteExt
 		}
+	}
 
-		h.prevBlockTime = block.Time()
+	return stakingtypes.SymbioticVoteExtension{Height: height, BlockHash: keeper2.INVALID_BLOCKHASH}
+}
 
-		return nil
+// tallyVoterStakes returns the voter stakes attested by more than 2/3 of the
+// total voting power of commit, sorted by request id. Undecodable or invalid
+// extensions count as absent.
+func tallyVoterStakes(commit abci.ExtendedCommitInfo, height int64) []stakingtypes.SymbioticVoterStake {
+	var totalVP int64
+	for _, vote := range commit.Votes {
+		totalVP += vote.Validator.Power
+	}
+
+	votes := make(map[string]int64)
+	stakes := make(map[string]stakingtypes.SymbioticVoterStake)
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		var voteExt stakingtypes.SymbioticVoteExtension
+		if err := voteExt.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		if validateVoteExtension(voteExt, height) != nil {
+			continue
+		}
+
+		for _, voterStake := range voteExt.VoterStakes {
+			key := fmt.Sprintf("%x/%s", voterStake.RequestId, voterStake.Stake)
+			votes[key] += vote.Validator.Power
+			stakes[key] = voterStake
+		}
+	}
+
+	var agreed []stakingtypes.SymbioticVoterStake
+	for key, power := range votes {
+		if power*3 > totalVP*2 {
+			agreed = append(agreed, stakes[key])
+		}
+	}
+	sort.Slice(agreed, func(i, j int) bool {
+		return bytes.Compare(agreed[i].RequestId, agreed[j].RequestId) < 0
+	})
+
+	return agreed
+}
+
+// equalVoterStakes reports whether a and b hold the same voter stakes in the
+// same order.
+func equalVoterStakes(a, b []stakingtypes.SymbioticVoterStake) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].RequestId, b[i].RequestId) || a[i].Stake.IsNil() != b[i].Stake.IsNil() ||
+			(!a[i].Stake.IsNil() && !a[i].Stake.Equal(b[i].Stake)) {
+			return false
+		}
 	}
+	return true
 }
@@ -0,0 +1,485 @@
+package abci_test
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/symStaking/abci"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtestutil "cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const (
+	chainID    = "chain-id"
+	maxTxBytes = 1 << 20
+)
+
+type fixture struct {
+	ctx        sdk.Context
+	keeper     *stakingkeeper.Keeper
+	dataSource *stakingtestutil.InMemoryDataSource
+	handler    *abci.ProposalHandler
+	vals       []*ed25519.PrivKey
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	return initFixtureWithSyncFailure(t, stakingtypes.SyncFailureSkip)
+}
+
+// initFixtureWithSyncFailure creates a fixture whose keeper applies the given
+// on-sync-failure policy.
+func initFixtureWithSyncFailure(t *testing.T, onSyncFailure string) *fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{
+		ChainID: chainID,
+		Height:  stakingtypes.DefaultSymbioticSyncPeriod,
+		Time:    time.Unix(stakingtypes.DefaultBeaconGenesisTimestamp, 0).Add(24 * time.Hour),
+	}).WithConsensusParams(cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{
+			VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 1},
+		},
+	})
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := stakingtestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	authority, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(stakingtypes.GovModuleName))
+	require.NoError(t, err)
+
+	dataSource := stakingtestutil.NewInMemoryDataSource()
+	keeper := stakingkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger()),
+		accountKeeper,
+		stakingtestutil.NewMockBankKeeper(ctrl),
+		authority,
+		address.NewBech32Codec("cosmosvaloper"),
+		address.NewBech32Codec("cosmosvalcons"),
+		runtime.NewContextAwareCometInfoService(),
+		dataSource,
+		stakingtypes.SymbioticConfig{
+			MiddlewareAddress: "0x0000000000000000000000000000000000000001",
+			OnSyncFailure:     onSyncFailure,
+		},
+	)
+	params := stakingtypes.DefaultParams()
+	params.MiddlewareAddress = "0x0000000000000000000000000000000000000001"
+	require.NoError(t, keeper.Params.Set(ctx, params))
+
+	vals := make([]*ed25519.PrivKey, 3)
+	for i := range vals {
+		vals[i] = ed25519.GenPrivKey()
+		validator := stakingtestutil.NewValidator(t, sdk.ValAddress(vals[i].PubKey().Address()), vals[i].PubKey())
+		require.NoError(t, keeper.SetValidator(ctx, validator))
+		require.NoError(t, keeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	return &fixture{
+		ctx:        ctx,
+		keeper:     keeper,
+		dataSource: dataSource,
+		handler:    abci.NewProposalHandler(coretesting.NewNopLogger(), keeper),
+		vals:       vals,
+	}
+}
+
+// addValidatorSet adds a finalized block holding a middleware validator set
+// with a stake for every fixture validator.
+func (f *fixture) addValidatorSet(t *testing.T) (string, []stakingtypes.SymbioticValidator) {
+	t.Helper()
+
+	blockHash := f.dataSource.AddBlock(1, uint64(f.ctx.HeaderInfo().Time.Add(-time.Minute).Unix()))
+	f.dataSource.SetBeaconBlockHash(0, blockHash)
+	// every past slot is finalized
+	f.dataSource.SetFinalizedEpoch(f.ctx.HeaderInfo().Time.Unix() / stakingtypes.DefaultSlotDuration / stakingtypes.DefaultSlotsInEpoch)
+
+	validators := make([]stakingtypes.SymbioticValidator, len(f.vals))
+	for i, val := range f.vals {
+		validators[i].Stake = math.NewInt(int64(i+1) * 1000).BigInt()
+		copy(validators[i].ConsAddr[:], val.PubKey().Address())
+	}
+	f.dataSource.SetValidatorSet(blockHash, validators)
+
+	return blockHash, validators
+}
+
+// extendedCommit returns the extended commit of the fixture validators, each
+// one signing the vote extension at the same index, and sets the matching last
+// commit in the returned context.
+func (f *fixture) extendedCommit(t *testing.T, exts ...[]byte) (sdk.Context, abcitypes.ExtendedCommitInfo) {
+	t.Helper()
+
+	commit := abcitypes.ExtendedCommitInfo{}
+	for i, val := range f.vals {
+		cve := cmtproto.CanonicalVoteExtension{
+			Extension: exts[i],
+			Height:    f.ctx.HeaderInfo().Height - 1,
+			ChainId:   chainID,
+		}
+		var buf bytes.Buffer
+		require.NoError(t, protoio.NewDelimitedWriter(&buf).WriteMsg(&cve))
+		sig, err := val.Sign(buf.Bytes())
+		require.NoError(t, err)
+
+		commit.Votes = append(commit.Votes, abcitypes.ExtendedVoteInfo{
+			Validator:          abcitypes.Validator{Address: val.PubKey().Address(), Power: 100},
+			VoteExtension:      exts[i],
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	sort.Slice(commit.Votes, func(i, j int) bool {
+		return bytes.Compare(commit.Votes[i].Validator.Address, commit.Votes[j].Validator.Address) < 0
+	})
+
+	lastCommit := comet.CommitInfo{}
+	for _, vote := range commit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, comet.VoteInfo{
+			Validator: comet.Validator{Address: vote.Validator.Address, Power: vote.Validator.Power},
+		})
+	}
+
+	return f.ctx.WithCometInfo(comet.Info{LastCommit: lastCommit}), commit
+}
+
+func voteExtension(t *testing.T, height int64, blockHash string, digest []byte) []byte {
+	t.Helper()
+	return encodeVoteExtension(t, stakingtypes.SymbioticVoteExtension{Height: height, BlockHash: blockHash, ValidatorSetDigest: digest})
+}
+
+func encodeVoteExtension(t *testing.T, voteExt stakingtypes.SymbioticVoteExtension) []byte {
+	t.Helper()
+
+	bz, err := voteExt.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+// attestation returns the vote extension attesting the fixture block and
+// validator set.
+func (f *fixture) attestation(t *testing.T, height int64, blockHash string, validators []stakingtypes.SymbioticValidator) stakingtypes.SymbioticVoteExtension {
+	t.Helper()
+
+	block, err := f.dataSource.GetBlockByHash(f.ctx, blockHash)
+	require.NoError(t, err)
+	return stakingtypes.SymbioticVoteExtension{
+		Height:             height,
+		BlockHash:          blockHash,
+		ValidatorSetDigest: stakingtypes.ValidatorSetDigest(validators),
+		BlockNumber:        block.NumberU64(),
+		BlockTimestamp:     block.Time(),
+	}
+}
+
+func (f *fixture) cachedBlockHash(t *testing.T) stakingtypes.CachedBlockHash {
+	t.Helper()
+
+	cached, err := f.keeper.CachedBlockHash.Get(f.ctx)
+	require.NoError(t, err)
+	return cached
+}
+
+func decodeSyncTx(t *testing.T, bz []byte) (stakingtypes.InjectedTx, stakingtypes.SymbioticSyncData) {
+	t.Helper()
+
+	injectedTx, err := stakingtypes.DecodeInjectedTx(bz)
+	require.NoError(t, err)
+
+	var syncData stakingtypes.SymbioticSyncData
+	require.NoError(t, syncData.Unmarshal(injectedTx.Payload))
+	return injectedTx, syncData
+}
+
+func encodeSyncTx(t *testing.T, height int64, blockHash string, syncData stakingtypes.SymbioticSyncData) []byte {
+	t.Helper()
+
+	payload, err := syncData.Marshal()
+	require.NoError(t, err)
+
+	bz, err := stakingtypes.InjectedTx{
+		Version:   stakingtypes.InjectedTxVersion,
+		Type:      stakingtypes.InjectedTxTypeSymbioticSync,
+		Height:    height,
+		BlockHash: blockHash,
+		Payload:   payload,
+	}.Bytes()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestPrepareProposal(t *testing.T) {
+	f := initFixture(t)
+	height := f.ctx.HeaderInfo().Height
+	userTx := []byte("tx")
+
+	// not a sync height, txs are passed through
+	res, err := f.handler.PrepareProposal()(f.ctx, &abcitypes.PrepareProposalRequest{Height: 1, Txs: [][]byte{userTx}})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{userTx}, res.Txs)
+
+	blockHash, validators := f.addValidatorSet(t)
+	attestation := f.attestation(t, height, blockHash, validators)
+	attested := encodeVoteExtension(t, attestation)
+	invalid := voteExtension(t, height, stakingkeeper.INVALID_BLOCKHASH, nil)
+
+	// no 2/3 agreement injects the invalid marker
+	ctx, commit := f.extendedCommit(t, attested, attested, invalid)
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: [][]byte{userTx}, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	injectedTx, _ := decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+
+	// unsigned vote extensions inject the invalid marker
+	ctx, commit = f.extendedCommit(t, attested, attested, attested)
+	commit.Votes[0].ExtensionSignature = nil
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: [][]byte{userTx}, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	injectedTx, _ = decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+
+	// unavailable data source on the proposer injects the invalid marker
+	ctx, commit = f.extendedCommit(t, attested, attested, attested)
+	f.dataSource.SetError(errors.New("rpc unavailable"))
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: [][]byte{userTx}, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	injectedTx, _ = decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+	f.dataSource.SetError(nil)
+
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: [][]byte{userTx}, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	injectedTx, syncData := decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, uint32(stakingtypes.InjectedTxVersion), injectedTx.Version)
+	require.Equal(t, stakingtypes.InjectedTxTypeSymbioticSync, injectedTx.Type)
+	require.Equal(t, height, injectedTx.Height)
+	require.Equal(t, blockHash, injectedTx.BlockHash)
+	require.Equal(t, commit, syncData.ExtendedCommitInfo)
+	require.Equal(t, stakingtypes.NewSymbioticValidatorStakes(validators), syncData.Validators)
+	require.Equal(t, attestation.BlockNumber, syncData.BlockNumber)
+	require.Equal(t, attestation.BlockTimestamp, syncData.BlockTimestamp)
+	require.Equal(t, userTx, res.Txs[1])
+
+	// proposer validator set not matching the agreed digest injects the invalid marker
+	f.dataSource.SetValidatorSet(blockHash, validators[:1])
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: [][]byte{userTx}, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	injectedTx, _ = decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+}
+
+func TestPrepareProposalMaxTxBytes(t *testing.T) {
+	f := initFixture(t)
+	height := f.ctx.HeaderInfo().Height
+
+	blockHash, validators := f.addValidatorSet(t)
+	attested := encodeVoteExtension(t, f.attestation(t, height, blockHash, validators))
+	ctx, commit := f.extendedCommit(t, attested, attested, attested)
+
+	// a full mempool, the txs of the request fill MaxTxBytes
+	txs := make([][]byte, 10)
+	var mempoolBytes int64
+	for i := range txs {
+		txs[i] = bytes.Repeat([]byte{byte(i)}, 100)
+		mempoolBytes += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txs[i]})
+	}
+
+	res, err := f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: txs, LocalLastCommit: commit, MaxTxBytes: mempoolBytes})
+	require.NoError(t, err)
+	injectedTx, _ := decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, blockHash, injectedTx.BlockHash)
+
+	// trailing txs are dropped to make room for the injected tx
+	require.Less(t, len(res.Txs), len(txs)+1)
+	require.Equal(t, txs[:len(res.Txs)-1], res.Txs[1:])
+	require.LessOrEqual(t, cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(res.Txs)), mempoolBytes)
+
+	// a sync tx larger than MaxTxBytes is replaced by the invalid marker
+	maxBytes := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{res.Txs[0]}) - 1
+	res, err = f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, Txs: txs, LocalLastCommit: commit, MaxTxBytes: maxBytes})
+	require.NoError(t, err)
+	injectedTx, syncData := decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+	require.Empty(t, syncData.Validators)
+	require.Equal(t, commit, syncData.ExtendedCommitInfo)
+	require.LessOrEqual(t, cmttypes.ComputeProtoSizeForTxs(cmttypes.ToTxs(res.Txs)), maxBytes)
+}
+
+func TestProcessProposal(t *testing.T) {
+	f := initFixture(t)
+	height := f.ctx.HeaderInfo().Height
+
+	blockHash, validators := f.addValidatorSet(t)
+	attestation := f.attestation(t, height, blockHash, validators)
+	attested := encodeVoteExtension(t, attestation)
+	invalid := voteExtension(t, height, stakingkeeper.INVALID_BLOCKHASH, nil)
+
+	ctx, commit := f.extendedCommit(t, attested, attested, invalid)
+	ctx23, commit23 := f.extendedCommit(t, attested, attested, attested)
+	valid := stakingtypes.SymbioticSyncData{
+		ExtendedCommitInfo: commit23,
+		Validators:         stakingtypes.NewSymbioticValidatorStakes(validators),
+		BlockNumber:        attestation.BlockNumber,
+		BlockTimestamp:     attestation.BlockTimestamp,
+	}
+	tampered := valid
+	tampered.Validators = stakingtypes.NewSymbioticValidatorStakes(validators[:1])
+	wrongBlock := valid
+	wrongBlock.BlockTimestamp++
+	notAttested := valid
+	notAttested.ExtendedCommitInfo = commit
+
+	validTx := encodeSyncTx(t, height, blockHash, valid)
+	skipTx := encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit})
+	payload, err := valid.Marshal()
+	require.NoError(t, err)
+	envelope := func(version uint32, txType stakingtypes.InjectedTxType) []byte {
+		bz, err := stakingtypes.InjectedTx{Version: version, Type: txType, Height: height, BlockHash: blockHash, Payload: payload}.Bytes()
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name   string
+		ctx    sdk.Context
+		height int64
+		txs    [][]byte
+		status abcitypes.ProcessProposalStatus
+	}{
+		{"not a sync height", f.ctx, 1, [][]byte{[]byte("tx")}, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"injected tx at a non sync height", f.ctx, 1, [][]byte{skipTx}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"missing sync tx", f.ctx, height, nil, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"regular tx in first position", f.ctx, height, [][]byte{[]byte("tx"), skipTx}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"sync tx without prefix", f.ctx, height, [][]byte{payload}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"malformed envelope", f.ctx, height, [][]byte{append(append([]byte{}, sdk.InjectedTxPrefix...), 0xff)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"unsupported version", ctx23, height, [][]byte{envelope(stakingtypes.InjectedTxVersion+1, stakingtypes.InjectedTxTypeSymbioticSync)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"unknown type", ctx23, height, [][]byte{envelope(stakingtypes.InjectedTxVersion, stakingtypes.InjectedTxTypeUnspecified)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"wrong height", ctx23, height, [][]byte{encodeSyncTx(t, height+1, blockHash, valid)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"skip", ctx, height, [][]byte{skipTx, []byte("tx")}, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"two injected txs", ctx, height, [][]byte{skipTx, skipTx}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"skip without commit", f.ctx, height, [][]byte{encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{})}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"skip of an agreed block hash", ctx23, height, [][]byte{encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit23})}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"skip with validators", ctx, height, [][]byte{encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit, Validators: valid.Validators})}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"valid", ctx23, height, [][]byte{validTx}, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"valid envelope", ctx23, height, [][]byte{envelope(stakingtypes.InjectedTxVersion, stakingtypes.InjectedTxTypeSymbioticSync)}, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT},
+		{"extended commit not matching last commit", f.ctx, height, [][]byte{validTx}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"block hash not attested by 2/3", ctx, height, [][]byte{encodeSyncTx(t, height, blockHash, notAttested)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"validator set not matching the digest", ctx23, height, [][]byte{encodeSyncTx(t, height, blockHash, tampered)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"block not matching the attestation", ctx23, height, [][]byte{encodeSyncTx(t, height, blockHash, wrongBlock)}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+		{"skip with block", ctx, height, [][]byte{encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit, BlockNumber: 1})}, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.handler.ProcessProposal()(tc.ctx, &abcitypes.ProcessProposalRequest{Height: tc.height, Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, res.Status)
+		})
+	}
+}
+
+func TestPreBlocker(t *testing.T) {
+	f := initFixture(t)
+	height := f.ctx.HeaderInfo().Height
+
+	// missing or malformed sync tx is skipped
+	err := f.handler.PreBlocker()(f.ctx, &abcitypes.FinalizeBlockRequest{Height: height})
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, f.cachedBlockHash(t).BlockHash)
+
+	err = f.handler.PreBlocker()(f.ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: [][]byte{[]byte("tx")}})
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, f.cachedBlockHash(t).BlockHash)
+
+	// the data source is not queried
+	blockHash, validators := f.addValidatorSet(t)
+	f.dataSource.SetError(errors.New("rpc unavailable"))
+	syncData := stakingtypes.SymbioticSyncData{Validators: stakingtypes.NewSymbioticValidatorStakes(validators), BlockNumber: 1, BlockTimestamp: 2}
+	err = f.handler.PreBlocker()(f.ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: [][]byte{encodeSyncTx(t, height, blockHash, syncData)}})
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: height, Attested: true, Validators: stakingtypes.NewSymbioticValidatorStakes(validators), BlockNumber: 1, BlockTimestamp: 2}, f.cachedBlockHash(t))
+}
+
+func TestSyncVoterStakes(t *testing.T) {
+	f := initFixture(t)
+	height := f.ctx.HeaderInfo().Height
+
+	id := stakingtypes.VoterStakeRequestID("0x01", make([]byte, 20), make([]byte, 20), make([]byte, 20))
+	other := stakingtypes.VoterStakeRequestID("0x02", make([]byte, 20), make([]byte, 20), make([]byte, 20))
+	agreed := []stakingtypes.SymbioticVoterStake{{RequestId: id, Stake: math.NewInt(700)}}
+	withVoterStakes := func(voterStakes ...stakingtypes.SymbioticVoterStake) []byte {
+		return encodeVoteExtension(t, stakingtypes.SymbioticVoteExtension{Height: height, BlockHash: stakingkeeper.INVALID_BLOCKHASH, VoterStakes: voterStakes})
+	}
+
+	// the voter stakes attested by more than 2/3 of the voting power are
+	// carried even without block hash agreement, the others are left out
+	ctx, commit := f.extendedCommit(t,
+		withVoterStakes(agreed[0], stakingtypes.SymbioticVoterStake{RequestId: other, Stake: math.NewInt(1)}),
+		withVoterStakes(agreed[0], stakingtypes.SymbioticVoterStake{RequestId: other, Stake: math.NewInt(1)}),
+		withVoterStakes(agreed[0], stakingtypes.SymbioticVoterStake{RequestId: other, Stake: math.NewInt(2)}),
+	)
+	res, err := f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	injectedTx, syncData := decodeSyncTx(t, res.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+	require.Equal(t, agreed, syncData.VoterStakes)
+	require.Equal(t, commit, syncData.ExtendedCommitInfo)
+
+	process := func(ctx sdk.Context, syncData stakingtypes.SymbioticSyncData) abcitypes.ProcessProposalStatus {
+		res, err := f.handler.ProcessProposal()(ctx, &abcitypes.ProcessProposalRequest{
+			Height: height,
+			Txs:    [][]byte{encodeSyncTx(t, height, stakingkeeper.INVALID_BLOCKHASH, syncData)},
+		})
+		require.NoError(t, err)
+		return res.Status
+	}
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT, process(ctx, syncData))
+
+	// voter stakes must match the attestations exactly
+	tampered := syncData
+	tampered.VoterStakes = []stakingtypes.SymbioticVoterStake{{RequestId: id, Stake: math.NewInt(701)}}
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, tampered))
+	tampered.VoterStakes = append(agreed, stakingtypes.SymbioticVoterStake{RequestId: other, Stake: math.NewInt(1)})
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, tampered))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(f.ctx, stakingtypes.SymbioticSyncData{VoterStakes: agreed}))
+	// agreed voter stakes cannot be left out
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(ctx, stakingtypes.SymbioticSyncData{ExtendedCommitInfo: commit}))
+
+	// the voter stakes are cached for EndBlock even if the sync is skipped
+	require.NoError(t, f.handler.PreBlocker()(f.ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: [][]byte{res.Txs[0]}}))
+	cached := f.cachedBlockHash(t)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, cached.BlockHash)
+	require.Equal(t, agreed, cached.VoterStakes)
+}
@@ -1,24 +1,243 @@
 package abci
 
 import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/log"
+	keeper2 "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-type VoteExtensionHandler struct{}
+// VoteExtensionHandler attests the finalized execution block hash and the
+// digest of the middleware validator set read at that block in the vote
+// extension of the block preceding a Symbiotic sync height, along with the
+// stakes of the oldest pending voter stake requests. Each validator reads
+// Ethereum on its own, a failing endpoint only makes it attest
+// INVALID_BLOCKHASH or leave out a voter stake.
+type VoteExtensionHandler struct {
+	logger log.Logger
+	keeper *keeper2.Keeper
+}
 
-func NewVoteExtensionHandler() *VoteExtensionHandler {
-	return &VoteExtensionHandler{}
+func NewVoteExtensionHandler(logger log.Logger, keeper *keeper2.Keeper) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger: logger,
+		keeper: keeper,
+	}
 }
 
 func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
-	return func(_ sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
-		return &abci.ExtendVoteResponse{VoteExtension: nil}, nil
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		syncHeight := req.Height + 1
+
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, syncHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSyncHeight {
+			return &abci.ExtendVoteResponse{}, nil
+		}
+
+		// the time of the sync height block is not known yet, the finalized
+		// block is looked up for the time of the block being voted on
+		info := ctx.HeaderInfo()
+		info.Time = req.Time
+		ctx = ctx.WithHeaderInfo(info)
+
+		voteExt := h.attest(ctx, syncHeight)
+		voteExt.VoterStakes = h.readVoterStakes(ctx)
+		bz, err := voteExt.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode symbiotic vote extension: %w", err)
+		}
+
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
 	}
 }
 
 func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
 	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		syncHeight := req.Height + 1
+
+		isSyncHeight, err := h.keeper.IsSymbioticSyncHeight(ctx, syncHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isSyncHeight {
+			if len(req.VoteExtension) > 0 {
+				h.logger.Error("VerifyVoteExtension: unexpected vote extension", "height", req.Height)
+				return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+			}
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+		}
+
+		var voteExt stakingtypes.SymbioticVoteExtension
+		if err := voteExt.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Error("VerifyVoteExtension: failed to decode vote extension", "height", req.Height, "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+
+		if err := validateVoteExtension(voteExt, syncHeight); err != nil {
+			h.logger.Error("VerifyVoteExtension: invalid vote extension", "height", req.Height, "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+
 		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
 	}
 }
+
+// attest returns the vote extension of this validator for the given sync
+// height. Any block or data source failure results in INVALID_BLOCKHASH, data
+// source failures are logged and counted but never halt the node.
+func (h *VoteExtensionHandler) attest(ctx sdk.Context, height int64) stakingtypes.SymbioticVoteExtension {
+	voteExt := stakingtypes.SymbioticVoteExtension{Height: height, BlockHash: keeper2.INVALID_BLOCKHASH}
+
+	blockHash, err := h.keeper.GetFinalizedBlockHash(ctx)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get finalized block hash", "height", height, "err", err)
+		// a whole epoch of missed slots is not an endpoint failure
+		if !errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+			h.keeper.IncrSyncCounter(keeper2.SyncOutcomeFailure)
+		}
+		return voteExt
+	}
+
+	if blockHash == keeper2.INVALID_BLOCKHASH {
+		return voteExt
+	}
+
+	block, err := h.keeper.GetBlockByHash(ctx, blockHash)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get block by hash", "hash", blockHash, "err", err)
+		h.keeper.IncrSyncCounter(keeper2.SyncOutcomeFailure)
+		return voteExt
+	}
+
+	canonical, err := h.keeper.GetBlockByNumber(ctx, block.Number())
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get block by number", "number", block.Number(), "err", err)
+		h.keeper.IncrSyncCounter(keeper2.SyncOutcomeFailure)
+		return voteExt
+	}
+	// very specific error caused by finalized check bug, ideally this check shouldn't exist
+	if canonical.Hash().String() != blockHash {
+		h.logger.Error("ExtendVote: block is not finalized", "hash", blockHash)
+		return voteExt
+	}
+
+	minBlockTimestamp, err := h.keeper.GetMinBlockTimestamp(ctx)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get min block timestamp", "err", err)
+		return voteExt
+	}
+
+	// the block must not be older than the one of the last applied sync, read
+	// from state so that every validator checks the same bound
+	checkpoint, err := h.keeper.GetSymbioticSyncCheckpoint(ctx)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get sync checkpoint", "err", err)
+		return voteExt
+	}
+
+	if block.Time() < checkpoint.BlockTimestamp || int64(block.Time()) >= ctx.HeaderInfo().Time.Unix() || block.Time() < minBlockTimestamp {
+		h.logger.Error("ExtendVote: block time out of range", "hash", blockHash, "time", block.Time())
+		return voteExt
+	}
+
+	validators, err := h.keeper.GetSymbioticValidatorSet(ctx, blockHash)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get validator set", "hash", blockHash, "err", err)
+		h.keeper.IncrSyncCounter(keeper2.SyncOutcomeFailure)
+		return voteExt
+	}
+
+	// a validator set too large for a block is not attested, so that the
+	// proposer can skip the sync
+	syncData := stakingtypes.SymbioticSyncData{Validators: stakingtypes.NewSymbioticValidatorStakes(validators)}
+	if maxBytes := maxSyncValidatorSetBytes(ctx); maxBytes > 0 && int64(syncData.Size()) > maxBytes {
+		h.logger.Error("ExtendVote: validator set too large for a block", "hash", blockHash, "size", syncData.Size())
+		return voteExt
+	}
+
+	voteExt.BlockHash = blockHash
+	voteExt.ValidatorSetDigest = stakingtypes.ValidatorSetDigest(validators)
+	voteExt.BlockNumber = block.NumberU64()
+	voteExt.BlockTimestamp = block.Time()
+
+	return voteExt
+}
+
+// maxSyncValidatorSetBytes returns the largest validator set a sync tx may
+// carry, half of the max block bytes to leave room for the commit and the
+// other txs, or zero if blocks are not limited.
+func maxSyncValidatorSetBytes(ctx sdk.Context) int64 {
+	if b := ctx.ConsensusParams().Block; b != nil && b.MaxBytes > 0 { // nolint:staticcheck // ignore linting error
+		return b.MaxBytes / 2
+	}
+	return 0
+}
+
+// readVoterStakes returns the stakes of the oldest pending voter stake
+// requests. A request whose stake cannot be read is left out.
+func (h *VoteExtensionHandler) readVoterStakes(ctx sdk.Context) []stakingtypes.SymbioticVoterStake {
+	requests, err := h.keeper.PendingSymbioticVoterStakeRequests(ctx, stakingtypes.MaxVoterStakesPerSync)
+	if err != nil {
+		h.logger.Error("ExtendVote: failed to get voter stake requests", "err", err)
+		return nil
+	}
+
+	var voterStakes []stakingtypes.SymbioticVoterStake
+	for _, req := range requests {
+		stake, err := h.keeper.ReadSymbioticVoterStake(ctx, req)
+		if err != nil {
+			h.logger.Error("ExtendVote: failed to read voter stake", "hash", req.BlockHash, "voter", common.BytesToAddress(req.Voter), "err", err)
+			continue
+		}
+		voterStakes = append(voterStakes, stakingtypes.SymbioticVoterStake{RequestId: req.ID(), Stake: stake})
+	}
+
+	return voterStakes
+}
+
+// validateVoteExtension checks that a decoded vote extension is made for the
+// given sync height, carries valid voter stakes and either INVALID_BLOCKHASH
+// without a digest and block, or a lower case hex block hash with a sha256
+// digest.
+func validateVoteExtension(voteExt stakingtypes.SymbioticVoteExtension, height int64) error {
+	if voteExt.Height != height {
+		return fmt.Errorf("vote extension height %d, expected %d", voteExt.Height, height)
+	}
+
+	if err := stakingtypes.ValidateVoterStakes(voteExt.VoterStakes); err != nil {
+		return err
+	}
+
+	if voteExt.BlockHash == keeper2.INVALID_BLOCKHASH {
+		if len(voteExt.ValidatorSetDigest) != 0 {
+			return errors.New("unexpected validator set digest for an invalid block hash")
+		}
+		if voteExt.BlockNumber != 0 || voteExt.BlockTimestamp != 0 {
+			return errors.New("unexpected block for an invalid block hash")
+		}
+		return nil
+	}
+
+	if common.HexToHash(voteExt.BlockHash).String() != voteExt.BlockHash {
+		return fmt.Errorf("malformed block hash %q", voteExt.BlockHash)
+	}
+
+	if len(voteExt.ValidatorSetDigest) != sha256.Size {
+		return fmt.Errorf("malformed validator set digest of %d bytes", len(voteExt.ValidatorSetDigest))
+	}
+
+	return nil
+}
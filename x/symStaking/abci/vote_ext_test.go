@@ -0,0 +1,166 @@
+package abci_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/abci"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+func TestExtendVote(t *testing.T) {
+	f := initFixture(t)
+	handler := abci.NewVoteExtensionHandler(coretesting.NewNopLogger(), f.keeper)
+	height := f.ctx.HeaderInfo().Height - 1
+	blockTime := f.ctx.HeaderInfo().Time
+
+	decode := func(bz []byte) stakingtypes.SymbioticVoteExtension {
+		var voteExt stakingtypes.SymbioticVoteExtension
+		require.NoError(t, voteExt.Unmarshal(bz))
+		return voteExt
+	}
+
+	// next block is not a sync height
+	res, err := handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height - 1, Time: blockTime})
+	require.NoError(t, err)
+	require.Empty(t, res.VoteExtension)
+
+	// unavailable data source attests the invalid marker, the skip policy does
+	// not halt
+	f.dataSource.SetError(errors.New("rpc unavailable"))
+	res, err = handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.SymbioticVoteExtension{Height: height + 1, BlockHash: stakingkeeper.INVALID_BLOCKHASH}, decode(res.VoteExtension))
+	require.Empty(t, f.keeper.HaltCh())
+	f.dataSource.SetError(nil)
+
+	// block not older than the voted block attests the invalid marker
+	blockHash, validators := f.addValidatorSet(t)
+	res, err = handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime.Add(-time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, decode(res.VoteExtension).BlockHash)
+
+	// a block older than the one of the last applied sync attests the invalid
+	// marker
+	require.NoError(t, f.keeper.SymbioticSyncCheckpoint.Set(f.ctx, stakingtypes.SymbioticSyncCheckpoint{BlockTimestamp: uint64(blockTime.Unix())}))
+	res, err = handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, decode(res.VoteExtension).BlockHash)
+	require.NoError(t, f.keeper.SymbioticSyncCheckpoint.Remove(f.ctx))
+
+	// a validator set too large for a block attests the invalid marker
+	smallBlocks := f.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxBytes: 64}})
+	res, err = handler.ExtendVote()(smallBlocks, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, decode(res.VoteExtension).BlockHash)
+
+	res, err = handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	require.Equal(t, f.attestation(t, height+1, blockHash, validators), decode(res.VoteExtension))
+
+	// the stakes of the pending voter stake requests are attested along
+	vault, operator, voter := common.Address{0x0a}, common.Address{0x0b}, common.Address{0x0c}
+	f.dataSource.SetVoterStake(blockHash, vault, operator, voter, big.NewInt(700))
+	require.NoError(t, f.keeper.RequestSymbioticVoterStake(f.ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes()))
+	res, err = handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	voteExt := decode(res.VoteExtension)
+	require.Equal(t, blockHash, voteExt.BlockHash)
+	require.Equal(t, []stakingtypes.SymbioticVoterStake{{
+		RequestId: stakingtypes.VoterStakeRequestID(blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes()),
+		Stake:     math.NewInt(700),
+	}}, voteExt.VoterStakes)
+}
+
+func TestSyncFailureHalt(t *testing.T) {
+	f := initFixtureWithSyncFailure(t, stakingtypes.SyncFailureHalt)
+	handler := abci.NewVoteExtensionHandler(coretesting.NewNopLogger(), f.keeper)
+	height := f.ctx.HeaderInfo().Height - 1
+	blockTime := f.ctx.HeaderInfo().Time
+
+	blockHash, validators := f.addValidatorSet(t)
+	attested := encodeVoteExtension(t, f.attestation(t, height+1, blockHash, validators))
+	ctx, commit := f.extendedCommit(t, attested, attested, attested)
+
+	// a failing endpoint in ExtendVote and PrepareProposal does not halt the
+	// node, it only attests or proposes the invalid marker
+	f.dataSource.SetError(errors.New("rpc unavailable"))
+	res, err := handler.ExtendVote()(f.ctx, &abcitypes.ExtendVoteRequest{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.VoteExtension)
+	require.Empty(t, f.keeper.HaltCh())
+
+	prepared, err := f.handler.PrepareProposal()(ctx, &abcitypes.PrepareProposalRequest{Height: height + 1, LocalLastCommit: commit, MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+	injectedTx, _ := decodeSyncTx(t, prepared.Txs[0])
+	require.Equal(t, stakingkeeper.INVALID_BLOCKHASH, injectedTx.BlockHash)
+	require.Empty(t, f.keeper.HaltCh())
+
+	// the skipped sync halts the node in EndBlock, at the same height on
+	// every node
+	require.NoError(t, f.handler.PreBlocker()(f.ctx, &abcitypes.FinalizeBlockRequest{Height: height + 1}))
+	require.NoError(t, f.keeper.SymbioticUpdateValidatorsPower(f.ctx))
+	require.ErrorContains(t, <-f.keeper.HaltCh(), "symbiotic sync skipped")
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	f := initFixture(t)
+	handler := abci.NewVoteExtensionHandler(coretesting.NewNopLogger(), f.keeper)
+	height := f.ctx.HeaderInfo().Height - 1
+	digest := stakingtypes.ValidatorSetDigest(nil)
+	blockHash := f.dataSource.AddBlock(1, 0)
+	voterStakes := func(n int, id []byte, stake math.Int) []stakingtypes.SymbioticVoterStake {
+		stakes := make([]stakingtypes.SymbioticVoterStake, n)
+		for i := range stakes {
+			stakes[i] = stakingtypes.SymbioticVoterStake{RequestId: id, Stake: stake}
+			if id == nil {
+				stakes[i].RequestId = common.BigToHash(big.NewInt(int64(i))).Bytes()
+			}
+		}
+		return stakes
+	}
+	withVoterStakes := func(voterStakes []stakingtypes.SymbioticVoterStake) []byte {
+		return encodeVoteExtension(t, stakingtypes.SymbioticVoteExtension{Height: height + 1, BlockHash: stakingkeeper.INVALID_BLOCKHASH, VoterStakes: voterStakes})
+	}
+
+	testCases := []struct {
+		name   string
+		height int64
+		ext    []byte
+		status abcitypes.VerifyVoteExtensionStatus
+	}{
+		{"not a sync height, empty", height - 1, nil, abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"not a sync height, non empty", height - 1, []byte("ext"), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"malformed", height, []byte("ext"), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid marker", height, voteExtension(t, height+1, stakingkeeper.INVALID_BLOCKHASH, nil), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"invalid marker with digest", height, voteExtension(t, height+1, stakingkeeper.INVALID_BLOCKHASH, digest), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid marker with block", height, encodeVoteExtension(t, stakingtypes.SymbioticVoteExtension{Height: height + 1, BlockHash: stakingkeeper.INVALID_BLOCKHASH, BlockNumber: 1}), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"attestation", height, voteExtension(t, height+1, blockHash, digest), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"wrong height", height, voteExtension(t, height, blockHash, digest), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"malformed block hash", height, voteExtension(t, height+1, "0x01", digest), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"malformed digest", height, voteExtension(t, height+1, blockHash, digest[:8]), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"invalid marker with voter stakes", height, withVoterStakes(voterStakes(stakingtypes.MaxVoterStakesPerSync, nil, math.NewInt(1))), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		{"too many voter stakes", height, withVoterStakes(voterStakes(stakingtypes.MaxVoterStakesPerSync+1, nil, math.NewInt(1))), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"duplicate voter stake", height, withVoterStakes(voterStakes(2, make([]byte, 32), math.NewInt(1))), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"malformed voter stake request id", height, withVoterStakes(voterStakes(1, []byte{1}, math.NewInt(1))), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		{"negative voter stake", height, withVoterStakes(voterStakes(1, nil, math.NewInt(-1))), abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := handler.VerifyVoteExtension()(f.ctx, &abcitypes.VerifyVoteExtensionRequest{Height: tc.height, VoteExtension: tc.ext})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, res.Status)
+		})
+	}
+}
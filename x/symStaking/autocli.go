@@ -44,6 +44,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "SymbioticSyncStatus",
+					Use:       "symbiotic-sync-status",
+					Short:     "Query the last Symbiotic sync",
+					Long:      "Query the last Symbiotic sync, the last one that was not skipped and the next sync height.",
+				},
+				{
+					RpcMethod: "SymbioticSyncHistory",
+					Use:       "symbiotic-sync-history",
+					Short:     "Query the persisted Symbiotic sync records",
+					Long:      "Query the persisted Symbiotic sync records by ascending height, with the block hash, execution block and stakes of each sync.",
+					Example:   fmt.Sprintf("$ %s query staking symbiotic-sync-history --reverse --limit 5", version.AppName),
+				},
+				{
+					RpcMethod: "SymbioticPendingPowerChanges",
+					Use:       "symbiotic-pending-power-changes",
+					Short:     "Query the Symbiotic power changes not applied yet",
+					Long:      "Query the Symbiotic power changes kept pending by the max_power_change_rate param, with the target tokens of each validator and the sync height that set it.",
+					Example:   fmt.Sprintf("$ %s query staking symbiotic-pending-power-changes", version.AppName),
+				},
+				{
+					RpcMethod:      "OperatorBinding",
+					Use:            "operator-binding [validator-addr]",
+					Short:          "Query the Symbiotic operator a validator is bound to",
+					Long:           "Query the Symbiotic operator a validator is bound to, with the nonce its next binding must be signed with.",
+					Example:        fmt.Sprintf("$ %s query staking operator-binding [validator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod:      "ConsPubKeyRotations",
+					Use:            "cons-pubkey-rotations [validator-addr]",
+					Short:          "Query the consensus key rotations of a validator",
+					Long:           "Query the consensus key rotation of a validator waiting for the Symbiotic middleware to report its new key, and the rotations applied to it by ascending height.",
+					Example:        fmt.Sprintf("$ %s query staking cons-pubkey-rotations [validator-addr]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
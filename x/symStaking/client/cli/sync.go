@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// WaitSymbioticSync returns the first applied Symbiotic sync at or after an
+// execution block, polling the chain every interval until there is one. The
+// sync must be fewer than maxBlocks blocks after the execution block, for the
+// headers between them to be provable.
+func WaitSymbioticSync(ctx context.Context, queryClient types.QueryClient, block, maxBlocks uint64, interval time.Duration) (types.SymbioticSyncRecord, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sync, found, err := firstSymbioticSyncAfter(ctx, queryClient, block)
+		if err != nil {
+			return sync, err
+		}
+		if found {
+			if sync.BlockNumber-block >= maxBlocks {
+				return sync, fmt.Errorf("first sync after block %d is at block %d, more than %d blocks later", block, sync.BlockNumber, maxBlocks)
+			}
+			return sync, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return sync, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// firstSymbioticSyncAfter returns the first applied Symbiotic sync at or after
+// the execution block among the persisted records.
+func firstSymbioticSyncAfter(ctx context.Context, queryClient types.QueryClient, block uint64) (types.SymbioticSyncRecord, bool, error) {
+	var (
+		first   types.SymbioticSyncRecord
+		found   bool
+		nextKey []byte
+	)
+	for {
+		res, err := queryClient.SymbioticSyncHistory(ctx, &types.QuerySymbioticSyncHistoryRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Reverse: true},
+		})
+		if err != nil {
+			return first, false, err
+		}
+
+		for _, sync := range res.Syncs {
+			if hash, err := hex.DecodeString(strings.TrimPrefix(sync.BlockHash, "0x")); err != nil || len(hash) != common.HashLength {
+				// skipped sync
+				continue
+			}
+			if sync.BlockNumber < block {
+				return first, found, nil
+			}
+			first, found = sync, true
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return first, found, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
@@ -40,6 +40,8 @@ func NewTxCmd() *cobra.Command {
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
 		NewEditValidatorCmd(),
+		NewUpdateOperatorBindingCmd(),
+		NewRotateConsPubKeyCmd(),
 	)
 
 	return stakingTxCmd
@@ -69,6 +71,9 @@ Where validator.json contains:
 	"commission-rate": "0.1",
 	"commission-max-rate": "0.2",
 	"commission-max-change-rate": "0.01",
+	"operator": "(optional) Ethereum address of the validator's Symbiotic operator",
+	"operator-signature-type": "(optional) eip191 or eip712",
+	"operator-signature": "(optional) hex encoded operator signature binding the validator",
 }
 
 where we can get the pubkey using "%s tendermint show-validator"
@@ -153,6 +158,81 @@ func NewEditValidatorCmd() *cobra.Command {
 	return cmd
 }
 
+// NewUpdateOperatorBindingCmd returns a CLI command handler for creating a MsgUpdateOperatorBinding transaction.
+func NewUpdateOperatorBindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-operator-binding [operator] [eip191|eip712] [signature]",
+		Short: "Bind the validator to a Symbiotic operator",
+		Long: `Bind the validator to a Symbiotic operator with a hex encoded signature of the operator's Ethereum key.
+The operator signs the binding of the validator's current consensus key with the nonce returned by the operator-binding query, or 0 if the validator was never bound.`,
+		Example: fmt.Sprintf("$ %s tx staking update-operator-binding 0x5FbDB2315678afecb367f032d93F642f64180aa3 eip712 0x... --from keyname", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := buildOperatorBindingProof(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateOperatorBinding(valAddr, *proof)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRotateConsPubKeyCmd returns a CLI command handler for creating a MsgRotateConsPubKey transaction.
+func NewRotateConsPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus key of the validator",
+		Long: `Rotate the consensus key of the validator to the given JSON encoded pubkey.
+The new key takes effect once the Symbiotic middleware reports it, the validator keeps signing with its current key until then.`,
+		Example: fmt.Sprintf(`$ %s tx staking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from keyname`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.ValidatorAddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(valAddr, pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, val validator, valAc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	valAddr := clientCtx.GetFromAddress()
 
@@ -174,6 +254,7 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+	msg.OperatorBinding = val.OperatorBinding
 	if err := msg.Validate(valAc); err != nil {
 		return txf, nil, err
 	}
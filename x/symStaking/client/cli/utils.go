@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/symStaking/types"
@@ -21,6 +24,7 @@ type validator struct {
 	Security        string
 	Details         string
 	CommissionRates types.CommissionRates
+	OperatorBinding *types.OperatorBindingProof
 }
 
 func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, error) {
@@ -34,6 +38,9 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		CommissionRate      string          `json:"commission-rate"`
 		CommissionMaxRate   string          `json:"commission-max-rate"`
 		CommissionMaxChange string          `json:"commission-max-change-rate"`
+		Operator            string          `json:"operator,omitempty"`
+		OperatorSigType     string          `json:"operator-signature-type,omitempty"`
+		OperatorSignature   string          `json:"operator-signature,omitempty"`
 	}
 
 	contents, err := os.ReadFile(path)
@@ -64,6 +71,14 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		return validator{}, err
 	}
 
+	var operatorBinding *types.OperatorBindingProof
+	if v.Operator != "" || v.OperatorSigType != "" || v.OperatorSignature != "" {
+		operatorBinding, err = buildOperatorBindingProof(v.Operator, v.OperatorSigType, v.OperatorSignature)
+		if err != nil {
+			return validator{}, err
+		}
+	}
+
 	return validator{
 		PubKey:          pk,
 		Moniker:         v.Moniker,
@@ -72,9 +87,38 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Security:        v.Security,
 		Details:         v.Details,
 		CommissionRates: commissionRates,
+		OperatorBinding: operatorBinding,
 	}, nil
 }
 
+func buildOperatorBindingProof(operator, sigTypeStr, signatureStr string) (*types.OperatorBindingProof, error) {
+	var sigType types.OperatorSignatureType
+	switch strings.ToLower(sigTypeStr) {
+	case "eip191":
+		sigType = types.OperatorSignatureTypeEIP191
+	case "eip712":
+		sigType = types.OperatorSignatureTypeEIP712
+	default:
+		return nil, fmt.Errorf("invalid operator signature type %q, expected eip191 or eip712", sigTypeStr)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(signatureStr, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator signature: %w", err)
+	}
+
+	proof := &types.OperatorBindingProof{
+		Operator:      operator,
+		SignatureType: sigType,
+		Signature:     signature,
+	}
+	if err := proof.Validate(); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
 func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionRates, err error) {
 	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
 		return commission, errors.New("must specify all validator commission parameters")
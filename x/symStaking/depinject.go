@@ -18,6 +18,7 @@ import (
 	"cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -46,6 +47,13 @@ type ModuleInputs struct {
 	Cdc                   codec.Codec
 	Environment           appmodule.Environment
 	CometInfoService      comet.Service
+	// DataSource overrides the default RPC backed Symbiotic data source.
+	DataSource types.SymbioticDataSource `optional:"true"`
+	// MiddlewareAdapter overrides the adapter described by the middleware_abi
+	// param, for middlewares it cannot describe.
+	MiddlewareAdapter types.MiddlewareAdapter `optional:"true"`
+
+	AppOpts servertypes.AppOptions `optional:"true"` // server v0
 }
 
 // Dependency Injection Outputs
@@ -68,6 +76,24 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
+	symbioticCfg, err := types.SymbioticConfigFromAppOptions(in.AppOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	// no silent fallback to public endpoints, a node refuses to start without
+	// a complete [symbiotic] config. Clients built without app options skip it.
+	if in.AppOpts != nil && in.DataSource == nil {
+		if err := symbioticCfg.Validate(); err != nil {
+			panic(fmt.Errorf("invalid [symbiotic] app.toml config: %w", err))
+		}
+	}
+
+	dataSource := in.DataSource
+	if dataSource == nil {
+		dataSource = keeper.NewRPCDataSource(in.Environment.Logger, symbioticCfg)
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Environment,
@@ -77,7 +103,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ValidatorAddressCodec,
 		in.ConsensusAddressCodec,
 		in.CometInfoService,
+		dataSource,
+		symbioticCfg,
 	)
+	if in.MiddlewareAdapter != nil {
+		k.SetMiddlewareAdapter(in.MiddlewareAdapter)
+	}
+
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 	return ModuleOutputs{StakingKeeper: k, Module: m}
 }
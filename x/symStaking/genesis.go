@@ -5,7 +5,9 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/ethereum/go-ethereum/common"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/symStaking/keeper"
 	"cosmossdk.io/x/symStaking/types"
 
@@ -55,9 +57,175 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisPendingPowerChanges(data.Validators, data.SymbioticPendingPowerChanges); err != nil {
+		return err
+	}
+
+	if err := validateGenesisOperatorBindings(data.OperatorBindings); err != nil {
+		return err
+	}
+
+	if err := validateGenesisConsPubKeyRotations(data.Validators, data.PendingConsPubkeyRotations, data.ConsPubkeyRotationHistory); err != nil {
+		return err
+	}
+
+	if err := validateGenesisSymbioticSyncs(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+// validateGenesisSymbioticSyncs checks the sync records and, for a genesis
+// bootstrapped from a Symbiotic snapshot, that the tokens of its validators
+// are the stakes of the last applied sync. Exported genesis are not checked,
+// as their tokens may still be moving towards the pending power changes.
+func validateGenesisSymbioticSyncs(data *types.GenesisState) error {
+	var (
+		snapshot types.SymbioticSyncRecord
+		found    bool
+	)
+	heights := make(map[int64]bool, len(data.SymbioticSyncs))
+	for _, record := range data.SymbioticSyncs {
+		if heights[record.Height] {
+			return fmt.Errorf("duplicate symbiotic sync record in genesis state: height %d", record.Height)
+		}
+		heights[record.Height] = true
+
+		for _, stake := range record.Stakes {
+			if stake.Stake.IsNil() || stake.Stake.IsNegative() {
+				return fmt.Errorf("invalid stake of symbiotic sync record in genesis state: height %d", record.Height)
+			}
+		}
+
+		if record.BlockHash != keeper.INVALID_BLOCKHASH && (!found || record.Height > snapshot.Height) {
+			snapshot, found = record, true
+		}
+	}
+
+	if data.Exported || !found {
+		return nil
+	}
+
+	valMap := make(map[string]bool, len(data.Validators))
+	for _, val := range data.Validators {
+		valMap[val.OperatorAddress] = true
+	}
+
+	stakes := make(map[string]math.Int, len(snapshot.Stakes))
+	for _, stake := range snapshot.Stakes {
+		if stake.ValidatorAddress == "" {
+			continue
+		}
+		if !valMap[stake.ValidatorAddress] {
+			return fmt.Errorf("symbiotic snapshot stake of unknown validator in genesis state: %s", stake.ValidatorAddress)
+		}
+		stakes[stake.ValidatorAddress] = stake.Stake
+	}
+
+	for _, val := range data.Validators {
+		stake, ok := stakes[val.OperatorAddress]
+		if !ok {
+			stake = math.ZeroInt()
+		}
+		if !val.Tokens.Equal(stake) {
+			return fmt.Errorf(
+				"tokens of validator %s do not match the symbiotic snapshot at block %s in genesis state: %s != %s",
+				val.OperatorAddress, snapshot.BlockHash, val.Tokens, stake,
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisOperatorBindings(bindings []types.OperatorBinding) error {
+	seen := make(map[string]bool, len(bindings))
+	for _, binding := range bindings {
+		if seen[binding.ValidatorAddress] {
+			return fmt.Errorf("duplicate operator binding in genesis state: %s", binding.ValidatorAddress)
+		}
+		if !common.IsHexAddress(binding.Operator) {
+			return fmt.Errorf("invalid operator address of validator %s in genesis state: %s", binding.ValidatorAddress, binding.Operator)
+		}
+		seen[binding.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisConsPubKeyRotations(validators []types.Validator, pending, history []types.ConsPubKeyRotation) error {
+	valMap := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valMap[val.OperatorAddress] = true
+	}
+
+	seen := make(map[string]bool, len(pending))
+	for _, rotation := range pending {
+		if !valMap[rotation.ValidatorAddress] {
+			return fmt.Errorf("pending consensus pubkey rotation of unknown validator in genesis state: %s", rotation.ValidatorAddress)
+		}
+		if seen[rotation.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending consensus pubkey rotation in genesis state: %s", rotation.ValidatorAddress)
+		}
+		if err := validateGenesisConsPubKeyRotation(rotation); err != nil {
+			return err
+		}
+		seen[rotation.ValidatorAddress] = true
+	}
+
+	rotated := make(map[string]bool, len(history))
+	for _, rotation := range history {
+		if err := validateGenesisConsPubKeyRotation(rotation); err != nil {
+			return err
+		}
+		if rotation.RotatedHeight <= 0 {
+			return fmt.Errorf("consensus pubkey rotation of validator %s without rotated height in genesis state", rotation.ValidatorAddress)
+		}
+		oldPk, _ := rotation.OldConsPubKey()
+		if rotated[string(oldPk.Bytes())] {
+			return fmt.Errorf("consensus pubkey rotated away from twice in genesis state: %s", rotation.ValidatorAddress)
+		}
+		rotated[string(oldPk.Bytes())] = true
+	}
+
+	return nil
+}
+
+func validateGenesisConsPubKeyRotation(rotation types.ConsPubKeyRotation) error {
+	if _, err := rotation.OldConsPubKey(); err != nil {
+		return fmt.Errorf("invalid old consensus pubkey of validator %s in genesis state: %w", rotation.ValidatorAddress, err)
+	}
+	if _, err := rotation.NewConsPubKey(); err != nil {
+		return fmt.Errorf("invalid new consensus pubkey of validator %s in genesis state: %w", rotation.ValidatorAddress, err)
+	}
+
+	return nil
+}
+
+func validateGenesisPendingPowerChanges(validators []types.Validator, changes []types.SymbioticPendingPowerChange) error {
+	valMap := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valMap[val.OperatorAddress] = true
+	}
+
+	seen := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if !valMap[change.ValidatorAddress] {
+			return fmt.Errorf("pending power change of unknown validator in genesis state: %s", change.ValidatorAddress)
+		}
+		if seen[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate pending power change in genesis state: %s", change.ValidatorAddress)
+		}
+		if change.TargetTokens.IsNil() || change.TargetTokens.IsNegative() {
+			return fmt.Errorf("invalid pending power change target tokens in genesis state: %s", change.ValidatorAddress)
+		}
+		seen[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
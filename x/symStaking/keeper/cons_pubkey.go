@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorIdentifier returns the consensus address a validator was created
+// with, for any of the consensus addresses it has rotated through. The given
+// address is returned if it was never rotated.
+func (k Keeper) ValidatorIdentifier(ctx context.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, error) {
+	initialConsAddr, err := k.ConsAddrToValidatorIdentifierMap.Get(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return consAddr, nil
+		}
+		return nil, err
+	}
+
+	return initialConsAddr, nil
+}
+
+// pendingConsPubKeyRotations returns the consensus pubkey rotations waiting
+// for the middleware, by the consensus address of their new key.
+func (k Keeper) pendingConsPubKeyRotations(ctx context.Context) (map[string]types.ConsPubKeyRotation, error) {
+	rotations := make(map[string]types.ConsPubKeyRotation)
+	err := k.PendingConsPubKeyRotations.Walk(ctx, nil, func(_ sdk.ValAddress, rotation types.ConsPubKeyRotation) (bool, error) {
+		newPk, err := rotation.NewConsPubKey()
+		if err != nil {
+			return true, err
+		}
+		rotations[string(newPk.Address())] = rotation
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotations, nil
+}
+
+// applyConsPubKeyRotation switches the validator of a pending rotation to its
+// new consensus key, once the middleware reports the new key. The validator
+// keeps being found by its old consensus addresses, and the key switch is sent
+// to CometBFT with the next validator set updates.
+func (k Keeper) applyConsPubKeyRotation(ctx context.Context, rotation types.ConsPubKeyRotation) (types.Validator, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(rotation.ValidatorAddress)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	oldPk, err := validator.ConsPubKey()
+	if err != nil {
+		return types.Validator{}, err
+	}
+	newPk, err := rotation.NewConsPubKey()
+	if err != nil {
+		return types.Validator{}, err
+	}
+	oldConsAddr := sdk.ConsAddress(oldPk.Address())
+	newConsAddr := sdk.ConsAddress(newPk.Address())
+
+	if err := k.ValidatorByConsensusAddress.Remove(ctx, oldConsAddr); err != nil {
+		return types.Validator{}, err
+	}
+
+	rotation.OldConsPubkey = validator.ConsensusPubkey
+	validator.ConsensusPubkey = rotation.NewConsPubkey
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return types.Validator{}, err
+	}
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return types.Validator{}, err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	rotation.RotatedHeight = height
+	if err := k.setConsPubKeyRotationHistory(ctx, valAddr, rotation); err != nil {
+		return types.Validator{}, err
+	}
+	if err := k.PendingConsPubKeyRotations.Remove(ctx, valAddr); err != nil {
+		return types.Validator{}, err
+	}
+
+	// CometBFT only knows the key of the validator before the first rotation
+	// not sent to it yet.
+	update, err := k.ConsPubKeyRotationUpdates.Get(ctx, valAddr)
+	switch {
+	case err == nil:
+		update.NewConsPubkey = rotation.NewConsPubkey
+		update.RotatedHeight = height
+	case errors.Is(err, collections.ErrNotFound):
+		update = rotation
+	default:
+		return types.Validator{}, err
+	}
+	if err := k.ConsPubKeyRotationUpdates.Set(ctx, valAddr, update); err != nil {
+		return types.Validator{}, err
+	}
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPk, newPk); err != nil {
+		return types.Validator{}, err
+	}
+
+	oldConsAddrStr, err := k.consensusAddressCodec.BytesToString(oldConsAddr)
+	if err != nil {
+		return types.Validator{}, err
+	}
+	newConsAddrStr, err := k.consensusAddressCodec.BytesToString(newConsAddr)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeConsPubKeyRotated,
+		event.NewAttribute(types.AttributeKeyValidator, rotation.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddrStr),
+		event.NewAttribute(types.AttributeKeyNewConsAddress, newConsAddrStr),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+	); err != nil {
+		return types.Validator{}, err
+	}
+
+	return validator, nil
+}
+
+// setConsPubKeyRotationHistory records an applied rotation and maps its old
+// consensus address to the new one.
+func (k Keeper) setConsPubKeyRotationHistory(ctx context.Context, valAddr sdk.ValAddress, rotation types.ConsPubKeyRotation) error {
+	oldPk, err := rotation.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+	newPk, err := rotation.NewConsPubKey()
+	if err != nil {
+		return err
+	}
+	oldConsAddr := sdk.ConsAddress(oldPk.Address())
+	newConsAddr := sdk.ConsAddress(newPk.Address())
+
+	if err := k.OldToNewConsAddrMap.Set(ctx, oldConsAddr, newConsAddr); err != nil {
+		return err
+	}
+	if err := k.setConsAddrToValidatorIdentifierMap(ctx, oldConsAddr, newConsAddr); err != nil {
+		return err
+	}
+
+	return k.ConsPubKeyRotationHistory.Set(ctx, collections.Join(valAddr, rotation.RotatedHeight), rotation)
+}
+
+// setConsAddrToValidatorIdentifierMap maps the new consensus address of a
+// validator to the consensus address it was created with.
+func (k Keeper) setConsAddrToValidatorIdentifierMap(ctx context.Context, oldConsAddr, newConsAddr sdk.ConsAddress) error {
+	initialConsAddr, err := k.ValidatorIdentifier(ctx, oldConsAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.ConsAddrToValidatorIdentifierMap.Set(ctx, newConsAddr, initialConsAddr)
+}
+
+// symbioticValidatorByConsAddr returns the validator of a middleware validator
+// set entry: the validator rotating to the consensus address of the entry, or
+// else the validator currently using it. ErrConsPubKeyRotated is returned for
+// a consensus address its validator has rotated away from.
+func (k Keeper) symbioticValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress, rotation types.ConsPubKeyRotation, rotating bool) (types.Validator, error) {
+	if rotating {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(rotation.ValidatorAddress)
+		if err != nil {
+			return types.Validator{}, err
+		}
+		return k.GetValidator(ctx, valAddr)
+	}
+
+	validator, err := k.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.Validator{}, err
+	}
+	if !bytes.Equal(valConsAddr, consAddr) {
+		return types.Validator{}, types.ErrConsPubKeyRotated
+	}
+
+	return validator, nil
+}
+
+// consPubKeyRotationUpdates returns the consensus pubkey rotations not sent
+// to CometBFT yet, by validator address.
+func (k Keeper) consPubKeyRotationUpdates(ctx context.Context) (map[string]types.ConsPubKeyRotation, error) {
+	rotations := make(map[string]types.ConsPubKeyRotation)
+	err := k.ConsPubKeyRotationUpdates.Walk(ctx, nil, func(_ sdk.ValAddress, rotation types.ConsPubKeyRotation) (bool, error) {
+		rotations[rotation.ValidatorAddress] = rotation
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rotations, nil
+}
+
+// consPubKeyRotationUpdateZero returns the validator update removing the
+// consensus key CometBFT knows a rotated validator by.
+func consPubKeyRotationUpdateZero(rotation types.ConsPubKeyRotation) (appmodule.ValidatorUpdate, error) {
+	oldPk, err := rotation.OldConsPubKey()
+	if err != nil {
+		return appmodule.ValidatorUpdate{}, err
+	}
+
+	return appmodule.ValidatorUpdate{
+		PubKey:     oldPk.Bytes(),
+		PubKeyType: oldPk.Type(),
+		Power:      0,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestConsPubKeyRotation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	params.MaxPowerChangeRate = math.LegacyZeroDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	oldPubKey, newPubKey := PKs[0], PKs[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+	valAddr := sdk.ValAddress(oldPubKey.Address())
+	valAddrStr := s.valAddressToString(valAddr)
+	validator := testutil.NewValidator(s.T(), valAddr, oldPubKey)
+	validator = validator.AddTokens(keeper.TokensFromConsensusPower(ctx, 10))
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	_, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+
+	// another validator cannot take the key
+	otherPubKey := PKs[2]
+	other := testutil.NewValidator(s.T(), sdk.ValAddress(otherPubKey.Address()), otherPubKey)
+	require.NoError(keeper.SetValidator(ctx, other))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, other))
+
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(valAddrStr, otherPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(valAddrStr, newPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	// nor the key of a pending rotation
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(other.OperatorAddress, newPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	res, err := s.queryClient.ConsPubKeyRotations(ctx, &stakingtypes.QueryConsPubKeyRotationsRequest{ValidatorAddr: valAddrStr})
+	require.NoError(err)
+	require.NotNil(res.Pending)
+	require.Empty(res.History)
+
+	// the validator keeps its key until the middleware reports the new one
+	stake := keeper.TokensFromConsensusPower(ctx, 20)
+	cache := stakingtypes.CachedBlockHash{
+		BlockHash:  "0x01",
+		Height:     stakingtypes.DefaultSymbioticSyncPeriod,
+		Attested:   true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{symbioticValidator(oldConsAddr, stake)}),
+	}
+	ctx = ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	consPubKey, err := validator.ConsPubKey()
+	require.NoError(err)
+	require.True(oldPubKey.Equals(consPubKey))
+	require.Equal(stake, validator.Tokens)
+
+	cache.Validators = stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{symbioticValidator(newConsAddr, stake)})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	consPubKey, err = validator.ConsPubKey()
+	require.NoError(err)
+	require.True(newPubKey.Equals(consPubKey))
+	require.Len(eventAttributes(ctx, stakingtypes.EventTypeConsPubKeyRotated), 1)
+
+	// the old consensus address still resolves to the validator
+	found, err := keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(valAddrStr, found.OperatorAddress)
+	identifier, err := keeper.ValidatorIdentifier(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(oldConsAddr, identifier)
+
+	res, err = s.queryClient.ConsPubKeyRotations(ctx, &stakingtypes.QueryConsPubKeyRotationsRequest{ValidatorAddr: valAddrStr})
+	require.NoError(err)
+	require.Nil(res.Pending)
+	require.Len(res.History, 1)
+	require.Equal(int64(stakingtypes.DefaultSymbioticSyncPeriod), res.History[0].RotatedHeight)
+
+	// CometBFT replaces the old key by the new one
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 2)
+	require.Equal(oldPubKey.Bytes(), updates[0].PubKey)
+	require.Zero(updates[0].Power)
+	require.Equal(newPubKey.Bytes(), updates[1].PubKey)
+	require.Equal(int64(20), updates[1].Power)
+
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Empty(updates)
+
+	// the old key is not matched anymore
+	cache.Validators = stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{symbioticValidator(oldConsAddr, stake)})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	unmatched := unmatchedValidatorEvents(ctx)
+	require.Len(unmatched, 1)
+	require.Equal(stakingtypes.UnmatchedReasonRotatedKey, unmatched[0][stakingtypes.AttributeKeyReason])
+
+	// and cannot be rotated back to
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(valAddrStr, oldPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+}
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/symStaking/types"
 
@@ -32,6 +36,78 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		return nil, err
 	}
 
+	if err := k.ValidateMiddlewareAddress(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateEthereumNetwork(ctx); err != nil {
+		return nil, err
+	}
+
+	if data.SymbioticSyncCheckpoint != nil {
+		if err := k.SymbioticSyncCheckpoint.Set(ctx, *data.SymbioticSyncCheckpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	if data.CachedBlockHash != nil {
+		if err := k.CachedBlockHash.Set(ctx, *data.CachedBlockHash); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, record := range data.SymbioticSyncs {
+		if err := k.SymbioticSyncs.Set(ctx, record.Height, record); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, binding := range data.OperatorBindings {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(binding.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.OperatorBindings.Set(ctx, valAddr, binding); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, change := range data.SymbioticPendingPowerChanges {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.PendingPowerChanges.Set(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rotation := range data.PendingConsPubkeyRotations {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(rotation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.PendingConsPubKeyRotations.Set(ctx, valAddr, rotation); err != nil {
+			return nil, err
+		}
+	}
+
+	// the rotations of a validator are replayed in order to map each of its
+	// old consensus addresses to the one it was created with
+	history := slices.Clone(data.ConsPubkeyRotationHistory)
+	slices.SortStableFunc(history, func(a, b types.ConsPubKeyRotation) int {
+		return cmp.Compare(a.RotatedHeight, b.RotatedHeight)
+	})
+	for _, rotation := range history {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(rotation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.setConsPubKeyRotationHistory(ctx, valAddr, rotation); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.LastTotalPower.Set(ctx, data.LastTotalPower); err != nil {
 		return nil, err
 	}
@@ -154,11 +230,77 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var checkpoint *types.SymbioticSyncCheckpoint
+	if c, err := k.SymbioticSyncCheckpoint.Get(ctx); err == nil {
+		checkpoint = &c
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var cachedBlockHash *types.CachedBlockHash
+	if c, err := k.CachedBlockHash.Get(ctx); err == nil {
+		cachedBlockHash = &c
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	var pendingChanges []types.SymbioticPendingPowerChange
+	err = k.PendingPowerChanges.Walk(ctx, nil, func(_ sdk.ValAddress, change types.SymbioticPendingPowerChange) (bool, error) {
+		pendingChanges = append(pendingChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var syncs []types.SymbioticSyncRecord
+	err = k.SymbioticSyncs.Walk(ctx, nil, func(_ int64, record types.SymbioticSyncRecord) (bool, error) {
+		syncs = append(syncs, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []types.OperatorBinding
+	err = k.OperatorBindings.Walk(ctx, nil, func(_ sdk.ValAddress, binding types.OperatorBinding) (bool, error) {
+		bindings = append(bindings, binding)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingRotations []types.ConsPubKeyRotation
+	err = k.PendingConsPubKeyRotations.Walk(ctx, nil, func(_ sdk.ValAddress, rotation types.ConsPubKeyRotation) (bool, error) {
+		pendingRotations = append(pendingRotations, rotation)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rotationHistory []types.ConsPubKeyRotation
+	err = k.ConsPubKeyRotationHistory.Walk(ctx, nil, func(_ collections.Pair[sdk.ValAddress, int64], rotation types.ConsPubKeyRotation) (bool, error) {
+		rotationHistory = append(rotationHistory, rotation)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:              params,
-		LastTotalPower:      totalPower,
-		LastValidatorPowers: lastValidatorPowers,
-		Validators:          allValidators,
-		Exported:            true,
+		Params:                       params,
+		LastTotalPower:               totalPower,
+		LastValidatorPowers:          lastValidatorPowers,
+		Validators:                   allValidators,
+		Exported:                     true,
+		SymbioticSyncCheckpoint:      checkpoint,
+		SymbioticPendingPowerChanges: pendingChanges,
+		CachedBlockHash:              cachedBlockHash,
+		OperatorBindings:             bindings,
+		PendingConsPubkeyRotations:   pendingRotations,
+		ConsPubkeyRotationHistory:    rotationHistory,
+		SymbioticSyncs:               syncs,
 	}, nil
 }
@@ -2,16 +2,19 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/symStaking/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -126,3 +129,138 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	}
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// SymbioticSyncStatus queries the last Symbiotic sync
+func (k Querier) SymbioticSyncStatus(ctx context.Context, _ *types.QuerySymbioticSyncStatusRequest) (*types.QuerySymbioticSyncStatusResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QuerySymbioticSyncStatusResponse{}
+	if params.SymbioticSyncPeriod > 0 {
+		height := k.HeaderService.HeaderInfo(ctx).Height
+		res.NextSyncHeight = (height/params.SymbioticSyncPeriod + 1) * params.SymbioticSyncPeriod
+	}
+
+	err = k.SymbioticSyncs.Walk(ctx, new(collections.Range[int64]).Descending(), func(_ int64, record types.SymbioticSyncRecord) (bool, error) {
+		if res.LastSync == nil {
+			res.LastSync = &record
+		}
+		if record.BlockHash != INVALID_BLOCKHASH {
+			res.LastAppliedSync = &record
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	checkpoint, err := k.SymbioticSyncCheckpoint.Get(ctx)
+	switch {
+	case err == nil:
+		res.Checkpoint = &checkpoint
+		res.StakeStaleness = k.StakeStaleness(ctx, checkpoint)
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// SymbioticSyncHistory queries the persisted Symbiotic sync records
+func (k Querier) SymbioticSyncHistory(ctx context.Context, req *types.QuerySymbioticSyncHistoryRequest) (*types.QuerySymbioticSyncHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	syncs, pageRes, err := query.CollectionPaginate(ctx, k.SymbioticSyncs, req.Pagination, func(_ int64, record types.SymbioticSyncRecord) (types.SymbioticSyncRecord, error) {
+		return record, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbioticSyncHistoryResponse{Syncs: syncs, Pagination: pageRes}, nil
+}
+
+// SymbioticPendingPowerChanges queries the Symbiotic power changes kept
+// pending by the max_power_change_rate param
+func (k Querier) SymbioticPendingPowerChanges(ctx context.Context, req *types.QuerySymbioticPendingPowerChangesRequest) (*types.QuerySymbioticPendingPowerChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(ctx, k.PendingPowerChanges, req.Pagination, func(_ sdk.ValAddress, change types.SymbioticPendingPowerChange) (types.SymbioticPendingPowerChange, error) {
+		return change, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbioticPendingPowerChangesResponse{PendingChanges: changes, Pagination: pageRes}, nil
+}
+
+// OperatorBinding queries the Symbiotic operator a validator is bound to
+func (k Querier) OperatorBinding(ctx context.Context, req *types.QueryOperatorBindingRequest) (*types.QueryOperatorBindingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	binding, found, err := k.GetOperatorBinding(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "operator binding of validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryOperatorBindingResponse{Binding: binding}, nil
+}
+
+// ConsPubKeyRotations queries the pending consensus key rotation of a
+// validator and the rotations applied to it
+func (k Querier) ConsPubKeyRotations(ctx context.Context, req *types.QueryConsPubKeyRotationsRequest) (*types.QueryConsPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryConsPubKeyRotationsResponse{}
+	pending, err := k.PendingConsPubKeyRotations.Get(ctx, valAddr)
+	switch {
+	case err == nil:
+		res.Pending = &pending
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, int64](valAddr)
+	err = k.ConsPubKeyRotationHistory.Walk(ctx, rng, func(_ collections.Pair[sdk.ValAddress, int64], rotation types.ConsPubKeyRotation) (bool, error) {
+		res.History = append(res.History, rotation)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
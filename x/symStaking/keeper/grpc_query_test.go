@@ -4,10 +4,13 @@ import (
 	gocontext "context"
 	"fmt"
 
+	"cosmossdk.io/core/header"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
 	"cosmossdk.io/x/symStaking/testutil"
 	"cosmossdk.io/x/symStaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryValidator() {
@@ -122,3 +125,96 @@ func (s *KeeperTestSuite) TestGRPCQueryValidators() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQuerySymbioticSync() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	valPubKey := PKs[0]
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(valPubKey.Address()), valPubKey)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	params.SymbioticSyncHistoryEntries = 2
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: ctx.HeaderInfo().Time})
+	status, err := querier.SymbioticSyncStatus(ctx, &types.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Nil(status.LastSync)
+	require.Equal(types.DefaultSymbioticSyncPeriod, status.NextSyncHeight)
+	_, err = keeper.GetLastAppliedSymbioticSync(ctx, ctx.HeaderInfo().Height)
+	require.ErrorIs(err, types.ErrSymbioticNotFound)
+
+	sync := func(height int64, cached types.CachedBlockHash) {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time})
+		cached.Height = height
+		require.NoError(keeper.CacheBlockHash(ctx, cached))
+		require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	}
+
+	stake := keeper.TokensFromConsensusPower(ctx, 3)
+	blockTimestamp := uint64(types.DefaultBeaconGenesisTimestamp + 2*types.DefaultSlotsInEpoch*types.DefaultSlotDuration + 1)
+	period := types.DefaultSymbioticSyncPeriod
+	sync(period, types.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH})
+	sync(2*period, types.CachedBlockHash{
+		BlockHash:      "0x01",
+		Attested:       true,
+		BlockNumber:    5,
+		BlockTimestamp: blockTimestamp,
+		Validators: types.NewSymbioticValidatorStakes([]types.SymbioticValidator{
+			symbioticValidator(sdk.ConsAddress(valPubKey.Address()), stake),
+			symbioticValidator(sdk.ConsAddress(PKs[1].Address()), stake),
+		}),
+	})
+	sync(3*period, types.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH})
+
+	status, err = querier.SymbioticSyncStatus(ctx, &types.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Equal(3*period, status.LastSync.Height)
+	require.Equal(stakingkeeper.INVALID_BLOCKHASH, status.LastSync.BlockHash)
+	require.Equal(4*period, status.NextSyncHeight)
+
+	applied := status.LastAppliedSync
+	require.Equal(2*period, applied.Height)
+	require.Equal("0x01", applied.BlockHash)
+	require.Equal(uint64(5), applied.BlockNumber)
+	require.Equal(blockTimestamp, applied.BlockTimestamp)
+	require.Equal(uint64(2), applied.Epoch)
+	require.Len(applied.Stakes, 2)
+	require.Equal(validator.OperatorAddress, applied.Stakes[0].ValidatorAddress)
+	require.Equal(stake, applied.Stakes[0].Stake)
+	unknownConsAddr, err := keeper.ConsensusAddressCodec().BytesToString(PKs[1].Address())
+	require.NoError(err)
+	require.Empty(applied.Stakes[1].ValidatorAddress)
+	require.Equal(unknownConsAddr, applied.Stakes[1].ConsAddress)
+
+	last, err := keeper.GetLastAppliedSymbioticSync(ctx, ctx.HeaderInfo().Height)
+	require.NoError(err)
+	require.Equal(*applied, last)
+
+	// the oldest record was pruned
+	history, err := querier.SymbioticSyncHistory(ctx, &types.QuerySymbioticSyncHistoryRequest{})
+	require.NoError(err)
+	require.Len(history.Syncs, 2)
+	require.Equal(2*period, history.Syncs[0].Height)
+	require.Equal(3*period, history.Syncs[1].Height)
+
+	history, err = querier.SymbioticSyncHistory(ctx, &types.QuerySymbioticSyncHistoryRequest{Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(err)
+	require.Len(history.Syncs, 1)
+	require.Equal(3*period, history.Syncs[0].Height)
+
+	// the last sync is kept without history
+	params.SymbioticSyncHistoryEntries = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	sync(4*period, types.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH})
+	history, err = querier.SymbioticSyncHistory(ctx, &types.QuerySymbioticSyncHistoryRequest{})
+	require.NoError(err)
+	require.Len(history.Syncs, 1)
+	require.Equal(4*period, history.Syncs[0].Height)
+}
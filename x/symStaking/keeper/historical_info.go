@@ -51,3 +51,9 @@ func (k Keeper) TrackHistoricalInfo(ctx context.Context) error {
 	// Set latest HistoricalInfo at current height
 	return k.HistoricalInfo.Set(ctx, uint64(headerInfo.Height), historicalEntry)
 }
+
+// GetHistoricalInfo returns the historical record of a height, or
+// collections.ErrNotFound if it is not kept.
+func (k Keeper) GetHistoricalInfo(ctx context.Context, height int64) (types.HistoricalRecord, error) {
+	return k.HistoricalInfo.Get(ctx, uint64(height))
+}
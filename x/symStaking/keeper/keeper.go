@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"os"
+	"context"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -41,21 +41,49 @@ func HistoricalInfoCodec(cdc codec.BinaryCodec) collcodec.ValueCodec[types.Histo
 type Keeper struct {
 	appmodule.Environment
 
-	cdc                      codec.BinaryCodec
-	authKeeper               types.AccountKeeper
-	bankKeeper               types.BankKeeper
-	hooks                    types.StakingHooks
-	authority                string
-	validatorAddressCodec    addresscodec.Codec
-	consensusAddressCodec    addresscodec.Codec
-	cometInfoService         comet.Service
-	apiUrls                  types.ApiUrls
-	networkMiddlewareAddress string
+	cdc                   codec.Codec
+	authKeeper            types.AccountKeeper
+	bankKeeper            types.BankKeeper
+	hooks                 types.StakingHooks
+	authority             string
+	validatorAddressCodec addresscodec.Codec
+	consensusAddressCodec addresscodec.Codec
+	cometInfoService      comet.Service
+	dataSource            types.SymbioticDataSource
+	symbioticConfig       types.SymbioticConfig
+	// middlewareAdapter overrides the adapter of the middleware_abi param.
+	middlewareAdapter types.MiddlewareAdapter
+	// haltCh receives the error of a Symbiotic sync failure the node must
+	// shut down on, see HandleSyncFailure.
+	haltCh chan error
 
 	Schema collections.Schema
 
 	// CachedBlockHash value: CachedBlockHash
-	CachedBlockHash collections.Item[[]byte]
+	CachedBlockHash collections.Item[types.CachedBlockHash]
+	// SymbioticSyncs key: Height | value: SymbioticSyncRecord
+	SymbioticSyncs collections.Map[int64, types.SymbioticSyncRecord]
+	// SymbioticSyncCheckpoint value: SymbioticSyncCheckpoint
+	SymbioticSyncCheckpoint collections.Item[types.SymbioticSyncCheckpoint]
+	// PendingPowerChanges key: valAddr | value: SymbioticPendingPowerChange
+	PendingPowerChanges collections.Map[sdk.ValAddress, types.SymbioticPendingPowerChange]
+	// OperatorBindings key: valAddr | value: OperatorBinding
+	OperatorBindings collections.Map[sdk.ValAddress, types.OperatorBinding]
+	// VoterStakeRequests key: requestID | value: SymbioticVoterStakeRequest
+	VoterStakeRequests collections.Map[[]byte, types.SymbioticVoterStakeRequest]
+	// VoterStakeRequestsByHeight key: height+requestID | value: none used (index key for VoterStakeRequests)
+	VoterStakeRequestsByHeight collections.KeySet[collections.Pair[int64, []byte]]
+	// PendingConsPubKeyRotations key: valAddr | value: ConsPubKeyRotation
+	PendingConsPubKeyRotations collections.Map[sdk.ValAddress, types.ConsPubKeyRotation]
+	// ConsPubKeyRotationUpdates key: valAddr | value: ConsPubKeyRotation
+	// not sent to CometBFT yet
+	ConsPubKeyRotationUpdates collections.Map[sdk.ValAddress, types.ConsPubKeyRotation]
+	// ConsPubKeyRotationHistory key: valAddr+rotatedHeight | value: ConsPubKeyRotation
+	ConsPubKeyRotationHistory collections.Map[collections.Pair[sdk.ValAddress, int64], types.ConsPubKeyRotation]
+	// ConsAddrToValidatorIdentifierMap key: consAddr | value: initial consAddr of the validator
+	ConsAddrToValidatorIdentifierMap collections.Map[sdk.ConsAddress, sdk.ConsAddress]
+	// OldToNewConsAddrMap key: old consAddr | value: new consAddr
+	OldToNewConsAddrMap collections.Map[sdk.ConsAddress, sdk.ConsAddress]
 	// HistoricalInfo key: Height | value: HistoricalInfo
 	HistoricalInfo collections.Map[uint64, types.HistoricalRecord]
 	// LastTotalPower value: LastTotalPower
@@ -79,7 +107,7 @@ type Keeper struct {
 
 // NewKeeper creates a new staking Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	env appmodule.Environment,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -87,6 +115,8 @@ func NewKeeper(
 	validatorAddressCodec addresscodec.Codec,
 	consensusAddressCodec addresscodec.Codec,
 	cometInfoService comet.Service,
+	dataSource types.SymbioticDataSource,
+	symbioticConfig types.SymbioticConfig,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
@@ -99,24 +129,82 @@ func NewKeeper(
 		panic("validator and/or consensus address codec are nil")
 	}
 
-	networkMiddlewareAddress := os.Getenv("MIDDLEWARE_ADDRESS")
+	if dataSource == nil {
+		panic("symbiotic data source is nil")
+	}
 
 	k := &Keeper{
-		Environment:              env,
-		cdc:                      cdc,
-		authKeeper:               ak,
-		bankKeeper:               bk,
-		hooks:                    nil,
-		authority:                authority,
-		validatorAddressCodec:    validatorAddressCodec,
-		consensusAddressCodec:    consensusAddressCodec,
-		cometInfoService:         cometInfoService,
-		apiUrls:                  types.NewApiUrls(),
-		networkMiddlewareAddress: networkMiddlewareAddress,
-		CachedBlockHash:          collections.NewItem(sb, types.CachedBlockHashKey, "cached_block_hash", collections.BytesValue),
-		LastTotalPower:           collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
-		HistoricalInfo:           collections.NewMap(sb, types.HistoricalInfoKey, "historical_info", collections.Uint64Key, HistoricalInfoCodec(cdc)),
-		UnbondingID:              collections.NewSequence(sb, types.UnbondingIDKey, "unbonding_id"),
+		Environment:             env,
+		cdc:                     cdc,
+		authKeeper:              ak,
+		bankKeeper:              bk,
+		hooks:                   nil,
+		authority:               authority,
+		validatorAddressCodec:   validatorAddressCodec,
+		consensusAddressCodec:   consensusAddressCodec,
+		cometInfoService:        cometInfoService,
+		dataSource:              dataSource,
+		symbioticConfig:         symbioticConfig,
+		haltCh:                  make(chan error, 1),
+		CachedBlockHash:         collections.NewItem(sb, types.CachedBlockHashKey, "cached_block_hash", codec.CollValue[types.CachedBlockHash](cdc)),
+		SymbioticSyncs:          collections.NewMap(sb, types.SymbioticSyncsKey, "symbiotic_syncs", collections.Int64Key, codec.CollValue[types.SymbioticSyncRecord](cdc)),
+		SymbioticSyncCheckpoint: collections.NewItem(sb, types.SymbioticSyncCheckpointKey, "symbiotic_sync_checkpoint", codec.CollValue[types.SymbioticSyncCheckpoint](cdc)),
+		PendingPowerChanges: collections.NewMap(
+			sb, types.SymbioticPendingPowerChangesKey,
+			"symbiotic_pending_power_changes",
+			sdk.ValAddressKey,
+			codec.CollValue[types.SymbioticPendingPowerChange](cdc),
+		),
+		OperatorBindings: collections.NewMap(
+			sb, types.OperatorBindingsKey,
+			"operator_bindings",
+			sdk.ValAddressKey,
+			codec.CollValue[types.OperatorBinding](cdc),
+		),
+		VoterStakeRequests: collections.NewMap(
+			sb, types.VoterStakeRequestsKey,
+			"voter_stake_requests",
+			collections.BytesKey,
+			codec.CollValue[types.SymbioticVoterStakeRequest](cdc),
+		),
+		VoterStakeRequestsByHeight: collections.NewKeySet(
+			sb, types.VoterStakeRequestsByHeightKey,
+			"voter_stake_requests_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+		),
+		PendingConsPubKeyRotations: collections.NewMap(
+			sb, types.PendingConsPubKeyRotationsKey,
+			"pending_cons_pubkey_rotations",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ConsPubKeyRotation](cdc),
+		),
+		ConsPubKeyRotationUpdates: collections.NewMap(
+			sb, types.ConsPubKeyRotationUpdatesKey,
+			"cons_pubkey_rotation_updates",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ConsPubKeyRotation](cdc),
+		),
+		ConsPubKeyRotationHistory: collections.NewMap(
+			sb, types.ValidatorConsPubKeyRotationHistoryKey,
+			"cons_pubkey_rotation_history",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Int64Key),
+			codec.CollValue[types.ConsPubKeyRotation](cdc),
+		),
+		ConsAddrToValidatorIdentifierMap: collections.NewMap(
+			sb, types.ConsAddrToValidatorIdentifierMapKey,
+			"cons_addr_to_validator_identifier_map",
+			sdk.ConsAddressKey,
+			collcodec.KeyToValueCodec(sdk.ConsAddressKey),
+		),
+		OldToNewConsAddrMap: collections.NewMap(
+			sb, types.OldToNewConsAddrMapKey,
+			"old_to_new_cons_addr_map",
+			sdk.ConsAddressKey,
+			collcodec.KeyToValueCodec(sdk.ConsAddressKey),
+		),
+		LastTotalPower: collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
+		HistoricalInfo: collections.NewMap(sb, types.HistoricalInfoKey, "historical_info", collections.Uint64Key, HistoricalInfoCodec(cdc)),
+		UnbondingID:    collections.NewSequence(sb, types.UnbondingIDKey, "unbonding_id"),
 		ValidatorByConsensusAddress: collections.NewMap(
 			sb, types.ValidatorsByConsAddrKey,
 			"validator_by_cons_addr",
@@ -171,6 +259,31 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetMiddlewareAdapter sets the adapter the validator set is read from the
+// middleware with, instead of the one described by the middleware_abi param.
+func (k *Keeper) SetMiddlewareAdapter(adapter types.MiddlewareAdapter) {
+	if k.middlewareAdapter != nil {
+		panic("cannot set middleware adapter twice")
+	}
+
+	k.middlewareAdapter = adapter
+}
+
+// MiddlewareAdapter returns the adapter the validator set is read from the
+// middleware with.
+func (k Keeper) MiddlewareAdapter(ctx context.Context) (types.MiddlewareAdapter, error) {
+	if k.middlewareAdapter != nil {
+		return k.middlewareAdapter, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMiddlewareAdapter(params.MiddlewareAbi)
+}
+
 // GetAuthority returns the x/symStaking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -36,6 +36,8 @@ var (
 	PKs = simtestutil.CreateTestPubKeys(500)
 )
 
+const testMiddlewareAddress = "0x0000000000000000000000000000000000000001"
+
 type KeeperTestSuite struct {
 	suite.Suite
 
@@ -44,6 +46,7 @@ type KeeperTestSuite struct {
 	stakingKeeper *stakingkeeper.Keeper
 	bankKeeper    *stakingtestutil.MockBankKeeper
 	accountKeeper *stakingtestutil.MockAccountKeeper
+	dataSource    *stakingtestutil.InMemoryDataSource
 	queryClient   stakingtypes.QueryClient
 	msgServer     stakingtypes.MsgServer
 	key           *storetypes.KVStoreKey
@@ -86,6 +89,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryHelper.GRPCQueryRouter), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
 	authority, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(stakingtypes.GovModuleName))
 	s.Require().NoError(err)
+	dataSource := stakingtestutil.NewInMemoryDataSource()
 	keeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
 		env,
@@ -95,6 +99,8 @@ func (s *KeeperTestSuite) SetupTest() {
 		address.NewBech32Codec("cosmosvaloper"),
 		address.NewBech32Codec("cosmosvalcons"),
 		runtime.NewContextAwareCometInfoService(),
+		dataSource,
+		stakingtypes.SymbioticConfig{MiddlewareAddress: testMiddlewareAddress},
 	)
 	require.NoError(keeper.Params.Set(ctx, stakingtypes.DefaultParams()))
 
@@ -102,6 +108,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.stakingKeeper = keeper
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
+	s.dataSource = dataSource
 
 	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	stakingtypes.RegisterQueryServer(queryHelper, stakingkeeper.Querier{Keeper: keeper})
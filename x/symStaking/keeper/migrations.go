@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/x/symStaking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -36,7 +40,118 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 	return nil
 }
 
-// Migrate4to5 migrates x/symStaking state from consensus version 5 to 6.
+// Migrate5to6 migrates x/symStaking state from consensus version 5 to 6.
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return nil
 }
+
+// SetMiddlewareAddress sets the Symbiotic middleware address of a chain at
+// consensus version 6, whose nodes read it from their environment. The upgrade
+// handler must call it before Migrate6to7 runs.
+func (m Migrator) SetMiddlewareAddress(ctx context.Context, addr string) error {
+	if err := types.ValidateMiddlewareAddress(addr); err != nil {
+		return err
+	}
+
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.MiddlewareAddress = addr
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate6to7 migrates x/symStaking state from consensus version 6 to 7.
+// It sets the Symbiotic params, previously hard-coded or missing, to their
+// defaults. The middleware address has no default, it must have been set with
+// SetMiddlewareAddress.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MiddlewareAddress == "" {
+		return errors.New("symbiotic middleware address must be set by the upgrade handler before the migration")
+	}
+
+	defaults := types.DefaultParams()
+	if params.SymbioticSyncPeriod == 0 {
+		params.SymbioticSyncPeriod = defaults.SymbioticSyncPeriod
+	}
+	if params.SlotsInEpoch == 0 {
+		params.SlotsInEpoch = defaults.SlotsInEpoch
+	}
+	if params.BeaconGenesisTimestamp == 0 {
+		params.BeaconGenesisTimestamp = defaults.BeaconGenesisTimestamp
+	}
+	if params.SlotDuration == 0 {
+		params.SlotDuration = defaults.SlotDuration
+	}
+	if params.SymbioticSyncHistoryEntries == 0 {
+		params.SymbioticSyncHistoryEntries = defaults.SymbioticSyncHistoryEntries
+	}
+	if params.MaxStakeStaleness == 0 {
+		params.MaxStakeStaleness = defaults.MaxStakeStaleness
+	}
+	if params.StaleStakeAction == types.StaleStakeActionUnspecified {
+		params.StaleStakeAction = defaults.StaleStakeAction
+	}
+	// an unset rate is decoded as zero, which would disable the limit
+	if params.MaxPowerChangeRate.IsNil() || params.MaxPowerChangeRate.IsZero() {
+		params.MaxPowerChangeRate = defaults.MaxPowerChangeRate
+	}
+	// the default network is only set if the beacon chain params are its own
+	if spec, _ := types.GetBeaconChainSpec(defaults.EthereumNetwork); params.EthereumNetwork == "" &&
+		params.BeaconGenesisTimestamp == spec.GenesisTimestamp &&
+		params.SlotDuration == spec.SlotDuration &&
+		params.SlotsInEpoch == spec.SlotsInEpoch {
+		params.EthereumNetwork = defaults.EthereumNetwork
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// legacyCachedBlockHash is the JSON encoding of the cached block hash before
+// consensus version 8.
+type legacyCachedBlockHash struct {
+	BlockHash      string
+	Height         int64
+	Attested       bool
+	Validators     []types.SymbioticValidator
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// Migrate7to8 migrates x/symStaking state from consensus version 7 to 8.
+// It re-encodes the cached block hash, previously stored as JSON, in protobuf.
+// A legacy cache not attested through vote extensions is kept as such, its
+// sync is skipped rather than read from Ethereum in EndBlock.
+func (m Migrator) Migrate7to8(ctx context.Context) error {
+	bz, err := m.keeper.KVStoreService.OpenKVStore(ctx).Get(types.CachedBlockHashKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var legacy legacyCachedBlockHash
+	if err := json.Unmarshal(bz, &legacy); err != nil {
+		return err
+	}
+
+	return m.keeper.CachedBlockHash.Set(ctx, types.CachedBlockHash{
+		BlockHash:      legacy.BlockHash,
+		Height:         legacy.Height,
+		Attested:       legacy.Attested,
+		Validators:     types.NewSymbioticValidatorStakes(legacy.Validators),
+		BlockNumber:    legacy.BlockNumber,
+		BlockTimestamp: legacy.BlockTimestamp,
+	})
+}
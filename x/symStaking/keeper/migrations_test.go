@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate6to7() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// params stored at consensus version 6, before the Symbiotic params
+	// existed, the middleware address being read from the environment
+	defaults := stakingtypes.DefaultParams()
+	require.NoError(keeper.Params.Set(ctx, stakingtypes.Params{
+		UnbondingTime:     defaults.UnbondingTime,
+		MaxValidators:     defaults.MaxValidators,
+		MaxEntries:        defaults.MaxEntries,
+		HistoricalEntries: defaults.HistoricalEntries,
+		BondDenom:         defaults.BondDenom,
+		MinCommissionRate: defaults.MinCommissionRate,
+	}))
+
+	migrator := stakingkeeper.NewMigrator(keeper)
+
+	// the upgrade handler must set the middleware address
+	require.Error(migrator.Migrate6to7(ctx))
+	require.Error(migrator.SetMiddlewareAddress(ctx, "0x0000000000000000000000000000000000000000"))
+
+	require.NoError(migrator.SetMiddlewareAddress(ctx, testMiddlewareAddress))
+	require.NoError(migrator.Migrate6to7(ctx))
+
+	res, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.NoError(res.Validate())
+
+	expected := stakingtypes.DefaultParams()
+	expected.MiddlewareAddress = testMiddlewareAddress
+	require.Equal(expected, res)
+}
+
+func (s *KeeperTestSuite) TestMigrate7to8() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	migrator := stakingkeeper.NewMigrator(keeper)
+
+	// nothing to migrate before the first sync
+	require.NoError(migrator.Migrate7to8(ctx))
+	_, err := keeper.CachedBlockHash.Get(ctx)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	// the JSON encoding stored before consensus version 8
+	validators := []stakingtypes.SymbioticValidator{
+		symbioticValidator(sdk.ConsAddress(PKs[0].Address()), keeper.TokensFromConsensusPower(ctx, 3)),
+		{Stake: big.NewInt(5), KeyType: stakingtypes.KeyTypeEd25519, ConsPubKey: PKs[1].Bytes(), Operator: []byte{0xaa}},
+	}
+	bz, err := json.Marshal(map[string]any{
+		"BlockHash":      "0x01",
+		"Height":         10,
+		"Attested":       true,
+		"Validators":     validators,
+		"BlockNumber":    5,
+		"BlockTimestamp": 6,
+	})
+	require.NoError(err)
+	require.NoError(keeper.KVStoreService.OpenKVStore(ctx).Set(stakingtypes.CachedBlockHashKey, bz))
+
+	require.NoError(migrator.Migrate7to8(ctx))
+	cached, err := keeper.CachedBlockHash.Get(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.CachedBlockHash{
+		BlockHash:      "0x01",
+		Height:         10,
+		Attested:       true,
+		Validators:     stakingtypes.NewSymbioticValidatorStakes(validators),
+		BlockNumber:    5,
+		BlockTimestamp: 6,
+	}, cached)
+
+	res, ok := cached.SymbioticValidators()
+	require.True(ok)
+	require.Equal(validators, res)
+}
@@ -1,17 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	consensusv1 "cosmossdk.io/x/consensus/types"
 	"cosmossdk.io/x/symStaking/types"
 
-	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -59,25 +59,8 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", msg.Pubkey.GetCachedValue())
 	}
 
-	res := consensusv1.QueryParamsResponse{}
-	if err := k.QueryRouterService.InvokeTyped(ctx, &consensusv1.QueryParamsRequest{}, &res); err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to query consensus params: %s", err)
-	}
-	if res.Params.Validator != nil {
-		pkType := pk.Type()
-		if !slices.Contains(res.Params.Validator.PubKeyTypes, pkType) {
-			return nil, errorsmod.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), res.Params.Validator.PubKeyTypes,
-			)
-		}
-
-		if pkType == sdk.PubKeyEd25519Type && len(pk.Bytes()) != ed25519.PubKeySize {
-			return nil, errorsmod.Wrapf(
-				types.ErrConsensusPubKeyLenInvalid,
-				"got: %d, expected: %d", len(pk.Bytes()), ed25519.PubKeySize,
-			)
-		}
+	if err := k.validateConsPubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	err = k.checkConsKeyAlreadyUsed(ctx, pk)
@@ -89,6 +72,19 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, err
 	}
 
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.OperatorBinding != nil {
+		if err := k.bindOperator(ctx, valAddr, pk, *msg.OperatorBinding); err != nil {
+			return nil, err
+		}
+	} else if err := k.checkOperatorBindingRequired(ctx, params, sdk.ConsAddress(pk.Address())); err != nil {
+		return nil, err
+	}
+
 	validator, err := types.NewValidator(msg.ValidatorAddress, pk, msg.Description)
 	if err != nil {
 		return nil, err
@@ -134,6 +130,174 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 	return &types.MsgCreateValidatorResponse{}, nil
 }
 
+// UpdateOperatorBinding defines a method for binding an existing validator to
+// a Symbiotic operator
+func (k msgServer) UpdateOperatorBinding(ctx context.Context, msg *types.MsgUpdateOperatorBinding) (*types.MsgUpdateOperatorBindingResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := validator.ConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bindOperator(ctx, valAddr, pk, msg.OperatorBinding); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateOperatorBindingResponse{}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus key of an
+// existing validator. The new key takes effect once the middleware reports it.
+func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return nil, types.ErrEmptyValidatorPubKey
+	}
+
+	// the key is not cached when the message is routed by MsgOperatorExec
+	var pk cryptotypes.PubKey
+	if err := k.cdc.InterfaceRegistry().UnpackAny(msg.NewPubkey, &pk); err != nil || pk == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %s", msg.NewPubkey.TypeUrl)
+	}
+
+	if err := k.validateConsPubKeyType(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkConsKeyAlreadyUsed(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// a new request replaces the rotation still waiting for the middleware
+	rotation := types.ConsPubKeyRotation{
+		ValidatorAddress: msg.ValidatorAddress,
+		OldConsPubkey:    validator.ConsensusPubkey,
+		NewConsPubkey:    msg.NewPubkey,
+		Height:           k.HeaderService.HeaderInfo(ctx).Height,
+	}
+	if err := k.PendingConsPubKeyRotations.Set(ctx, valAddr, rotation); err != nil {
+		return nil, err
+	}
+
+	oldConsAddrStr, err := k.consensusAddressCodec.BytesToString(oldConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	newConsAddrStr, err := k.consensusAddressCodec.BytesToString(pk.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRotateConsPubKey,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddrStr),
+		event.NewAttribute(types.AttributeKeyNewConsAddress, newConsAddrStr),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// OperatorExec defines a method for executing messages on behalf of a
+// validator registered by a Symbiotic sync. Its operator address is the
+// Ethereum address of its operator, which nobody holds an account key for, so
+// the messages are authorized by a signature of the operator key with the
+// nonce of the validator binding, which is then incremented.
+func (k msgServer) OperatorExec(ctx context.Context, msg *types.MsgOperatorExec) (*types.MsgOperatorExecResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Submitter); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address: %s", err)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidOperatorExec, "no messages")
+	}
+
+	binding, found, err := k.GetOperatorBinding(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	operator := common.HexToAddress(binding.Operator)
+	if !found || !bytes.Equal(valAddr, operator.Bytes()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidOperatorExec, "validator %s is not registered under its operator address", msg.ValidatorAddress)
+	}
+
+	if err := msg.Verify(k.HeaderService.HeaderInfo(ctx).ChainID, operator, binding.Nonce); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidOperatorExec, err.Error())
+	}
+
+	for i, m := range msgs {
+		signers, _, err := k.cdc.GetMsgSigners(m)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range signers {
+			if !bytes.Equal(signer, valAddr) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %d is not signed by validator %s", i, msg.ValidatorAddress)
+			}
+		}
+	}
+
+	binding.Nonce++
+	if err := k.OperatorBindings.Set(ctx, valAddr, binding); err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, m := range msgs {
+		res, err := k.MsgRouterService.InvokeUntyped(ctx, m)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d; message %v", i, m)
+		}
+		if results[i], err = k.cdc.Marshal(res); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeOperatorExec,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyOperator, operator.Hex()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOperatorExecResponse{Results: results}, nil
+}
+
 // EditValidator defines a method for editing an existing validator
 func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidator) (*types.MsgEditValidatorResponse, error) {
 	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
@@ -219,6 +383,11 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// the middleware address may only be left unset in genesis
+	if msg.Params.MiddlewareAddress == "" && previousParams.MiddlewareAddress != "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "symbiotic middleware address cannot be unset")
+	}
+
 	// store params
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
@@ -264,5 +433,23 @@ func (k msgServer) checkConsKeyAlreadyUsed(ctx context.Context, newConsPubKey cr
 		return types.ErrValidatorPubKeyExists
 	}
 
+	// checks if NewPubKey was rotated away from
+	rotated, err := k.OldToNewConsAddrMap.Has(ctx, newConsAddr)
+	if err != nil {
+		return err
+	}
+	if rotated {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	// checks if NewPubKey is not waiting for the middleware in a rotation
+	pending, err := k.pendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := pending[string(newConsAddr)]; ok {
+		return types.ErrValidatorPubKeyExists
+	}
+
 	return nil
 }
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/testutil"
 	"cosmossdk.io/x/symStaking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -15,6 +20,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -294,3 +300,412 @@ func (s *KeeperTestSuite) TestMsgEditValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	params := types.DefaultParams()
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	withParams := func(update func(p *types.Params)) types.Params {
+		p := params
+		update(&p)
+		return p
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid middleware address",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.MiddlewareAddress = "0x01" }),
+			},
+			expErr:    true,
+			expErrMsg: "invalid symbiotic middleware address",
+		},
+		{
+			name: "unset middleware address",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.MiddlewareAddress = "" }),
+			},
+			expErr:    true,
+			expErrMsg: "cannot be unset",
+		},
+		{
+			name: "zero sync period",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.SymbioticSyncPeriod = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "symbiotic sync period must be positive",
+		},
+		{
+			name: "zero slots in epoch",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.SlotsInEpoch = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "slots in epoch must be positive",
+		},
+		{
+			name: "negative beacon genesis timestamp",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.BeaconGenesisTimestamp = -1 }),
+			},
+			expErr:    true,
+			expErrMsg: "beacon genesis timestamp must be positive",
+		},
+		{
+			name: "zero slot duration",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.SlotDuration = 0 }),
+			},
+			expErr:    true,
+			expErrMsg: "slot duration must be positive",
+		},
+		{
+			name: "unknown ethereum network",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params:    withParams(func(p *types.Params) { p.EthereumNetwork = "goerli" }),
+			},
+			expErr:    true,
+			expErrMsg: "unknown ethereum network",
+		},
+		{
+			name: "beacon chain params not matching the ethereum network",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: withParams(func(p *types.Params) {
+					p.EthereumNetwork = types.ChainMainnet
+					p.SlotDuration = 6
+				}),
+			},
+			expErr:    true,
+			expErrMsg: "beacon chain params do not match the mainnet network",
+		},
+		{
+			name: "valid msg",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: withParams(func(p *types.Params) {
+					p.MiddlewareAddress = "0x0000000000000000000000000000000000000002"
+					p.SymbioticSyncPeriod = 20
+					p.EthereumNetwork = types.ChainDevnet
+					p.SlotsInEpoch = 8
+					p.BeaconGenesisTimestamp = 1655733600
+					p.SlotDuration = 6
+				}),
+			},
+			expErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+
+				res, err := keeper.Params.Get(ctx)
+				require.NoError(err)
+				require.Equal(tc.input.Params, res)
+			}
+		})
+	}
+}
+
+// signOperatorBinding returns a proof of key binding validator and its
+// consensus public key pk with nonce on the chain with the given id.
+func signOperatorBinding(t *testing.T, key *ecdsa.PrivateKey, sigType types.OperatorSignatureType, chainID, validator string, pk cryptotypes.PubKey, nonce uint64) *types.OperatorBindingProof {
+	t.Helper()
+
+	operator := ethcrypto.PubkeyToAddress(key.PublicKey)
+	digest, err := types.OperatorBindingSignBytes(sigType, chainID, validator, pk.Bytes(), operator, nonce)
+	require.NoError(t, err)
+	signature, err := ethcrypto.Sign(digest, key)
+	require.NoError(t, err)
+
+	return &types.OperatorBindingProof{Operator: operator.Hex(), SignatureType: sigType, Signature: signature}
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateOperatorBinding() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	const chainID = "symbiotic-test"
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: ctx.HeaderInfo().Time})
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	params.RequireOperatorBinding = true
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	operatorKey, err := ethcrypto.GenerateKey()
+	require.NoError(err)
+	operator := ethcrypto.PubkeyToAddress(operatorKey.PublicKey)
+	rotatedKey, err := ethcrypto.GenerateKey()
+	require.NoError(err)
+	rotated := ethcrypto.PubkeyToAddress(rotatedKey.PublicKey)
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address())
+	valAddrStr := s.valAddressToString(valAddr)
+	pubkey, err := codectypes.NewAnyWithValue(valPubKey)
+	require.NoError(err)
+	msg := &types.MsgCreateValidator{
+		Description: types.Description{Moniker: "NewValidator"},
+		Commission: types.CommissionRates{
+			Rate:          math.LegacyNewDecWithPrec(5, 1),
+			MaxRate:       math.LegacyNewDecWithPrec(5, 1),
+			MaxChangeRate: math.LegacyNewDec(0),
+		},
+		ValidatorAddress: valAddrStr,
+		Pubkey:           pubkey,
+	}
+
+	// the binding is required by the params
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrOperatorBindingRequired)
+
+	// a binding signed for another chain is rejected
+	msg.OperatorBinding = signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, "other-chain", valAddrStr, valPubKey, 0)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorBinding)
+
+	// a binding signed for another consensus key is rejected
+	msg.OperatorBinding = signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, valAddrStr, PKs[1], 0)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorBinding)
+
+	msg.OperatorBinding = signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, valAddrStr, valPubKey, 0)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	res, err := s.queryClient.OperatorBinding(ctx, &types.QueryOperatorBindingRequest{ValidatorAddr: valAddrStr})
+	require.NoError(err)
+	require.Equal(types.OperatorBinding{ValidatorAddress: valAddrStr, Operator: operator.Hex(), Nonce: 1}, res.Binding)
+
+	// the middleware reports the stake of the validator key under another
+	// operator: the validator is not given the stake
+	stake := keeper.TokensFromConsensusPower(ctx, 42)
+	cache := types.CachedBlockHash{
+		BlockHash: "0x01",
+		Height:    types.DefaultSymbioticSyncPeriod,
+		Attested:  true,
+		Validators: types.NewSymbioticValidatorStakes([]types.SymbioticValidator{
+			{Stake: stake.BigInt(), KeyType: types.KeyTypeEd25519, ConsPubKey: valPubKey.Bytes(), Operator: rotated.Bytes()},
+		}),
+	}
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: chainID, Height: types.DefaultSymbioticSyncPeriod, Time: ctx.HeaderInfo().Time}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err := keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+	unmatched := unmatchedValidatorEvents(ctx)
+	require.Len(unmatched, 1)
+	require.Equal(types.UnmatchedReasonOperatorMismatch, unmatched[0][types.AttributeKeyReason])
+
+	// a binding to an operator the middleware does not report is rejected
+	_, err = msgServer.UpdateOperatorBinding(ctx, types.NewMsgUpdateOperatorBinding(valAddrStr, *signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, valAddrStr, valPubKey, 1)))
+	require.ErrorIs(err, types.ErrOperatorMismatch)
+
+	// a replayed binding is rejected
+	_, err = msgServer.UpdateOperatorBinding(ctx, types.NewMsgUpdateOperatorBinding(valAddrStr, *signOperatorBinding(s.T(), rotatedKey, types.OperatorSignatureTypeEIP191, chainID, valAddrStr, valPubKey, 0)))
+	require.ErrorIs(err, types.ErrInvalidOperatorBinding)
+
+	// a proof not signed by its operator is rejected
+	proof := signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, valAddrStr, valPubKey, 1)
+	proof.Operator = rotated.Hex()
+	_, err = msgServer.UpdateOperatorBinding(ctx, types.NewMsgUpdateOperatorBinding(valAddrStr, *proof))
+	require.ErrorIs(err, types.ErrInvalidOperatorBinding)
+
+	// the rotated operator gives its stake to the validator
+	_, err = msgServer.UpdateOperatorBinding(ctx, types.NewMsgUpdateOperatorBinding(valAddrStr, *signOperatorBinding(s.T(), rotatedKey, types.OperatorSignatureTypeEIP191, chainID, valAddrStr, valPubKey, 1)))
+	require.NoError(err)
+	binding, found, err := keeper.GetOperatorBinding(ctx, valAddr)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(rotated.Hex(), binding.Operator)
+	require.Equal(uint64(2), binding.Nonce)
+
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(stake, validator.Tokens)
+
+	// unknown validators cannot be bound
+	unknownAddr := s.valAddressToString(sdk.ValAddress(PKs[1].Address()))
+	_, err = msgServer.UpdateOperatorBinding(ctx, types.NewMsgUpdateOperatorBinding(unknownAddr, *signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, unknownAddr, PKs[1], 0)))
+	require.ErrorIs(err, types.ErrNoValidatorFound)
+
+	// without the param, the binding is still required for a key the
+	// middleware reports under an operator
+	params.RequireOperatorBinding = false
+	require.NoError(keeper.Params.Set(ctx, params))
+	cache.Height = 2 * types.DefaultSymbioticSyncPeriod
+	cache.Validators = append(cache.Validators, types.NewSymbioticValidatorStakes([]types.SymbioticValidator{
+		{Stake: stake.BigInt(), KeyType: types.KeyTypeEd25519, ConsPubKey: PKs[1].Bytes(), Operator: operator.Bytes()},
+	})...)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: chainID, Height: cache.Height, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	pubkey, err = codectypes.NewAnyWithValue(PKs[1])
+	require.NoError(err)
+	msg.ValidatorAddress, msg.Pubkey, msg.OperatorBinding = unknownAddr, pubkey, nil
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrOperatorBindingRequired)
+
+	msg.OperatorBinding = signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, unknownAddr, PKs[1], 0)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	// a validator registering a key before the middleware reports it under an
+	// operator is not given the stake of the operator
+	squatterAddr := s.valAddressToString(sdk.ValAddress(PKs[2].Address()))
+	pubkey, err = codectypes.NewAnyWithValue(PKs[2])
+	require.NoError(err)
+	msg.ValidatorAddress, msg.Pubkey, msg.OperatorBinding = squatterAddr, pubkey, nil
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	cache.Height = 3 * types.DefaultSymbioticSyncPeriod
+	cache.Validators = append(cache.Validators, types.NewSymbioticValidatorStakes([]types.SymbioticValidator{
+		{Stake: stake.BigInt(), KeyType: types.KeyTypeEd25519, ConsPubKey: PKs[2].Bytes(), Operator: operator.Bytes()},
+	})...)
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: chainID, Height: cache.Height, Time: ctx.HeaderInfo().Time}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, sdk.ValAddress(PKs[2].Address()))
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+	unmatched = unmatchedValidatorEvents(ctx)
+	require.Len(unmatched, 1)
+	require.Equal(types.UnmatchedReasonOperatorMismatch, unmatched[0][types.AttributeKeyReason])
+}
+
+func signOperatorExec(t *testing.T, key *ecdsa.PrivateKey, sigType types.OperatorSignatureType, chainID string, msg *types.MsgOperatorExec, nonce uint64) {
+	t.Helper()
+
+	digest, err := types.OperatorExecSignBytes(sigType, chainID, msg.ValidatorAddress, types.OperatorExecMsgsHash(msg.Msgs), nonce)
+	require.NoError(t, err)
+	msg.SignatureType = sigType
+	msg.Signature, err = ethcrypto.Sign(digest, key)
+	require.NoError(t, err)
+}
+
+func (s *KeeperTestSuite) TestMsgOperatorExec() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	types.RegisterMsgServer(s.baseApp.MsgServiceRouter(), msgServer)
+
+	const chainID = "symbiotic-test"
+	ctx = ctx.WithHeaderInfo(header.Info{ChainID: chainID, Time: ctx.HeaderInfo().Time})
+
+	operatorKey, err := ethcrypto.GenerateKey()
+	require.NoError(err)
+	operator := ethcrypto.PubkeyToAddress(operatorKey.PublicKey)
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(err)
+
+	// a validator registered by a sync under its operator address
+	valAddr := sdk.ValAddress(operator.Bytes())
+	valAddrStr := s.valAddressToString(valAddr)
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(keeper.OperatorBindings.Set(ctx, valAddr, types.OperatorBinding{ValidatorAddress: valAddrStr, Operator: operator.Hex()}))
+
+	submitter := s.addressToString(Addr)
+	newExec := func(valAddrStr string, msgs ...sdk.Msg) *types.MsgOperatorExec {
+		msg, err := types.NewMsgOperatorExec(submitter, valAddrStr, msgs)
+		require.NoError(err)
+		return msg
+	}
+	rotate, err := types.NewMsgRotateConsPubKey(valAddrStr, PKs[2])
+	require.NoError(err)
+
+	// a validator bound to an operator with another operator address cannot
+	// be executed for
+	boundAddr := sdk.ValAddress(PKs[1].Address())
+	boundAddrStr := s.valAddressToString(boundAddr)
+	require.NoError(keeper.OperatorBindings.Set(ctx, boundAddr, types.OperatorBinding{ValidatorAddress: boundAddrStr, Operator: operator.Hex()}))
+	msg := newExec(boundAddrStr, types.NewMsgUpdateOperatorBinding(boundAddrStr, *signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, boundAddrStr, PKs[1], 1)))
+	signOperatorExec(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, msg, 0)
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorExec)
+
+	// messages not signed by the operator are rejected
+	msg = newExec(valAddrStr, rotate)
+	signOperatorExec(s.T(), otherKey, types.OperatorSignatureTypeEIP712, chainID, msg, 0)
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorExec)
+
+	// messages signed for another chain are rejected
+	signOperatorExec(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, "other-chain", msg, 0)
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorExec)
+
+	// messages of another signer than the validator are rejected
+	msg = newExec(valAddrStr, rotate, types.NewMsgUpdateOperatorBinding(boundAddrStr, *signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, boundAddrStr, PKs[1], 1)))
+	signOperatorExec(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, msg, 0)
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg = newExec(valAddrStr, rotate)
+	signOperatorExec(s.T(), operatorKey, types.OperatorSignatureTypeEIP712, chainID, msg, 0)
+	res, err := msgServer.OperatorExec(ctx, msg)
+	require.NoError(err)
+	require.Len(res.Results, 1)
+
+	rotations, err := s.queryClient.ConsPubKeyRotations(ctx, &types.QueryConsPubKeyRotationsRequest{ValidatorAddr: valAddrStr})
+	require.NoError(err)
+	require.NotNil(rotations.Pending)
+	binding, _, err := keeper.GetOperatorBinding(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(uint64(1), binding.Nonce)
+
+	// a replayed signature is rejected
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.ErrorIs(err, types.ErrInvalidOperatorExec)
+
+	// the binding signed by the operator follows the nonce incremented by the
+	// execution
+	msg = newExec(valAddrStr, types.NewMsgUpdateOperatorBinding(valAddrStr, *signOperatorBinding(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, valAddrStr, PKs[0], 2)))
+	signOperatorExec(s.T(), operatorKey, types.OperatorSignatureTypeEIP191, chainID, msg, 1)
+	_, err = msgServer.OperatorExec(ctx, msg)
+	require.NoError(err)
+
+	binding, _, err = keeper.GetOperatorBinding(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(types.OperatorBinding{ValidatorAddress: valAddrStr, Operator: operator.Hex(), Nonce: 3}, binding)
+}
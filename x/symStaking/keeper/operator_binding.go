@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/symStaking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetOperatorBinding returns the Symbiotic operator binding of a validator.
+func (k Keeper) GetOperatorBinding(ctx context.Context, valAddr sdk.ValAddress) (types.OperatorBinding, bool, error) {
+	binding, err := k.OperatorBindings.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.OperatorBinding{}, false, nil
+	}
+	if err != nil {
+		return types.OperatorBinding{}, false, err
+	}
+
+	return binding, true, nil
+}
+
+// bindOperator binds a validator with the consensus public key pk to the
+// operator of proof. The proof must be signed for pk with the nonce of the
+// current binding of the validator, and the last applied sync must not report
+// another operator for its consensus address.
+func (k Keeper) bindOperator(ctx context.Context, valAddr sdk.ValAddress, pk cryptotypes.PubKey, proof types.OperatorBindingProof) error {
+	valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	binding, _, err := k.GetOperatorBinding(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := proof.Verify(k.HeaderService.HeaderInfo(ctx).ChainID, valAddrStr, pk.Bytes(), binding.Nonce); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidOperatorBinding, err.Error())
+	}
+
+	operator := common.HexToAddress(proof.Operator)
+	if err := k.checkMiddlewareOperator(ctx, sdk.ConsAddress(pk.Address()), operator); err != nil {
+		return err
+	}
+
+	if err := k.OperatorBindings.Set(ctx, valAddr, types.OperatorBinding{
+		ValidatorAddress: valAddrStr,
+		Operator:         operator.Hex(),
+		Nonce:            binding.Nonce + 1,
+	}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUpdateOperatorBinding,
+		event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+		event.NewAttribute(types.AttributeKeyOperator, operator.Hex()),
+	)
+}
+
+// middlewareOperator returns the operator the last applied sync reports for a
+// consensus address, with the height of that sync. The operator is empty if
+// the middleware does not report operators, and found is false if the sync
+// does not report the consensus address.
+func (k Keeper) middlewareOperator(ctx context.Context, consAddr sdk.ConsAddress) (operator []byte, height int64, found bool, err error) {
+	record, err := k.GetLastAppliedSymbioticSync(ctx, k.HeaderService.HeaderInfo(ctx).Height)
+	if errors.Is(err, types.ErrSymbioticNotFound) {
+		return nil, 0, false, nil
+	}
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	for _, stake := range record.Stakes {
+		if stake.ConsAddress == consAddrStr {
+			return stake.Operator, record.Height, true, nil
+		}
+	}
+
+	return nil, record.Height, false, nil
+}
+
+// checkMiddlewareOperator returns ErrOperatorMismatch if the last applied sync
+// reports another operator than the given one for a consensus address. A
+// consensus address not reported yet accepts any operator: the binding is then
+// checked by every sync, a middleware entry of another operator giving no
+// stake to the validator.
+func (k Keeper) checkMiddlewareOperator(ctx context.Context, consAddr sdk.ConsAddress, operator common.Address) error {
+	reported, height, found, err := k.middlewareOperator(ctx, consAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	if len(reported) != common.AddressLength || common.BytesToAddress(reported) != operator {
+		return errorsmod.Wrapf(types.ErrOperatorMismatch, "middleware reports operator 0x%x at height %d", reported, height)
+	}
+
+	return nil
+}
+
+// checkOperatorBindingRequired returns ErrOperatorBindingRequired if a
+// validator created without binding must be bound: when the require_operator_binding
+// param is set, or when the last applied sync reports an operator for its
+// consensus address, so that the key of an operator cannot be claimed by
+// another validator.
+func (k Keeper) checkOperatorBindingRequired(ctx context.Context, params types.Params, consAddr sdk.ConsAddress) error {
+	if params.RequireOperatorBinding {
+		return types.ErrOperatorBindingRequired
+	}
+
+	reported, height, _, err := k.middlewareOperator(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if len(reported) != 0 {
+		return errorsmod.Wrapf(types.ErrOperatorBindingRequired, "middleware reports operator 0x%x at height %d", reported, height)
+	}
+
+	return nil
+}
+
+// checkSymbioticOperator returns ErrOperatorMismatch if a middleware validator
+// set entry does not report the operator its validator is bound to. An unbound
+// validator gets no stake from an entry reporting an operator, which it could
+// have claimed by registering the consensus key first, and matches an entry
+// without operator unless the require_operator_binding param is set.
+func (k Keeper) checkSymbioticOperator(ctx context.Context, params types.Params, val types.Validator, v types.SymbioticValidator) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	binding, found, err := k.GetOperatorBinding(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if params.RequireOperatorBinding || len(v.Operator) != 0 {
+			return errorsmod.Wrapf(types.ErrOperatorMismatch, "validator %s is not bound to an operator", val.GetOperator())
+		}
+		return nil
+	}
+
+	if len(v.Operator) != common.AddressLength || common.BytesToAddress(v.Operator) != common.HexToAddress(binding.Operator) {
+		return errorsmod.Wrapf(types.ErrOperatorMismatch, "validator %s is bound to %s", val.GetOperator(), binding.Operator)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -54,3 +55,23 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// MiddlewareAddress - Address of the Symbiotic middleware contract
+func (k Keeper) MiddlewareAddress(ctx context.Context) (string, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MiddlewareAddress, err
+}
+
+// MiddlewareKeyType - Type of the keys the Symbiotic middleware identifies
+// validators by
+func (k Keeper) MiddlewareKeyType(ctx context.Context) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if params.MiddlewareAbi.IsEmpty() {
+		return types.DefaultMiddlewareABI().KeyType, nil
+	}
+	return params.MiddlewareAbi.KeyType, nil
+}
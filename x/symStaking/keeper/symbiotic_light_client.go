@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"cosmossdk.io/x/symStaking/lightclient"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// Beacon light client API paths.
+const (
+	LIGHT_CLIENT_BOOTSTRAP_PATH       = "/eth/v1/beacon/light_client/bootstrap/"
+	LIGHT_CLIENT_UPDATES_PATH         = "/eth/v1/beacon/light_client/updates"
+	LIGHT_CLIENT_FINALITY_UPDATE_PATH = "/eth/v1/beacon/light_client/finality_update"
+)
+
+var (
+	_ lightclient.BeaconAPI    = beaconLightClientAPI{}
+	_ lightclient.ExecutionAPI = executionProofAPI{}
+)
+
+// lightClientNetworks maps the supported chains to their light client network.
+var lightClientNetworks = map[string]lightclient.Network{
+	types.ChainMainnet: lightclient.Mainnet,
+	types.ChainSepolia: lightclient.Sepolia,
+	types.ChainHolesky: lightclient.Holesky,
+}
+
+// newLightClientVerifier returns the verifier of a light client enabled
+// config, which Validate already checked.
+func newLightClientVerifier(ds *RPCDataSource) *lightclient.Verifier {
+	checkpoint, _ := ds.config.Checkpoint()
+	return lightclient.NewVerifier(lightClientNetworks[ds.config.Chain], checkpoint, beaconLightClientAPI{ds})
+}
+
+// verifiedValidatorSet computes the validator set at blockHash from proven
+// middleware storage. The block must be finalized according to the light
+// client.
+func (ds *RPCDataSource) verifiedValidatorSet(ctx context.Context, client *ethclient.Client, middlewareAddress, blockHash string, adapter types.MiddlewareAdapter) ([]types.SymbioticValidator, error) {
+	api := executionProofAPI{client: client.Client(), timeout: ds.config.RequestTimeout}
+	hash := common.HexToHash(blockHash)
+
+	header, err := ds.verifier.FinalizedHeader(ctx, api, hash)
+	if err != nil {
+		if errors.Is(err, lightclient.ErrInvalidUpdate) {
+			ds.logger.Error("light client error: invalid beacon data", "url", ds.apiUrls.GetBeaconApiUrl(), "err", err)
+			ds.apiUrls.RotateBeaconUrl()
+		} else {
+			ds.logger.Error("light client error: block verification failed", "url", ds.apiUrls.GetEthApiUrl(), "err", err)
+		}
+		return nil, err
+	}
+
+	to := common.HexToAddress(middlewareAddress)
+	return adapter.ValidatorSet(func(data []byte) ([]byte, error) {
+		result, err := ds.verifier.Call(ctx, api, header, hash, to, data)
+		if err != nil {
+			ds.logger.Error("light client error: verified call failed", "url", ds.apiUrls.GetEthApiUrl(), "err", err)
+		}
+		return result, err
+	})
+}
+
+// beaconLightClientAPI serves the light client from the beacon endpoints,
+// rotating through them on failure.
+type beaconLightClientAPI struct {
+	ds *RPCDataSource
+}
+
+// beaconResponse is the versioned envelope of the light client API objects.
+type beaconResponse[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+// Bootstrap implements lightclient.BeaconAPI.
+func (api beaconLightClientAPI) Bootstrap(ctx context.Context, blockRoot common.Hash) (lightclient.LightClientBootstrap, error) {
+	var res beaconResponse[lightclient.LightClientBootstrap]
+	err := api.get(ctx, LIGHT_CLIENT_BOOTSTRAP_PATH+blockRoot.Hex(), &res)
+	return res.Data, err
+}
+
+// Updates implements lightclient.BeaconAPI.
+func (api beaconLightClientAPI) Updates(ctx context.Context, startPeriod, count uint64) ([]lightclient.LightClientUpdate, error) {
+	var res []beaconResponse[lightclient.LightClientUpdate]
+	path := LIGHT_CLIENT_UPDATES_PATH + "?start_period=" + strconv.FormatUint(startPeriod, 10) + "&count=" + strconv.FormatUint(count, 10)
+	if err := api.get(ctx, path, &res); err != nil {
+		return nil, err
+	}
+
+	updates := make([]lightclient.LightClientUpdate, len(res))
+	for i, r := range res {
+		updates[i] = r.Data
+	}
+	return updates, nil
+}
+
+// FinalityUpdate implements lightclient.BeaconAPI.
+func (api beaconLightClientAPI) FinalityUpdate(ctx context.Context) (lightclient.LightClientUpdate, error) {
+	var res beaconResponse[lightclient.LightClientUpdate]
+	err := api.get(ctx, LIGHT_CLIENT_FINALITY_UPDATE_PATH, &res)
+	return res.Data, err
+}
+
+// get decodes the JSON response of a beacon API path, retrying on the next
+// beacon endpoint on failure.
+func (api beaconLightClientAPI) get(ctx context.Context, path string, out any) error {
+	ds := api.ds
+
+	var err error
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		url := ds.apiUrls.GetBeaconApiUrl() + path
+		if err = ds.getJSON(ctx, url, out); err == nil {
+			return nil
+		}
+		ds.logger.Error("rpc error: beacon light client call error", "url", url, "err", err)
+
+		ds.apiUrls.RotateBeaconUrl()
+		if !ds.backoff(attempt, start) {
+			return err
+		}
+	}
+}
+
+func (ds *RPCDataSource) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := ds.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making HTTP request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %v", err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+
+	return nil
+}
+
+// executionProofAPI serves the light client verified calls from an execution
+// endpoint.
+type executionProofAPI struct {
+	client  *rpc.Client
+	timeout time.Duration
+}
+
+// HeaderByHash implements lightclient.ExecutionAPI.
+func (api executionProofAPI) HeaderByHash(ctx context.Context, hash common.Hash) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	var raw json.RawMessage
+	err := api.client.CallContext(ctx, &raw, "eth_getBlockByHash", hash, false)
+	return raw, err
+}
+
+// GetProof implements lightclient.ExecutionAPI.
+func (api executionProofAPI) GetProof(ctx context.Context, account common.Address, keys []common.Hash, blockHash common.Hash) (*lightclient.AccountResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	var res lightclient.AccountResult
+	err := api.client.CallContext(ctx, &res, "eth_getProof", account, keys, rpc.BlockNumberOrHashWithHash(blockHash, true))
+	return &res, err
+}
+
+// CodeAt implements lightclient.ExecutionAPI.
+func (api executionProofAPI) CodeAt(ctx context.Context, account common.Address, blockHash common.Hash) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	var code hexutil.Bytes
+	err := api.client.CallContext(ctx, &code, "eth_getCode", account, rpc.BlockNumberOrHashWithHash(blockHash, true))
+	return code, err
+}
+
+// CreateAccessList implements lightclient.ExecutionAPI.
+func (api executionProofAPI) CreateAccessList(ctx context.Context, to common.Address, data []byte, blockHash common.Hash) (ethtypes.AccessList, error) {
+	ctx, cancel := context.WithTimeout(ctx, api.timeout)
+	defer cancel()
+
+	msg := map[string]any{"to": to, "input": hexutil.Bytes(data)}
+	var res struct {
+		AccessList ethtypes.AccessList `json:"accessList"`
+	}
+	err := api.client.CallContext(ctx, &res, "eth_createAccessList", msg, rpc.BlockNumberOrHashWithHash(blockHash, true))
+	return res.AccessList, err
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setSymbioticPowerTarget records the tokens a sync at height sets on a
+// validator. They are applied by applySymbioticPendingPowerChanges.
+func (k *Keeper) setSymbioticPowerTarget(ctx context.Context, val types.Validator, tokens math.Int, height int64) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if val.Tokens.Equal(tokens) {
+		return k.PendingPowerChanges.Remove(ctx, valAddr)
+	}
+
+	return k.PendingPowerChanges.Set(ctx, valAddr, types.SymbioticPendingPowerChange{
+		ValidatorAddress: val.OperatorAddress,
+		TargetTokens:     tokens,
+		Height:           height,
+	})
+}
+
+// applySymbioticPendingPowerChanges moves the tokens of the validators with a
+// pending power change towards their target. The changes summed over every
+// validator are capped to the max_power_change_rate param of the last total
+// power: above it each validator moves by its share of the cap and keeps its
+// change pending for the next syncs.
+func (k *Keeper) applySymbioticPendingPowerChanges(ctx context.Context, params types.Params) error {
+	var (
+		vals    []types.Validator
+		changes []types.SymbioticPendingPowerChange
+		stale   []sdk.ValAddress
+	)
+	total := math.ZeroInt()
+	err := k.PendingPowerChanges.Walk(ctx, nil, func(valAddr sdk.ValAddress, change types.SymbioticPendingPowerChange) (bool, error) {
+		val, err := k.GetValidator(ctx, valAddr)
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			stale = append(stale, valAddr)
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+
+		vals = append(vals, val)
+		changes = append(changes, change)
+		total = total.Add(change.TargetTokens.Sub(val.Tokens).Abs())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range stale {
+		if err := k.PendingPowerChanges.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	budget, err := k.symbioticPowerChangeBudget(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	limited := !budget.IsZero() && total.GT(budget)
+	applied := math.ZeroInt()
+	for i, val := range vals {
+		tokens := changes[i].TargetTokens
+		if limited {
+			// the step is rounded up so that a small change still moves,
+			// the budget being overrun by less than a token per validator
+			delta := tokens.Sub(val.Tokens)
+			step := delta.Abs().Mul(budget).Add(total).SubRaw(1).Quo(total)
+			if delta.IsNegative() {
+				step = step.Neg()
+			}
+			tokens = val.Tokens.Add(step)
+		}
+
+		applied = applied.Add(tokens.Sub(val.Tokens).Abs())
+		if err := k.setSymbioticValidatorTokens(ctx, val, tokens); err != nil {
+			return err
+		}
+
+		if tokens.Equal(changes[i].TargetTokens) {
+			valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+			if err != nil {
+				return err
+			}
+			if err := k.PendingPowerChanges.Remove(ctx, valAddr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !limited {
+		return nil
+	}
+
+	pending := total.Sub(applied)
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	k.Logger.Info("symbiotic power change limited", "height", height, "applied_tokens", applied, "pending_tokens", pending)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSymbioticPowerChangeLimited,
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		event.NewAttribute(types.AttributeKeyAppliedTokens, applied.String()),
+		event.NewAttribute(types.AttributeKeyPendingTokens, pending.String()),
+	)
+}
+
+// setSymbioticValidatorTokens sets the tokens a sync moves a validator to,
+// emits their event and calls the AfterSymbioticStakeChanged hook.
+func (k *Keeper) setSymbioticValidatorTokens(ctx context.Context, val types.Validator, tokens math.Int) error {
+	if _, err := k.SetValidatorTokens(ctx, val, tokens); err != nil {
+		return err
+	}
+
+	if tokens.Equal(val.Tokens) {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSymbioticStakeUpdated,
+		event.NewAttribute(types.AttributeKeyValidator, val.OperatorAddress),
+		event.NewAttribute(types.AttributeKeyPreviousStake, val.Tokens.String()),
+		event.NewAttribute(types.AttributeKeyStake, tokens.String()),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(k.HeaderService.HeaderInfo(ctx).Height, 10)),
+	); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterSymbioticStakeChanged(ctx, valAddr, val.Tokens, tokens)
+}
+
+// symbioticPowerChangeBudget returns the tokens a sync may change, zero if
+// the change is not limited. Before the first validator set update the total
+// power is zero and the validator set is applied as is. The budget is at least
+// one consensus power, so that a rate truncating to zero tokens does not stop
+// the power changes.
+func (k *Keeper) symbioticPowerChangeBudget(ctx context.Context, params types.Params) (math.Int, error) {
+	rate := params.MaxPowerChangeRate
+	if rate.IsNil() || !rate.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	totalPower, err := k.LastTotalPower.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if !totalPower.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	powerReduction := k.PowerReduction(ctx)
+	return math.MaxInt(rate.MulInt(totalPower.Mul(powerReduction)).TruncateInt(), powerReduction), nil
+}
@@ -0,0 +1,344 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/symStaking/lightclient"
+	"cosmossdk.io/x/symStaking/types"
+)
+
+// Struct to unmarshal the response from the Beacon Chain API
+type Block struct {
+	Data struct {
+		Message struct {
+			Body struct {
+				ExecutionPayload struct {
+					BlockHash string `json:"block_hash"`
+				} `json:"execution_payload"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"data"`
+}
+
+// FinalityCheckpoints is the response of the beacon API finality_checkpoints
+// endpoint, only the finalized checkpoint is read.
+type FinalityCheckpoints struct {
+	Data struct {
+		Finalized struct {
+			Epoch string `json:"epoch"`
+			Root  string `json:"root"`
+		} `json:"finalized"`
+	} `json:"data"`
+}
+
+type RPCRequest struct {
+	Jsonrpc string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	ID      int           `json:"id"`
+}
+
+type RPCResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+var _ types.SymbioticDataSource = &RPCDataSource{}
+
+// RPCDataSource is the default types.SymbioticDataSource. It reads beacon
+// blocks over the beacon HTTP API and execution data over JSON-RPC, rotating
+// through the configured endpoints on failure.
+type RPCDataSource struct {
+	logger     log.Logger
+	config     types.SymbioticConfig
+	apiUrls    *types.ApiUrls
+	httpClient *http.Client
+
+	// mu guards verifiedEthUrls, the data source is read concurrently by
+	// ExtendVote, PrepareProposal and queries.
+	mu sync.Mutex
+	// verifiedEthUrls caches the execution endpoints whose chain id matched
+	// the configured chain.
+	verifiedEthUrls map[string]bool
+	// verifier verifies the validator set when the light client is enabled.
+	verifier *lightclient.Verifier
+}
+
+// NewRPCDataSource creates a new RPCDataSource instance from a validated
+// Symbiotic config.
+func NewRPCDataSource(logger log.Logger, config types.SymbioticConfig) *RPCDataSource {
+	ds := &RPCDataSource{
+		logger:          logger,
+		config:          config,
+		apiUrls:         types.NewApiUrls(config.BeaconAPIURLs, config.EthAPIURLs),
+		httpClient:      &http.Client{Timeout: config.RequestTimeout},
+		verifiedEthUrls: make(map[string]bool),
+	}
+
+	if config.LightClient {
+		ds.verifier = newLightClientVerifier(ds)
+	}
+
+	return ds
+}
+
+// GetBeaconBlockHash implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetBeaconBlockHash(ctx context.Context, slot int64) (string, error) {
+	var block Block
+	if err := ds.getBeacon(ctx, BLOCK_PATH+strconv.FormatInt(slot, 10), &block); err != nil {
+		return "", err
+	}
+
+	return block.Data.Message.Body.ExecutionPayload.BlockHash, nil
+}
+
+// GetFinalizedEpoch implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetFinalizedEpoch(ctx context.Context) (int64, error) {
+	var checkpoints FinalityCheckpoints
+	if err := ds.getBeacon(ctx, FINALITY_CHECKPOINTS_PATH, &checkpoints); err != nil {
+		return 0, err
+	}
+
+	epoch, err := strconv.ParseInt(checkpoints.Data.Finalized.Epoch, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid finalized checkpoint epoch %q: %w", checkpoints.Data.Finalized.Epoch, err)
+	}
+
+	return epoch, nil
+}
+
+// getBeacon reads the beacon API path into res, rotating through the beacon
+// endpoints and backing off on failure. Resources not found are not retried,
+// the keeper looks at the previous slots.
+func (ds *RPCDataSource) getBeacon(ctx context.Context, path string, res any) error {
+	var err error
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		err = ds.parseBeacon(ctx, path, res)
+		if err == nil || errors.Is(err, types.ErrSymbioticNotFound) {
+			return err
+		}
+
+		ds.apiUrls.RotateBeaconUrl()
+		if !ds.backoff(attempt, start) {
+			return err
+		}
+	}
+}
+
+// GetBlockByHash implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetBlockByHash(ctx context.Context, blockHash string) (*ethtypes.Block, error) {
+	return retryEth(ds, func(client *ethclient.Client) (*ethtypes.Block, error) {
+		ctx, cancel := context.WithTimeout(ctx, ds.config.RequestTimeout)
+		defer cancel()
+
+		return client.BlockByHash(ctx, common.HexToHash(blockHash))
+	})
+}
+
+// GetBlockByNumber implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetBlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	return retryEth(ds, func(client *ethclient.Client) (*ethtypes.Block, error) {
+		ctx, cancel := context.WithTimeout(ctx, ds.config.RequestTimeout)
+		defer cancel()
+
+		return client.BlockByNumber(ctx, number)
+	})
+}
+
+// GetValidatorSet implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetValidatorSet(ctx context.Context, middlewareAddress, blockHash string, adapter types.MiddlewareAdapter) ([]types.SymbioticValidator, error) {
+	if ds.verifier != nil {
+		return retryEth(ds, func(client *ethclient.Client) ([]types.SymbioticValidator, error) {
+			return ds.verifiedValidatorSet(ctx, client, middlewareAddress, blockHash, adapter)
+		})
+	}
+
+	return retryEth(ds, func(client *ethclient.Client) ([]types.SymbioticValidator, error) {
+		ctx, cancel := context.WithTimeout(ctx, ds.config.RequestTimeout)
+		defer cancel()
+
+		to := common.HexToAddress(middlewareAddress)
+		return adapter.ValidatorSet(func(data []byte) ([]byte, error) {
+			result, err := client.CallContractAtHash(ctx, ethereum.CallMsg{To: &to, Data: data}, common.HexToHash(blockHash))
+			if err != nil {
+				ds.logger.Error("rpc error: eth_call error", "url", ds.apiUrls.GetEthApiUrl(), "err", err)
+			}
+			return result, err
+		})
+	})
+}
+
+// GetVoterStake implements types.SymbioticDataSource.
+func (ds *RPCDataSource) GetVoterStake(ctx context.Context, middlewareAddress, blockHash string, vault, operator, voter common.Address) (*big.Int, error) {
+	return retryEth(ds, func(client *ethclient.Client) (*big.Int, error) {
+		ctx, cancel := context.WithTimeout(ctx, ds.config.RequestTimeout)
+		defer cancel()
+
+		to := common.HexToAddress(middlewareAddress)
+		return types.VoterStake(func(data []byte) ([]byte, error) {
+			result, err := client.CallContractAtHash(ctx, ethereum.CallMsg{To: &to, Data: data}, common.HexToHash(blockHash))
+			if err != nil {
+				ds.logger.Error("rpc error: eth_call error", "url", ds.apiUrls.GetEthApiUrl(), "err", err)
+			}
+			return result, err
+		}, vault, operator, voter)
+	})
+}
+
+// retryEth runs call against the current execution endpoint, rotating to the
+// next one and backing off on failure. Calls on a block that is not canonical
+// are not retried, the answer would not change.
+func retryEth[T any](ds *RPCDataSource, call func(client *ethclient.Client) (T, error)) (T, error) {
+	var (
+		res T
+		err error
+	)
+
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		var client *ethclient.Client
+		client, err = ds.dialEth()
+		if err == nil {
+			res, err = call(client)
+			client.Close()
+		}
+
+		if err == nil || strings.HasSuffix(err.Error(), "is not currently canonical") {
+			break
+		}
+
+		ds.apiUrls.RotateEthUrl()
+		if !ds.backoff(attempt, start) {
+			break
+		}
+	}
+
+	return res, err
+}
+
+// backoff waits before the retry following the given failed attempt, doubling
+// the configured backoff each time. It returns false once the retries or the
+// max retry duration, counted from start, are exhausted.
+func (ds *RPCDataSource) backoff(attempt int, start time.Time) bool {
+	if attempt+1 >= ds.config.Retries {
+		return false
+	}
+
+	delay := ds.config.RetryBackoff << attempt
+	if delay < 0 || time.Since(start)+delay > ds.config.MaxRetryDuration {
+		return false
+	}
+
+	incrSyncCounter(SyncOutcomeRetry)
+	time.Sleep(delay)
+	return true
+}
+
+// dialEth connects to the current execution endpoint and, on first use,
+// checks that it serves the configured chain.
+func (ds *RPCDataSource) dialEth() (*ethclient.Client, error) {
+	url := ds.apiUrls.GetEthApiUrl()
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		ds.logger.Error("rpc error: ethclient dial error", "url", url, "err", err)
+		return nil, err
+	}
+
+	expected, ok := ds.config.EthChainID()
+	if !ok || ds.isVerifiedEthUrl(url) {
+		return client, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ds.config.RequestTimeout)
+	defer cancel()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		ds.logger.Error("rpc error: eth_chainId error", "url", url, "err", err)
+		return nil, err
+	}
+
+	if !chainID.IsUint64() || chainID.Uint64() != expected {
+		client.Close()
+		return nil, fmt.Errorf("execution endpoint %s serves chain id %s, expected %d (%s)", url, chainID, expected, ds.config.Chain)
+	}
+
+	ds.mu.Lock()
+	ds.verifiedEthUrls[url] = true
+	ds.mu.Unlock()
+	return client, nil
+}
+
+// isVerifiedEthUrl reports whether the execution endpoint was checked to
+// serve the configured chain.
+func (ds *RPCDataSource) isVerifiedEthUrl(url string) bool {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	return ds.verifiedEthUrls[url]
+}
+
+func (ds *RPCDataSource) parseBeacon(ctx context.Context, path string, res any) error {
+	url := ds.apiUrls.GetBeaconApiUrl() + path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %v", err)
+	}
+
+	resp, err := ds.httpClient.Do(req)
+	if err != nil {
+		ds.logger.Error("rpc error: beacon rpc call error", "url", url, "err", err)
+		return fmt.Errorf("error making HTTP request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		ds.logger.Error("rpc error: beacon rpc call error", "url", url, "err", "no err", "status", resp.StatusCode)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return types.ErrSymbioticNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %v", err)
+	}
+
+	err = json.Unmarshal(body, res)
+	if err != nil {
+		return fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// GetSymbioticSyncCheckpoint returns the last applied sync checkpoint. Before
+// tracking starts it returns a checkpoint at the current block time.
+func (k Keeper) GetSymbioticSyncCheckpoint(ctx context.Context) (types.SymbioticSyncCheckpoint, error) {
+	checkpoint, err := k.SymbioticSyncCheckpoint.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SymbioticSyncCheckpoint{Time: k.HeaderService.HeaderInfo(ctx).Time}, nil
+	}
+
+	return checkpoint, err
+}
+
+// StakeStaleness returns the age of the stake of a checkpoint at the current
+// block time. The stake is as old as its execution block, or as the checkpoint
+// if no sync was applied yet.
+func (k Keeper) StakeStaleness(ctx context.Context, checkpoint types.SymbioticSyncCheckpoint) time.Duration {
+	since := checkpoint.Time
+	if checkpoint.BlockTimestamp != 0 {
+		since = time.Unix(int64(checkpoint.BlockTimestamp), 0)
+	}
+
+	return max(k.HeaderService.HeaderInfo(ctx).Time.Sub(since), 0)
+}
+
+// GetSymbioticSafetyMode returns the safety mode in force because of stale
+// stake, StaleStakeActionUnspecified if none is.
+func (k Keeper) GetSymbioticSafetyMode(ctx context.Context) (types.StaleStakeAction, error) {
+	checkpoint, err := k.SymbioticSyncCheckpoint.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.StaleStakeActionUnspecified, nil
+	}
+	if err != nil {
+		return types.StaleStakeActionUnspecified, err
+	}
+
+	return checkpoint.SafetyMode, nil
+}
+
+// applySymbioticSyncCheckpoint moves the checkpoint to a sync that updated the
+// validator set. The safety mode is left to updateSymbioticSafetyMode.
+func (k *Keeper) applySymbioticSyncCheckpoint(ctx context.Context, record types.SymbioticSyncRecord) error {
+	checkpoint, err := k.GetSymbioticSyncCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	checkpoint.Height = record.Height
+	checkpoint.Time = record.Time
+	checkpoint.BlockHash = record.BlockHash
+	checkpoint.BlockNumber = record.BlockNumber
+	checkpoint.BlockTimestamp = record.BlockTimestamp
+	checkpoint.SkippedSyncs = 0
+
+	return k.SymbioticSyncCheckpoint.Set(ctx, checkpoint)
+}
+
+// skipSymbioticSync records a sync the validator set could not be synced at,
+// counts it on the checkpoint and emits its event. The power changes still
+// pending from the previous syncs keep being applied.
+func (k *Keeper) skipSymbioticSync(ctx context.Context, params types.Params, record types.SymbioticSyncRecord, reason string) error {
+	checkpoint, err := k.GetSymbioticSyncCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	checkpoint.SkippedSyncs++
+	if err := k.SymbioticSyncCheckpoint.Set(ctx, checkpoint); err != nil {
+		return err
+	}
+
+	staleness := k.StakeStaleness(ctx, checkpoint)
+	k.Logger.Warn("symbiotic sync skipped", "height", record.Height, "reason", reason,
+		"skipped_syncs", checkpoint.SkippedSyncs, "staleness", staleness)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSymbioticSyncSkipped,
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(record.Height, 10)),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+		event.NewAttribute(types.AttributeKeySkippedSyncs, strconv.FormatUint(checkpoint.SkippedSyncs, 10)),
+		event.NewAttribute(types.AttributeKeyStaleness, staleness.String()),
+	); err != nil {
+		return err
+	}
+
+	if err := k.applySymbioticPendingPowerChanges(ctx, params); err != nil {
+		return err
+	}
+
+	return k.recordSymbioticSync(ctx, params, record)
+}
+
+// failSymbioticSync skips a sync whose Ethereum data could not be agreed on
+// and hands it to the on-sync-failure policy.
+func (k *Keeper) failSymbioticSync(ctx context.Context, params types.Params, record types.SymbioticSyncRecord, reason string) error {
+	if err := k.skipSymbioticSync(ctx, params, record, reason); err != nil {
+		return err
+	}
+
+	k.HandleSyncFailure(fmt.Errorf("symbiotic sync skipped at height %d: %s", record.Height, reason))
+	return nil
+}
+
+// updateSymbioticSafetyMode enters the safety mode set in params when the
+// stake is staler than the max_stake_staleness param, and leaves it once the
+// stake is fresh again. It returns the safety mode in force.
+func (k *Keeper) updateSymbioticSafetyMode(ctx context.Context) (types.StaleStakeAction, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.StaleStakeActionUnspecified, err
+	}
+
+	checkpoint, err := k.GetSymbioticSyncCheckpoint(ctx)
+	if err != nil {
+		return types.StaleStakeActionUnspecified, err
+	}
+
+	staleness := k.StakeStaleness(ctx, checkpoint)
+	telemetry.SetGauge(float32(staleness.Seconds()), types.ModuleName, "symbiotic_stake_staleness_seconds")
+
+	mode := types.StaleStakeActionUnspecified
+	if params.MaxStakeStaleness > 0 && staleness > params.MaxStakeStaleness {
+		mode = params.StaleStakeAction
+	}
+
+	has, err := k.SymbioticSyncCheckpoint.Has(ctx)
+	if err != nil {
+		return types.StaleStakeActionUnspecified, err
+	}
+	// the checkpoint is stored on the first block to start tracking staleness
+	if has && mode == checkpoint.SafetyMode {
+		return mode, nil
+	}
+
+	if mode != checkpoint.SafetyMode {
+		if mode == types.StaleStakeActionUnspecified {
+			k.Logger.Info("symbiotic stake is fresh again, leaving safety mode", "safety_mode", checkpoint.SafetyMode, "staleness", staleness)
+		} else {
+			k.Logger.Error("symbiotic stake is stale, entering safety mode", "safety_mode", mode, "staleness", staleness,
+				"max_stake_staleness", params.MaxStakeStaleness)
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSymbioticSafetyMode,
+			event.NewAttribute(types.AttributeKeySafetyMode, mode.String()),
+			event.NewAttribute(types.AttributeKeyStaleness, staleness.String()),
+		); err != nil {
+			return types.StaleStakeActionUnspecified, err
+		}
+	}
+
+	checkpoint.SafetyMode = mode
+	if err := k.SymbioticSyncCheckpoint.Set(ctx, checkpoint); err != nil {
+		return types.StaleStakeActionUnspecified, err
+	}
+
+	return mode, nil
+}
+
+// CircuitBreaker wraps the circuit breaker of the app so that every message is
+// rejected while the chain is halted because of stale stake.
+func (k *Keeper) CircuitBreaker(cb types.CircuitBreaker) types.CircuitBreaker {
+	return safetyModeCircuitBreaker{keeper: k, cb: cb}
+}
+
+type safetyModeCircuitBreaker struct {
+	keeper *Keeper
+	cb     types.CircuitBreaker
+}
+
+// IsAllowed implements types.CircuitBreaker.
+func (s safetyModeCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	mode, err := s.keeper.GetSymbioticSafetyMode(ctx)
+	if err != nil {
+		return false, err
+	}
+	if mode == types.StaleStakeActionHaltChain {
+		return false, nil
+	}
+
+	return s.cb.IsAllowed(ctx, typeURL)
+}
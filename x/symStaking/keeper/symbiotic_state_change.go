@@ -2,140 +2,52 @@ package keeper
 
 import (
 	"context"
-	"cosmossdk.io/math"
-	stakingtypes "cosmossdk.io/x/symStaking/types"
-	"encoding/json"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"io/ioutil"
 	"math/big"
-	"net/http"
-	"strconv"
-	"strings"
-	"time"
-)
 
-// Struct to unmarshal the response from the Beacon Chain API
-type Block struct {
-	Finalized bool `json:"finalized"`
-	Data      struct {
-		Message struct {
-			Body struct {
-				ExecutionPayload struct {
-					BlockHash string `json:"block_hash"`
-				} `json:"execution_payload"`
-			} `json:"body"`
-		} `json:"message"`
-	} `json:"data"`
-}
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
-type RPCRequest struct {
-	Jsonrpc string        `json:"jsonrpc"`
-	Method  string        `json:"method"`
-	Params  []interface{} `json:"params"`
-	ID      int           `json:"id"`
-}
-
-type RPCResponse struct {
-	Jsonrpc string          `json:"jsonrpc"`
-	ID      int             `json:"id"`
-	Result  json.RawMessage `json:"result"`
-	Error   *RPCError       `json:"error,omitempty"`
-}
-
-type RPCError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
 
-type Validator struct {
-	Stake    *big.Int
-	ConsAddr [32]byte
-}
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
-	SYMBIOTIC_SYNC_PERIOD           = 10
-	SLEEP_ON_RETRY                  = 200
-	RETRIES                         = 5
-	BEACON_GENESIS_TIMESTAMP        = 1695902400
-	SLOTS_IN_EPOCH                  = 32
-	SLOT_DURATION                   = 12
-	INVALID_BLOCKHASH               = "invalid"
-	BLOCK_PATH                      = "/eth/v2/beacon/blocks/"
-	GET_VALIDATOR_SET_FUNCTION_NAME = "getValidatorSet"
-	GET_CURRENT_EPOCH_FUNCTION_NAME = "getCurrentEpoch"
-	CONTRACT_ABI                    = `[
-		{
-			"type": "function",
-			"name": "getCurrentEpoch",
-			"outputs": [
-				{
-					"name": "epoch",
-					"type": "uint48",
-					"internalType": "uint48"
-				}
-			],
-			"stateMutability": "view"
-		},	
-		{
-			"type": "function",
-			"name": "getValidatorSet",
-			"inputs": [
-				{
-					"name": "epoch",
-					"type": "uint48",
-					"internalType": "uint48"
-				}
-			],
-			"outputs": [
-				{
-					"name": "validatorsData",
-					"type": "tuple[]",
-					"internalType": "struct SimpleMiddleware.ValidatorData[]",
-					"components": [
-						{
-							"name": "stake",
-							"type": "uint256",
-							"internalType": "uint256"
-						},
-						{
-							"name": "consAddr",
-							"type": "bytes32",
-							"internalType": "bytes32"
-						}
-					]
-				}
-			],
-			"stateMutability": "view"
-		}
-	]`
+	INVALID_BLOCKHASH         = "invalid"
+	BLOCK_PATH                = "/eth/v2/beacon/blocks/"
+	FINALITY_CHECKPOINTS_PATH = "/eth/v1/beacon/states/head/finality_checkpoints"
 )
 
+// maxFinalizedEpochLag is the number of epochs the attested execution block
+// may be older than the block time. The finalized checkpoint of the beacon
+// chain normally lags its head by two to three epochs.
+const maxFinalizedEpochLag = 5
+
 func (k *Keeper) CacheBlockHash(ctx context.Context, blockHash stakingtypes.CachedBlockHash) error {
-	bz, err := json.Marshal(blockHash)
-	if err != nil {
-		return err
-	}
-	err = k.CachedBlockHash.Set(ctx, bz)
-	return err
+	return k.CachedBlockHash.Set(ctx, blockHash)
 }
 
 func (k *Keeper) SymbioticUpdateValidatorsPower(ctx context.Context) error {
-	if k.networkMiddlewareAddress == "" {
-		panic("middleware address is not set")
-	}
-
 	height := k.HeaderService.HeaderInfo(ctx).Height
 
-	if height%SYMBIOTIC_SYNC_PERIOD != 0 {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if height%params.SymbioticSyncPeriod != 0 {
 		return nil
 	}
 
+	if params.MiddlewareAddress == "" {
+		return errors.New("symbiotic middleware address is not set")
+	}
+
 	exist, err := k.CachedBlockHash.Has(ctx)
 	if err != nil {
 		return err
@@ -145,250 +57,402 @@ func (k *Keeper) SymbioticUpdateValidatorsPower(ctx context.Context) error {
 		return nil
 	}
 
-	data, err := k.CachedBlockHash.Get(ctx)
+	cachedBlockHash, err := k.CachedBlockHash.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	var cachedBlockHash stakingtypes.CachedBlockHash
+	record := stakingtypes.SymbioticSyncRecord{
+		Height:    height,
+		Time:      k.HeaderService.HeaderInfo(ctx).Time,
+		BlockHash: INVALID_BLOCKHASH,
+	}
 
-	if err := json.Unmarshal(data, &cachedBlockHash); err != nil {
-		return err
+	// PreBlocker caches the block hash at the sync heights of the period in
+	// force before the messages of the block, a MsgUpdateParams changing the
+	// period leaves the cache of another height
+	if cachedBlockHash.Height != height {
+		k.Logger.Warn("symbiotic block hash cached for another height", "cached_height", cachedBlockHash.Height, "height", height)
+		incrSyncCounter(SyncOutcomeSkip)
+		return k.skipSymbioticSync(ctx, params, record, stakingtypes.SkippedReasonStaleCache)
 	}
 
-	if cachedBlockHash.Height != height { // TODO need to research failures on processProposal, mb better to skip block if height is old
-		return fmt.Errorf("symbiotic no blockhash cache, actual cached height %v, expected %v", cachedBlockHash.Height, height)
+	// the voter stakes are agreed on independently of the validator set
+	if err := k.applySymbioticVoterStakes(ctx, params, height, cachedBlockHash.VoterStakes); err != nil {
+		return err
 	}
 
 	if cachedBlockHash.BlockHash == INVALID_BLOCKHASH {
-		return nil
+		return k.failSymbioticSync(ctx, params, record, stakingtypes.SkippedReasonInvalidBlockHash)
 	}
 
-	var validators []Validator
+	// only validator sets agreed on through vote extensions are applied, EndBlock
+	// never reads Ethereum. A cache without attestation, migrated from a legacy
+	// cache or imported in genesis, is skipped.
+	if !cachedBlockHash.Attested {
+		k.Logger.Warn("symbiotic block hash cache not attested", "hash", cachedBlockHash.BlockHash)
+		incrSyncCounter(SyncOutcomeSkip)
+		return k.skipSymbioticSync(ctx, params, record, stakingtypes.SkippedReasonNotAttested)
+	}
 
-	for i := 0; i < RETRIES; i++ {
-		validators, err = k.getSymbioticValidatorSet(ctx, cachedBlockHash.BlockHash)
-		if err == nil {
-			break
-		}
+	validators, ok := cachedBlockHash.SymbioticValidators()
+	if !ok {
+		return errors.New("malformed cached symbiotic validator set")
+	}
+	blockNumber, blockTimestamp := cachedBlockHash.BlockNumber, cachedBlockHash.BlockTimestamp
 
-		if strings.HasSuffix(err.Error(), "is not currently canonical") {
-			k.Logger.Warn("not canonical block hash", "hash", cachedBlockHash.BlockHash)
-			err = nil
-			break
-		}
+	record.BlockHash = cachedBlockHash.BlockHash
+	record.BlockNumber = blockNumber
+	record.BlockTimestamp = blockTimestamp
+	record.Epoch = params.BeaconEpoch(blockTimestamp)
 
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+	// the changes still pending from the previous syncs are replaced by the
+	// ones of this sync
+	if err := k.PendingPowerChanges.Clear(ctx, nil); err != nil {
+		return err
 	}
 
+	rotations, err := k.pendingConsPubKeyRotations(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, v := range validators {
-		val, err := k.GetValidatorByConsAddr(ctx, v.ConsAddr[:20])
+	matched := make(map[string]struct{}, len(validators))
+	record.Stakes = make([]stakingtypes.SymbioticSyncStake, len(validators))
+	for i, v := range validators {
+		stake := math.ZeroInt()
+		if v.Stake != nil {
+			stake = math.NewIntFromBigInt(v.Stake)
+		}
+		record.Stakes[i] = stakingtypes.SymbioticSyncStake{Operator: v.Operator, Stake: stake}
+
+		consAddr, err := v.ConsAddress()
 		if err != nil {
-			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
-				continue
+			if err := k.emitUnmatchedValidator(ctx, v, nil, stakingtypes.UnmatchedReasonInvalidKey); err != nil {
+				return err
 			}
-			return err
+			continue
 		}
 
-		k.SetValidatorTokens(ctx, val, math.NewIntFromBigInt(v.Stake))
-	}
-
-	return nil
-}
+		if record.Stakes[i].ConsAddress, err = k.consensusAddressCodec.BytesToString(consAddr); err != nil {
+			return err
+		}
 
-func (k *Keeper) GetFinalizedBlockHash(ctx context.Context) (string, error) {
-	var err error
-	var block Block
-
-	for i := 0; i < RETRIES; i++ {
-		slot := k.getSlot(ctx)
-		block, err = k.parseBlock(slot)
-
-		for errors.Is(err, stakingtypes.ErrSymbioticNotFound) { // some slots on api may be omitted
-			for i := 1; i < SLOTS_IN_EPOCH; i++ {
-				block, err = k.parseBlock(slot - i)
-				if err == nil {
-					break
+		// the new key of a pending rotation takes effect once reported
+		rotation, rotating := rotations[string(consAddr)]
+		val, err := k.symbioticValidatorByConsAddr(ctx, consAddr, rotation, rotating)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) && params.AutoRegisterValidators && v.KeyType != "" {
+			val, err = k.registerSymbioticValidator(ctx, v, consAddr)
+		}
+		if err == nil {
+			err = k.checkSymbioticOperator(ctx, params, val, v)
+		}
+		if err == nil && rotating {
+			val, err = k.applyConsPubKeyRotation(ctx, rotation)
+		}
+		if err != nil {
+			reason := ""
+			switch {
+			case errors.Is(err, stakingtypes.ErrNoValidatorFound):
+				reason = stakingtypes.UnmatchedReasonNoValidator
+			case errors.Is(err, stakingtypes.ErrConsPubKeyRotated):
+				reason = stakingtypes.UnmatchedReasonRotatedKey
+			case errors.Is(err, stakingtypes.ErrValidatorPubKeyTypeNotSupported):
+				reason = stakingtypes.UnmatchedReasonUnsupportedKey
+			case errors.Is(err, stakingtypes.ErrValidatorOwnerExists):
+				reason = stakingtypes.UnmatchedReasonOperatorExists
+			case errors.Is(err, stakingtypes.ErrOperatorMismatch):
+				// the stake of the operator is not given to a validator it
+				// did not bind
+				reason = stakingtypes.UnmatchedReasonOperatorMismatch
+				if err := k.setSymbioticPowerTarget(ctx, val, math.ZeroInt(), height); err != nil {
+					return err
 				}
-				if !errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
-					return "", err
+			default:
+				return err
+			}
+			if err := k.emitUnmatchedValidator(ctx, v, consAddr, reason); err != nil {
+				return err
+			}
+			if reason == stakingtypes.UnmatchedReasonNoValidator {
+				if err := k.emitUnknownOperator(ctx, v, consAddr); err != nil {
+					return err
 				}
 			}
+			continue
 		}
 
-		if err == nil {
-			break
+		if err := k.setSymbioticPowerTarget(ctx, val, stake, height); err != nil {
+			return err
 		}
+		record.Stakes[i].ValidatorAddress = val.OperatorAddress
+		matched[string(consAddr)] = struct{}{}
+	}
 
-		k.apiUrls.RotateBeaconUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+	if params.AutoRegisterValidators {
+		if err := k.removeMissingSymbioticValidators(ctx, matched, height); err != nil {
+			return err
+		}
 	}
 
-	if err != nil {
-		return "", err
+	if err := k.applySymbioticPendingPowerChanges(ctx, params); err != nil {
+		return err
 	}
 
-	if !block.Finalized {
-		return INVALID_BLOCKHASH, nil
+	if err := k.applySymbioticSyncCheckpoint(ctx, record); err != nil {
+		return err
 	}
 
-	return block.Data.Message.Body.ExecutionPayload.BlockHash, nil
+	return k.recordSymbioticSync(ctx, params, record)
 }
 
-func (k *Keeper) GetBlockByHash(ctx context.Context, blockHash string) (*types.Block, error) {
-	var block *types.Block
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// recordSymbioticSync persists the record of a sync, prunes the records
+// beyond the symbiotic_sync_history_entries param, keeping at least the last
+// one, and calls the AfterSymbioticSync hook.
+func (k *Keeper) recordSymbioticSync(ctx context.Context, params stakingtypes.Params, record stakingtypes.SymbioticSyncRecord) error {
+	if err := k.SymbioticSyncs.Set(ctx, record.Height, record); err != nil {
+		return err
+	}
+
+	entries := max(params.SymbioticSyncHistoryEntries, 1)
+
+	iter, err := k.SymbioticSyncs.Iterate(ctx, new(collections.Range[int64]).Descending())
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer client.Close()
+	defer iter.Close()
 
-	for i := 0; i < RETRIES; i++ {
-		block, err = client.BlockByHash(ctx, common.HexToHash(blockHash))
-		if err == nil {
-			break
+	var pruned []int64
+	for kept := uint32(0); iter.Valid(); iter.Next() {
+		if kept < entries {
+			kept++
+			continue
 		}
-
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+		height, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		pruned = append(pruned, height)
 	}
 
-	if err != nil {
-		return nil, err
+	for _, height := range pruned {
+		if err := k.SymbioticSyncs.Remove(ctx, height); err != nil {
+			return err
+		}
 	}
 
-	return block, nil
+	return k.Hooks().AfterSymbioticSync(ctx, record)
 }
 
-func (k *Keeper) GetBlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	var block *types.Block
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// GetLastAppliedSymbioticSync returns the record of the last sync at or
+// before height that updated the validator set, or ErrSymbioticNotFound if
+// none is kept.
+func (k *Keeper) GetLastAppliedSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error) {
+	var (
+		last  stakingtypes.SymbioticSyncRecord
+		found bool
+	)
+	err := k.SymbioticSyncs.Walk(ctx, new(collections.Range[int64]).EndInclusive(height).Descending(), func(_ int64, record stakingtypes.SymbioticSyncRecord) (bool, error) {
+		if record.BlockHash == INVALID_BLOCKHASH {
+			return false, nil
+		}
+		last, found = record, true
+		return true, nil
+	})
 	if err != nil {
-		return nil, err
+		return stakingtypes.SymbioticSyncRecord{}, err
+	}
+	if !found {
+		return stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound
 	}
-	defer client.Close()
 
-	for i := 0; i < RETRIES; i++ {
-		block, err = client.BlockByNumber(ctx, number)
-		if err == nil {
-			break
-		}
+	return last, nil
+}
 
-		k.apiUrls.RotateEthUrl()
-		time.Sleep(time.Millisecond * SLEEP_ON_RETRY)
+// GetSymbioticSync returns the record of the sync at height, or
+// ErrSymbioticNotFound if none is kept.
+func (k *Keeper) GetSymbioticSync(ctx context.Context, height int64) (stakingtypes.SymbioticSyncRecord, error) {
+	record, err := k.SymbioticSyncs.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.SymbioticSyncRecord{}, stakingtypes.ErrSymbioticNotFound
 	}
+	return record, err
+}
 
-	if err != nil {
-		return nil, err
+// emitUnmatchedValidator emits the event of a middleware validator set entry
+// no validator was found for. Its stake is not applied.
+func (k *Keeper) emitUnmatchedValidator(ctx context.Context, v stakingtypes.SymbioticValidator, consAddr sdk.ConsAddress, reason string) error {
+	k.Logger.Info("symbiotic validator set entry without validator", "cons_address", consAddr, "operator", hex.EncodeToString(v.Operator), "reason", reason)
+
+	consAddrStr := ""
+	if consAddr != nil {
+		var err error
+		if consAddrStr, err = k.consensusAddressCodec.BytesToString(consAddr); err != nil {
+			return err
+		}
 	}
 
-	return block, nil
-}
+	stake := "0"
+	if v.Stake != nil {
+		stake = v.Stake.String()
+	}
 
-func (k Keeper) GetMinBlockTimestamp(ctx context.Context) uint64 {
-	return uint64(k.getSlot(ctx)-SLOTS_IN_EPOCH)*12 + BEACON_GENESIS_TIMESTAMP
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticUnmatchedValidator,
+		event.NewAttribute(stakingtypes.AttributeKeyConsAddress, consAddrStr),
+		event.NewAttribute(stakingtypes.AttributeKeyConsPubKey, hex.EncodeToString(v.ConsPubKey)),
+		event.NewAttribute(stakingtypes.AttributeKeyOperator, hex.EncodeToString(v.Operator)),
+		event.NewAttribute(stakingtypes.AttributeKeyStake, stake),
+		event.NewAttribute(stakingtypes.AttributeKeyReason, reason),
+	)
 }
 
-func (k Keeper) getSymbioticValidatorSet(ctx context.Context, blockHash string) ([]Validator, error) {
-	client, err := ethclient.Dial(k.apiUrls.GetEthApiUrl())
+// emitUnknownOperator emits the event of a middleware validator set entry
+// whose consensus key no validator uses, for the operator to create one.
+func (k *Keeper) emitUnknownOperator(ctx context.Context, v stakingtypes.SymbioticValidator, consAddr sdk.ConsAddress) error {
+	consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
 	if err != nil {
-		k.Logger.Error("rpc error: ethclient dial error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
-		return nil, err
+		return err
 	}
-	defer client.Close()
 
-	contractABI, err := abi.JSON(strings.NewReader(CONTRACT_ABI))
-	if err != nil {
-		return nil, err
+	stake := "0"
+	if v.Stake != nil {
+		stake = v.Stake.String()
 	}
 
-	contractAddress := common.HexToAddress(k.networkMiddlewareAddress)
+	return k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeSymbioticOperatorUnknown,
+		event.NewAttribute(stakingtypes.AttributeKeyOperator, hex.EncodeToString(v.Operator)),
+		event.NewAttribute(stakingtypes.AttributeKeyConsAddress, consAddrStr),
+		event.NewAttribute(stakingtypes.AttributeKeyStake, stake),
+	)
+}
 
-	data, err := contractABI.Pack(GET_CURRENT_EPOCH_FUNCTION_NAME)
+// GetSymbioticValidatorSet returns the validator set reported by the
+// middleware set in params at the given execution block hash.
+func (k *Keeper) GetSymbioticValidatorSet(ctx context.Context, blockHash string) ([]stakingtypes.SymbioticValidator, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	query := ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
+	if params.MiddlewareAddress == "" {
+		return nil, errors.New("symbiotic middleware address is not set")
 	}
-	result, err := client.CallContractAtHash(ctx, query, common.HexToHash(blockHash))
+
+	adapter, err := k.MiddlewareAdapter(ctx)
 	if err != nil {
-		k.Logger.Error("rpc error: eth_call error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
 		return nil, err
 	}
 
-	currentEpoch := new(big.Int).SetBytes(result)
+	return k.dataSource.GetValidatorSet(ctx, params.MiddlewareAddress, blockHash, adapter)
+}
 
-	data, err = contractABI.Pack(GET_VALIDATOR_SET_FUNCTION_NAME, currentEpoch)
+// ValidateMiddlewareAddress checks that the middleware address set in the
+// module params matches the one of the node local config. A node syncing
+// validator power from another middleware would diverge from the network.
+func (k Keeper) ValidateMiddlewareAddress(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	query = ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
-	}
-	result, err = client.CallContractAtHash(ctx, query, common.HexToHash(blockHash))
-	if err != nil {
-		k.Logger.Error("rpc error: eth_call error", "url", k.apiUrls.GetEthApiUrl(), "err", err)
-		return nil, err
+	if !stakingtypes.EqualMiddlewareAddress(params.MiddlewareAddress, k.symbioticConfig.MiddlewareAddress) {
+		return fmt.Errorf(
+			"symbiotic middleware address mismatch: on-chain %q, local config %q",
+			params.MiddlewareAddress, k.symbioticConfig.MiddlewareAddress,
+		)
 	}
 
-	var validators []Validator
-	err = contractABI.UnpackIntoInterface(&validators, GET_VALIDATOR_SET_FUNCTION_NAME, result)
+	return nil
+}
+
+// ValidateEthereumNetwork checks that the Ethereum network set in the module
+// params matches the chain of the node local config. A node reading another
+// network would never agree with the others on a block hash.
+func (k Keeper) ValidateEthereumNetwork(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return validators, nil
-}
+	if params.EthereumNetwork != "" && params.EthereumNetwork != k.symbioticConfig.Chain {
+		return fmt.Errorf(
+			"symbiotic ethereum network mismatch: on-chain %q, local config %q",
+			params.EthereumNetwork, k.symbioticConfig.Chain,
+		)
+	}
 
-func (k Keeper) getSlot(ctx context.Context) int {
-	slot := (k.HeaderService.HeaderInfo(ctx).Time.Unix() - BEACON_GENESIS_TIMESTAMP) / SLOT_DURATION // get beacon slot
-	slot = slot / SLOTS_IN_EPOCH * SLOTS_IN_EPOCH                                                    // first slot of epoch
-	slot -= 3 * SLOTS_IN_EPOCH                                                                       // get finalized slot
-	return int(slot)
+	return nil
 }
 
-func (k Keeper) parseBlock(slot int) (Block, error) {
-	url := k.apiUrls.GetBeaconApiUrl() + BLOCK_PATH + strconv.Itoa(slot)
-
-	var block Block
-	resp, err := http.Get(url)
+// GetFinalizedBlockHash returns the execution block hash of the finalized
+// checkpoint of the beacon chain, the block of the first slot of its epoch, or
+// INVALID_BLOCKHASH if nothing was finalized yet. Slots omitted by the beacon
+// chain are skipped, looking back at most one epoch like the checkpoint root.
+// Validators reading another checkpoint, around an epoch transition, do not
+// agree with the others and the sync is skipped.
+func (k *Keeper) GetFinalizedBlockHash(ctx context.Context) (string, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		k.Logger.Error("rpc error: beacon rpc call error", "url", url, "err", err)
-		return block, fmt.Errorf("error making HTTP request: %v", err)
+		return "", err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		k.Logger.Error("rpc error: beacon rpc call error", "url", k.apiUrls.GetEthApiUrl(), "err", "no err", "status", resp.StatusCode)
+	finalizedEpoch, err := k.dataSource.GetFinalizedEpoch(ctx)
+	if err != nil {
+		return "", err
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return block, stakingtypes.ErrSymbioticNotFound
+	if finalizedEpoch <= 0 {
+		return INVALID_BLOCKHASH, nil
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return block, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	slot := finalizedEpoch * params.SlotsInEpoch
+	for i := int64(0); i < params.SlotsInEpoch; i++ {
+		blockHash, err := k.dataSource.GetBeaconBlockHash(ctx, slot-i)
+		if errors.Is(err, stakingtypes.ErrSymbioticNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return blockHash, nil
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	return "", stakingtypes.ErrSymbioticNotFound
+}
+
+// GetBlockByHash returns the execution block with the given hash.
+func (k *Keeper) GetBlockByHash(ctx context.Context, blockHash string) (*ethtypes.Block, error) {
+	return k.dataSource.GetBlockByHash(ctx, blockHash)
+}
+
+// GetBlockByNumber returns the canonical execution block with the given number.
+func (k *Keeper) GetBlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	return k.dataSource.GetBlockByNumber(ctx, number)
+}
+
+// GetMinBlockTimestamp returns the minimum timestamp of an execution block
+// accepted for the current block time, maxFinalizedEpochLag epochs earlier, so
+// that a lagging beacon endpoint does not attest a stale finalized checkpoint.
+func (k Keeper) GetMinBlockTimestamp(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return block, fmt.Errorf("error reading response body: %v", err)
+		return 0, err
 	}
 
-	err = json.Unmarshal(body, &block)
+	minTimestamp := k.HeaderService.HeaderInfo(ctx).Time.Unix() - maxFinalizedEpochLag*params.SlotsInEpoch*params.SlotDuration
+	return uint64(max(minTimestamp, 0)), nil
+}
+
+// IsSymbioticSyncHeight reports whether validator power is synced from the
+// middleware at the given height.
+func (k Keeper) IsSymbioticSyncHeight(ctx context.Context, height int64) (bool, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return block, fmt.Errorf("error unmarshaling JSON: %v", err)
+		return false, err
 	}
 
-	return block, nil
+	return height%params.SymbioticSyncPeriod == 0, nil
 }
@@ -0,0 +1,762 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/symStaking/keeper"
+	"cosmossdk.io/x/symStaking/testutil"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// symbioticValidator builds a middleware validator record for the given
+// consensus address, left-aligned in the bytes32 slot as the contract does.
+func symbioticValidator(consAddr sdk.ConsAddress, stake math.Int) stakingtypes.SymbioticValidator {
+	v := stakingtypes.SymbioticValidator{Stake: stake.BigInt()}
+	copy(v.ConsAddr[:], consAddr)
+	return v
+}
+
+// unmatchedValidatorEvents returns the attributes of the unmatched validator
+// events emitted in ctx.
+func unmatchedValidatorEvents(ctx sdk.Context) []map[string]string {
+	var res []map[string]string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != stakingtypes.EventTypeSymbioticUnmatchedValidator {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, a := range e.Attributes {
+			attrs[a.Key] = a.Value
+		}
+		res = append(res, attrs)
+	}
+	return res
+}
+
+func (s *KeeperTestSuite) TestSymbioticUpdateValidatorsPower() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, valPubKey)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	unknownPubKey := PKs[1]
+	stake := keeper.TokensFromConsensusPower(ctx, 42)
+
+	blockHash := s.dataSource.AddBlock(100, uint64(ctx.HeaderInfo().Time.Unix()))
+	s.dataSource.SetValidatorSet(blockHash, []stakingtypes.SymbioticValidator{
+		symbioticValidator(sdk.ConsAddress(valPubKey.Address()), stake),
+		symbioticValidator(sdk.ConsAddress(unknownPubKey.Address()), stake),
+	})
+
+	// not a sync height, nothing happens
+	ctx = ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod + 1, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: ctx.HeaderInfo().Time})
+
+	// a skipped sync keeps the previous power
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH, Height: ctx.HeaderInfo().Height}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+
+	// a block hash cached for another height, under a sync period changed
+	// since, skips the sync
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: ctx.HeaderInfo().Height - 1}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+	skipped := eventAttributes(ctx, stakingtypes.EventTypeSymbioticSyncSkipped)
+	require.Len(skipped, 1)
+	require.Equal(stakingtypes.SkippedReasonStaleCache, skipped[0][stakingtypes.AttributeKeyReason])
+
+	// a block hash not attested through vote extensions skips the sync, the
+	// data source is never read in EndBlock
+	s.dataSource.SetError(errors.New("rpc unavailable"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: ctx.HeaderInfo().Height}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+	skipped = eventAttributes(ctx, stakingtypes.EventTypeSymbioticSyncSkipped)
+	require.Len(skipped, 1)
+	require.Equal(stakingtypes.SkippedReasonNotAttested, skipped[0][stakingtypes.AttributeKeyReason])
+
+	// a malformed validator set halts the node at end block
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash:  blockHash,
+		Height:     ctx.HeaderInfo().Height,
+		Attested:   true,
+		Validators: []stakingtypes.SymbioticValidatorStake{{ConsAddr: []byte{1}, Stake: stake}},
+	}))
+	_, err = keeper.BlockValidatorUpdates(ctx)
+	require.ErrorIs(err, stakingtypes.ErrSymbioticValUpdate)
+	require.ErrorIs(<-keeper.HaltCh(), stakingtypes.ErrSymbioticValUpdate)
+
+	// operators unknown on chain are reported, known ones get the middleware stake
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash: blockHash,
+		Height:    ctx.HeaderInfo().Height,
+		Attested:  true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+			symbioticValidator(sdk.ConsAddress(valPubKey.Address()), stake),
+			symbioticValidator(sdk.ConsAddress(unknownPubKey.Address()), stake),
+		}),
+	}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(stake, validator.Tokens)
+	unmatched := unmatchedValidatorEvents(ctx)
+	require.Len(unmatched, 1)
+	unknownConsAddr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(unknownPubKey.Address())
+	require.NoError(err)
+	require.Equal(unknownConsAddr, unmatched[0][stakingtypes.AttributeKeyConsAddress])
+	require.Equal(stakingtypes.UnmatchedReasonNoValidator, unmatched[0][stakingtypes.AttributeKeyReason])
+
+	// a validator set agreed on later replaces the stakes
+	attestedStake := keeper.TokensFromConsensusPower(ctx, 7)
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash:  blockHash,
+		Height:     ctx.HeaderInfo().Height,
+		Attested:   true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{symbioticValidator(sdk.ConsAddress(valPubKey.Address()), attestedStake)}),
+	}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(attestedStake, validator.Tokens)
+
+	// validators may be given by their full consensus public key
+	pubKeyStake := keeper.TokensFromConsensusPower(ctx, 9)
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash: blockHash,
+		Height:    ctx.HeaderInfo().Height,
+		Attested:  true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+			{Stake: pubKeyStake.BigInt(), KeyType: stakingtypes.KeyTypeEd25519, ConsPubKey: valPubKey.Bytes()},
+			{Stake: pubKeyStake.BigInt(), KeyType: stakingtypes.KeyTypeEd25519, ConsPubKey: []byte{1, 2, 3}, Operator: []byte{0xaa}},
+		}),
+	}))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(pubKeyStake, validator.Tokens)
+	unmatched = unmatchedValidatorEvents(ctx)
+	require.Len(unmatched, 1)
+	require.Equal(stakingtypes.UnmatchedReasonInvalidKey, unmatched[0][stakingtypes.AttributeKeyReason])
+	require.Equal("aa", unmatched[0][stakingtypes.AttributeKeyOperator])
+	s.dataSource.SetError(nil)
+
+	// the sync period is read from params
+	params.SymbioticSyncPeriod = stakingtypes.DefaultSymbioticSyncPeriod * 2
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: blockHash, Height: ctx.HeaderInfo().Height - 1}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetFinalizedBlockHash() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(stakingtypes.DefaultBeaconGenesisTimestamp, 0).Add(24 * time.Hour)})
+
+	// nothing finalized yet
+	res, err := keeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal(stakingkeeper.INVALID_BLOCKHASH, res)
+
+	// the block hash is read at the finalized checkpoint, omitted slots are
+	// skipped for an epoch at most
+	epoch := int64(10)
+	s.dataSource.SetFinalizedEpoch(epoch)
+	_, err = keeper.GetFinalizedBlockHash(ctx)
+	require.ErrorIs(err, stakingtypes.ErrSymbioticNotFound)
+
+	blockHash := s.dataSource.AddBlock(1, uint64(ctx.HeaderInfo().Time.Unix()))
+	s.dataSource.SetBeaconBlockHash(epoch*stakingtypes.DefaultSlotsInEpoch-stakingtypes.DefaultSlotsInEpoch+1, blockHash)
+	res, err = keeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal(blockHash, res)
+
+	// a later checkpoint reads a later slot
+	laterHash := s.dataSource.AddBlock(2, uint64(ctx.HeaderInfo().Time.Unix()))
+	s.dataSource.SetBeaconBlockHash((epoch+1)*stakingtypes.DefaultSlotsInEpoch, laterHash)
+	res, err = keeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal(blockHash, res)
+	s.dataSource.SetFinalizedEpoch(epoch + 1)
+	res, err = keeper.GetFinalizedBlockHash(ctx)
+	require.NoError(err)
+	require.Equal(laterHash, res)
+
+	// a block older than the finalized checkpoint lag is not accepted
+	minTimestamp, err := keeper.GetMinBlockTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(uint64(ctx.HeaderInfo().Time.Unix()-5*stakingtypes.DefaultSlotsInEpoch*stakingtypes.DefaultSlotDuration), minTimestamp)
+
+	block, err := keeper.GetBlockByHash(ctx, res)
+	require.NoError(err)
+	require.Equal(laterHash, block.Hash().String())
+
+	block, err = keeper.GetBlockByNumber(ctx, block.Number())
+	require.NoError(err)
+	require.Equal(laterHash, block.Hash().String())
+}
+
+func (s *KeeperTestSuite) TestValidateMiddlewareAddress() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+
+	// unset on chain
+	require.ErrorContains(keeper.ValidateMiddlewareAddress(ctx), "mismatch")
+
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.ValidateMiddlewareAddress(ctx))
+
+	// checksum casing is ignored
+	params.MiddlewareAddress = strings.ToUpper(testMiddlewareAddress[2:])
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.ValidateMiddlewareAddress(ctx))
+
+	params.MiddlewareAddress = "0x0000000000000000000000000000000000000002"
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.ErrorContains(keeper.ValidateMiddlewareAddress(ctx), "mismatch")
+}
+
+func (s *KeeperTestSuite) TestSymbioticVoterStake() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var (
+		vault    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+		operator = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+		voter    = common.HexToAddress("0x00000000000000000000000000000000000000c3")
+	)
+	blockHash := s.dataSource.AddBlock(200, uint64(ctx.HeaderInfo().Time.Unix()))
+	s.dataSource.SetVoterStake(blockHash, vault, operator, voter, big.NewInt(500))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// not requested
+	_, err = keeper.GetSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes())
+	require.ErrorIs(err, stakingtypes.ErrSymbioticNotFound)
+
+	require.ErrorContains(keeper.RequestSymbioticVoterStake(ctx, blockHash, vault.Bytes(), []byte{1}, voter.Bytes()), "invalid ethereum address length")
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 5})
+	require.NoError(keeper.RequestSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes()))
+	require.NoError(keeper.RequestSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), operator.Bytes()))
+	// requesting again is a no-op
+	require.NoError(keeper.RequestSymbioticVoterStake(ctx.WithHeaderInfo(header.Info{Height: 6}), blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes()))
+
+	// not agreed on yet
+	_, err = keeper.GetSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes())
+	require.ErrorIs(err, stakingtypes.ErrSymbioticPending)
+
+	pending, err := keeper.PendingSymbioticVoterStakeRequests(ctx, stakingtypes.MaxVoterStakesPerSync)
+	require.NoError(err)
+	require.Len(pending, 2)
+	for _, req := range pending {
+		require.Equal(int64(5), req.Height)
+	}
+	pending, err = keeper.PendingSymbioticVoterStakeRequests(ctx, 1)
+	require.NoError(err)
+	require.Len(pending, 1)
+
+	// the stake is read from Ethereum for the vote extensions only
+	id := stakingtypes.VoterStakeRequestID(blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes())
+	req, err := keeper.VoterStakeRequests.Get(ctx, id)
+	require.NoError(err)
+	stake, err := keeper.ReadSymbioticVoterStake(ctx, req)
+	require.NoError(err)
+	require.Equal(math.NewInt(500), stake)
+
+	// the agreed stakes are applied at the sync, unknown requests are ignored
+	height := params.SymbioticSyncPeriod
+	ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash: stakingkeeper.INVALID_BLOCKHASH,
+		Height:    height,
+		VoterStakes: []stakingtypes.SymbioticVoterStake{
+			{RequestId: id, Stake: math.NewInt(500)},
+			{RequestId: make([]byte, 32), Stake: math.NewInt(1)},
+		},
+	}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	stake, err = keeper.GetSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes())
+	require.NoError(err)
+	require.Equal(math.NewInt(500), stake)
+
+	pending, err = keeper.PendingSymbioticVoterStakeRequests(ctx, stakingtypes.MaxVoterStakesPerSync)
+	require.NoError(err)
+	require.Len(pending, 1)
+	require.Equal(operator.Bytes(), pending[0].Voter)
+
+	// the requests expire, resolved or not
+	height = 20 * params.SymbioticSyncPeriod
+	ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time})
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH, Height: height}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	_, err = keeper.GetSymbioticVoterStake(ctx, blockHash, vault.Bytes(), operator.Bytes(), voter.Bytes())
+	require.ErrorIs(err, stakingtypes.ErrSymbioticNotFound)
+	pending, err = keeper.PendingSymbioticVoterStakeRequests(ctx, stakingtypes.MaxVoterStakesPerSync)
+	require.NoError(err)
+	require.Empty(pending)
+}
+
+func (s *KeeperTestSuite) TestSymbioticAutoRegisterValidators() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	params.AutoRegisterValidators = true
+	// the validators are removed at once
+	params.MaxPowerChangeRate = math.LegacyZeroDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// a validator created on chain but missing from the middleware
+	removedPubKey := PKs[1]
+	removedAddr := sdk.ValAddress(removedPubKey.Address())
+	removed := testutil.NewValidator(s.T(), removedAddr, removedPubKey)
+	removed = removed.AddTokens(keeper.TokensFromConsensusPower(ctx, 5))
+	require.NoError(keeper.SetValidator(ctx, removed))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, removed))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, removed))
+
+	operator := []byte{0xaa, 19: 0xbb}
+	stake := keeper.TokensFromConsensusPower(ctx, 42)
+	entry := stakingtypes.SymbioticValidator{Stake: stake.BigInt(), KeyType: stakingtypes.KeyTypeEd25519, ConsPubKey: PKs[0].Bytes(), Operator: operator}
+	cache := stakingtypes.CachedBlockHash{
+		BlockHash: "0x01",
+		Height:    stakingtypes.DefaultSymbioticSyncPeriod,
+		Attested:  true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+			entry,
+			// the operator already has a validator with another key
+			{Stake: stake.BigInt(), KeyType: stakingtypes.KeyTypeEd25519, ConsPubKey: PKs[2].Bytes(), Operator: operator},
+			// not allowed by the consensus params
+			{Stake: stake.BigInt(), KeyType: stakingtypes.KeyTypeBls12381, ConsPubKey: make([]byte, 48)},
+			// a consensus address cannot register a validator
+			symbioticValidator(sdk.ConsAddress(PKs[3].Address()), stake),
+		}),
+	}
+	ctx = ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: ctx.HeaderInfo().Time}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	registered, err := keeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.NoError(err)
+	require.Equal(stake, registered.Tokens)
+	require.Equal("0xaa000000000000000000000000000000000000bb", registered.Description.Moniker)
+	consPubKey, err := registered.ConsPubKey()
+	require.NoError(err)
+	require.True(PKs[0].Equals(consPubKey))
+
+	removed, err = keeper.GetValidator(ctx, removedAddr)
+	require.NoError(err)
+	require.True(removed.Tokens.IsZero())
+
+	var reasons []string
+	for _, attrs := range unmatchedValidatorEvents(ctx) {
+		reasons = append(reasons, attrs[stakingtypes.AttributeKeyReason])
+	}
+	require.Equal([]string{stakingtypes.UnmatchedReasonOperatorExists, stakingtypes.UnmatchedReasonUnsupportedKey, stakingtypes.UnmatchedReasonNoValidator}, reasons)
+
+	// the registered validator is bonded by the validator set update
+	_, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	registered, err = keeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.NoError(err)
+	require.True(registered.IsBonded())
+
+	// an operator leaving the middleware starts unbonding
+	cache.Validators = nil
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	_, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	registered, err = keeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.NoError(err)
+	require.True(registered.Tokens.IsZero())
+	require.True(registered.IsUnbonding())
+
+	// an entry of the same operator and key updates its validator
+	cache.Validators = stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{entry})
+	require.NoError(keeper.CacheBlockHash(ctx, cache))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	registered, err = keeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.NoError(err)
+	require.Equal(stake, registered.Tokens)
+}
+
+// eventAttributes returns the attributes of the events of the given type
+// emitted in ctx.
+func eventAttributes(ctx sdk.Context, eventType string) []map[string]string {
+	var res []map[string]string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != eventType {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, a := range e.Attributes {
+			attrs[a.Key] = a.Value
+		}
+		res = append(res, attrs)
+	}
+	return res
+}
+
+type allowAllCircuitBreaker struct{}
+
+func (allowAllCircuitBreaker) IsAllowed(context.Context, string) (bool, error) { return true, nil }
+
+func (s *KeeperTestSuite) TestSymbioticStakeStaleness() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, valPubKey)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	params.MaxStakeStaleness = time.Hour
+	params.StaleStakeAction = stakingtypes.StaleStakeActionHaltValidatorUpdates
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	period := stakingtypes.DefaultSymbioticSyncPeriod
+	start := ctx.HeaderInfo().Time.Truncate(time.Second)
+	circuitBreaker := keeper.CircuitBreaker(allowAllCircuitBreaker{})
+
+	// tracking starts at the first block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	_, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	checkpoint, err := keeper.SymbioticSyncCheckpoint.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(0), checkpoint.Height)
+	require.True(start.Equal(checkpoint.Time))
+
+	// skipped syncs are counted and reported
+	ctx = ctx.WithHeaderInfo(header.Info{Height: period, Time: start.Add(30 * time.Minute)}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH, Height: period}))
+	updates, err := keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	require.Empty(updates)
+	skipped := eventAttributes(ctx, stakingtypes.EventTypeSymbioticSyncSkipped)
+	require.Len(skipped, 1)
+	require.Equal(stakingtypes.SkippedReasonInvalidBlockHash, skipped[0][stakingtypes.AttributeKeyReason])
+	require.Equal("1", skipped[0][stakingtypes.AttributeKeySkippedSyncs])
+	require.Equal("30m0s", skipped[0][stakingtypes.AttributeKeyStaleness])
+	require.Empty(eventAttributes(ctx, stakingtypes.EventTypeSymbioticSafetyMode))
+
+	// past max_stake_staleness the validator set is frozen
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2 * period, Time: start.Add(2 * time.Hour)}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH, Height: 2 * period}))
+	_, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	require.Equal("2", eventAttributes(ctx, stakingtypes.EventTypeSymbioticSyncSkipped)[0][stakingtypes.AttributeKeySkippedSyncs])
+	safetyMode := eventAttributes(ctx, stakingtypes.EventTypeSymbioticSafetyMode)
+	require.Len(safetyMode, 1)
+	require.Equal(stakingtypes.StaleStakeActionHaltValidatorUpdates.String(), safetyMode[0][stakingtypes.AttributeKeySafetyMode])
+	mode, err := keeper.GetSymbioticSafetyMode(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.StaleStakeActionHaltValidatorUpdates, mode)
+	allowed, err := circuitBreaker.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(err)
+	require.True(allowed)
+
+	// stake changes are not sent to CometBFT while frozen
+	stake := keeper.TokensFromConsensusPower(ctx, 42)
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	_, err = keeper.SetValidatorTokens(ctx, validator, stake)
+	require.NoError(err)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2*period + 1, Time: start.Add(2 * time.Hour)})
+	updates, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	require.Empty(updates)
+
+	// an applied sync makes the stake fresh again and releases the changes
+	now := start.Add(3 * time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3 * period, Time: now}).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash:      "0x01",
+		Height:         3 * period,
+		Attested:       true,
+		Validators:     stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{symbioticValidator(sdk.ConsAddress(valPubKey.Address()), stake)}),
+		BlockNumber:    100,
+		BlockTimestamp: uint64(now.Add(-10 * time.Minute).Unix()),
+	}))
+	updates, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 1)
+	safetyMode = eventAttributes(ctx, stakingtypes.EventTypeSymbioticSafetyMode)
+	require.Len(safetyMode, 1)
+	require.Equal(stakingtypes.StaleStakeActionUnspecified.String(), safetyMode[0][stakingtypes.AttributeKeySafetyMode])
+	checkpoint, err = keeper.SymbioticSyncCheckpoint.Get(ctx)
+	require.NoError(err)
+	require.Equal(3*period, checkpoint.Height)
+	require.Equal(uint64(100), checkpoint.BlockNumber)
+	require.Equal(uint64(0), checkpoint.SkippedSyncs)
+	require.Equal(10*time.Minute, keeper.StakeStaleness(ctx, checkpoint))
+
+	// the stake ages from its execution block, halting the chain rejects
+	// every message
+	params.StaleStakeAction = stakingtypes.StaleStakeActionHaltChain
+	require.NoError(keeper.Params.Set(ctx, params))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3*period + 1, Time: now.Add(51 * time.Minute)})
+	_, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	mode, err = keeper.GetSymbioticSafetyMode(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.StaleStakeActionHaltChain, mode)
+	allowed, err = circuitBreaker.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(err)
+	require.False(allowed)
+
+	// the status query reports the checkpoint
+	res, err := stakingkeeper.NewQuerier(keeper).SymbioticSyncStatus(ctx, &stakingtypes.QuerySymbioticSyncStatusRequest{})
+	require.NoError(err)
+	require.Equal(stakingtypes.StaleStakeActionHaltChain, res.Checkpoint.SafetyMode)
+	require.Equal(61*time.Minute, res.StakeStaleness)
+
+	// disabling the check leaves the safety mode
+	params.MaxStakeStaleness = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = keeper.BlockValidatorUpdates(ctx)
+	require.NoError(err)
+	mode, err = keeper.GetSymbioticSafetyMode(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.StaleStakeActionUnspecified, mode)
+}
+
+func (s *KeeperTestSuite) TestSymbioticPowerChangeLimit() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.NewQuerier(keeper)
+
+	var valAddrs []sdk.ValAddress
+	for _, pk := range PKs[:2] {
+		valAddr := sdk.ValAddress(pk.Address())
+		validator := testutil.NewValidator(s.T(), valAddr, pk)
+		validator = validator.AddTokens(keeper.TokensFromConsensusPower(ctx, 100))
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+		valAddrs = append(valAddrs, valAddr)
+	}
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.NewInt(200)))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.Equal(stakingtypes.DefaultMaxPowerChangeRate, params.MaxPowerChangeRate)
+
+	sync := func(height int64, cached stakingtypes.CachedBlockHash) {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: ctx.HeaderInfo().Time}).WithEventManager(sdk.NewEventManager())
+		cached.Height = height
+		require.NoError(keeper.CacheBlockHash(ctx, cached))
+		require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	}
+	requireTokens := func(power ...int64) {
+		for i, valAddr := range valAddrs {
+			validator, err := keeper.GetValidator(ctx, valAddr)
+			require.NoError(err)
+			require.Equal(keeper.TokensFromConsensusPower(ctx, power[i]), validator.Tokens)
+		}
+	}
+	stakes := func(power ...int64) stakingtypes.CachedBlockHash {
+		validators := make([]stakingtypes.SymbioticValidator, len(power))
+		for i, p := range power {
+			validators[i] = symbioticValidator(sdk.ConsAddress(PKs[i].Address()), keeper.TokensFromConsensusPower(ctx, p))
+		}
+		return stakingtypes.CachedBlockHash{BlockHash: "0x01", Attested: true, Validators: stakingtypes.NewSymbioticValidatorStakes(validators)}
+	}
+	period := stakingtypes.DefaultSymbioticSyncPeriod
+
+	// an invalid rate is rejected
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(params.Validate())
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(-1, 1)
+	require.Error(params.Validate())
+	params.MaxPowerChangeRate = stakingtypes.DefaultMaxPowerChangeRate
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// a change within 30% of the total power is applied at once
+	sync(period, stakes(100, 150))
+	requireTokens(100, 150)
+	require.Empty(eventAttributes(ctx, stakingtypes.EventTypeSymbioticPowerChangeLimited))
+
+	// above it the excess is kept pending
+	sync(2*period, stakes(100, 350))
+	requireTokens(100, 210)
+	limited := eventAttributes(ctx, stakingtypes.EventTypeSymbioticPowerChangeLimited)
+	require.Len(limited, 1)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 60).String(), limited[0][stakingtypes.AttributeKeyAppliedTokens])
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 140).String(), limited[0][stakingtypes.AttributeKeyPendingTokens])
+
+	res, err := querier.SymbioticPendingPowerChanges(ctx, &stakingtypes.QuerySymbioticPendingPowerChangesRequest{})
+	require.NoError(err)
+	require.Len(res.PendingChanges, 1)
+	require.Equal(valAddrs[1].String(), res.PendingChanges[0].ValidatorAddress)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 350), res.PendingChanges[0].TargetTokens)
+	require.Equal(2*period, res.PendingChanges[0].Height)
+
+	// a new sync replaces the pending changes, the limit is shared by the
+	// validators by their part of the change and skipped syncs keep applying
+	// it
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.NewInt(400)))
+	params.MaxPowerChangeRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	sync(3*period, stakes(60, 250))
+	requireTokens(80, 230)
+	sync(4*period, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH})
+	requireTokens(60, 250)
+
+	// a rate truncating to zero tokens still moves one consensus power
+	params.MaxPowerChangeRate = math.LegacySmallestDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+	sync(5*period, stakes(70, 250))
+	requireTokens(61, 250)
+	limited = eventAttributes(ctx, stakingtypes.EventTypeSymbioticPowerChangeLimited)
+	require.Len(limited, 1)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 1).String(), limited[0][stakingtypes.AttributeKeyAppliedTokens])
+
+	// a change whose share of the limit is below a token still moves
+	oneToken := keeper.TokensFromConsensusPower(ctx, 250).AddRaw(1)
+	sync(6*period, stakingtypes.CachedBlockHash{BlockHash: "0x01", Attested: true, Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+		symbioticValidator(sdk.ConsAddress(PKs[0].Address()), keeper.TokensFromConsensusPower(ctx, 70)),
+		symbioticValidator(sdk.ConsAddress(PKs[1].Address()), oneToken),
+	})})
+	validator, err := keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 62), validator.Tokens)
+	validator, err = keeper.GetValidator(ctx, valAddrs[1])
+	require.NoError(err)
+	require.Equal(oneToken, validator.Tokens)
+
+	// without limit the changes are applied at once
+	params.MaxPowerChangeRate = math.LegacyZeroDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+	sync(7*period, stakes(90, 300))
+	requireTokens(90, 300)
+	res, err = querier.SymbioticPendingPowerChanges(ctx, &stakingtypes.QuerySymbioticPendingPowerChangesRequest{})
+	require.NoError(err)
+	require.Empty(res.PendingChanges)
+}
+
+func (s *KeeperTestSuite) TestSymbioticStakeHooksAndEvents() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, valPubKey)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MiddlewareAddress = testMiddlewareAddress
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	keeper.SetHooks(hooks)
+
+	unknownPubKey := PKs[1]
+	stake := keeper.TokensFromConsensusPower(ctx, 42)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: stakingtypes.DefaultSymbioticSyncPeriod, Time: ctx.HeaderInfo().Time})
+
+	// a skipped sync only calls the sync hook
+	hooks.EXPECT().AfterSymbioticSync(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record stakingtypes.SymbioticSyncRecord) error {
+		require.Equal(stakingkeeper.INVALID_BLOCKHASH, record.BlockHash)
+		return nil
+	})
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{BlockHash: stakingkeeper.INVALID_BLOCKHASH, Height: ctx.HeaderInfo().Height}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	// an applied sync calls the stake hook of the changed validators first
+	gomock.InOrder(
+		hooks.EXPECT().AfterSymbioticStakeChanged(gomock.Any(), valAddr, math.ZeroInt(), stake).Return(nil),
+		hooks.EXPECT().AfterSymbioticSync(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record stakingtypes.SymbioticSyncRecord) error {
+			require.Equal("0x01", record.BlockHash)
+			require.Len(record.Stakes, 2)
+			return nil
+		}),
+	)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.CacheBlockHash(ctx, stakingtypes.CachedBlockHash{
+		BlockHash: "0x01",
+		Height:    ctx.HeaderInfo().Height,
+		Attested:  true,
+		Validators: stakingtypes.NewSymbioticValidatorStakes([]stakingtypes.SymbioticValidator{
+			symbioticValidator(sdk.ConsAddress(valPubKey.Address()), stake),
+			symbioticValidator(sdk.ConsAddress(unknownPubKey.Address()), stake),
+		}),
+	}))
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+
+	updated := eventAttributes(ctx, stakingtypes.EventTypeSymbioticStakeUpdated)
+	require.Len(updated, 1)
+	require.Equal(s.valAddressToString(valAddr), updated[0][stakingtypes.AttributeKeyValidator])
+	require.Equal("0", updated[0][stakingtypes.AttributeKeyPreviousStake])
+	require.Equal(stake.String(), updated[0][stakingtypes.AttributeKeyStake])
+
+	unknown := eventAttributes(ctx, stakingtypes.EventTypeSymbioticOperatorUnknown)
+	require.Len(unknown, 1)
+	unknownConsAddr, err := keeper.ConsensusAddressCodec().BytesToString(unknownPubKey.Address())
+	require.NoError(err)
+	require.Equal(unknownConsAddr, unknown[0][stakingtypes.AttributeKeyConsAddress])
+	require.Equal(stake.String(), unknown[0][stakingtypes.AttributeKeyStake])
+
+	// an unchanged stake does not call the stake hook
+	hooks.EXPECT().AfterSymbioticSync(gomock.Any(), gomock.Any()).Return(nil)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.SymbioticUpdateValidatorsPower(ctx))
+	require.Empty(eventAttributes(ctx, stakingtypes.EventTypeSymbioticStakeUpdated))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Outcomes of the symbiotic_sync telemetry counter.
+const (
+	// SyncOutcomeSuccess counts sync heights applying an attested validator set.
+	SyncOutcomeSuccess = "success"
+	// SyncOutcomeSkip counts sync heights skipped with INVALID_BLOCKHASH.
+	SyncOutcomeSkip = "skip"
+	// SyncOutcomeRetry counts data source request retries.
+	SyncOutcomeRetry = "retry"
+	// SyncOutcomeFailure counts data source failures of ExtendVote and
+	// PrepareProposal, once retries are exhausted.
+	SyncOutcomeFailure = "failure"
+	// SyncOutcomeHalt counts sync failures halting the node.
+	SyncOutcomeHalt = "halt"
+)
+
+func incrSyncCounter(outcome string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "symbiotic_sync"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("outcome", outcome)},
+	)
+}
+
+// IncrSyncCounter increments the symbiotic_sync telemetry counter for the
+// given outcome.
+func (k Keeper) IncrSyncCounter(outcome string) {
+	incrSyncCounter(outcome)
+}
+
+// HandleSyncFailure applies the configured on-sync-failure policy to a sync
+// skipped in EndBlock. With the skip policy the chain goes on with the current
+// stakes, with the halt policy the node is halted. It is only called on the
+// consensus path so that every node halts at the same height, a failing
+// endpoint in ExtendVote or PrepareProposal is only logged and counted.
+func (k Keeper) HandleSyncFailure(err error) {
+	if k.symbioticConfig.OnSyncFailure == types.SyncFailureHalt {
+		k.Halt(err)
+	}
+}
+
+// Halt requests the node to stop because of err. The error is delivered on
+// HaltCh, the server is expected to shut down gracefully and exit with a
+// non-zero code. Only the first error is kept.
+func (k Keeper) Halt(err error) {
+	k.Logger.Error("symbiotic sync failure, halting node", "err", err)
+	incrSyncCounter(SyncOutcomeHalt)
+
+	select {
+	case k.haltCh <- err:
+	default:
+	}
+}
+
+// HaltCh returns the channel the halt errors are delivered on.
+func (k Keeper) HaltCh() <-chan error {
+	return k.haltCh
+}
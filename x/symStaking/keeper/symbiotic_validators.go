@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// registerSymbioticValidator creates the validator of a middleware validator
+// set entry reporting a consensus public key. Its operator address is the
+// middleware operator, or its consensus address if the middleware reports
+// none. Nobody holds an account key for it: the operator signs the messages of
+// the validator with its Ethereum key through MsgOperatorExec.
+func (k *Keeper) registerSymbioticValidator(ctx context.Context, v stakingtypes.SymbioticValidator, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+	pk, err := v.PubKey()
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.validateConsPubKeyType(ctx, pk); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	valAddr := sdk.ValAddress(consAddr)
+	if len(v.Operator) != 0 {
+		valAddr = sdk.ValAddress(v.Operator)
+	}
+
+	if _, err := k.GetValidator(ctx, valAddr); err == nil {
+		return stakingtypes.Validator{}, stakingtypes.ErrValidatorOwnerExists
+	} else if !errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return stakingtypes.Validator{}, err
+	}
+
+	valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	moniker := valAddrStr
+	if len(v.Operator) != 0 {
+		moniker = "0x" + hex.EncodeToString(v.Operator)
+	}
+
+	validator, err := stakingtypes.NewValidator(valAddrStr, pk, stakingtypes.Description{Moniker: moniker})
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	// the commission is fixed to the minimum, the operator did not set any
+	minCommRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+	commission := stakingtypes.NewCommissionWithTime(minCommRate, minCommRate, math.LegacyZeroDec(), k.HeaderService.HeaderInfo(ctx).Time)
+	validator, err = validator.SetInitialCommission(commission)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.SetNewValidatorByPowerIndex(ctx, validator); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	// the middleware entry binds the validator to its operator
+	if len(v.Operator) == common.AddressLength {
+		if err := k.OperatorBindings.Set(ctx, valAddr, stakingtypes.OperatorBinding{
+			ValidatorAddress: valAddrStr,
+			Operator:         common.BytesToAddress(v.Operator).Hex(),
+		}); err != nil {
+			return stakingtypes.Validator{}, err
+		}
+	}
+
+	if err := k.Hooks().AfterValidatorCreated(ctx, valAddr); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	k.Logger.Info("registered symbiotic validator", "validator", valAddrStr, "cons_address", consAddr)
+
+	return validator, k.EventService.EventManager(ctx).EmitKV(
+		stakingtypes.EventTypeCreateValidator,
+		event.NewAttribute(stakingtypes.AttributeKeyValidator, valAddrStr),
+	)
+}
+
+// removeMissingSymbioticValidators sets a zero power target on the validators
+// whose consensus address is not in the middleware validator set. Once their
+// tokens are zeroed the end block validator set update moves the bonded ones
+// through the unbonding queue, after which they are removed.
+func (k *Keeper) removeMissingSymbioticValidators(ctx context.Context, matched map[string]struct{}, height int64) error {
+	validators, err := k.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range validators {
+		if val.Tokens.IsZero() {
+			continue
+		}
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := matched[string(consAddr)]; ok {
+			continue
+		}
+
+		if err := k.setSymbioticPowerTarget(ctx, val, math.ZeroInt(), height); err != nil {
+			return err
+		}
+
+		k.Logger.Info("symbiotic validator missing from the validator set", "validator", val.OperatorAddress)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			stakingtypes.EventTypeSymbioticRemoveValidator,
+			event.NewAttribute(stakingtypes.AttributeKeyValidator, val.OperatorAddress),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
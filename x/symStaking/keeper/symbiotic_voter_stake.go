@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/symStaking/types"
+)
+
+// voterStakeRequestRetention is the number of sync periods a voter stake
+// request is kept for, resolved or not.
+const voterStakeRequestRetention = 10
+
+// RequestSymbioticVoterStake requests the stake voter backs operator with
+// through vault at the given execution block hash. Validators read it in the
+// vote extensions of the next syncs, GetSymbioticVoterStake returns it once
+// more than 2/3 of the voting power agreed on it. Requesting a stake already
+// requested is a no-op. The addresses are 20 bytes Ethereum addresses.
+func (k *Keeper) RequestSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) error {
+	for _, addr := range [][]byte{vault, operator, voter} {
+		if len(addr) != common.AddressLength {
+			return fmt.Errorf("invalid ethereum address length %d", len(addr))
+		}
+	}
+
+	id := stakingtypes.VoterStakeRequestID(blockHash, vault, operator, voter)
+	has, err := k.VoterStakeRequests.Has(ctx, id)
+	if err != nil || has {
+		return err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if err := k.VoterStakeRequests.Set(ctx, id, stakingtypes.SymbioticVoterStakeRequest{
+		BlockHash: blockHash,
+		Vault:     vault,
+		Operator:  operator,
+		Voter:     voter,
+		Height:    height,
+		Stake:     math.ZeroInt(),
+	}); err != nil {
+		return err
+	}
+
+	return k.VoterStakeRequestsByHeight.Set(ctx, collections.Join(height, id))
+}
+
+// GetSymbioticVoterStake returns the stake voter backs operator with through
+// vault at the given execution block hash, as agreed on in the vote
+// extensions of a sync. It returns ErrSymbioticPending if the stake was
+// requested but not agreed on yet, ErrSymbioticNotFound if it was not
+// requested or its request expired.
+func (k *Keeper) GetSymbioticVoterStake(ctx context.Context, blockHash string, vault, operator, voter []byte) (math.Int, error) {
+	req, err := k.VoterStakeRequests.Get(ctx, stakingtypes.VoterStakeRequestID(blockHash, vault, operator, voter))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.Int{}, stakingtypes.ErrSymbioticNotFound
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if !req.Resolved {
+		return math.Int{}, stakingtypes.ErrSymbioticPending
+	}
+
+	return req.Stake, nil
+}
+
+// PendingSymbioticVoterStakeRequests returns at most limit voter stake
+// requests not resolved yet, the oldest first.
+func (k *Keeper) PendingSymbioticVoterStakeRequests(ctx context.Context, limit int) ([]stakingtypes.SymbioticVoterStakeRequest, error) {
+	var pending []stakingtypes.SymbioticVoterStakeRequest
+	err := k.VoterStakeRequestsByHeight.Walk(ctx, nil, func(key collections.Pair[int64, []byte]) (bool, error) {
+		req, err := k.VoterStakeRequests.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if !req.Resolved {
+			pending = append(pending, req)
+		}
+		return len(pending) >= limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// ReadSymbioticVoterStake reads the stake of a voter stake request from the
+// middleware set in params. It queries Ethereum and must only be called
+// outside of the state machine, when extending votes.
+func (k *Keeper) ReadSymbioticVoterStake(ctx context.Context, req stakingtypes.SymbioticVoterStakeRequest) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if params.MiddlewareAddress == "" {
+		return math.Int{}, errors.New("symbiotic middleware address is not set")
+	}
+
+	stake, err := k.dataSource.GetVoterStake(ctx, params.MiddlewareAddress, req.BlockHash,
+		common.BytesToAddress(req.Vault), common.BytesToAddress(req.Operator), common.BytesToAddress(req.Voter))
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return math.NewIntFromBigInt(stake), nil
+}
+
+// applySymbioticVoterStakes drops the voter stake requests made more than
+// voterStakeRequestRetention sync periods ago, then resolves the pending
+// requests with the voter stakes agreed on at height. Stakes of unknown or
+// resolved requests are ignored.
+func (k *Keeper) applySymbioticVoterStakes(ctx context.Context, params stakingtypes.Params, height int64, voterStakes []stakingtypes.SymbioticVoterStake) error {
+	expired := height - voterStakeRequestRetention*params.SymbioticSyncPeriod
+	iter, err := k.VoterStakeRequestsByHeight.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, []byte](expired-1))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.VoterStakeRequests.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.VoterStakeRequestsByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, voterStake := range voterStakes {
+		req, err := k.VoterStakeRequests.Get(ctx, voterStake.RequestId)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.Resolved {
+			continue
+		}
+
+		req.Resolved = true
+		req.Stake = voterStake.Stake
+		if err := k.VoterStakeRequests.Set(ctx, voterStake.RequestId, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,12 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	gogotypes "github.com/cosmos/gogoproto/types"
-	"os"
 	"sort"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	types "cosmossdk.io/x/symStaking/types"
 
@@ -23,9 +24,18 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 	// Calculate validator set changes.
 	//
 	if err := k.SymbioticUpdateValidatorsPower(ctx); err != nil {
-		k.Logger.Error("Symbiotic val update panic", "err", err)
-		os.Exit(0) // TODO somehow fix, just to restart on failure, panic will be recovered
+		// the block cannot be finalized without the Symbiotic validator set,
+		// the error stops the node through the ABCI error path
+		err = errorsmod.Wrap(types.ErrSymbioticValUpdate, err.Error())
+		k.Halt(err)
+		return nil, err
+	}
+
+	safetyMode, err := k.updateSymbioticSafetyMode(ctx)
+	if err != nil {
+		return nil, err
 	}
+
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
 	// UnbondAllMatureValidatorQueue.
 	// This fixes a bug when the unbonding period is instant (is the case in
@@ -33,9 +43,15 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
-	validatorUpdates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
-	if err != nil {
-		return nil, err
+	//
+	// While the stake is stale the validator set is frozen, the last validator
+	// powers keep the changes for the first block the stake is fresh again.
+	var validatorUpdates []appmodule.ValidatorUpdate
+	if safetyMode != types.StaleStakeActionHaltValidatorUpdates {
+		validatorUpdates, err = k.ApplyAndReturnValidatorSetUpdates(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// unbond all mature validators from the unbonding queue
@@ -76,6 +92,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		return nil, err
 	}
 
+	// Retrieve the consensus key rotations CometBFT does not know about yet.
+	rotations, err := k.consPubKeyRotationUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
@@ -130,8 +152,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// a validator which rotated its key is replaced by its new key
+		rotation, rotated := rotations[valAddrStr]
+		if found && rotated {
+			update, err := consPubKeyRotationUpdateZero(rotation)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or key has changed
+		if !found || rotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ModuleValidatorUpdate(powerReduction))
 			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
 				return nil, err
@@ -166,9 +198,23 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 			return nil, err
 		}
 
+		// CometBFT does not know the new key of a validator which rotated it
+		if rotation, rotated := rotations[validator.GetOperator()]; rotated {
+			update, err := consPubKeyRotationUpdateZero(rotation)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+			continue
+		}
+
 		updates = append(updates, validator.ModuleValidatorUpdateZero())
 	}
 
+	if err = k.ConsPubKeyRotationUpdates.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
 	// set total power on lookup index if there are any updates
 	if len(updates) > 0 {
 		if err = k.LastTotalPower.Set(ctx, totalPower); err != nil {
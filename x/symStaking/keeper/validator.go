@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -14,10 +15,13 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	consensusv1 "cosmossdk.io/x/consensus/types"
 	"cosmossdk.io/x/symStaking/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var timeBzKeySize = uint64(29) // time bytes key size is 29 by default
@@ -42,10 +46,17 @@ func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAdd
 		if !errors.Is(err, collections.ErrNotFound) {
 			return types.Validator{}, err
 		}
-	}
 
-	if opAddr == nil {
-		return types.Validator{}, types.ErrNoValidatorFound
+		newConsAddr, err := k.OldToNewConsAddrMap.Get(ctx, consAddr)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.Validator{}, types.ErrNoValidatorFound
+			}
+			return types.Validator{}, err
+		}
+
+		// the new address may have been rotated away from as well
+		return k.GetValidatorByConsAddr(ctx, newConsAddr)
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -226,6 +237,10 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err = k.PendingConsPubKeyRotations.Remove(ctx, address); err != nil {
+		return err
+	}
+
 	if err = store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx), k.validatorAddressCodec)); err != nil {
 		return err
 	}
@@ -361,6 +376,11 @@ func (k Keeper) IterateLastValidatorPowers(ctx context.Context, handler func(ope
 	return nil
 }
 
+// GetLastTotalPower loads the last total power of the bonded validators.
+func (k Keeper) GetLastTotalPower(ctx context.Context) (math.Int, error) {
+	return k.LastTotalPower.Get(ctx)
+}
+
 // GetLastValidators gets the group of the bonded validators
 func (k Keeper) GetLastValidators(ctx context.Context) (validators []types.Validator, err error) {
 	// add the actual validator power sorted store
@@ -597,3 +617,30 @@ func (k Keeper) TotalBondedTokens(ctx context.Context) (math.Int, error) {
 	}
 	return k.TokensFromConsensusPower(ctx, lastTotalPower.Int64()), nil
 }
+
+// validateConsPubKeyType checks that the consensus params allow validators
+// with the given consensus public key type.
+func (k Keeper) validateConsPubKeyType(ctx context.Context, pk cryptotypes.PubKey) error {
+	res := consensusv1.QueryParamsResponse{}
+	if err := k.QueryRouterService.InvokeTyped(ctx, &consensusv1.QueryParamsRequest{}, &res); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to query consensus params: %s", err)
+	}
+	if res.Params.Validator != nil {
+		pkType := pk.Type()
+		if !slices.Contains(res.Params.Validator.PubKeyTypes, pkType) {
+			return errorsmod.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), res.Params.Validator.PubKeyTypes,
+			)
+		}
+
+		if pkType == sdk.PubKeyEd25519Type && len(pk.Bytes()) != ed25519.PubKeySize {
+			return errorsmod.Wrapf(
+				types.ErrConsensusPubKeyLenInvalid,
+				"got: %d, expected: %d", len(pk.Bytes()), ed25519.PubKeySize,
+			)
+		}
+	}
+
+	return nil
+}
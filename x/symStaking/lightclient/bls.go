@@ -0,0 +1,93 @@
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	blsPubkeySize    = 48
+	blsSignatureSize = 96
+)
+
+// blsDST is the hash to curve domain separation tag of the Ethereum BLS
+// signature scheme.
+var blsDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// committee is a sync committee with its decoded public keys.
+type committee struct {
+	root    common.Hash
+	pubkeys []bls12381.G1Affine
+}
+
+func newCommittee(c SyncCommittee) (*committee, error) {
+	root, err := c.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([]bls12381.G1Affine, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		if _, err := pubkeys[i].SetBytes(pk); err != nil {
+			return nil, fmt.Errorf("invalid sync committee pubkey %d: %w", i, err)
+		}
+	}
+
+	return &committee{root: root, pubkeys: pubkeys}, nil
+}
+
+// verify checks the aggregate signature of the participating members over
+// the signing root. At least SyncCommitteeSupermajority members must have
+// signed.
+func (c *committee) verify(bits []byte, signature []byte, signingRoot common.Hash) error {
+	if len(bits) != SyncCommitteeSize/8 {
+		return fmt.Errorf("invalid sync committee bits length %d", len(bits))
+	}
+	if len(signature) != blsSignatureSize {
+		return fmt.Errorf("invalid sync committee signature length %d", len(signature))
+	}
+
+	var (
+		aggregate    bls12381.G1Jac
+		participants int
+	)
+	for i := range c.pubkeys {
+		if bits[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		aggregate.AddMixed(&c.pubkeys[i])
+		participants++
+	}
+	if participants < SyncCommitteeSupermajority {
+		return fmt.Errorf("sync committee participation %d below %d", participants, SyncCommitteeSupermajority)
+	}
+
+	var sig bls12381.G2Affine
+	if _, err := sig.SetBytes(signature); err != nil {
+		return fmt.Errorf("invalid sync committee signature: %w", err)
+	}
+
+	msg, err := bls12381.HashToG2(signingRoot[:], blsDST)
+	if err != nil {
+		return err
+	}
+
+	var pk, negG1 bls12381.G1Affine
+	pk.FromJacobian(&aggregate)
+	_, _, g1, _ := bls12381.Generators()
+	negG1.Neg(&g1)
+
+	// e(pk, H(m)) == e(g1, sig)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{pk, negG1}, []bls12381.G2Affine{msg, sig})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("invalid sync committee signature")
+	}
+
+	return nil
+}
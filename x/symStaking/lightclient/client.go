@@ -0,0 +1,227 @@
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxUpdatesPerRequest is the largest number of sync committee updates the
+// beacon API serves per request.
+const maxUpdatesPerRequest = 128
+
+// ErrInvalidUpdate is returned when beacon data fails verification.
+var ErrInvalidUpdate = errors.New("invalid light client data")
+
+// BeaconAPI is the beacon light client API subset used by the client. Nothing
+// it returns is trusted.
+type BeaconAPI interface {
+	// Bootstrap returns the light client bootstrap at a block root.
+	Bootstrap(ctx context.Context, blockRoot common.Hash) (LightClientBootstrap, error)
+	// Updates returns the best update of count sync committee periods from
+	// startPeriod on.
+	Updates(ctx context.Context, startPeriod, count uint64) ([]LightClientUpdate, error)
+	// FinalityUpdate returns the latest finality update.
+	FinalityUpdate(ctx context.Context) (LightClientUpdate, error)
+}
+
+// Client is a beacon chain sync committee light client. Starting from a
+// trusted checkpoint block root it follows the sync committees and tracks the
+// latest finalized header.
+type Client struct {
+	network    Network
+	checkpoint common.Hash
+	api        BeaconAPI
+
+	mu         sync.Mutex
+	committees map[uint64]*committee
+	finalized  *LightClientHeader
+}
+
+// NewClient returns a light client bootstrapped from the checkpoint block root
+// on first use.
+func NewClient(network Network, checkpoint common.Hash, api BeaconAPI) *Client {
+	return &Client{
+		network:    network,
+		checkpoint: checkpoint,
+		api:        api,
+		committees: make(map[uint64]*committee),
+	}
+}
+
+// Finalized advances the client to the latest finality update and returns the
+// finalized header.
+func (c *Client) Finalized(ctx context.Context) (LightClientHeader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.finalized == nil {
+		if err := c.bootstrap(ctx); err != nil {
+			return LightClientHeader{}, err
+		}
+	}
+
+	update, err := c.api.FinalityUpdate(ctx)
+	if err != nil {
+		return LightClientHeader{}, err
+	}
+	if err := c.syncCommittees(ctx, syncPeriod(update.SignatureSlot)); err != nil {
+		return LightClientHeader{}, err
+	}
+	if err := c.applyUpdate(update); err != nil {
+		return LightClientHeader{}, err
+	}
+
+	return *c.finalized, nil
+}
+
+// bootstrap verifies the bootstrap of the checkpoint and trusts its sync
+// committee.
+func (c *Client) bootstrap(ctx context.Context) error {
+	bootstrap, err := c.api.Bootstrap(ctx, c.checkpoint)
+	if err != nil {
+		return err
+	}
+
+	header := bootstrap.Header
+	if root := header.Beacon.HashTreeRoot(); root != c.checkpoint {
+		return fmt.Errorf("%w: bootstrap header %s does not match checkpoint %s", ErrInvalidUpdate, root, c.checkpoint)
+	}
+	if err := c.verifyExecution(header); err != nil {
+		return err
+	}
+
+	current, err := newCommittee(bootstrap.CurrentSyncCommittee)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidUpdate, err)
+	}
+	gindex := c.network.currentSyncCommitteeGindex(header.Beacon.Slot)
+	if err := verifyBranch(header.Beacon.StateRoot, gindex, bootstrap.CurrentSyncCommitteeBranch, current.root); err != nil {
+		return fmt.Errorf("%w: current sync committee: %w", ErrInvalidUpdate, err)
+	}
+
+	c.committees[syncPeriod(header.Beacon.Slot)] = current
+	c.finalized = &header
+
+	return nil
+}
+
+// syncCommittees fetches and applies updates until the committee of period
+// is known.
+func (c *Client) syncCommittees(ctx context.Context, period uint64) error {
+	for {
+		next := syncPeriod(c.finalized.Beacon.Slot)
+		for c.committees[next+1] != nil {
+			next++
+		}
+		if next >= period {
+			return nil
+		}
+
+		count := min(period-next, maxUpdatesPerRequest)
+		updates, err := c.api.Updates(ctx, next, count)
+		if err != nil {
+			return err
+		}
+		if len(updates) == 0 {
+			return fmt.Errorf("%w: no update for sync committee period %d", ErrInvalidUpdate, next)
+		}
+		for _, update := range updates {
+			if err := c.applyUpdate(update); err != nil {
+				return err
+			}
+		}
+		if c.committees[next+1] == nil {
+			return fmt.Errorf("%w: no next sync committee for period %d", ErrInvalidUpdate, next)
+		}
+	}
+}
+
+// applyUpdate verifies an update against the known sync committees, learns
+// its next sync committee and advances the finalized header.
+func (c *Client) applyUpdate(update LightClientUpdate) error {
+	attested := update.AttestedHeader
+	finalized := update.FinalizedHeader
+
+	if update.SignatureSlot <= attested.Beacon.Slot {
+		return fmt.Errorf("%w: signature slot %d not after attested slot %d", ErrInvalidUpdate, update.SignatureSlot, attested.Beacon.Slot)
+	}
+	if attested.Beacon.Slot < finalized.Beacon.Slot {
+		return fmt.Errorf("%w: attested slot %d before finalized slot %d", ErrInvalidUpdate, attested.Beacon.Slot, finalized.Beacon.Slot)
+	}
+
+	signaturePeriod := syncPeriod(update.SignatureSlot)
+	current, ok := c.committees[signaturePeriod]
+	if !ok {
+		return fmt.Errorf("%w: unknown sync committee for period %d", ErrInvalidUpdate, signaturePeriod)
+	}
+
+	if err := c.verifyExecution(attested); err != nil {
+		return err
+	}
+
+	hasFinality := finalized.Beacon != (BeaconBlockHeader{})
+	if hasFinality {
+		gindex := c.network.finalizedRootGindex(attested.Beacon.Slot)
+		if err := verifyBranch(attested.Beacon.StateRoot, gindex, update.FinalityBranch, finalized.Beacon.HashTreeRoot()); err != nil {
+			return fmt.Errorf("%w: finality branch: %w", ErrInvalidUpdate, err)
+		}
+		if err := c.verifyExecution(finalized); err != nil {
+			return err
+		}
+	}
+
+	signingRoot := c.network.signingRoot(attested.Beacon.HashTreeRoot(), update.SignatureSlot)
+	if err := current.verify(update.SyncAggregate.SyncCommitteeBits, update.SyncAggregate.SyncCommitteeSignature, signingRoot); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidUpdate, err)
+	}
+
+	attestedPeriod := syncPeriod(attested.Beacon.Slot)
+	if update.NextSyncCommittee != nil && attestedPeriod == signaturePeriod {
+		next, err := newCommittee(*update.NextSyncCommittee)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidUpdate, err)
+		}
+		gindex := c.network.nextSyncCommitteeGindex(attested.Beacon.Slot)
+		if err := verifyBranch(attested.Beacon.StateRoot, gindex, update.NextSyncCommitteeBranch, next.root); err != nil {
+			return fmt.Errorf("%w: next sync committee: %w", ErrInvalidUpdate, err)
+		}
+		if known, ok := c.committees[attestedPeriod+1]; ok && known.root != next.root {
+			return fmt.Errorf("%w: conflicting sync committee for period %d", ErrInvalidUpdate, attestedPeriod+1)
+		}
+		c.committees[attestedPeriod+1] = next
+	}
+
+	if hasFinality && finalized.Beacon.Slot > c.finalized.Beacon.Slot {
+		c.finalized = &finalized
+		for period := range c.committees {
+			if period+1 < syncPeriod(finalized.Beacon.Slot) {
+				delete(c.committees, period)
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyExecution checks the execution payload header of a light client
+// header against its beacon block body.
+func (c *Client) verifyExecution(header LightClientHeader) error {
+	slot := header.Beacon.Slot
+	if !c.network.activeAt("capella", slot) {
+		return fmt.Errorf("%w: header at slot %d predates capella", ErrInvalidUpdate, slot)
+	}
+
+	root, err := header.Execution.HashTreeRoot(c.network.activeAt("deneb", slot))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidUpdate, err)
+	}
+	if err := verifyBranch(header.Beacon.BodyRoot, gindexExecutionPayload, header.ExecutionBranch, root); err != nil {
+		return fmt.Errorf("%w: execution branch: %w", ErrInvalidUpdate, err)
+	}
+
+	return nil
+}
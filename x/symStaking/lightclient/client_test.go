@@ -0,0 +1,322 @@
+package lightclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var testNetwork = Network{
+	GenesisValidatorsRoot: common.Hash{0x42},
+	Forks: []Fork{
+		{Name: "altair", Epoch: 0, Version: [4]byte{0x01}},
+		{Name: "bellatrix", Epoch: 0, Version: [4]byte{0x02}},
+		{Name: "capella", Epoch: 0, Version: [4]byte{0x03}},
+		{Name: "deneb", Epoch: 0, Version: [4]byte{0x04}},
+		{Name: "electra", Epoch: 1 << 32, Version: [4]byte{0x05}},
+	},
+	ChainConfig: params.MainnetChainConfig,
+}
+
+// testCommittee is a sync committee with known secret keys.
+type testCommittee struct {
+	secrets   []*big.Int
+	committee SyncCommittee
+}
+
+func newTestCommittee(offset int64) testCommittee {
+	var c testCommittee
+	var aggregate bls12381.G1Jac
+	for i := int64(0); i < SyncCommitteeSize; i++ {
+		sk := big.NewInt(offset + i + 1)
+		var pk bls12381.G1Affine
+		pk.ScalarMultiplicationBase(sk)
+		aggregate.AddMixed(&pk)
+
+		c.secrets = append(c.secrets, sk)
+		b := pk.Bytes()
+		c.committee.Pubkeys = append(c.committee.Pubkeys, b[:])
+	}
+	var aggregatePk bls12381.G1Affine
+	aggregatePk.FromJacobian(&aggregate)
+	b := aggregatePk.Bytes()
+	c.committee.AggregatePubkey = b[:]
+	return c
+}
+
+// sign returns the sync aggregate of the first signers members.
+func (c testCommittee) sign(t *testing.T, signingRoot common.Hash, signers int) SyncAggregate {
+	t.Helper()
+
+	msg, err := bls12381.HashToG2(signingRoot[:], blsDST)
+	require.NoError(t, err)
+
+	bits := make([]byte, SyncCommitteeSize/8)
+	sk := new(big.Int)
+	for i := 0; i < signers; i++ {
+		bits[i/8] |= 1 << (i % 8)
+		sk.Add(sk, c.secrets[i])
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&msg, sk)
+	b := sig.Bytes()
+
+	return SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: b[:]}
+}
+
+// branchRoot returns a root and a branch proving leaf at gindex in it.
+func branchRoot(leaf common.Hash, gindex uint64) (common.Hash, []common.Hash) {
+	var branch []common.Hash
+	node := leaf
+	for ; gindex > 1; gindex /= 2 {
+		sibling := common.Hash{byte(gindex), 0xbb}
+		branch = append(branch, sibling)
+		if gindex%2 == 0 {
+			node = sha256.Sum256(append(node[:], sibling[:]...))
+		} else {
+			node = sha256.Sum256(append(sibling[:], node[:]...))
+		}
+	}
+	return node, branch
+}
+
+// testLightClientHeader returns a light client header at slot whose
+// execution payload is block.
+func testLightClientHeader(t *testing.T, slot uint64, block *ethtypes.Header, stateRoot common.Hash) LightClientHeader {
+	t.Helper()
+
+	execution := ExecutionPayloadHeader{
+		LogsBloom:     make([]byte, 256),
+		BaseFeePerGas: (*gethmath.HexOrDecimal256)(big.NewInt(7)),
+	}
+	if block != nil {
+		execution.BlockHash = block.Hash()
+		execution.BlockNumber = block.Number.Uint64()
+		execution.StateRoot = block.Root
+	}
+	root, err := execution.HashTreeRoot(true)
+	require.NoError(t, err)
+	bodyRoot, branch := branchRoot(root, gindexExecutionPayload)
+
+	return LightClientHeader{
+		Beacon: BeaconBlockHeader{
+			Slot:      slot,
+			StateRoot: stateRoot,
+			BodyRoot:  bodyRoot,
+		},
+		Execution:       execution,
+		ExecutionBranch: branch,
+	}
+}
+
+type fakeBeacon struct {
+	bootstrap LightClientBootstrap
+	updates   map[uint64]LightClientUpdate
+	finality  LightClientUpdate
+}
+
+func (f *fakeBeacon) Bootstrap(_ context.Context, blockRoot common.Hash) (LightClientBootstrap, error) {
+	if f.bootstrap.Header.Beacon.HashTreeRoot() != blockRoot {
+		return LightClientBootstrap{}, errors.New("not found")
+	}
+	return f.bootstrap, nil
+}
+
+func (f *fakeBeacon) Updates(_ context.Context, startPeriod, count uint64) ([]LightClientUpdate, error) {
+	var res []LightClientUpdate
+	for p := startPeriod; p < startPeriod+count; p++ {
+		if u, ok := f.updates[p]; ok {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeBeacon) FinalityUpdate(context.Context) (LightClientUpdate, error) {
+	return f.finality, nil
+}
+
+// testChain is a light client scenario: a checkpoint in period 1, a sync
+// committee update to period 2 and a finality update signed in period 2.
+type testChain struct {
+	checkpoint common.Hash
+	beacon     *fakeBeacon
+	execution  *fakeExecution
+	blocks     []*ethtypes.Header
+	next       testCommittee
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	current, next := newTestCommittee(0), newTestCommittee(SyncCommitteeSize)
+	period := uint64(SlotsPerSyncCommitteePeriod)
+
+	// bootstrap
+	currentRoot, err := current.committee.HashTreeRoot()
+	require.NoError(t, err)
+	stateRoot, committeeBranch := branchRoot(currentRoot, gindexCurrentSyncCommittee)
+	checkpoint := testLightClientHeader(t, period+10, nil, stateRoot)
+
+	// update of period 1 handing over to the next committee
+	nextRoot, err := next.committee.HashTreeRoot()
+	require.NoError(t, err)
+	stateRoot, nextBranch := branchRoot(nextRoot, gindexNextSyncCommittee)
+	attested := testLightClientHeader(t, period+100, nil, stateRoot)
+	update := LightClientUpdate{
+		AttestedHeader:          attested,
+		NextSyncCommittee:       &next.committee,
+		NextSyncCommitteeBranch: nextBranch,
+		SignatureSlot:           period + 101,
+	}
+	update.SyncAggregate = current.sign(t, testNetwork.signingRoot(attested.Beacon.HashTreeRoot(), update.SignatureSlot), SyncCommitteeSize)
+
+	// finality update signed by the next committee
+	execution, executionRoot := newFakeExecution(t, common.HexToHash("0x2a"))
+	var blocks []*ethtypes.Header
+	parent := common.Hash{}
+	for number := uint64(100); number < 104; number++ {
+		block := testHeader(number, parent, executionRoot)
+		raw, err := json.Marshal(block)
+		require.NoError(t, err)
+		execution.headers[block.Hash()] = raw
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+
+	finalized := testLightClientHeader(t, 2*period+10, blocks[len(blocks)-1], common.Hash{})
+	stateRoot, finalityBranch := branchRoot(finalized.Beacon.HashTreeRoot(), gindexFinalizedRoot)
+	attested = testLightClientHeader(t, 2*period+80, nil, stateRoot)
+	finality := LightClientUpdate{
+		AttestedHeader:  attested,
+		FinalizedHeader: finalized,
+		FinalityBranch:  finalityBranch,
+		SignatureSlot:   2*period + 81,
+	}
+	finality.SyncAggregate = next.sign(t, testNetwork.signingRoot(attested.Beacon.HashTreeRoot(), finality.SignatureSlot), SyncCommitteeSupermajority)
+
+	return &testChain{
+		checkpoint: checkpoint.Beacon.HashTreeRoot(),
+		beacon: &fakeBeacon{
+			bootstrap: LightClientBootstrap{
+				Header:                     checkpoint,
+				CurrentSyncCommittee:       current.committee,
+				CurrentSyncCommitteeBranch: committeeBranch,
+			},
+			updates:  map[uint64]LightClientUpdate{1: update},
+			finality: finality,
+		},
+		execution: execution,
+		blocks:    blocks,
+		next:      next,
+	}
+}
+
+func TestClientFinalized(t *testing.T) {
+	chain := newTestChain(t)
+	client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+
+	finalized, err := client.Finalized(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, chain.beacon.finality.FinalizedHeader.Beacon, finalized.Beacon)
+	require.Equal(t, chain.blocks[len(chain.blocks)-1].Hash(), finalized.Execution.BlockHash)
+
+	// the committee of period 2 was learned from the update of period 1
+	require.Contains(t, client.committees, uint64(2))
+}
+
+func TestClientRejectsInvalidData(t *testing.T) {
+	t.Run("checkpoint mismatch", func(t *testing.T) {
+		chain := newTestChain(t)
+		chain.beacon.bootstrap.Header.Beacon.ProposerIndex = 1
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("forged committee", func(t *testing.T) {
+		chain := newTestChain(t)
+		chain.beacon.bootstrap.CurrentSyncCommittee = newTestCommittee(1 << 20).committee
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.ErrorIs(t, err, ErrInvalidUpdate)
+	})
+
+	t.Run("low participation", func(t *testing.T) {
+		chain := newTestChain(t)
+		finality := &chain.beacon.finality
+		signingRoot := testNetwork.signingRoot(finality.AttestedHeader.Beacon.HashTreeRoot(), finality.SignatureSlot)
+		finality.SyncAggregate = chain.next.sign(t, signingRoot, SyncCommitteeSupermajority-1)
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.ErrorIs(t, err, ErrInvalidUpdate)
+		require.ErrorContains(t, err, "participation")
+	})
+
+	t.Run("forged finalized header", func(t *testing.T) {
+		chain := newTestChain(t)
+		chain.beacon.finality.FinalizedHeader.Execution.BlockNumber++
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.ErrorIs(t, err, ErrInvalidUpdate)
+	})
+
+	t.Run("forged signature", func(t *testing.T) {
+		chain := newTestChain(t)
+		chain.beacon.finality.SyncAggregate = chain.next.sign(t, common.Hash{1}, SyncCommitteeSize)
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.ErrorIs(t, err, ErrInvalidUpdate)
+		require.ErrorContains(t, err, "invalid sync committee signature")
+	})
+
+	t.Run("missing committee update", func(t *testing.T) {
+		chain := newTestChain(t)
+		chain.beacon.updates = nil
+		client := NewClient(testNetwork, chain.checkpoint, chain.beacon)
+		_, err := client.Finalized(context.Background())
+		require.ErrorIs(t, err, ErrInvalidUpdate)
+	})
+}
+
+func TestVerifierFinalizedHeader(t *testing.T) {
+	chain := newTestChain(t)
+	verifier := NewVerifier(testNetwork, chain.checkpoint, chain.beacon)
+	ctx := context.Background()
+
+	block := chain.blocks[1]
+	header, err := verifier.FinalizedHeader(ctx, chain.execution, block.Hash())
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), header.Hash())
+
+	res, err := verifier.Call(ctx, chain.execution, header, block.Hash(), contractAddress, nil)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Bytes(common.HexToHash("0x2a").Bytes()), hexutil.Bytes(res))
+
+	// a block on another fork at a finalized height
+	fork := testHeader(block.Number.Uint64(), common.Hash{0xff}, block.Root)
+	raw, err := json.Marshal(fork)
+	require.NoError(t, err)
+	chain.execution.headers[fork.Hash()] = raw
+	_, err = verifier.FinalizedHeader(ctx, chain.execution, fork.Hash())
+	require.ErrorContains(t, err, "is not finalized")
+
+	// a block above the finalized one
+	above := testHeader(200, chain.blocks[len(chain.blocks)-1].Hash(), block.Root)
+	raw, err = json.Marshal(above)
+	require.NoError(t, err)
+	chain.execution.headers[above.Hash()] = raw
+	_, err = verifier.FinalizedHeader(ctx, chain.execution, above.Hash())
+	require.ErrorContains(t, err, "below finalized block")
+}
@@ -0,0 +1,348 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// callGasLimit is the gas available to a verified call, the default
+	// eth_call gas cap.
+	callGasLimit = 50_000_000
+	// maxCallRounds bounds the prove and execute rounds of a verified call.
+	maxCallRounds = 16
+)
+
+// ExecutionAPI is the execution layer JSON-RPC subset used to verify calls.
+// Nothing it returns is trusted.
+type ExecutionAPI interface {
+	// HeaderByHash returns the eth_getBlockByHash JSON of the block, without
+	// transactions.
+	HeaderByHash(ctx context.Context, hash common.Hash) (json.RawMessage, error)
+	// GetProof returns the eth_getProof result of the account and storage
+	// keys at the block.
+	GetProof(ctx context.Context, account common.Address, keys []common.Hash, blockHash common.Hash) (*AccountResult, error)
+	// CodeAt returns the code of the account at the block.
+	CodeAt(ctx context.Context, account common.Address, blockHash common.Hash) ([]byte, error)
+	// CreateAccessList returns the accounts and storage slots a call reads at
+	// the block. It is only used as a hint.
+	CreateAccessList(ctx context.Context, to common.Address, data []byte, blockHash common.Hash) (ethtypes.AccessList, error)
+}
+
+// AccountResult is the eth_getProof result.
+type AccountResult struct {
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the proof of a single storage slot in an eth_getProof
+// result.
+type StorageResult struct {
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// headerRLP is the consensus encoding of an execution block header up to
+// prague.
+type headerRLP struct {
+	ParentHash       common.Hash
+	UncleHash        common.Hash
+	Coinbase         common.Address
+	Root             common.Hash
+	TxHash           common.Hash
+	ReceiptHash      common.Hash
+	Bloom            ethtypes.Bloom
+	Difficulty       *big.Int
+	Number           *big.Int
+	GasLimit         uint64
+	GasUsed          uint64
+	Time             uint64
+	Extra            []byte
+	MixDigest        common.Hash
+	Nonce            ethtypes.BlockNonce
+	BaseFee          *big.Int     `rlp:"optional"`
+	WithdrawalsHash  *common.Hash `rlp:"optional"`
+	BlobGasUsed      *uint64      `rlp:"optional"`
+	ExcessBlobGas    *uint64      `rlp:"optional"`
+	ParentBeaconRoot *common.Hash `rlp:"optional"`
+	RequestsHash     *common.Hash `rlp:"optional"`
+}
+
+// decodeHeader decodes an eth_getBlockByHash result and checks that it hashes
+// to hash.
+func decodeHeader(raw json.RawMessage, hash common.Hash) (*ethtypes.Header, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, fmt.Errorf("block %s not found", hash)
+	}
+
+	header, enc, err := encodeHeader(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block %s: %w", hash, err)
+	}
+
+	if got := crypto.Keccak256Hash(enc); got != hash {
+		return nil, fmt.Errorf("block %s does not match its hash %s", hash, got)
+	}
+
+	return header, nil
+}
+
+// encodeHeader decodes an eth_getBlockByHash result and returns its header
+// with the consensus encoding of the header.
+func encodeHeader(raw json.RawMessage) (*ethtypes.Header, []byte, error) {
+	var header ethtypes.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, nil, err
+	}
+	var prague struct {
+		RequestsHash *common.Hash `json:"requestsHash"`
+	}
+	if err := json.Unmarshal(raw, &prague); err != nil {
+		return nil, nil, err
+	}
+
+	enc, err := rlp.EncodeToBytes(&headerRLP{
+		ParentHash:       header.ParentHash,
+		UncleHash:        header.UncleHash,
+		Coinbase:         header.Coinbase,
+		Root:             header.Root,
+		TxHash:           header.TxHash,
+		ReceiptHash:      header.ReceiptHash,
+		Bloom:            header.Bloom,
+		Difficulty:       header.Difficulty,
+		Number:           header.Number,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Time:             header.Time,
+		Extra:            header.Extra,
+		MixDigest:        header.MixDigest,
+		Nonce:            header.Nonce,
+		BaseFee:          header.BaseFee,
+		WithdrawalsHash:  header.WithdrawalsHash,
+		BlobGasUsed:      header.BlobGasUsed,
+		ExcessBlobGas:    header.ExcessBlobGas,
+		ParentBeaconRoot: header.ParentBeaconRoot,
+		RequestsHash:     prague.RequestsHash,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &header, enc, nil
+}
+
+// call executes a read-only call at the verified header of blockHash against
+// state proven with eth_getProof. Accounts and slots read by the call but
+// missing from the access list hint are proven and the call executed again.
+func call(ctx context.Context, api ExecutionAPI, chainConfig *params.ChainConfig, header *ethtypes.Header, blockHash common.Hash, to common.Address, data []byte) ([]byte, error) {
+	state := newProvenState()
+
+	pending := map[common.Address]map[common.Hash]struct{}{to: {}}
+	if hint, err := api.CreateAccessList(ctx, to, data, blockHash); err == nil {
+		for _, tuple := range hint {
+			slots, ok := pending[tuple.Address]
+			if !ok {
+				slots = make(map[common.Hash]struct{})
+				pending[tuple.Address] = slots
+			}
+			for _, key := range tuple.StorageKeys {
+				slots[key] = struct{}{}
+			}
+		}
+	}
+
+	for round := 0; round < maxCallRounds; round++ {
+		for addr, slots := range pending {
+			if err := prove(ctx, api, state, header.Root, blockHash, addr, slots); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := execute(state, chainConfig, header, to, data)
+		if len(state.missing) == 0 {
+			return res, err
+		}
+		pending = state.missing
+	}
+
+	return nil, fmt.Errorf("call to %s still reads unproven state after %d rounds", to, maxCallRounds)
+}
+
+// prove verifies the eth_getProof result of an account and its slots against
+// the state root and adds them to the proven state.
+func prove(ctx context.Context, api ExecutionAPI, state *provenState, stateRoot, blockHash common.Hash, addr common.Address, slots map[common.Hash]struct{}) error {
+	acc, known := state.accounts[addr]
+	keys := make([]common.Hash, 0, len(slots))
+	for key := range slots {
+		if known && !acc.exists {
+			continue
+		}
+		if _, ok := acc.storageOrNil()[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	if known && len(keys) == 0 {
+		return nil
+	}
+
+	res, err := api.GetProof(ctx, addr, keys, blockHash)
+	if err != nil {
+		return err
+	}
+	if len(res.StorageProof) != len(keys) {
+		return fmt.Errorf("account %s proof has %d storage proofs, expected %d", addr, len(res.StorageProof), len(keys))
+	}
+
+	if !known {
+		if acc, err = proveAccount(stateRoot, addr, res.AccountProof); err != nil {
+			return err
+		}
+		if acc.exists && acc.codeHash != ethtypes.EmptyCodeHash {
+			code, err := api.CodeAt(ctx, addr, blockHash)
+			if err != nil {
+				return err
+			}
+			if crypto.Keccak256Hash(code) != acc.codeHash {
+				return fmt.Errorf("code of account %s does not match its code hash", addr)
+			}
+			acc.code = code
+		}
+		state.accounts[addr] = acc
+	}
+
+	if !acc.exists {
+		return nil
+	}
+	for i, key := range keys {
+		value, err := verifyProof(acc.root, crypto.Keccak256(key[:]), toBytes(res.StorageProof[i].Proof))
+		if err != nil {
+			return fmt.Errorf("invalid proof of account %s slot %s: %w", addr, key, err)
+		}
+		if len(value) > 0 {
+			_, content, _, err := rlp.Split(value)
+			if err != nil || len(content) > common.HashLength {
+				return fmt.Errorf("invalid value of account %s slot %s", addr, key)
+			}
+			acc.storage[key] = common.BytesToHash(content)
+		} else {
+			acc.storage[key] = common.Hash{}
+		}
+	}
+
+	return nil
+}
+
+// proveAccount verifies an account proof against the state root.
+func proveAccount(stateRoot common.Hash, addr common.Address, proof []hexutil.Bytes) (*provenAccount, error) {
+	value, err := verifyProof(stateRoot, crypto.Keccak256(addr[:]), toBytes(proof))
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof of account %s: %w", addr, err)
+	}
+	if value == nil {
+		return &provenAccount{balance: new(uint256.Int), codeHash: ethtypes.EmptyCodeHash}, nil
+	}
+
+	var account ethtypes.StateAccount
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return nil, fmt.Errorf("invalid account %s: %w", addr, err)
+	}
+
+	return &provenAccount{
+		exists:   true,
+		nonce:    account.Nonce,
+		balance:  account.Balance,
+		codeHash: common.BytesToHash(account.CodeHash),
+		root:     account.Root,
+		storage:  make(map[common.Hash]common.Hash),
+	}, nil
+}
+
+func (a *provenAccount) storageOrNil() map[common.Hash]common.Hash {
+	if a == nil {
+		return nil
+	}
+	return a.storage
+}
+
+// execute runs the call once against the proven state.
+func execute(state *provenState, chainConfig *params.ChainConfig, header *ethtypes.Header, to common.Address, data []byte) ([]byte, error) {
+	state.reset()
+
+	var guard opcodeGuard
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *uint256.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(vm.StateDB, common.Address, common.Address, *uint256.Int) {},
+		GetHash: func(uint64) common.Hash {
+			guard.err = errors.New("BLOCKHASH is not supported in verified calls")
+			return common.Hash{}
+		},
+		Coinbase:    header.Coinbase,
+		GasLimit:    header.GasLimit,
+		BlockNumber: header.Number,
+		Time:        header.Time,
+		Difficulty:  new(big.Int),
+		BaseFee:     header.BaseFee,
+		BlobBaseFee: new(big.Int),
+		Random:      &header.MixDigest,
+	}
+	if blockCtx.BaseFee == nil {
+		blockCtx.BaseFee = new(big.Int)
+	}
+
+	evm := vm.NewEVM(blockCtx, vm.TxContext{GasPrice: new(big.Int)}, state, chainConfig, vm.Config{NoBaseFee: true, Tracer: &guard})
+	rules := chainConfig.Rules(header.Number, true, header.Time)
+	state.Prepare(rules, common.Address{}, header.Coinbase, &to, vm.ActivePrecompiles(rules), nil)
+
+	res, _, err := evm.StaticCall(vm.AccountRef(common.Address{}), to, data, callGasLimit)
+	if guard.err != nil {
+		return nil, guard.err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("call to %s failed: %w", to, err)
+	}
+
+	return res, nil
+}
+
+// opcodeGuard fails calls using opcodes whose result cannot be derived from
+// the verified header.
+type opcodeGuard struct {
+	err error
+}
+
+func (g *opcodeGuard) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+	if op == vm.BLOBBASEFEE && g.err == nil {
+		g.err = errors.New("BLOBBASEFEE is not supported in verified calls")
+	}
+}
+
+func (g *opcodeGuard) CaptureTxStart(uint64) {}
+func (g *opcodeGuard) CaptureTxEnd(uint64)   {}
+func (g *opcodeGuard) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+func (g *opcodeGuard) CaptureEnd([]byte, uint64, error) {}
+func (g *opcodeGuard) CaptureEnter(vm.OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+func (g *opcodeGuard) CaptureExit([]byte, uint64, error)                                            {}
+func (g *opcodeGuard) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+func toBytes(proof []hexutil.Bytes) [][]byte {
+	res := make([][]byte, len(proof))
+	for i, p := range proof {
+		res[i] = p
+	}
+	return res
+}
@@ -0,0 +1,145 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExecution serves a state holding a single contract account with a
+// single storage slot.
+type fakeExecution struct {
+	headers      map[common.Hash]json.RawMessage
+	code         []byte
+	accountProof []hexutil.Bytes
+	storageProof []hexutil.Bytes
+	proofs       int
+}
+
+func (f *fakeExecution) HeaderByHash(_ context.Context, hash common.Hash) (json.RawMessage, error) {
+	return f.headers[hash], nil
+}
+
+func (f *fakeExecution) GetProof(_ context.Context, _ common.Address, keys []common.Hash, _ common.Hash) (*AccountResult, error) {
+	f.proofs++
+	res := &AccountResult{AccountProof: f.accountProof}
+	for range keys {
+		res.StorageProof = append(res.StorageProof, StorageResult{Proof: f.storageProof})
+	}
+	return res, nil
+}
+
+func (f *fakeExecution) CodeAt(context.Context, common.Address, common.Hash) ([]byte, error) {
+	return f.code, nil
+}
+
+func (f *fakeExecution) CreateAccessList(context.Context, common.Address, []byte, common.Hash) (ethtypes.AccessList, error) {
+	return nil, nil
+}
+
+// contract returns slot 0 of its storage.
+var (
+	contractCode    = common.FromHex("60005460005260206000f3")
+	contractAddress = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+)
+
+// newFakeExecution returns the fake and the state root of a state where slot 0
+// of the contract holds value.
+func newFakeExecution(t *testing.T, value common.Hash) (*fakeExecution, common.Hash) {
+	t.Helper()
+
+	slot := common.Hash{}
+	encValue, err := rlp.EncodeToBytes(new(big.Int).SetBytes(value[:]))
+	require.NoError(t, err)
+	storageLeaf := leafNode(t, crypto.Keccak256(slot[:]), encValue)
+
+	account, err := rlp.EncodeToBytes(&ethtypes.StateAccount{
+		Nonce:    1,
+		Balance:  uint256.NewInt(0),
+		Root:     crypto.Keccak256Hash(storageLeaf),
+		CodeHash: crypto.Keccak256(contractCode),
+	})
+	require.NoError(t, err)
+	accountLeaf := leafNode(t, crypto.Keccak256(contractAddress[:]), account)
+
+	return &fakeExecution{
+		headers:      make(map[common.Hash]json.RawMessage),
+		code:         contractCode,
+		accountProof: []hexutil.Bytes{accountLeaf},
+		storageProof: []hexutil.Bytes{storageLeaf},
+	}, crypto.Keccak256Hash(accountLeaf)
+}
+
+func testHeader(number uint64, parent, stateRoot common.Hash) *ethtypes.Header {
+	return &ethtypes.Header{
+		ParentHash:  parent,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Root:        stateRoot,
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: ethtypes.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int).SetUint64(number),
+		GasLimit:    30_000_000,
+		Time:        1_800_000_000,
+		BaseFee:     big.NewInt(params.InitialBaseFee),
+	}
+}
+
+func TestCall(t *testing.T) {
+	value := common.HexToHash("0x2a")
+	api, stateRoot := newFakeExecution(t, value)
+	header := testHeader(20_000_000, common.Hash{}, stateRoot)
+
+	res, err := call(context.Background(), api, params.MainnetChainConfig, header, header.Hash(), contractAddress, nil)
+	require.NoError(t, err)
+	require.Equal(t, value[:], res)
+	// the contract account is proven first, its slot once read
+	require.Equal(t, 2, api.proofs)
+}
+
+func TestCallRejectsForgedState(t *testing.T) {
+	api, stateRoot := newFakeExecution(t, common.HexToHash("0x2a"))
+	header := testHeader(20_000_000, common.Hash{}, stateRoot)
+
+	forged, _ := newFakeExecution(t, common.HexToHash("0xffff"))
+	api.storageProof = forged.storageProof
+	_, err := call(context.Background(), api, params.MainnetChainConfig, header, header.Hash(), contractAddress, nil)
+	require.ErrorContains(t, err, "invalid proof of account")
+
+	api, _ = newFakeExecution(t, common.HexToHash("0x2a"))
+	api.code = common.FromHex("60ff60005260206000f3")
+	_, err = call(context.Background(), api, params.MainnetChainConfig, header, header.Hash(), contractAddress, nil)
+	require.ErrorContains(t, err, "does not match its code hash")
+
+	// a state root the proofs were not made for
+	header.Root = common.Hash{1}
+	api, _ = newFakeExecution(t, common.HexToHash("0x2a"))
+	_, err = call(context.Background(), api, params.MainnetChainConfig, header, header.Hash(), contractAddress, nil)
+	require.ErrorContains(t, err, "invalid proof of account")
+}
+
+func TestDecodeHeader(t *testing.T) {
+	header := testHeader(1, common.Hash{2}, common.Hash{3})
+	raw, err := json.Marshal(header)
+	require.NoError(t, err)
+
+	got, err := decodeHeader(raw, header.Hash())
+	require.NoError(t, err)
+	require.Equal(t, header.Root, got.Root)
+
+	_, err = decodeHeader(raw, common.Hash{1})
+	require.ErrorContains(t, err, "does not match its hash")
+
+	_, err = decodeHeader(json.RawMessage("null"), header.Hash())
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,249 @@
+package lightclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// HeaderRLP returns the consensus encoding of the header of an
+// eth_getBlockByHash result, which hashes to the block hash.
+func HeaderRLP(raw json.RawMessage) ([]byte, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, errors.New("block not found")
+	}
+
+	_, enc, err := encodeHeader(raw)
+	return enc, err
+}
+
+// VerifyHeaderChain decodes consensus encoded headers, each the parent of the
+// next one, and checks that the last one hashes to tip. Every header is thus
+// an ancestor of the tip block, or the tip block itself.
+func VerifyHeaderChain(headers [][]byte, tip common.Hash) ([]*ethtypes.Header, error) {
+	if len(headers) == 0 {
+		return nil, errors.New("no headers")
+	}
+
+	decoded := make([]*ethtypes.Header, len(headers))
+	var parent common.Hash
+	for i, enc := range headers {
+		var h headerRLP
+		if err := rlp.DecodeBytes(enc, &h); err != nil {
+			return nil, fmt.Errorf("invalid header %d: %w", i, err)
+		}
+		if i > 0 && h.ParentHash != parent {
+			return nil, fmt.Errorf("header %d is not the child of header %d", i, i-1)
+		}
+		parent = crypto.Keccak256Hash(enc)
+
+		decoded[i] = &ethtypes.Header{
+			ParentHash:  h.ParentHash,
+			Root:        h.Root,
+			TxHash:      h.TxHash,
+			ReceiptHash: h.ReceiptHash,
+			Number:      h.Number,
+			Time:        h.Time,
+		}
+	}
+
+	if parent != tip {
+		return nil, fmt.Errorf("headers end at block %s, expected %s", parent, tip)
+	}
+
+	return decoded, nil
+}
+
+// VerifyTransaction returns the transaction at index of a block, proven
+// against the transactions root of its header.
+func VerifyTransaction(header *ethtypes.Header, index uint64, proof [][]byte) (*ethtypes.Transaction, error) {
+	value, err := verifyListItem(header.TxHash, index, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(value); err != nil {
+		return nil, fmt.Errorf("invalid transaction %d: %w", index, err)
+	}
+	return &tx, nil
+}
+
+// VerifyReceipt returns the receipt of the transaction at index of a block,
+// proven against the receipts root of its header.
+func VerifyReceipt(header *ethtypes.Header, index uint64, proof [][]byte) (*ethtypes.Receipt, error) {
+	value, err := verifyListItem(header.ReceiptHash, index, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(value); err != nil {
+		return nil, fmt.Errorf("invalid receipt %d: %w", index, err)
+	}
+	return &receipt, nil
+}
+
+// verifyListItem returns the item at index of a block list trie proven
+// against root.
+func verifyListItem(root common.Hash, index uint64, proof [][]byte) ([]byte, error) {
+	key, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := verifyProof(root, key, proof)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("no item %d in the proof", index)
+	}
+	return value, nil
+}
+
+// ProveListItem returns the Merkle-Patricia proof of the item at index of a
+// block list, such as the transactions or receipts of a block, against the
+// root the list derives.
+func ProveListItem(list ethtypes.DerivableList, index int) ([][]byte, error) {
+	if index < 0 || index >= list.Len() {
+		return nil, fmt.Errorf("index %d out of range [0, %d)", index, list.Len())
+	}
+
+	items := make([]trieItem, list.Len())
+	var target []byte
+	for i := range items {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		list.EncodeIndex(i, &buf)
+		items[i] = trieItem{path: keybytesToNibbles(key), value: buf.Bytes()}
+		if i == index {
+			target = items[i].path
+		}
+	}
+
+	var proof [][]byte
+	root := buildTrie(items, target, &proof)
+	if len(root) < common.HashLength {
+		// the root node is hashed whatever its size
+		proof = append(proof, root)
+	}
+
+	// the nodes are collected from the leaf up
+	for i, j := 0, len(proof)-1; i < j; i, j = i+1, j-1 {
+		proof[i], proof[j] = proof[j], proof[i]
+	}
+	return proof, nil
+}
+
+// trieItem is an entry of a trie built in memory, keyed by nibbles.
+type trieItem struct {
+	path  []byte
+	value []byte
+}
+
+// buildTrie returns the encoding of the trie node holding items and appends to
+// proof the hashed nodes on the path of target.
+func buildTrie(items []trieItem, target []byte, proof *[][]byte) []byte {
+	var node []any
+
+	switch prefix := commonPrefix(items); {
+	case len(items) == 1:
+		node = []any{hexPrefix(items[0].path, true), items[0].value}
+
+	case prefix > 0:
+		children := make([]trieItem, len(items))
+		for i, item := range items {
+			children[i] = trieItem{path: item.path[prefix:], value: item.value}
+		}
+		childTarget := []byte(nil)
+		if bytes.HasPrefix(target, items[0].path[:prefix]) {
+			childTarget = target[prefix:]
+		}
+		node = []any{hexPrefix(items[0].path[:prefix], false), nodeRef(buildTrie(children, childTarget, proof))}
+
+	default:
+		var (
+			groups [16][]trieItem
+			value  []byte
+		)
+		for _, item := range items {
+			if len(item.path) == 0 {
+				value = item.value
+				continue
+			}
+			groups[item.path[0]] = append(groups[item.path[0]], trieItem{path: item.path[1:], value: item.value})
+		}
+
+		node = make([]any, 17)
+		for i, group := range groups {
+			if len(group) == 0 {
+				node[i] = []byte{}
+				continue
+			}
+			childTarget := []byte(nil)
+			if len(target) > 0 && int(target[0]) == i {
+				childTarget = target[1:]
+			}
+			node[i] = nodeRef(buildTrie(group, childTarget, proof))
+		}
+		node[16] = value
+	}
+
+	enc, _ := rlp.EncodeToBytes(node)
+	if target != nil && len(enc) >= common.HashLength {
+		*proof = append(*proof, enc)
+	}
+	return enc
+}
+
+// nodeRef returns the reference to a child node: the node itself if shorter
+// than a hash, its hash otherwise.
+func nodeRef(enc []byte) any {
+	if len(enc) < common.HashLength {
+		return rlp.RawValue(enc)
+	}
+	return crypto.Keccak256(enc)
+}
+
+// commonPrefix returns the length of the nibble prefix shared by items.
+func commonPrefix(items []trieItem) int {
+	n := len(items[0].path)
+	for _, item := range items[1:] {
+		i := 0
+		for i < n && i < len(item.path) && item.path[i] == items[0].path[i] {
+			i++
+		}
+		n = i
+	}
+	return n
+}
+
+// hexPrefix returns the hex prefix encoding of a nibble path.
+func hexPrefix(nibbles []byte, leaf bool) []byte {
+	var flag byte
+	if leaf {
+		flag = 2
+	}
+
+	var compact []byte
+	if len(nibbles)%2 == 1 {
+		compact = append(compact, (flag+1)<<4|nibbles[0])
+		nibbles = nibbles[1:]
+	} else {
+		compact = append(compact, flag<<4)
+	}
+	for i := 0; i < len(nibbles); i += 2 {
+		compact = append(compact, nibbles[i]<<4|nibbles[i+1])
+	}
+	return compact
+}
@@ -0,0 +1,113 @@
+package lightclient
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTrieRoot(t *testing.T) {
+	// the example trie of the Ethereum wiki
+	var items []trieItem
+	for _, kv := range [][2]string{{"doe", "reindeer"}, {"dog", "puppy"}, {"dogglesworth", "cat"}} {
+		items = append(items, trieItem{path: keybytesToNibbles([]byte(kv[0])), value: []byte(kv[1])})
+	}
+
+	root := crypto.Keccak256Hash(buildTrie(items, nil, nil))
+	require.Equal(t, common.HexToHash("0x8aad789dff2f538bca5d8ea56e8abe10f4c7ba3a5dea95fea4cd6e7c3a1168d3"), root)
+}
+
+func TestProveListItem(t *testing.T) {
+	// more than 128 items for keys of different lengths
+	var (
+		txs      ethtypes.Transactions
+		receipts ethtypes.Receipts
+	)
+	for i := 0; i < 200; i++ {
+		txs = append(txs, ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     uint64(i),
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Gas:       21000,
+			Value:     big.NewInt(int64(i)),
+		}))
+		receipts = append(receipts, &ethtypes.Receipt{
+			Type:              ethtypes.DynamicFeeTxType,
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*ethtypes.Log{},
+		})
+	}
+
+	txProof, err := ProveListItem(txs, 0)
+	require.NoError(t, err)
+	receiptProof, err := ProveListItem(receipts, 0)
+	require.NoError(t, err)
+	header := &ethtypes.Header{
+		TxHash:      crypto.Keccak256Hash(txProof[0]),
+		ReceiptHash: crypto.Keccak256Hash(receiptProof[0]),
+	}
+
+	for _, i := range []int{0, 1, 127, 128, 199} {
+		proof, err := ProveListItem(txs, i)
+		require.NoError(t, err)
+		tx, err := VerifyTransaction(header, uint64(i), proof)
+		require.NoError(t, err)
+		require.Equal(t, txs[i].Hash(), tx.Hash())
+
+		proof, err = ProveListItem(receipts, i)
+		require.NoError(t, err)
+		receipt, err := VerifyReceipt(header, uint64(i), proof)
+		require.NoError(t, err)
+		require.Equal(t, receipts[i].CumulativeGasUsed, receipt.CumulativeGasUsed)
+
+		// the proof of an item does not prove another one
+		_, err = VerifyReceipt(header, uint64(i+1), proof)
+		require.Error(t, err)
+	}
+
+	_, err = ProveListItem(txs, 200)
+	require.Error(t, err)
+}
+
+func TestVerifyHeaderChain(t *testing.T) {
+	var (
+		headers [][]byte
+		parent  common.Hash
+	)
+	for i := 0; i < 3; i++ {
+		enc, err := rlp.EncodeToBytes(&ethtypes.Header{
+			ParentHash: parent,
+			Difficulty: big.NewInt(0),
+			Number:     big.NewInt(int64(100 + i)),
+			TxHash:     common.Hash{byte(i)},
+			BaseFee:    big.NewInt(1),
+		})
+		require.NoError(t, err)
+		headers = append(headers, enc)
+		parent = crypto.Keccak256Hash(enc)
+	}
+
+	decoded, err := VerifyHeaderChain(headers, parent)
+	require.NoError(t, err)
+	require.Len(t, decoded, 3)
+	require.Equal(t, uint64(100), decoded[0].Number.Uint64())
+	require.Equal(t, common.Hash{0}, decoded[0].TxHash)
+
+	// a chain ending at another block
+	_, err = VerifyHeaderChain(headers[:2], parent)
+	require.Error(t, err)
+
+	// a header missing from the chain
+	_, err = VerifyHeaderChain([][]byte{headers[0], headers[2]}, parent)
+	require.Error(t, err)
+
+	_, err = VerifyHeaderChain(nil, parent)
+	require.Error(t, err)
+}
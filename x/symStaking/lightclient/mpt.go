@@ -0,0 +1,145 @@
+package lightclient
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// verifyProof checks a Merkle-Patricia proof of key against root and returns
+// the proven value, or nil if the proof shows the key is absent.
+func verifyProof(root common.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == ethtypes.EmptyRootHash {
+		return nil, nil
+	}
+
+	nodes := make(map[common.Hash][]byte, len(proof))
+	for _, node := range proof {
+		nodes[crypto.Keccak256Hash(node)] = node
+	}
+
+	node, ok := nodes[root]
+	if !ok {
+		return nil, errors.New("missing proof root node")
+	}
+
+	path := keybytesToNibbles(key)
+	for {
+		elems, err := splitNode(node)
+		if err != nil {
+			return nil, err
+		}
+
+		var child []byte
+		switch len(elems) {
+		case 17:
+			if len(path) == 0 {
+				return nodeValue(elems[16])
+			}
+			child, path = elems[path[0]], path[1:]
+
+		case 2:
+			compact, _, err := rlp.SplitString(elems[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid proof node key: %w", err)
+			}
+			nibbles, leaf := compactToNibbles(compact)
+			if leaf {
+				if !bytes.Equal(nibbles, path) {
+					return nil, nil
+				}
+				return nodeValue(elems[1])
+			}
+			if !bytes.HasPrefix(path, nibbles) {
+				return nil, nil
+			}
+			child, path = elems[1], path[len(nibbles):]
+
+		default:
+			return nil, fmt.Errorf("invalid proof node with %d items", len(elems))
+		}
+
+		kind, content, _, err := rlp.Split(child)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node reference: %w", err)
+		}
+		switch {
+		case kind == rlp.List:
+			// nodes shorter than 32 bytes are embedded in their parent
+			node = child
+		case len(content) == 0:
+			return nil, nil
+		case len(content) == common.HashLength:
+			if node, ok = nodes[common.BytesToHash(content)]; !ok {
+				return nil, errors.New("missing proof node")
+			}
+		default:
+			return nil, fmt.Errorf("invalid proof node reference length %d", len(content))
+		}
+	}
+}
+
+// splitNode returns the raw items of a trie node.
+func splitNode(node []byte) ([][]byte, error) {
+	content, rest, err := rlp.SplitList(node)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof node: %w", err)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("invalid proof node: trailing bytes")
+	}
+
+	var elems [][]byte
+	for len(content) > 0 {
+		_, _, tail, err := rlp.Split(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node: %w", err)
+		}
+		elems = append(elems, content[:len(content)-len(tail)])
+		content = tail
+	}
+
+	return elems, nil
+}
+
+// nodeValue returns the value stored in a leaf or branch node item.
+func nodeValue(item []byte) ([]byte, error) {
+	value, _, err := rlp.SplitString(item)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof node value: %w", err)
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return value, nil
+}
+
+func keybytesToNibbles(key []byte) []byte {
+	nibbles := make([]byte, len(key)*2)
+	for i, b := range key {
+		nibbles[2*i] = b / 16
+		nibbles[2*i+1] = b % 16
+	}
+	return nibbles
+}
+
+// compactToNibbles decodes a hex prefix encoded node key and reports whether
+// the node is a leaf.
+func compactToNibbles(compact []byte) ([]byte, bool) {
+	if len(compact) == 0 {
+		return nil, false
+	}
+
+	flag := compact[0] >> 4
+	nibbles := keybytesToNibbles(compact[1:])
+	if flag&1 == 1 {
+		nibbles = append([]byte{compact[0] & 0x0f}, nibbles...)
+	}
+
+	return nibbles, flag&2 == 2
+}
@@ -0,0 +1,107 @@
+package lightclient
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+// leafNode returns the encoding of a trie holding a single key.
+func leafNode(t *testing.T, key, value []byte) []byte {
+	t.Helper()
+
+	// hex prefix encoding of the full even length path with the leaf flag
+	compact := append([]byte{0x20}, key...)
+	node, err := rlp.EncodeToBytes([][]byte{compact, value})
+	require.NoError(t, err)
+	return node
+}
+
+func TestVerifyProofSingleLeaf(t *testing.T) {
+	key := crypto.Keccak256([]byte("key"))
+	value := []byte("value")
+	node := leafNode(t, key, value)
+	root := crypto.Keccak256Hash(node)
+
+	got, err := verifyProof(root, key, [][]byte{node})
+	require.NoError(t, err)
+	require.Equal(t, value, got)
+
+	// another key ends at the same leaf, which proves its absence
+	got, err = verifyProof(root, crypto.Keccak256([]byte("other")), [][]byte{node})
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, err = verifyProof(root, key, nil)
+	require.Error(t, err)
+
+	tampered := leafNode(t, key, []byte("forged"))
+	_, err = verifyProof(root, key, [][]byte{tampered})
+	require.Error(t, err)
+
+	got, err = verifyProof(ethtypes.EmptyRootHash, key, nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestVerifyProofBranch(t *testing.T) {
+	keyA := crypto.Keccak256([]byte("a"))
+	keyB := crypto.Keccak256([]byte("b"))
+	require.NotEqual(t, keyA[0]>>4, keyB[0]>>4)
+
+	// a branch node at the root with a leaf for each key below it, the leaves
+	// hold the remaining odd length path
+	leaf := func(key []byte, value []byte) []byte {
+		nibbles := keybytesToNibbles(key)[1:]
+		compact := []byte{0x30 | nibbles[0]}
+		for i := 1; i < len(nibbles); i += 2 {
+			compact = append(compact, nibbles[i]<<4|nibbles[i+1])
+		}
+		node, err := rlp.EncodeToBytes([][]byte{compact, value})
+		require.NoError(t, err)
+		return node
+	}
+	leafA := leaf(keyA, []byte("value a"))
+	leafB := leaf(keyB, []byte("value b"))
+
+	children := make([]any, 17)
+	for i := range children {
+		children[i] = []byte{}
+	}
+	children[keyA[0]>>4] = crypto.Keccak256(leafA)
+	children[keyB[0]>>4] = crypto.Keccak256(leafB)
+	branch, err := rlp.EncodeToBytes(children)
+	require.NoError(t, err)
+	root := crypto.Keccak256Hash(branch)
+
+	got, err := verifyProof(root, keyA, [][]byte{branch, leafA})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value a"), got)
+
+	got, err = verifyProof(root, keyB, [][]byte{branch, leafB})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value b"), got)
+
+	// the proof of a key must include the nodes on its path
+	_, err = verifyProof(root, keyB, [][]byte{branch, leafA})
+	require.ErrorContains(t, err, "missing proof node")
+
+	// an empty branch slot proves absence
+	var keyC []byte
+	for i := 0; keyC == nil; i++ {
+		k := crypto.Keccak256([]byte{byte(i)})
+		if k[0]>>4 != keyA[0]>>4 && k[0]>>4 != keyB[0]>>4 {
+			keyC = k
+		}
+	}
+	got, err = verifyProof(root, keyC, [][]byte{branch})
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, err = verifyProof(common.Hash{1}, keyA, [][]byte{branch, leafA})
+	require.ErrorContains(t, err, "missing proof root node")
+}
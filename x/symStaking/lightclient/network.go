@@ -0,0 +1,162 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Beacon chain constants used by the light client.
+const (
+	SlotsPerEpoch                = 32
+	EpochsPerSyncCommitteePeriod = 256
+	SlotsPerSyncCommitteePeriod  = SlotsPerEpoch * EpochsPerSyncCommitteePeriod
+	SyncCommitteeSize            = 512
+	// SyncCommitteeSupermajority is the minimum number of sync committee
+	// signatures accepted on an update.
+	SyncCommitteeSupermajority = (SyncCommitteeSize*2 + 2) / 3
+)
+
+// domainSyncCommittee is the signature domain type of sync committee messages.
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// Fork is a beacon chain fork activation.
+type Fork struct {
+	Name    string
+	Epoch   uint64
+	Version [4]byte
+}
+
+// Network holds the beacon chain parameters a light client needs to verify
+// sync committee signatures, and the execution chain config used to replay
+// calls.
+type Network struct {
+	GenesisValidatorsRoot common.Hash
+	// Forks are sorted by activation epoch, Altair first.
+	Forks []Fork
+	// ChainConfig is the execution chain config.
+	ChainConfig *params.ChainConfig
+}
+
+// Known networks.
+var (
+	Mainnet = Network{
+		GenesisValidatorsRoot: common.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 74240, Version: [4]byte{0x01, 0x00, 0x00, 0x00}},
+			{Name: "bellatrix", Epoch: 144896, Version: [4]byte{0x02, 0x00, 0x00, 0x00}},
+			{Name: "capella", Epoch: 194048, Version: [4]byte{0x03, 0x00, 0x00, 0x00}},
+			{Name: "deneb", Epoch: 269568, Version: [4]byte{0x04, 0x00, 0x00, 0x00}},
+			{Name: "electra", Epoch: 364032, Version: [4]byte{0x05, 0x00, 0x00, 0x00}},
+			{Name: "fulu", Epoch: 411392, Version: [4]byte{0x06, 0x00, 0x00, 0x00}},
+		},
+		ChainConfig: params.MainnetChainConfig,
+	}
+	Sepolia = Network{
+		GenesisValidatorsRoot: common.HexToHash("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 50, Version: [4]byte{0x90, 0x00, 0x00, 0x70}},
+			{Name: "bellatrix", Epoch: 100, Version: [4]byte{0x90, 0x00, 0x00, 0x71}},
+			{Name: "capella", Epoch: 56832, Version: [4]byte{0x90, 0x00, 0x00, 0x72}},
+			{Name: "deneb", Epoch: 132608, Version: [4]byte{0x90, 0x00, 0x00, 0x73}},
+			{Name: "electra", Epoch: 222464, Version: [4]byte{0x90, 0x00, 0x00, 0x74}},
+			{Name: "fulu", Epoch: 272640, Version: [4]byte{0x90, 0x00, 0x00, 0x75}},
+		},
+		ChainConfig: params.SepoliaChainConfig,
+	}
+	Holesky = Network{
+		GenesisValidatorsRoot: common.HexToHash("0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1"),
+		Forks: []Fork{
+			{Name: "altair", Epoch: 0, Version: [4]byte{0x02, 0x01, 0x70, 0x00}},
+			{Name: "bellatrix", Epoch: 0, Version: [4]byte{0x03, 0x01, 0x70, 0x00}},
+			{Name: "capella", Epoch: 256, Version: [4]byte{0x04, 0x01, 0x70, 0x00}},
+			{Name: "deneb", Epoch: 29696, Version: [4]byte{0x05, 0x01, 0x70, 0x00}},
+			{Name: "electra", Epoch: 115968, Version: [4]byte{0x06, 0x01, 0x70, 0x00}},
+			{Name: "fulu", Epoch: 165120, Version: [4]byte{0x07, 0x01, 0x70, 0x00}},
+		},
+		ChainConfig: params.HoleskyChainConfig,
+	}
+)
+
+// forkAt returns the fork active at the given slot.
+func (n Network) forkAt(slot uint64) Fork {
+	epoch := slot / SlotsPerEpoch
+	fork := n.Forks[0]
+	for _, f := range n.Forks {
+		if f.Epoch <= epoch {
+			fork = f
+		}
+	}
+	return fork
+}
+
+// activeAt reports whether the named fork is active at the given slot.
+func (n Network) activeAt(name string, slot uint64) bool {
+	epoch := slot / SlotsPerEpoch
+	for _, f := range n.Forks {
+		if f.Name == name {
+			return f.Epoch <= epoch
+		}
+	}
+	return false
+}
+
+// signingRoot returns the root signed by the sync committee for a beacon block
+// root attested in a block at signatureSlot.
+func (n Network) signingRoot(blockRoot common.Hash, signatureSlot uint64) common.Hash {
+	// the signature is made with the fork of the slot preceding signatureSlot
+	slot := signatureSlot
+	if slot > 0 {
+		slot--
+	}
+	version := n.forkAt(slot).Version
+
+	var versionLeaf [32]byte
+	copy(versionLeaf[:], version[:])
+	forkDataRoot := sha256.Sum256(append(versionLeaf[:], n.GenesisValidatorsRoot[:]...))
+
+	var domain [32]byte
+	copy(domain[:4], domainSyncCommittee[:])
+	copy(domain[4:], forkDataRoot[:28])
+
+	return sha256.Sum256(append(blockRoot[:], domain[:]...))
+}
+
+// Generalized indices of the light client proofs, before and after electra
+// grew the beacon state past 32 fields.
+const (
+	gindexFinalizedRoot               = 105
+	gindexCurrentSyncCommittee        = 54
+	gindexNextSyncCommittee           = 55
+	gindexFinalizedRootElectra        = 169
+	gindexCurrentSyncCommitteeElectra = 86
+	gindexNextSyncCommitteeElectra    = 87
+	gindexExecutionPayload            = 25
+)
+
+func (n Network) finalizedRootGindex(slot uint64) uint64 {
+	if n.activeAt("electra", slot) {
+		return gindexFinalizedRootElectra
+	}
+	return gindexFinalizedRoot
+}
+
+func (n Network) currentSyncCommitteeGindex(slot uint64) uint64 {
+	if n.activeAt("electra", slot) {
+		return gindexCurrentSyncCommitteeElectra
+	}
+	return gindexCurrentSyncCommittee
+}
+
+func (n Network) nextSyncCommitteeGindex(slot uint64) uint64 {
+	if n.activeAt("electra", slot) {
+		return gindexNextSyncCommitteeElectra
+	}
+	return gindexNextSyncCommittee
+}
+
+// syncPeriod returns the sync committee period of a slot.
+func syncPeriod(slot uint64) uint64 {
+	return slot / SlotsPerSyncCommitteePeriod
+}
@@ -0,0 +1,126 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// RPCCaller is the execution JSON-RPC client inclusion proofs are built with.
+type RPCCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// InclusionProof proves that a transaction and its receipt are in a block
+// chained to a tip block, as checked by VerifyHeaderChain, VerifyTransaction
+// and VerifyReceipt.
+type InclusionProof struct {
+	// Headers are the consensus encoded headers from the block of the
+	// transaction to the tip block, each the parent of the next one.
+	Headers [][]byte
+	// TxIndex is the index of the transaction in its block.
+	TxIndex uint64
+	// TxProof is the proof of the transaction against the first header.
+	TxProof [][]byte
+	// ReceiptProof is the proof of the receipt against the first header.
+	ReceiptProof [][]byte
+}
+
+// ProveInclusion returns the proof of the transaction of receipt against the
+// tip block, which must descend from the block of the transaction by fewer
+// than maxHeaders blocks.
+func ProveInclusion(ctx context.Context, caller RPCCaller, tip common.Hash, receipt *ethtypes.Receipt, maxHeaders int) (InclusionProof, error) {
+	headers, err := headerChain(ctx, caller, tip, receipt.BlockHash, receipt.BlockNumber.Uint64(), maxHeaders)
+	if err != nil {
+		return InclusionProof{}, err
+	}
+
+	var blockReceipts []*ethtypes.Receipt
+	if err := caller.CallContext(ctx, &blockReceipts, "eth_getBlockReceipts", receipt.BlockHash); err != nil {
+		return InclusionProof{}, err
+	}
+
+	receipts := make(encodedList, len(blockReceipts))
+	txs := make(encodedList, len(blockReceipts))
+	for i, rcpt := range blockReceipts {
+		if receipts[i], err = rcpt.MarshalBinary(); err != nil {
+			return InclusionProof{}, err
+		}
+		if err := caller.CallContext(ctx, &txs[i], "eth_getRawTransactionByBlockHashAndIndex", receipt.BlockHash, hexutil.Uint(i)); err != nil {
+			return InclusionProof{}, err
+		}
+	}
+
+	index := int(receipt.TransactionIndex)
+	txProof, err := ProveListItem(txs, index)
+	if err != nil {
+		return InclusionProof{}, err
+	}
+	receiptProof, err := ProveListItem(receipts, index)
+	if err != nil {
+		return InclusionProof{}, err
+	}
+
+	return InclusionProof{
+		Headers:      headers,
+		TxIndex:      uint64(index),
+		TxProof:      txProof,
+		ReceiptProof: receiptProof,
+	}, nil
+}
+
+// headerChain returns the consensus encoded headers from the block of a
+// transaction to the tip block.
+func headerChain(ctx context.Context, caller RPCCaller, tip, blockHash common.Hash, block uint64, maxHeaders int) ([][]byte, error) {
+	var headers [][]byte
+	for hash := tip; ; {
+		var raw json.RawMessage
+		if err := caller.CallContext(ctx, &raw, "eth_getBlockByHash", hash, false); err != nil {
+			return nil, err
+		}
+		enc, err := HeaderRLP(raw)
+		if err != nil {
+			return nil, fmt.Errorf("block %s: %w", hash.Hex(), err)
+		}
+		headers = append(headers, enc)
+
+		var header struct {
+			ParentHash common.Hash  `json:"parentHash"`
+			Number     *hexutil.Big `json:"number"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil {
+			return nil, err
+		}
+		if header.Number == nil || header.Number.ToInt().Uint64() <= block {
+			if hash != blockHash {
+				return nil, fmt.Errorf("transaction block %s is not an ancestor of block %s", blockHash.Hex(), tip.Hex())
+			}
+			break
+		}
+		if len(headers) >= maxHeaders {
+			return nil, fmt.Errorf("more than %d headers from block %d to block %s", maxHeaders, block, tip.Hex())
+		}
+		hash = header.ParentHash
+	}
+
+	// the headers are proven from the transaction block to the tip
+	for i, j := 0, len(headers)-1; i < j; i, j = i+1, j-1 {
+		headers[i], headers[j] = headers[j], headers[i]
+	}
+	return headers, nil
+}
+
+// encodedList is a block list of consensus encoded items, whose types may be
+// unknown to the go-ethereum version the proof is built with.
+type encodedList []hexutil.Bytes
+
+func (l encodedList) Len() int { return len(l) }
+
+func (l encodedList) EncodeIndex(i int, w *bytes.Buffer) { w.Write(l[i]) }
+
+var _ ethtypes.DerivableList = encodedList(nil)
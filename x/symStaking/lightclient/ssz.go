@@ -0,0 +1,219 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+)
+
+// BeaconBlockHeader is the beacon block header as served by the beacon API.
+type BeaconBlockHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Hash `json:"parent_root"`
+	StateRoot     common.Hash `json:"state_root"`
+	BodyRoot      common.Hash `json:"body_root"`
+}
+
+// HashTreeRoot returns the SSZ root of the header, the beacon block root.
+func (h BeaconBlockHeader) HashTreeRoot() common.Hash {
+	return merkleize([][32]byte{
+		uint64Leaf(h.Slot),
+		uint64Leaf(h.ProposerIndex),
+		h.ParentRoot,
+		h.StateRoot,
+		h.BodyRoot,
+	})
+}
+
+// ExecutionPayloadHeader is the execution payload header of a beacon block,
+// from capella on. The blob gas fields are only part of the root from deneb on.
+type ExecutionPayloadHeader struct {
+	ParentHash       common.Hash               `json:"parent_hash"`
+	FeeRecipient     common.Address            `json:"fee_recipient"`
+	StateRoot        common.Hash               `json:"state_root"`
+	ReceiptsRoot     common.Hash               `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes             `json:"logs_bloom"`
+	PrevRandao       common.Hash               `json:"prev_randao"`
+	BlockNumber      uint64                    `json:"block_number,string"`
+	GasLimit         uint64                    `json:"gas_limit,string"`
+	GasUsed          uint64                    `json:"gas_used,string"`
+	Timestamp        uint64                    `json:"timestamp,string"`
+	ExtraData        hexutil.Bytes             `json:"extra_data"`
+	BaseFeePerGas    *gethmath.HexOrDecimal256 `json:"base_fee_per_gas"`
+	BlockHash        common.Hash               `json:"block_hash"`
+	TransactionsRoot common.Hash               `json:"transactions_root"`
+	WithdrawalsRoot  common.Hash               `json:"withdrawals_root"`
+	BlobGasUsed      uint64                    `json:"blob_gas_used,string"`
+	ExcessBlobGas    uint64                    `json:"excess_blob_gas,string"`
+}
+
+// HashTreeRoot returns the SSZ root of the payload header.
+func (h ExecutionPayloadHeader) HashTreeRoot(deneb bool) (common.Hash, error) {
+	if len(h.LogsBloom) != 256 {
+		return common.Hash{}, fmt.Errorf("invalid logs bloom length %d", len(h.LogsBloom))
+	}
+	if len(h.ExtraData) > 32 {
+		return common.Hash{}, fmt.Errorf("invalid extra data length %d", len(h.ExtraData))
+	}
+	if h.BaseFeePerGas == nil {
+		return common.Hash{}, fmt.Errorf("missing base fee per gas")
+	}
+
+	var feeRecipient, extraData [32]byte
+	copy(feeRecipient[:], h.FeeRecipient[:])
+	copy(extraData[:], h.ExtraData)
+
+	baseFee := (*big.Int)(h.BaseFeePerGas)
+	if baseFee.Sign() < 0 || baseFee.BitLen() > 256 {
+		return common.Hash{}, fmt.Errorf("invalid base fee per gas %s", baseFee)
+	}
+	var baseFeeLeaf [32]byte
+	baseFee.FillBytes(baseFeeLeaf[:])
+	reverse(baseFeeLeaf[:])
+
+	leaves := [][32]byte{
+		h.ParentHash,
+		feeRecipient,
+		h.StateRoot,
+		h.ReceiptsRoot,
+		merkleize(chunks(h.LogsBloom)),
+		h.PrevRandao,
+		uint64Leaf(h.BlockNumber),
+		uint64Leaf(h.GasLimit),
+		uint64Leaf(h.GasUsed),
+		uint64Leaf(h.Timestamp),
+		mixInLength(extraData, uint64(len(h.ExtraData))),
+		baseFeeLeaf,
+		h.BlockHash,
+		h.TransactionsRoot,
+		h.WithdrawalsRoot,
+	}
+	if deneb {
+		leaves = append(leaves, uint64Leaf(h.BlobGasUsed), uint64Leaf(h.ExcessBlobGas))
+	}
+
+	return merkleize(leaves), nil
+}
+
+// LightClientHeader is a beacon block header with its execution payload
+// header and the proof of the latter in the block body.
+type LightClientHeader struct {
+	Beacon          BeaconBlockHeader      `json:"beacon"`
+	Execution       ExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []common.Hash          `json:"execution_branch"`
+}
+
+// SyncCommittee is a beacon chain sync committee.
+type SyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+// HashTreeRoot returns the SSZ root of the committee.
+func (c SyncCommittee) HashTreeRoot() (common.Hash, error) {
+	if len(c.Pubkeys) != SyncCommitteeSize {
+		return common.Hash{}, fmt.Errorf("invalid sync committee size %d", len(c.Pubkeys))
+	}
+
+	leaves := make([][32]byte, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		if len(pk) != blsPubkeySize {
+			return common.Hash{}, fmt.Errorf("invalid sync committee pubkey length %d", len(pk))
+		}
+		leaves[i] = merkleize(chunks(pk))
+	}
+	if len(c.AggregatePubkey) != blsPubkeySize {
+		return common.Hash{}, fmt.Errorf("invalid sync committee aggregate pubkey length %d", len(c.AggregatePubkey))
+	}
+
+	return merkleize([][32]byte{merkleize(leaves), merkleize(chunks(c.AggregatePubkey))}), nil
+}
+
+// SyncAggregate holds the sync committee participation bits and signature.
+type SyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+// LightClientBootstrap is the light client state at a trusted block root.
+type LightClientBootstrap struct {
+	Header                     LightClientHeader `json:"header"`
+	CurrentSyncCommittee       SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []common.Hash     `json:"current_sync_committee_branch"`
+}
+
+// LightClientUpdate is a sync committee signed update. Finality updates have
+// no next sync committee.
+type LightClientUpdate struct {
+	AttestedHeader          LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee    `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []common.Hash     `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []common.Hash     `json:"finality_branch"`
+	SyncAggregate           SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64            `json:"signature_slot,string"`
+}
+
+// verifyBranch checks that leaf is at the generalized index gindex of the
+// tree of the given root.
+func verifyBranch(root common.Hash, gindex uint64, branch []common.Hash, leaf common.Hash) error {
+	values := make(merkle.Values, len(branch))
+	for i, b := range branch {
+		values[i] = merkle.Value(b)
+	}
+	return merkle.VerifyProof(root, gindex, values, merkle.Value(leaf))
+}
+
+// merkleize returns the root of the binary merkle tree of the leaves, padded
+// with zero leaves to the next power of two.
+func merkleize(leaves [][32]byte) common.Hash {
+	width := 1
+	for width < len(leaves) {
+		width *= 2
+	}
+
+	layer := make([][32]byte, width)
+	copy(layer, leaves)
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
+
+// chunks splits b into zero padded 32 byte chunks.
+func chunks(b []byte) [][32]byte {
+	res := make([][32]byte, (len(b)+31)/32)
+	for i := range res {
+		copy(res[i][:], b[i*32:])
+	}
+	return res
+}
+
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	l := uint64Leaf(length)
+	return sha256.Sum256(append(root[:], l[:]...))
+}
+
+func uint64Leaf(v uint64) [32]byte {
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:8], v)
+	return leaf
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
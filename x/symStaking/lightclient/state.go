@@ -0,0 +1,191 @@
+package lightclient
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+var _ vm.StateDB = &provenState{}
+
+// provenAccount is an account whose fields, code and read storage slots were
+// proven against a state root.
+type provenAccount struct {
+	exists   bool
+	nonce    uint64
+	balance  *uint256.Int
+	codeHash common.Hash
+	code     []byte
+	root     common.Hash
+	storage  map[common.Hash]common.Hash
+}
+
+// provenState is a read-only vm.StateDB backed by proven accounts only. Reads
+// of accounts or slots that were not proven return zero values and are
+// recorded as missing, the caller proves them and executes again.
+type provenState struct {
+	accounts map[common.Address]*provenAccount
+	missing  map[common.Address]map[common.Hash]struct{}
+
+	transient   map[common.Address]map[common.Hash]common.Hash
+	accessAddrs map[common.Address]struct{}
+	accessSlots map[common.Address]map[common.Hash]struct{}
+	refund      uint64
+}
+
+func newProvenState() *provenState {
+	return &provenState{
+		accounts: make(map[common.Address]*provenAccount),
+	}
+}
+
+// reset clears the per execution state, proven accounts are kept.
+func (s *provenState) reset() {
+	s.missing = make(map[common.Address]map[common.Hash]struct{})
+	s.transient = make(map[common.Address]map[common.Hash]common.Hash)
+	s.accessAddrs = make(map[common.Address]struct{})
+	s.accessSlots = make(map[common.Address]map[common.Hash]struct{})
+	s.refund = 0
+}
+
+func (s *provenState) markMissing(addr common.Address, slot *common.Hash) {
+	slots, ok := s.missing[addr]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		s.missing[addr] = slots
+	}
+	if slot != nil {
+		slots[*slot] = struct{}{}
+	}
+}
+
+func (s *provenState) account(addr common.Address) *provenAccount {
+	if acc, ok := s.accounts[addr]; ok {
+		return acc
+	}
+	s.markMissing(addr, nil)
+	return &provenAccount{balance: new(uint256.Int)}
+}
+
+func (s *provenState) GetBalance(addr common.Address) *uint256.Int {
+	return new(uint256.Int).Set(s.account(addr).balance)
+}
+
+func (s *provenState) GetNonce(addr common.Address) uint64 {
+	return s.account(addr).nonce
+}
+
+func (s *provenState) GetCodeHash(addr common.Address) common.Hash {
+	acc := s.account(addr)
+	if !acc.exists {
+		return common.Hash{}
+	}
+	return acc.codeHash
+}
+
+func (s *provenState) GetCode(addr common.Address) []byte {
+	return s.account(addr).code
+}
+
+func (s *provenState) GetCodeSize(addr common.Address) int {
+	return len(s.account(addr).code)
+}
+
+func (s *provenState) GetState(addr common.Address, key common.Hash) common.Hash {
+	acc, ok := s.accounts[addr]
+	if !ok {
+		s.markMissing(addr, &key)
+		return common.Hash{}
+	}
+	if !acc.exists {
+		return common.Hash{}
+	}
+	value, ok := acc.storage[key]
+	if !ok {
+		s.markMissing(addr, &key)
+	}
+	return value
+}
+
+func (s *provenState) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	return s.GetState(addr, key)
+}
+
+func (s *provenState) Exist(addr common.Address) bool {
+	return s.account(addr).exists
+}
+
+func (s *provenState) Empty(addr common.Address) bool {
+	acc := s.account(addr)
+	return !acc.exists || (acc.nonce == 0 && acc.balance.IsZero() && acc.codeHash == ethtypes.EmptyCodeHash)
+}
+
+func (s *provenState) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *provenState) SetTransientState(addr common.Address, key, value common.Hash) {
+	if _, ok := s.transient[addr]; !ok {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
+func (s *provenState) AddressInAccessList(addr common.Address) bool {
+	_, ok := s.accessAddrs[addr]
+	return ok
+}
+
+func (s *provenState) SlotInAccessList(addr common.Address, slot common.Hash) (addressOk, slotOk bool) {
+	_, addressOk = s.accessAddrs[addr]
+	_, slotOk = s.accessSlots[addr][slot]
+	return addressOk, slotOk
+}
+
+func (s *provenState) AddAddressToAccessList(addr common.Address) {
+	s.accessAddrs[addr] = struct{}{}
+}
+
+func (s *provenState) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	s.AddAddressToAccessList(addr)
+	if _, ok := s.accessSlots[addr]; !ok {
+		s.accessSlots[addr] = make(map[common.Hash]struct{})
+	}
+	s.accessSlots[addr][slot] = struct{}{}
+}
+
+func (s *provenState) Prepare(rules params.Rules, sender, coinbase common.Address, dest *common.Address, precompiles []common.Address, _ ethtypes.AccessList) {
+	s.AddAddressToAccessList(sender)
+	if dest != nil {
+		s.AddAddressToAccessList(*dest)
+	}
+	for _, addr := range precompiles {
+		s.AddAddressToAccessList(addr)
+	}
+	if rules.IsShanghai {
+		s.AddAddressToAccessList(coinbase)
+	}
+}
+
+func (s *provenState) AddRefund(gas uint64) { s.refund += gas }
+func (s *provenState) SubRefund(gas uint64) { s.refund -= gas }
+func (s *provenState) GetRefund() uint64    { return s.refund }
+
+// The state is only used for static calls, which cannot write. The EVM still
+// touches the callee with a zero balance change.
+
+func (s *provenState) CreateAccount(common.Address)                      {}
+func (s *provenState) SubBalance(common.Address, *uint256.Int)           {}
+func (s *provenState) AddBalance(common.Address, *uint256.Int)           {}
+func (s *provenState) SetNonce(common.Address, uint64)                   {}
+func (s *provenState) SetCode(common.Address, []byte)                    {}
+func (s *provenState) SetState(common.Address, common.Hash, common.Hash) {}
+func (s *provenState) SelfDestruct(common.Address)                       {}
+func (s *provenState) HasSelfDestructed(common.Address) bool             { return false }
+func (s *provenState) Selfdestruct6780(common.Address)                   {}
+func (s *provenState) RevertToSnapshot(int)                              {}
+func (s *provenState) Snapshot() int                                     { return 0 }
+func (s *provenState) AddLog(*ethtypes.Log)                              {}
+func (s *provenState) AddPreimage(common.Hash, []byte)                   {}
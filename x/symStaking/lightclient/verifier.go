@@ -0,0 +1,110 @@
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxAncestorDepth bounds how far below the finalized block a verified
+// block may be.
+const maxAncestorDepth = 1024
+
+// Verifier verifies execution blocks against beacon finality and runs calls
+// against proven state of those blocks.
+type Verifier struct {
+	network Network
+	client  *Client
+
+	mu sync.Mutex
+	// verified caches verified execution headers by hash.
+	verified map[common.Hash]*ethtypes.Header
+}
+
+// NewVerifier returns a verifier following beacon finality from the trusted
+// checkpoint block root.
+func NewVerifier(network Network, checkpoint common.Hash, api BeaconAPI) *Verifier {
+	return &Verifier{
+		network:  network,
+		client:   NewClient(network, checkpoint, api),
+		verified: make(map[common.Hash]*ethtypes.Header),
+	}
+}
+
+// FinalizedHeader returns the header of the execution block with the given
+// hash after checking that it is the latest finalized execution block or one
+// of its ancestors.
+func (v *Verifier) FinalizedHeader(ctx context.Context, api ExecutionAPI, blockHash common.Hash) (*ethtypes.Header, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if header, ok := v.verified[blockHash]; ok {
+		return header, nil
+	}
+
+	finalized, err := v.client.Finalized(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := v.header(ctx, api, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	head := finalized.Execution.BlockNumber
+	if !header.Number.IsUint64() || header.Number.Uint64() > head || head-header.Number.Uint64() > maxAncestorDepth {
+		return nil, fmt.Errorf("block %s number %s is not within %d blocks below finalized block %d", blockHash, header.Number, maxAncestorDepth, head)
+	}
+
+	// walk back from the finalized block, every parent hash links the
+	// verified headers to the beacon finalized one
+	verified := make(map[common.Hash]*ethtypes.Header)
+	hash := finalized.Execution.BlockHash
+	for number := head; ; number-- {
+		ancestor, err := v.header(ctx, api, hash)
+		if err != nil {
+			return nil, err
+		}
+		if !ancestor.Number.IsUint64() || ancestor.Number.Uint64() != number {
+			return nil, fmt.Errorf("block %s has number %s, expected %d", hash, ancestor.Number, number)
+		}
+		verified[hash] = ancestor
+
+		if number == header.Number.Uint64() {
+			if hash != blockHash {
+				return nil, fmt.Errorf("block %s is not finalized, block %d is %s", blockHash, number, hash)
+			}
+			break
+		}
+		hash = ancestor.ParentHash
+	}
+
+	// only the headers leading to the requested block are kept, later
+	// requests walk back to them
+	v.verified = verified
+
+	return header, nil
+}
+
+// header returns the cached verified header of hash, or fetches the header
+// and checks that it hashes to hash.
+func (v *Verifier) header(ctx context.Context, api ExecutionAPI, hash common.Hash) (*ethtypes.Header, error) {
+	if header, ok := v.verified[hash]; ok {
+		return header, nil
+	}
+
+	raw, err := api.HeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return decodeHeader(raw, hash)
+}
+
+// Call executes a read-only call at a verified header of blockHash against
+// state proven with eth_getProof.
+func (v *Verifier) Call(ctx context.Context, api ExecutionAPI, header *ethtypes.Header, blockHash common.Hash, to common.Address, data []byte) ([]byte, error) {
+	return call(ctx, api, v.network.ChainConfig, header, blockHash, to, data)
+}
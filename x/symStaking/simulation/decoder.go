@@ -46,6 +46,14 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &paramsB)
 
 			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.CachedBlockHashKey):
+			var cachedA, cachedB types.CachedBlockHash
+
+			cdc.MustUnmarshal(kvA.Value, &cachedA)
+			cdc.MustUnmarshal(kvB.Value, &cachedB)
+
+			return fmt.Sprintf("%v\n%v", cachedA, cachedB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
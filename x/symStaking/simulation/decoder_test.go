@@ -29,10 +29,13 @@ func TestDecodeStore(t *testing.T) {
 	oneIntBz, err := math.OneInt().Marshal()
 	require.NoError(t, err)
 
+	cached := types.CachedBlockHash{BlockHash: "0x01", Height: 10}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.LastTotalPowerKey, Value: oneIntBz},
 			{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
+			{Key: types.CachedBlockHashKey, Value: cdc.MustMarshal(&cached)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -43,6 +46,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"LastTotalPower", fmt.Sprintf("%v\n%v", math.OneInt(), math.OneInt())},
 		{"LastValidatorPower/ValidatorsByConsAddr/ValidatorsByPowerIndex", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"CachedBlockHash", fmt.Sprintf("%v\n%v", cached, cached)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -35,7 +35,7 @@ func ProposalMsgs() []simtypes.WeightedProposalMsg {
 // SimulateMsgUpdateParams returns a random MsgUpdateParams
 func SimulateMsgUpdateParams(r *rand.Rand, _ []simtypes.Account, addressCodec coreaddress.Codec) (sdk.Msg, error) {
 	// use the default gov module account address as authority
-	var authority sdk.AccAddress = address.Module("gov")
+	var authority sdk.AccAddress = address.Module(types.GovModuleName)
 
 	params := types.DefaultParams()
 	params.BondDenom = simtypes.RandStringOfLength(r, 10)
@@ -44,6 +44,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ []simtypes.Account, addressCodec co
 	params.MaxValidators = uint32(simtypes.RandIntBetween(r, 1, 1000))
 	params.UnbondingTime = time.Duration(simtypes.RandTimestamp(r).UnixNano())
 	params.MinCommissionRate = simtypes.RandomDecAmount(r, sdkmath.LegacyNewDec(1))
+	params.SymbioticSyncPeriod = int64(simtypes.RandIntBetween(r, 1, 100))
 
 	addr, err := addressCodec.BytesToString(authority)
 	if err != nil {
@@ -38,7 +38,7 @@ func TestProposalMsgs(t *testing.T) {
 	msgUpdateParams, ok := msg.(*types.MsgUpdateParams)
 	assert.Assert(t, ok)
 
-	addr, err := addressCodec.BytesToString(address.Module("gov"))
+	addr, err := addressCodec.BytesToString(address.Module(types.GovModuleName))
 	assert.NilError(t, err)
 
 	assert.Equal(t, addr, msgUpdateParams.Authority)
@@ -0,0 +1,230 @@
+package testutil
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/x/symStaking/types"
+)
+
+var _ types.SymbioticDataSource = &InMemoryDataSource{}
+
+// InMemoryDataSource is a deterministic, in-memory types.SymbioticDataSource.
+// It lets keeper, ProposalHandler and app level tests drive the Symbiotic sync
+// path without talking to beacon or execution RPC endpoints.
+type InMemoryDataSource struct {
+	mu sync.Mutex
+
+	blocks         map[common.Hash]*ethtypes.Block
+	canonical      map[uint64]common.Hash
+	beaconBlocks   map[int64]common.Hash
+	finalizedEpoch int64
+	validatorSets  map[common.Hash][]types.SymbioticValidator
+	voterStakes    map[common.Hash]map[voterStakeKey]*big.Int
+
+	err error
+}
+
+// NewInMemoryDataSource creates an empty InMemoryDataSource.
+func NewInMemoryDataSource() *InMemoryDataSource {
+	return &InMemoryDataSource{
+		blocks:        make(map[common.Hash]*ethtypes.Block),
+		canonical:     make(map[uint64]common.Hash),
+		beaconBlocks:  make(map[int64]common.Hash),
+		validatorSets: make(map[common.Hash][]types.SymbioticValidator),
+		voterStakes:   make(map[common.Hash]map[voterStakeKey]*big.Int),
+	}
+}
+
+// voterStakeKey identifies the stake a voter backs an operator with through a
+// vault.
+type voterStakeKey struct {
+	vault, operator, voter common.Address
+}
+
+// AddBlock adds a canonical execution block with the given number and
+// timestamp, replacing any block previously stored at that number, and returns
+// its hash.
+func (ds *InMemoryDataSource) AddBlock(number, timestamp uint64) string {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	header := &ethtypes.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Time:       timestamp,
+		Difficulty: big.NewInt(0),
+	}
+	if number > 0 {
+		header.ParentHash = ds.canonical[number-1]
+	}
+
+	block := ethtypes.NewBlockWithHeader(header)
+	ds.blocks[block.Hash()] = block
+	ds.canonical[number] = block.Hash()
+
+	return block.Hash().String()
+}
+
+// SetBeaconBlockHash makes the beacon block at slot reference blockHash.
+// Lookups for later slots without an explicit entry fall back to the closest
+// preceding one.
+func (ds *InMemoryDataSource) SetBeaconBlockHash(slot int64, blockHash string) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	ds.beaconBlocks[slot] = common.HexToHash(blockHash)
+}
+
+// SetFinalizedEpoch sets the epoch of the finalized checkpoint.
+func (ds *InMemoryDataSource) SetFinalizedEpoch(epoch int64) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	ds.finalizedEpoch = epoch
+}
+
+// SetValidatorSet sets the middleware validator set returned at blockHash.
+func (ds *InMemoryDataSource) SetValidatorSet(blockHash string, validators []types.SymbioticValidator) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	ds.validatorSets[common.HexToHash(blockHash)] = validators
+}
+
+// SetVoterStake sets the stake voter backs operator with through vault,
+// returned at blockHash.
+func (ds *InMemoryDataSource) SetVoterStake(blockHash string, vault, operator, voter common.Address, stake *big.Int) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	hash := common.HexToHash(blockHash)
+	if ds.voterStakes[hash] == nil {
+		ds.voterStakes[hash] = make(map[voterStakeKey]*big.Int)
+	}
+	ds.voterStakes[hash][voterStakeKey{vault, operator, voter}] = stake
+}
+
+// SetError makes every subsequent call fail with err, simulating unavailable
+// endpoints. Passing nil restores normal operation.
+func (ds *InMemoryDataSource) SetError(err error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	ds.err = err
+}
+
+// GetBeaconBlockHash implements types.SymbioticDataSource.
+func (ds *InMemoryDataSource) GetBeaconBlockHash(_ context.Context, slot int64) (string, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return "", ds.err
+	}
+
+	slots := make([]int64, 0, len(ds.beaconBlocks))
+	for s := range ds.beaconBlocks {
+		slots = append(slots, s)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] > slots[j] })
+
+	for _, s := range slots {
+		if s <= slot {
+			return ds.beaconBlocks[s].String(), nil
+		}
+	}
+
+	return "", types.ErrSymbioticNotFound
+}
+
+// GetFinalizedEpoch implements types.SymbioticDataSource.
+func (ds *InMemoryDataSource) GetFinalizedEpoch(_ context.Context) (int64, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return 0, ds.err
+	}
+
+	return ds.finalizedEpoch, nil
+}
+
+// GetBlockByHash implements types.SymbioticDataSource.
+func (ds *InMemoryDataSource) GetBlockByHash(_ context.Context, blockHash string) (*ethtypes.Block, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	block, ok := ds.blocks[common.HexToHash(blockHash)]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return block, nil
+}
+
+// GetBlockByNumber implements types.SymbioticDataSource.
+func (ds *InMemoryDataSource) GetBlockByNumber(_ context.Context, number *big.Int) (*ethtypes.Block, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	hash, ok := ds.canonical[number.Uint64()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return ds.blocks[hash], nil
+}
+
+// GetValidatorSet implements types.SymbioticDataSource. The validator set is
+// returned as set, without going through the adapter.
+func (ds *InMemoryDataSource) GetValidatorSet(_ context.Context, _, blockHash string, _ types.MiddlewareAdapter) ([]types.SymbioticValidator, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	hash := common.HexToHash(blockHash)
+	if _, ok := ds.blocks[hash]; !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return ds.validatorSets[hash], nil
+}
+
+// GetVoterStake implements types.SymbioticDataSource. Stakes that were not set
+// are zero.
+func (ds *InMemoryDataSource) GetVoterStake(_ context.Context, _, blockHash string, vault, operator, voter common.Address) (*big.Int, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	hash := common.HexToHash(blockHash)
+	if _, ok := ds.blocks[hash]; !ok {
+		return nil, ethereum.NotFound
+	}
+
+	if stake, ok := ds.voterStakes[hash][voterStakeKey{vault, operator, voter}]; ok {
+		return new(big.Int).Set(stake), nil
+	}
+
+	return new(big.Int), nil
+}
@@ -509,6 +509,48 @@ func (m *MockStakingHooks) EXPECT() *MockStakingHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterConsensusPubKeyUpdate mocks base method.
+func (m *MockStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey types1.PubKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsensusPubKeyUpdate", ctx, oldPubKey, newPubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsensusPubKeyUpdate indicates an expected call of AfterConsensusPubKeyUpdate.
+func (mr *MockStakingHooksMockRecorder) AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsensusPubKeyUpdate", reflect.TypeOf((*MockStakingHooks)(nil).AfterConsensusPubKeyUpdate), ctx, oldPubKey, newPubKey)
+}
+
+// AfterSymbioticStakeChanged mocks base method.
+func (m *MockStakingHooks) AfterSymbioticStakeChanged(ctx context.Context, valAddr types2.ValAddress, oldTokens, newTokens math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterSymbioticStakeChanged", ctx, valAddr, oldTokens, newTokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterSymbioticStakeChanged indicates an expected call of AfterSymbioticStakeChanged.
+func (mr *MockStakingHooksMockRecorder) AfterSymbioticStakeChanged(ctx, valAddr, oldTokens, newTokens interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterSymbioticStakeChanged", reflect.TypeOf((*MockStakingHooks)(nil).AfterSymbioticStakeChanged), ctx, valAddr, oldTokens, newTokens)
+}
+
+// AfterSymbioticSync mocks base method.
+func (m *MockStakingHooks) AfterSymbioticSync(ctx context.Context, record types0.SymbioticSyncRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterSymbioticSync", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterSymbioticSync indicates an expected call of AfterSymbioticSync.
+func (mr *MockStakingHooksMockRecorder) AfterSymbioticSync(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterSymbioticSync", reflect.TypeOf((*MockStakingHooks)(nil).AfterSymbioticSync), ctx, record)
+}
+
 // AfterUnbondingInitiated mocks base method.
 func (m *MockStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -1,48 +1,39 @@
 package types
 
-import (
-	"os"
-	"strings"
-)
+import "sync"
 
+// ApiUrls rotates over the beacon and execution endpoints. It is safe for
+// concurrent use, ExtendVote, PrepareProposal and queries read Ethereum
+// concurrently.
 type ApiUrls struct {
+	mu              sync.Mutex
 	beaconApiUrls   []string
 	ethApiUrls      []string
 	currentBeaconId int
 	currentEthId    int
 }
 
-func NewApiUrls() ApiUrls {
-	// USE ONLY YOUR LOCAL BEACON CLIENT FOR SAFETY!!!
-	beaconApiUrls := strings.Split(os.Getenv("BEACON_API_URLS"), ",")
-	if len(beaconApiUrls) == 1 && beaconApiUrls[0] == "" {
-		beaconApiUrls[0] = "https://eth-holesky-beacon.public.blastapi.io"
-		beaconApiUrls = append(beaconApiUrls, "http://unstable.holesky.beacon-api.nimbus.team")
-		beaconApiUrls = append(beaconApiUrls, "https://ethereum-holesky-beacon-api.publicnode.com")
-	}
-
-	ethApiUrls := strings.Split(os.Getenv("ETH_API_URLS"), ",")
-
-	if len(ethApiUrls) == 1 && ethApiUrls[0] == "" {
-		ethApiUrls[0] = "https://rpc.ankr.com/eth_holesky"
-		ethApiUrls = append(ethApiUrls, "https://ethereum-holesky.blockpi.network/v1/rpc/public")
-		ethApiUrls = append(ethApiUrls, "https://eth-holesky.public.blastapi.io")
-		ethApiUrls = append(ethApiUrls, "https://ethereum-holesky.gateway.tatum.io")
-		ethApiUrls = append(ethApiUrls, "https://holesky.gateway.tenderly.co")
-	}
-
-	return ApiUrls{beaconApiUrls: beaconApiUrls, ethApiUrls: ethApiUrls}
+// NewApiUrls creates a new ApiUrls rotating over the given beacon and
+// execution endpoints. Both lists must be non-empty, see SymbioticConfig.
+func NewApiUrls(beaconApiUrls, ethApiUrls []string) *ApiUrls {
+	return &ApiUrls{beaconApiUrls: beaconApiUrls, ethApiUrls: ethApiUrls}
 }
 
-func (au ApiUrls) GetEthApiUrl() string {
+func (au *ApiUrls) GetEthApiUrl() string {
+	au.mu.Lock()
+	defer au.mu.Unlock()
 	return au.ethApiUrls[au.currentEthId]
 }
 
-func (au ApiUrls) GetBeaconApiUrl() string {
+func (au *ApiUrls) GetBeaconApiUrl() string {
+	au.mu.Lock()
+	defer au.mu.Unlock()
 	return au.beaconApiUrls[au.currentBeaconId]
 }
 
 func (au *ApiUrls) RotateEthUrl() {
+	au.mu.Lock()
+	defer au.mu.Unlock()
 	au.currentEthId++
 	if au.currentEthId == len(au.ethApiUrls) {
 		au.currentEthId = 0
@@ -50,6 +41,8 @@ func (au *ApiUrls) RotateEthUrl() {
 }
 
 func (au *ApiUrls) RotateBeaconUrl() {
+	au.mu.Lock()
+	defer au.mu.Unlock()
 	au.currentBeaconId++
 	if au.currentBeaconId == len(au.beaconApiUrls) {
 		au.currentBeaconId = 0
@@ -14,6 +14,9 @@ import (
 func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreateValidator{}, "cosmos-sdk/MsgCreateValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgEditValidator{}, "cosmos-sdk/MsgEditValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOperatorBinding{}, "cosmos-sdk/MsgUpdateOperatorBinding")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgOperatorExec{}, "cosmos-sdk/MsgOperatorExec")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/symStaking/MsgUpdateParams")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/symStaking/Params")
@@ -24,6 +27,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgCreateValidator{},
 		&MsgEditValidator{},
+		&MsgUpdateOperatorBinding{},
+		&MsgRotateConsPubKey{},
+		&MsgOperatorExec{},
 		&MsgUpdateParams{},
 	)
 
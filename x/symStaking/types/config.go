@@ -0,0 +1,366 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Symbiotic app.toml keys
+const (
+	FlagSymbioticBeaconAPIURLs  = "symbiotic.beacon-api-urls"
+	FlagSymbioticEthAPIURLs     = "symbiotic.eth-api-urls"
+	FlagSymbioticMiddlewareAddr = "symbiotic.middleware-address"
+	FlagSymbioticChain          = "symbiotic.chain"
+	FlagSymbioticRequestTimeout = "symbiotic.request-timeout"
+	FlagSymbioticRetries        = "symbiotic.retries"
+	FlagSymbioticRetryBackoff   = "symbiotic.retry-backoff"
+	FlagSymbioticMaxRetryTime   = "symbiotic.max-retry-duration"
+	FlagSymbioticOnSyncFailure  = "symbiotic.on-sync-failure"
+	FlagSymbioticLightClient    = "symbiotic.light-client"
+	FlagSymbioticCheckpoint     = "symbiotic.light-client-checkpoint"
+)
+
+// Symbiotic config default values
+const (
+	DefaultSymbioticChain          = ChainHolesky
+	DefaultSymbioticRequestTimeout = 10 * time.Second
+	DefaultSymbioticRetries        = 5
+	DefaultSymbioticRetryBackoff   = 200 * time.Millisecond
+	DefaultSymbioticMaxRetryTime   = time.Minute
+	DefaultSymbioticOnSyncFailure  = SyncFailureSkip
+)
+
+// Policies applied when a Symbiotic sync is skipped. A validator failing to
+// read the Ethereum data after all retries attests INVALID_BLOCKHASH either
+// way, the sync is skipped for the height unless enough other validators could
+// read it.
+const (
+	// SyncFailureSkip keeps the current stakes until the next sync.
+	SyncFailureSkip = "skip"
+	// SyncFailureHalt stops the node through the server shutdown path once
+	// the skipped sync is committed.
+	SyncFailureHalt = "halt"
+)
+
+// Ethereum chains the Symbiotic middleware can be deployed on.
+const (
+	ChainMainnet = "mainnet"
+	ChainSepolia = "sepolia"
+	ChainHolesky = "holesky"
+	ChainDevnet  = "devnet"
+)
+
+// ethChainIDs maps the supported chains to their execution layer chain id.
+// Devnets are not checked.
+var ethChainIDs = map[string]uint64{
+	ChainMainnet: 1,
+	ChainSepolia: 11155111,
+	ChainHolesky: 17000,
+}
+
+// BeaconChainSpec is the timing of a beacon chain.
+type BeaconChainSpec struct {
+	// GenesisTimestamp is the unix time of the beacon chain genesis.
+	GenesisTimestamp int64
+	// SlotDuration is the duration of a slot, in seconds.
+	SlotDuration int64
+	// SlotsInEpoch is the number of slots in an epoch.
+	SlotsInEpoch int64
+}
+
+// beaconChainSpecs maps the supported public chains to their beacon chain
+// timing. Devnets set theirs in params.
+var beaconChainSpecs = map[string]BeaconChainSpec{
+	ChainMainnet: {GenesisTimestamp: 1606824023, SlotDuration: 12, SlotsInEpoch: 32},
+	ChainSepolia: {GenesisTimestamp: 1655733600, SlotDuration: 12, SlotsInEpoch: 32},
+	ChainHolesky: {GenesisTimestamp: 1695902400, SlotDuration: 12, SlotsInEpoch: 32},
+}
+
+// GetBeaconChainSpec returns the beacon chain timing of a public chain. The
+// second return value is false for devnets and unknown chains.
+func GetBeaconChainSpec(chain string) (BeaconChainSpec, bool) {
+	spec, ok := beaconChainSpecs[chain]
+	return spec, ok
+}
+
+// SymbioticConfig defines the node local configuration used to reach the
+// Ethereum beacon and execution layers. It is read from the [symbiotic]
+// section of app.toml.
+type SymbioticConfig struct {
+	// BeaconAPIURLs are the beacon node HTTP API endpoints, tried in order.
+	BeaconAPIURLs []string `mapstructure:"beacon-api-urls"`
+	// EthAPIURLs are the execution node JSON-RPC endpoints, tried in order.
+	EthAPIURLs []string `mapstructure:"eth-api-urls"`
+	// MiddlewareAddress is the address of the Symbiotic network middleware.
+	// It must match the middleware address set in genesis.
+	MiddlewareAddress string `mapstructure:"middleware-address"`
+	// Chain is the Ethereum chain the middleware is deployed on.
+	Chain string `mapstructure:"chain"`
+	// RequestTimeout bounds every single beacon or execution request.
+	RequestTimeout time.Duration `mapstructure:"request-timeout"`
+	// Retries is the number of attempts made before a request fails.
+	Retries int `mapstructure:"retries"`
+	// RetryBackoff is the delay before the first retry, doubled after each
+	// attempt.
+	RetryBackoff time.Duration `mapstructure:"retry-backoff"`
+	// MaxRetryDuration bounds the total time spent retrying a request.
+	MaxRetryDuration time.Duration `mapstructure:"max-retry-duration"`
+	// OnSyncFailure is the policy applied when a sync is skipped, either
+	// SyncFailureSkip or SyncFailureHalt.
+	OnSyncFailure string `mapstructure:"on-sync-failure"`
+	// LightClient enables verified reads of the middleware: the validator set
+	// is computed locally from eth_getProof storage proofs checked against the
+	// finalized execution block, itself checked against beacon finality with a
+	// sync committee light client. Endpoints are then not trusted.
+	LightClient bool `mapstructure:"light-client"`
+	// LightClientCheckpoint is the trusted beacon block root the light client
+	// starts from. It must be a finalized block within the weak subjectivity
+	// period.
+	LightClientCheckpoint string `mapstructure:"light-client-checkpoint"`
+}
+
+// DefaultSymbioticConfig returns the default Symbiotic configuration. Endpoints
+// and the middleware address have no default and must be set by the operator.
+func DefaultSymbioticConfig() SymbioticConfig {
+	return SymbioticConfig{
+		BeaconAPIURLs:    []string{},
+		EthAPIURLs:       []string{},
+		Chain:            DefaultSymbioticChain,
+		RequestTimeout:   DefaultSymbioticRequestTimeout,
+		Retries:          DefaultSymbioticRetries,
+		RetryBackoff:     DefaultSymbioticRetryBackoff,
+		MaxRetryDuration: DefaultSymbioticMaxRetryTime,
+		OnSyncFailure:    DefaultSymbioticOnSyncFailure,
+	}
+}
+
+// SymbioticConfigFromAppOptions reads the [symbiotic] app.toml section.
+// Unset timeouts and retries fall back to their defaults, endpoints and the
+// middleware address do not.
+func SymbioticConfigFromAppOptions(opts servertypes.AppOptions) (SymbioticConfig, error) {
+	cfg := DefaultSymbioticConfig()
+	if opts == nil {
+		return cfg, nil
+	}
+
+	var err error
+	if v := opts.Get(FlagSymbioticBeaconAPIURLs); v != nil {
+		if cfg.BeaconAPIURLs, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticBeaconAPIURLs, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticEthAPIURLs); v != nil {
+		if cfg.EthAPIURLs, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticEthAPIURLs, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticMiddlewareAddr); v != nil {
+		if cfg.MiddlewareAddress, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticMiddlewareAddr, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticChain); v != nil {
+		if cfg.Chain, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticChain, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticRequestTimeout); v != nil {
+		if cfg.RequestTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticRequestTimeout, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticRetries); v != nil {
+		if cfg.Retries, err = cast.ToIntE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticRetries, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticRetryBackoff); v != nil {
+		if cfg.RetryBackoff, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticRetryBackoff, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticMaxRetryTime); v != nil {
+		if cfg.MaxRetryDuration, err = cast.ToDurationE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticMaxRetryTime, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticOnSyncFailure); v != nil {
+		if cfg.OnSyncFailure, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticOnSyncFailure, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticLightClient); v != nil {
+		if cfg.LightClient, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticLightClient, err)
+		}
+	}
+	if v := opts.Get(FlagSymbioticCheckpoint); v != nil {
+		if cfg.LightClientCheckpoint, err = cast.ToStringE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagSymbioticCheckpoint, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Validate performs basic validation of the Symbiotic configuration.
+func (c SymbioticConfig) Validate() error {
+	if err := validateURLs("beacon-api-urls", c.BeaconAPIURLs); err != nil {
+		return err
+	}
+	if err := validateURLs("eth-api-urls", c.EthAPIURLs); err != nil {
+		return err
+	}
+	if err := ValidateMiddlewareAddress(c.MiddlewareAddress); err != nil {
+		return err
+	}
+	if _, ok := ethChainIDs[c.Chain]; !ok && c.Chain != ChainDevnet {
+		return fmt.Errorf("unknown symbiotic chain %q, expected one of %s, %s, %s or %s",
+			c.Chain, ChainMainnet, ChainSepolia, ChainHolesky, ChainDevnet)
+	}
+	if c.RequestTimeout <= 0 {
+		return fmt.Errorf("symbiotic request-timeout must be positive: %s", c.RequestTimeout)
+	}
+	if c.Retries <= 0 {
+		return fmt.Errorf("symbiotic retries must be positive: %d", c.Retries)
+	}
+	if c.RetryBackoff < 0 {
+		return fmt.Errorf("symbiotic retry-backoff cannot be negative: %s", c.RetryBackoff)
+	}
+	if c.MaxRetryDuration <= 0 {
+		return fmt.Errorf("symbiotic max-retry-duration must be positive: %s", c.MaxRetryDuration)
+	}
+	if c.OnSyncFailure != SyncFailureSkip && c.OnSyncFailure != SyncFailureHalt {
+		return fmt.Errorf("unknown symbiotic on-sync-failure %q, expected %s or %s", c.OnSyncFailure, SyncFailureSkip, SyncFailureHalt)
+	}
+	if c.LightClient {
+		if c.Chain == ChainDevnet {
+			return errors.New("symbiotic light-client is not supported on devnet")
+		}
+		if _, err := c.Checkpoint(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EthChainID returns the execution layer chain id of the configured chain.
+// The second return value is false for devnets, whose chain id is not checked.
+func (c SymbioticConfig) EthChainID() (uint64, bool) {
+	id, ok := ethChainIDs[c.Chain]
+	return id, ok
+}
+
+// Checkpoint returns the light client checkpoint block root.
+func (c SymbioticConfig) Checkpoint() (common.Hash, error) {
+	b, err := hexutil.Decode(c.LightClientCheckpoint)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid symbiotic light-client-checkpoint %q, expected a 0x prefixed beacon block root", c.LightClientCheckpoint)
+	}
+	root := common.BytesToHash(b)
+	if root == (common.Hash{}) {
+		return common.Hash{}, errors.New("symbiotic light-client-checkpoint cannot be zero")
+	}
+
+	return root, nil
+}
+
+// ValidateMiddlewareAddress checks that addr is a hex encoded, non-zero
+// Ethereum address.
+func ValidateMiddlewareAddress(addr string) error {
+	if addr == "" {
+		return errors.New("symbiotic middleware address cannot be empty")
+	}
+	if !common.IsHexAddress(addr) {
+		return fmt.Errorf("invalid symbiotic middleware address: %s", addr)
+	}
+	if common.HexToAddress(addr) == (common.Address{}) {
+		return errors.New("symbiotic middleware address cannot be the zero address")
+	}
+
+	return nil
+}
+
+// EqualMiddlewareAddress reports whether a and b are the same Ethereum
+// address, ignoring the EIP-55 checksum casing.
+func EqualMiddlewareAddress(a, b string) bool {
+	return common.IsHexAddress(a) && common.IsHexAddress(b) && common.HexToAddress(a) == common.HexToAddress(b)
+}
+
+func validateURLs(name string, urls []string) error {
+	if len(urls) == 0 {
+		return fmt.Errorf("symbiotic %s cannot be empty", name)
+	}
+	for _, u := range urls {
+		if strings.TrimSpace(u) == "" {
+			return fmt.Errorf("symbiotic %s cannot contain an empty url", name)
+		}
+	}
+
+	return nil
+}
+
+// DefaultSymbioticConfigTemplate is the app.toml template of the [symbiotic]
+// section, to be appended to the server config template.
+const DefaultSymbioticConfigTemplate = `
+
+###############################################################################
+###                         Symbiotic Configuration                         ###
+###############################################################################
+
+[symbiotic]
+
+# Beacon node HTTP API endpoints, tried in order. Use your own beacon node,
+# the finalized block hash the chain agrees on is read from it.
+beacon-api-urls = [{{ range $i, $u := .Symbiotic.BeaconAPIURLs }}{{ if $i }}, {{ end }}"{{ $u }}"{{ end }}]
+
+# Execution node JSON-RPC endpoints, tried in order.
+eth-api-urls = [{{ range $i, $u := .Symbiotic.EthAPIURLs }}{{ if $i }}, {{ end }}"{{ $u }}"{{ end }}]
+
+# Address of the Symbiotic network middleware. It must match the middleware
+# address set in the symStaking genesis params or the node will not start.
+middleware-address = "{{ .Symbiotic.MiddlewareAddress }}"
+
+# Ethereum chain the middleware is deployed on: mainnet, sepolia, holesky or devnet.
+# The execution endpoints chain id is checked against it, except on devnet. It must
+# match the ethereum_network param of the chain.
+chain = "{{ .Symbiotic.Chain }}"
+
+# Timeout of a single beacon or execution request.
+request-timeout = "{{ .Symbiotic.RequestTimeout }}"
+
+# Number of attempts made before a request fails, rotating through the endpoints.
+retries = {{ .Symbiotic.Retries }}
+
+# Delay before the first retry, doubled after each attempt.
+retry-backoff = "{{ .Symbiotic.RetryBackoff }}"
+
+# Maximum time spent retrying a single request across all endpoints.
+max-retry-duration = "{{ .Symbiotic.MaxRetryDuration }}"
+
+# What to do when a sync is skipped, because no more than 2/3 of the voting
+# power could read its Ethereum data: "skip" keeps the current stakes until the
+# next sync, "halt" stops the node with a non-zero exit code. A failing endpoint
+# only makes this node attest INVALID_BLOCKHASH, whatever the policy.
+on-sync-failure = "{{ .Symbiotic.OnSyncFailure }}"
+
+# Verify the validator set instead of trusting the endpoints. The middleware
+# calls are executed locally against eth_getProof storage proofs, checked
+# against the finalized execution block, itself checked against beacon finality
+# with a sync committee light client. Not supported on devnet.
+light-client = {{ .Symbiotic.LightClient }}
+
+# Trusted beacon block root the light client starts from, required with
+# light-client. Take a recent finalized block root from a source you trust, it
+# must be within the weak subjectivity period (about two weeks).
+light-client-checkpoint = "{{ .Symbiotic.LightClientCheckpoint }}"
+`
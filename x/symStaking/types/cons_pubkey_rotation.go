@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = ConsPubKeyRotation{}
+
+// OldConsPubKey returns the consensus public key replaced by the rotation.
+func (r ConsPubKeyRotation) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// NewConsPubKey returns the consensus public key of the validator after the
+// rotation.
+func (r ConsPubKeyRotation) NewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotation) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var oldPk, newPk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldConsPubkey, &oldPk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewConsPubkey, &newPk)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryConsPubKeyRotationsResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	if res.Pending != nil {
+		if err := res.Pending.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for i := range res.History {
+		if err := res.History[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
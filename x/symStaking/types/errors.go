@@ -53,4 +53,16 @@ var (
 
 	ErrSymbioticValUpdate = errors.Register(ModuleName, 48, "symbiotic validator update error")
 	ErrSymbioticNotFound  = errors.Register(ModuleName, 49, "symbiotic not found")
+	ErrInvalidInjectedTx  = errors.Register(ModuleName, 50, "invalid injected tx")
+
+	// operator binding errors
+	ErrInvalidOperatorBinding  = errors.Register(ModuleName, 51, "invalid operator binding")
+	ErrOperatorBindingRequired = errors.Register(ModuleName, 52, "operator binding required")
+	ErrOperatorMismatch        = errors.Register(ModuleName, 53, "operator does not match the middleware")
+
+	ErrConsPubKeyRotated = errors.Register(ModuleName, 54, "consensus pubkey was rotated")
+
+	ErrSymbioticPending = errors.Register(ModuleName, 55, "symbiotic request pending")
+
+	ErrInvalidOperatorExec = errors.Register(ModuleName, 56, "invalid operator exec")
 )
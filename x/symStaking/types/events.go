@@ -7,8 +7,88 @@ const (
 	EventTypeEditValidator     = "edit_validator"
 	EventTypeUnbond            = "unbond"
 
+	// EventTypeUpdateOperatorBinding is emitted when a validator is bound to
+	// a Symbiotic operator.
+	EventTypeUpdateOperatorBinding = "update_operator_binding"
+	// EventTypeRotateConsPubKey is emitted when a validator requests the
+	// rotation of its consensus key.
+	EventTypeRotateConsPubKey = "rotate_cons_pubkey"
+	// EventTypeConsPubKeyRotated is emitted when a Symbiotic sync reports the
+	// new consensus key of a validator and the rotation is applied.
+	EventTypeConsPubKeyRotated = "cons_pubkey_rotated"
+	// EventTypeOperatorExec is emitted when the operator of a validator
+	// executes messages on its behalf.
+	EventTypeOperatorExec = "operator_exec"
+
+	// EventTypeSymbioticUnmatchedValidator is emitted for each middleware
+	// validator set entry no validator was found for.
+	EventTypeSymbioticUnmatchedValidator = "symbiotic_unmatched_validator"
+	// EventTypeSymbioticRemoveValidator is emitted when a validator missing
+	// from the middleware validator set loses its tokens.
+	EventTypeSymbioticRemoveValidator = "symbiotic_remove_validator"
+	// EventTypeSymbioticSyncSkipped is emitted for each sync height the
+	// validator set could not be synced at.
+	EventTypeSymbioticSyncSkipped = "symbiotic_sync_skipped"
+	// EventTypeSymbioticSafetyMode is emitted when the safety mode entered on
+	// stale stake changes.
+	EventTypeSymbioticSafetyMode = "symbiotic_safety_mode"
+	// EventTypeSymbioticPowerChangeLimited is emitted for each sync height
+	// part of the power changes is kept pending because of the
+	// max_power_change_rate param.
+	EventTypeSymbioticPowerChangeLimited = "symbiotic_power_change_limited"
+	// EventTypeSymbioticStakeUpdated is emitted for each validator a sync
+	// changes the tokens of.
+	EventTypeSymbioticStakeUpdated = "symbiotic_stake_updated"
+	// EventTypeSymbioticOperatorUnknown is emitted for each middleware
+	// validator set entry whose consensus key no validator uses.
+	EventTypeSymbioticOperatorUnknown = "symbiotic_operator_unknown"
+
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
 	AttributeKeyCreationHeight = "creation_height"
 	AttributeKeyCompletionTime = "completion_time"
+	AttributeKeyConsAddress    = "cons_address"
+	AttributeKeyConsPubKey     = "cons_pubkey"
+	AttributeKeyOperator       = "operator"
+	AttributeKeyStake          = "stake"
+	AttributeKeyPreviousStake  = "previous_stake"
+	AttributeKeyReason         = "reason"
+	AttributeKeyHeight         = "height"
+	AttributeKeySkippedSyncs   = "skipped_syncs"
+	AttributeKeyStaleness      = "staleness"
+	AttributeKeySafetyMode     = "safety_mode"
+	AttributeKeyAppliedTokens  = "applied_tokens"
+	AttributeKeyPendingTokens  = "pending_tokens"
+	AttributeKeyOldConsAddress = "old_cons_address"
+	AttributeKeyNewConsAddress = "new_cons_address"
+
+	// UnmatchedReasonInvalidKey is the reason of an entry whose consensus key
+	// does not match its key type.
+	UnmatchedReasonInvalidKey = "invalid_key"
+	// UnmatchedReasonNoValidator is the reason of an entry no validator has
+	// the consensus address of.
+	UnmatchedReasonNoValidator = "no_validator"
+	// UnmatchedReasonUnsupportedKey is the reason of an entry whose consensus
+	// key type is not allowed by the consensus params.
+	UnmatchedReasonUnsupportedKey = "unsupported_key"
+	// UnmatchedReasonOperatorExists is the reason of an entry whose operator
+	// already has a validator with another consensus key.
+	UnmatchedReasonOperatorExists = "operator_exists"
+	// UnmatchedReasonOperatorMismatch is the reason of an entry whose
+	// operator is not the one its validator is bound to.
+	UnmatchedReasonOperatorMismatch = "operator_mismatch"
+	// UnmatchedReasonRotatedKey is the reason of an entry whose consensus key
+	// was rotated away from by its validator.
+	UnmatchedReasonRotatedKey = "rotated_key"
+
+	// SkippedReasonInvalidBlockHash is the reason of a sync skipped because no
+	// finalized execution block hash was agreed on.
+	SkippedReasonInvalidBlockHash = "invalid_block_hash"
+	// SkippedReasonNotAttested is the reason of a sync skipped because the
+	// cached block hash was not attested through vote extensions.
+	SkippedReasonNotAttested = "not_attested"
+	// SkippedReasonStaleCache is the reason of a sync skipped because the
+	// block hash was cached for another height, under a sync period changed
+	// since.
+	SkippedReasonStaleCache = "stale_cache"
 )
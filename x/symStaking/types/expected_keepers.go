@@ -85,6 +85,10 @@ type StakingHooks interface {
 
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 	AfterUnbondingInitiated(ctx context.Context, id uint64) error
+	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error // Must be called when a validator rotates its consensus key
+
+	AfterSymbioticStakeChanged(ctx context.Context, valAddr sdk.ValAddress, oldTokens, newTokens math.Int) error // Must be called when a Symbiotic sync changes the tokens of a validator
+	AfterSymbioticSync(ctx context.Context, record SymbioticSyncRecord) error                                    // Must be called after each Symbiotic sync height, applied or skipped
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
@@ -97,3 +101,9 @@ type ConsensusKeeper interface {
 	Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 	GetCometInfo(context.Context, *consensustypes.QueryGetCometInfoRequest) (*consensustypes.QueryGetCometInfoResponse, error)
 }
+
+// CircuitBreaker is the circuit breaker of the app, gating messages by type
+// URL.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
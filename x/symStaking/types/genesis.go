@@ -2,8 +2,15 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"slices"
+	"time"
 
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -42,5 +49,96 @@ func (g GenesisState) UnpackInterfaces(c gogoprotoany.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.PendingConsPubkeyRotations {
+		if err := g.PendingConsPubkeyRotations[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	for i := range g.ConsPubkeyRotationHistory {
+		if err := g.ConsPubkeyRotationHistory[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplySymbioticSnapshot bootstraps the genesis validator power from the
+// middleware validator set of an execution block, as the first sync would:
+// the genesis validators get the stake of their consensus key, or none, and
+// the snapshot is recorded as the sync of height 0. It is also cached as the
+// attested validator set of height 0, so that the validators created by
+// genesis transactions get their stake at InitChain without reading Ethereum.
+func (g *GenesisState) ApplySymbioticSnapshot(snapshot CachedBlockHash, genesisTime time.Time, consAddrCodec address.Codec) error {
+	validators, ok := snapshot.SymbioticValidators()
+	if !ok {
+		return errors.New("malformed symbiotic snapshot validator set")
+	}
+
+	valIndex := make(map[string]int, len(g.Validators))
+	for i, val := range g.Validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		valIndex[string(consAddr)] = i
+		g.Validators[i].Tokens = math.ZeroInt()
+	}
+
+	bindings := make(map[string]string, len(g.OperatorBindings))
+	for _, binding := range g.OperatorBindings {
+		bindings[binding.ValidatorAddress] = binding.Operator
+	}
+
+	record := SymbioticSyncRecord{
+		Height:         0,
+		Time:           genesisTime,
+		BlockHash:      snapshot.BlockHash,
+		BlockNumber:    snapshot.BlockNumber,
+		BlockTimestamp: snapshot.BlockTimestamp,
+		Epoch:          g.Params.BeaconEpoch(snapshot.BlockTimestamp),
+		Stakes:         make([]SymbioticSyncStake, len(validators)),
+	}
+	for i, v := range validators {
+		stake := math.NewIntFromBigInt(v.Stake)
+		record.Stakes[i] = SymbioticSyncStake{Operator: v.Operator, Stake: stake}
+
+		consAddr, err := v.ConsAddress()
+		if err != nil {
+			continue
+		}
+		if record.Stakes[i].ConsAddress, err = consAddrCodec.BytesToString(consAddr); err != nil {
+			return err
+		}
+
+		j, found := valIndex[string(consAddr)]
+		if !found {
+			continue
+		}
+		// the stake of an operator is not given to a validator it did not
+		// bind, as checked by the syncs
+		operator, bound := bindings[g.Validators[j].OperatorAddress]
+		if bound && (len(v.Operator) != common.AddressLength || common.BytesToAddress(v.Operator) != common.HexToAddress(operator)) {
+			continue
+		}
+		if !bound && g.Params.RequireOperatorBinding {
+			continue
+		}
+
+		g.Validators[j].Tokens = stake
+		record.Stakes[i].ValidatorAddress = g.Validators[j].OperatorAddress
+	}
+
+	g.SymbioticSyncs = slices.DeleteFunc(g.SymbioticSyncs, func(r SymbioticSyncRecord) bool { return r.Height == 0 })
+	g.SymbioticSyncs = append([]SymbioticSyncRecord{record}, g.SymbioticSyncs...)
+	g.SymbioticPendingPowerChanges = nil
+	g.CachedBlockHash = &CachedBlockHash{
+		BlockHash:      snapshot.BlockHash,
+		Height:         0,
+		Attested:       true,
+		Validators:     snapshot.Validators,
+		BlockNumber:    snapshot.BlockNumber,
+		BlockTimestamp: snapshot.BlockTimestamp,
+	}
+
 	return nil
 }
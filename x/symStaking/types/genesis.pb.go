@@ -40,6 +40,27 @@ type GenesisState struct {
 	Validators []Validator `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,5,opt,name=exported,proto3" json:"exported,omitempty"`
+	// symbiotic_sync_checkpoint is the last applied Symbiotic sync checkpoint,
+	// nil to start tracking stake staleness at the first block.
+	SymbioticSyncCheckpoint *SymbioticSyncCheckpoint `protobuf:"bytes,6,opt,name=symbiotic_sync_checkpoint,json=symbioticSyncCheckpoint,proto3" json:"symbiotic_sync_checkpoint,omitempty"`
+	// symbiotic_pending_power_changes are the validator stakes not fully applied
+	// yet because of the max_power_change_rate param.
+	SymbioticPendingPowerChanges []SymbioticPendingPowerChange `protobuf:"bytes,7,rep,name=symbiotic_pending_power_changes,json=symbioticPendingPowerChanges,proto3" json:"symbiotic_pending_power_changes"`
+	// cached_block_hash is the execution block hash stored for the pending
+	// Symbiotic sync, nil if none is.
+	CachedBlockHash *CachedBlockHash `protobuf:"bytes,8,opt,name=cached_block_hash,json=cachedBlockHash,proto3" json:"cached_block_hash,omitempty"`
+	// operator_bindings are the Symbiotic operators the validators are bound to.
+	OperatorBindings []OperatorBinding `protobuf:"bytes,9,rep,name=operator_bindings,json=operatorBindings,proto3" json:"operator_bindings"`
+	// pending_cons_pubkey_rotations are the consensus key rotations not reported
+	// by the Symbiotic middleware yet.
+	PendingConsPubkeyRotations []ConsPubKeyRotation `protobuf:"bytes,10,rep,name=pending_cons_pubkey_rotations,json=pendingConsPubkeyRotations,proto3" json:"pending_cons_pubkey_rotations"`
+	// cons_pubkey_rotation_history are the applied consensus key rotations, by
+	// ascending rotated height.
+	ConsPubkeyRotationHistory []ConsPubKeyRotation `protobuf:"bytes,11,rep,name=cons_pubkey_rotation_history,json=consPubkeyRotationHistory,proto3" json:"cons_pubkey_rotation_history"`
+	// symbiotic_syncs are the kept Symbiotic sync records, by ascending height.
+	// The last applied one of a genesis bootstrapped from a Symbiotic snapshot
+	// is that snapshot, the tokens of the genesis validators must match.
+	SymbioticSyncs []SymbioticSyncRecord `protobuf:"bytes,12,rep,name=symbiotic_syncs,json=symbioticSyncs,proto3" json:"symbiotic_syncs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,6 +124,55 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetSymbioticSyncCheckpoint() *SymbioticSyncCheckpoint {
+	if m != nil {
+		return m.SymbioticSyncCheckpoint
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbioticPendingPowerChanges() []SymbioticPendingPowerChange {
+	if m != nil {
+		return m.SymbioticPendingPowerChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCachedBlockHash() *CachedBlockHash {
+	if m != nil {
+		return m.CachedBlockHash
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorBindings() []OperatorBinding {
+	if m != nil {
+		return m.OperatorBindings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingConsPubkeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.PendingConsPubkeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubkeyRotationHistory() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubkeyRotationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbioticSyncs() []SymbioticSyncRecord {
+	if m != nil {
+		return m.SymbioticSyncs
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -154,34 +224,50 @@ func init() {
 }
 
 var fileDescriptor_c4a78334c4fc7e58 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0xc6, 0x5d, 0xbb, 0xb3, 0x8b, 0xe8, 0xd8, 0x85, 0xd8, 0x43, 0x12, 0x17, 0xc1,
-	0x20, 0x34, 0x71, 0xeb, 0x49, 0x6f, 0x06, 0x41, 0x16, 0x04, 0x4b, 0x2a, 0x1e, 0x7a, 0x29, 0x53,
-	0x33, 0xa4, 0xa1, 0x49, 0x26, 0x64, 0xfe, 0xd4, 0xf6, 0x0d, 0x04, 0x2f, 0x3e, 0x42, 0x8f, 0x1e,
-	0x3d, 0xf4, 0x21, 0x7a, 0x2c, 0x3d, 0x89, 0x87, 0x22, 0xed, 0x41, 0x1f, 0x43, 0x92, 0x49, 0xdb,
-	0x48, 0xb1, 0x97, 0x90, 0xc9, 0xff, 0xfb, 0x7e, 0xdf, 0x47, 0xe6, 0x8f, 0x9f, 0x7c, 0xe4, 0x22,
-	0xe6, 0xc2, 0x11, 0x93, 0xb8, 0x03, 0x74, 0x18, 0x26, 0x81, 0x33, 0xba, 0xee, 0x33, 0xa0, 0xd7,
-	0x4e, 0xc0, 0x12, 0x26, 0x42, 0x61, 0xa7, 0x19, 0x07, 0x4e, 0x1e, 0x4a, 0xa1, 0xbd, 0x17, 0xda,
-	0xa5, 0xb0, 0x51, 0x0f, 0x78, 0xc0, 0x0b, 0x95, 0x93, 0xbf, 0x49, 0x43, 0xe3, 0x08, 0x59, 0x94,
-	0x00, 0x29, 0x2c, 0xc9, 0x3d, 0x49, 0x28, 0x63, 0xe4, 0xe8, 0x3e, 0x8d, 0xc3, 0x84, 0x3b, 0xc5,
-	0x53, 0x7e, 0xba, 0xfa, 0xa2, 0xe2, 0x8b, 0x37, 0xb2, 0x59, 0x07, 0x28, 0x30, 0xf2, 0x1a, 0x9f,
-	0xa6, 0x34, 0xa3, 0xb1, 0xd0, 0x90, 0x89, 0xac, 0xf3, 0xd6, 0x23, 0xfb, 0xbf, 0x4d, 0xed, 0x76,
-	0x21, 0x74, 0xcf, 0xe6, 0x2b, 0x43, 0xf9, 0xf6, 0xfb, 0xfb, 0x53, 0xe4, 0x95, 0x5e, 0xd2, 0xc5,
-	0xf7, 0x22, 0x2a, 0xa0, 0x07, 0x1c, 0x68, 0xd4, 0x4b, 0xf9, 0x27, 0x96, 0x69, 0xb7, 0x4c, 0x64,
-	0x5d, 0xb8, 0xcf, 0x72, 0xf1, 0xcf, 0x95, 0x71, 0x29, 0xb1, 0xc2, 0x1f, 0xda, 0x21, 0x77, 0x62,
-	0x0a, 0x03, 0xfb, 0x26, 0x81, 0xe5, 0xac, 0x89, 0xcb, 0xbc, 0x9b, 0x04, 0x24, 0xf3, 0x6e, 0x4e,
-	0x7a, 0x9f, 0x83, 0xda, 0x39, 0x87, 0x44, 0xf8, 0xb2, 0x60, 0x8f, 0x68, 0x14, 0xfa, 0x14, 0x78,
-	0x26, 0xf9, 0x42, 0x53, 0x4d, 0xd5, 0x3a, 0x6f, 0x35, 0x8f, 0x14, 0x7e, 0x4b, 0x05, 0x7c, 0xd8,
-	0xda, 0x0a, 0x5a, 0xb5, 0xfc, 0x83, 0xe8, 0x60, 0x2c, 0xc8, 0x3b, 0x8c, 0x77, 0x41, 0x42, 0xbb,
-	0x5d, 0x44, 0x3c, 0x3e, 0x12, 0xb1, 0xf3, 0x57, 0xc9, 0x15, 0x04, 0x69, 0xe0, 0x1a, 0x1b, 0xa7,
-	0x3c, 0x03, 0xe6, 0x6b, 0x27, 0x26, 0xb2, 0x6a, 0xde, 0xee, 0x7c, 0x35, 0xc0, 0xe4, 0xb0, 0x22,
-	0x69, 0xe1, 0x3b, 0xd4, 0xf7, 0x33, 0x26, 0xe4, 0x9d, 0x9c, 0xb9, 0xda, 0x72, 0xd6, 0xac, 0x97,
-	0x15, 0x5e, 0xc9, 0x49, 0x07, 0xb2, 0x30, 0x09, 0xbc, 0xad, 0x90, 0xd4, 0xf1, 0xc9, 0xfe, 0xaf,
-	0xab, 0x9e, 0x3c, 0xbc, 0xac, 0x7d, 0x9e, 0x1a, 0xca, 0x9f, 0xa9, 0xa1, 0xb8, 0x2f, 0xe6, 0x6b,
-	0x1d, 0x2d, 0xd6, 0x3a, 0xfa, 0xb5, 0xd6, 0xd1, 0xd7, 0x8d, 0xae, 0x2c, 0x36, 0xba, 0xf2, 0x63,
-	0xa3, 0x2b, 0x5d, 0xe3, 0x9f, 0x8b, 0x19, 0x57, 0x17, 0x0e, 0x26, 0x29, 0x13, 0xfd, 0xd3, 0x62,
-	0x73, 0x9e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf6, 0x83, 0x12, 0xec, 0x02, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x22, 0xd0, 0x0e, 0x84, 0x3f, 0x23, 0xc4, 0xa5, 0xc1, 0xb6, 0x12, 0x13, 0x2b,
+	0x09, 0x5b, 0xa9, 0x89, 0x89, 0xde, 0x6c, 0x4d, 0x84, 0x68, 0x42, 0xb3, 0x35, 0x1c, 0xb8, 0x6c,
+	0x66, 0x67, 0x27, 0xdd, 0x4d, 0xdb, 0x99, 0xcd, 0xbe, 0x03, 0xb2, 0xf1, 0x0b, 0x70, 0xf4, 0x13,
+	0x18, 0x8e, 0x1e, 0x3d, 0xf0, 0x21, 0x38, 0x12, 0x4e, 0xc6, 0x03, 0x31, 0x70, 0xd0, 0x8f, 0x61,
+	0x3a, 0xb3, 0x6d, 0xb7, 0x01, 0x2a, 0x89, 0x17, 0xc2, 0xee, 0xfb, 0x3c, 0xcf, 0x6f, 0xde, 0xd9,
+	0xb7, 0x2f, 0x7a, 0x4a, 0x05, 0x74, 0x05, 0x54, 0x20, 0xee, 0x36, 0x25, 0x69, 0x07, 0xbc, 0x55,
+	0x39, 0xd8, 0x74, 0x99, 0x24, 0x9b, 0x95, 0x16, 0xe3, 0x0c, 0x02, 0xb0, 0xc2, 0x48, 0x48, 0x81,
+	0x57, 0xb4, 0xd0, 0x1a, 0x0a, 0xad, 0x44, 0x98, 0x5f, 0x6a, 0x89, 0x96, 0x50, 0xaa, 0x4a, 0xef,
+	0x3f, 0x6d, 0xc8, 0x8f, 0x49, 0x86, 0x24, 0x40, 0x0b, 0x9f, 0x8d, 0x11, 0xc6, 0x5d, 0x37, 0x10,
+	0x32, 0xa0, 0x89, 0x34, 0x39, 0x84, 0xa3, 0x61, 0xc9, 0x89, 0x74, 0x69, 0x91, 0x74, 0x03, 0x2e,
+	0x2a, 0xea, 0xaf, 0x7e, 0xb5, 0xf6, 0x35, 0x87, 0x66, 0xdf, 0xe9, 0x26, 0x9a, 0x92, 0x48, 0x86,
+	0xdf, 0xa2, 0xa9, 0x90, 0x44, 0xa4, 0x0b, 0xa6, 0x51, 0x32, 0xca, 0x33, 0xd5, 0xc7, 0xd6, 0xad,
+	0x4d, 0x59, 0x0d, 0x25, 0xac, 0xe5, 0x4e, 0x2f, 0x8a, 0x99, 0x6f, 0xbf, 0xbf, 0xaf, 0x1b, 0x76,
+	0xe2, 0xc5, 0x7b, 0x68, 0xa1, 0x43, 0x40, 0x3a, 0x52, 0x48, 0xd2, 0x71, 0x42, 0xf1, 0x89, 0x45,
+	0xe6, 0xbd, 0x92, 0x51, 0x9e, 0xad, 0x3d, 0xef, 0x89, 0x7f, 0x5e, 0x14, 0x97, 0x75, 0x2c, 0x78,
+	0x6d, 0x2b, 0x10, 0x95, 0x2e, 0x91, 0xbe, 0xb5, 0xcd, 0xe5, 0xf9, 0xc9, 0x06, 0x4a, 0x78, 0xdb,
+	0x5c, 0xea, 0xcc, 0xb9, 0x5e, 0xd2, 0xc7, 0x5e, 0x50, 0xa3, 0x97, 0x83, 0x3b, 0x68, 0x59, 0x65,
+	0x1f, 0x90, 0x4e, 0xe0, 0x11, 0x29, 0x22, 0x9d, 0x0f, 0xe6, 0x44, 0x69, 0xa2, 0x3c, 0x53, 0xdd,
+	0x18, 0x73, 0xe0, 0x0f, 0x04, 0xe4, 0x6e, 0xdf, 0xa6, 0xd2, 0xd2, 0x87, 0x7f, 0xd0, 0xb9, 0x56,
+	0x06, 0xbc, 0x83, 0xd0, 0x00, 0x04, 0xe6, 0x7d, 0x85, 0x78, 0x32, 0x06, 0x31, 0xf0, 0xa7, 0x93,
+	0x53, 0x11, 0x38, 0x8f, 0xb2, 0xec, 0x30, 0x14, 0x91, 0x64, 0x9e, 0x39, 0x59, 0x32, 0xca, 0x59,
+	0x7b, 0xf0, 0x8c, 0x39, 0x5a, 0x19, 0x7c, 0x4e, 0x07, 0x62, 0x4e, 0x1d, 0xea, 0x33, 0xda, 0x0e,
+	0x45, 0xc0, 0xa5, 0x39, 0xa5, 0xbe, 0x47, 0x75, 0x0c, 0xbb, 0xd9, 0xf7, 0x36, 0x63, 0x4e, 0xeb,
+	0x03, 0xa7, 0xfd, 0x10, 0x6e, 0x2e, 0xe0, 0x23, 0x03, 0x15, 0x87, 0xc0, 0x90, 0x71, 0x2f, 0xe0,
+	0x2d, 0x7d, 0x9d, 0x0e, 0xf5, 0x09, 0x6f, 0x31, 0x30, 0xa7, 0x55, 0xcb, 0x2f, 0xef, 0x82, 0x6d,
+	0xe8, 0x00, 0x75, 0x73, 0x75, 0x65, 0x4f, 0x5f, 0xc2, 0x2a, 0xdc, 0xae, 0x03, 0xbc, 0x8b, 0x16,
+	0x29, 0xa1, 0x3e, 0xf3, 0x1c, 0xb7, 0x23, 0x68, 0xdb, 0xf1, 0x09, 0xf8, 0x66, 0x56, 0xb5, 0xbc,
+	0x3e, 0x86, 0x5d, 0x57, 0x9e, 0x5a, 0xcf, 0xb2, 0x45, 0xc0, 0xb7, 0xe7, 0xe9, 0xe8, 0x0b, 0xec,
+	0xa2, 0x45, 0x11, 0xb2, 0x48, 0xcd, 0x89, 0x1b, 0x28, 0x2e, 0x98, 0x39, 0xd5, 0xd3, 0xb8, 0xdc,
+	0x9d, 0xc4, 0x53, 0xd3, 0x96, 0x74, 0x1f, 0x0b, 0x62, 0xb4, 0x06, 0xf8, 0x33, 0x7a, 0xd4, 0xbf,
+	0x3b, 0x2a, 0x38, 0x38, 0xe1, 0xbe, 0xdb, 0x66, 0xb1, 0x13, 0x09, 0x49, 0x64, 0x20, 0x38, 0x98,
+	0xe8, 0x9f, 0x93, 0x59, 0x17, 0x1c, 0x1a, 0xfb, 0xee, 0x7b, 0x16, 0xdb, 0x89, 0x2b, 0x8d, 0xcc,
+	0x27, 0xf1, 0x89, 0xaa, 0x3d, 0x54, 0x01, 0x8e, 0xd1, 0xea, 0x4d, 0x50, 0xc7, 0x0f, 0x40, 0x8a,
+	0x28, 0x36, 0x67, 0xfe, 0x93, 0xbd, 0x42, 0xaf, 0x41, 0xb7, 0x74, 0x34, 0x76, 0xd1, 0xfc, 0xe8,
+	0xb8, 0x82, 0x39, 0xab, 0x68, 0xd6, 0x5d, 0x87, 0xd4, 0x66, 0x54, 0x44, 0x5e, 0x1a, 0x37, 0x37,
+	0x32, 0xab, 0xb0, 0xe6, 0x23, 0x7c, 0xfd, 0x57, 0x8b, 0xab, 0x68, 0x9a, 0x78, 0x5e, 0xc4, 0x40,
+	0xaf, 0xa9, 0x5c, 0xcd, 0x3c, 0x3f, 0xd9, 0x58, 0x4a, 0xa0, 0x6f, 0x74, 0xa5, 0x29, 0xa3, 0x80,
+	0xb7, 0xec, 0xbe, 0x10, 0x2f, 0xa1, 0xc9, 0xe1, 0x22, 0x9a, 0xb0, 0xf5, 0xc3, 0xeb, 0xec, 0xd1,
+	0x71, 0x31, 0xf3, 0xe7, 0xb8, 0x98, 0xa9, 0xbd, 0x3a, 0xbd, 0x2c, 0x18, 0x67, 0x97, 0x05, 0xe3,
+	0xd7, 0x65, 0xc1, 0xf8, 0x72, 0x55, 0xc8, 0x9c, 0x5d, 0x15, 0x32, 0x3f, 0xae, 0x0a, 0x99, 0xbd,
+	0xe2, 0xc8, 0xae, 0x3a, 0x4c, 0x6f, 0x61, 0x19, 0x87, 0x0c, 0xdc, 0x29, 0xb5, 0x4c, 0x5f, 0xfc,
+	0x0d, 0x00, 0x00, 0xff, 0xff, 0x43, 0xcb, 0xcd, 0x21, 0x2a, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +290,100 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SymbioticSyncs) > 0 {
+		for iNdEx := len(m.SymbioticSyncs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbioticSyncs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PendingConsPubkeyRotations) > 0 {
+		for iNdEx := len(m.PendingConsPubkeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingConsPubkeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OperatorBindings) > 0 {
+		for iNdEx := len(m.OperatorBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CachedBlockHash != nil {
+		{
+			size, err := m.CachedBlockHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SymbioticPendingPowerChanges) > 0 {
+		for iNdEx := len(m.SymbioticPendingPowerChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbioticPendingPowerChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SymbioticSyncCheckpoint != nil {
+		{
+			size, err := m.SymbioticSyncCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -336,6 +516,44 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if m.SymbioticSyncCheckpoint != nil {
+		l = m.SymbioticSyncCheckpoint.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SymbioticPendingPowerChanges) > 0 {
+		for _, e := range m.SymbioticPendingPowerChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CachedBlockHash != nil {
+		l = m.CachedBlockHash.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OperatorBindings) > 0 {
+		for _, e := range m.OperatorBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingConsPubkeyRotations) > 0 {
+		for _, e := range m.PendingConsPubkeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbioticSyncs) > 0 {
+		for _, e := range m.SymbioticSyncs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -544,6 +762,248 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SymbioticSyncCheckpoint == nil {
+				m.SymbioticSyncCheckpoint = &SymbioticSyncCheckpoint{}
+			}
+			if err := m.SymbioticSyncCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticPendingPowerChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticPendingPowerChanges = append(m.SymbioticPendingPowerChanges, SymbioticPendingPowerChange{})
+			if err := m.SymbioticPendingPowerChanges[len(m.SymbioticPendingPowerChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedBlockHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CachedBlockHash == nil {
+				m.CachedBlockHash = &CachedBlockHash{}
+			}
+			if err := m.CachedBlockHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorBindings = append(m.OperatorBindings, OperatorBinding{})
+			if err := m.OperatorBindings[len(m.OperatorBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConsPubkeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingConsPubkeyRotations = append(m.PendingConsPubkeyRotations, ConsPubKeyRotation{})
+			if err := m.PendingConsPubkeyRotations[len(m.PendingConsPubkeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistory = append(m.ConsPubkeyRotationHistory, ConsPubKeyRotation{})
+			if err := m.ConsPubkeyRotationHistory[len(m.ConsPubkeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbioticSyncs = append(m.SymbioticSyncs, SymbioticSyncRecord{})
+			if err := m.SymbioticSyncs[len(m.SymbioticSyncs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
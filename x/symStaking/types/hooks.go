@@ -5,6 +5,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -80,3 +81,30 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint6
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterSymbioticStakeChanged(ctx context.Context, valAddr sdk.ValAddress, oldTokens, newTokens sdkmath.Int) error {
+	for i := range h {
+		if err := h[i].AfterSymbioticStakeChanged(ctx, valAddr, oldTokens, newTokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterSymbioticSync(ctx context.Context, record SymbioticSyncRecord) error {
+	for i := range h {
+		if err := h[i].AfterSymbioticSync(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InjectedTxVersion is the current version of the InjectedTx envelope.
+const InjectedTxVersion = 1
+
+// Bytes returns the envelope encoded after sdk.InjectedTxPrefix, as included
+// in a block proposal.
+func (tx InjectedTx) Bytes() ([]byte, error) {
+	bz, err := tx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, sdk.InjectedTxPrefix...), bz...), nil
+}
+
+// DecodeInjectedTx decodes an injected tx and checks its version and type.
+func DecodeInjectedTx(bz []byte) (InjectedTx, error) {
+	var tx InjectedTx
+	if !sdk.IsInjectedTx(bz) {
+		return tx, errorsmod.Wrap(ErrInvalidInjectedTx, "missing injected tx prefix")
+	}
+
+	if err := tx.Unmarshal(bz[len(sdk.InjectedTxPrefix):]); err != nil {
+		return tx, errorsmod.Wrap(ErrInvalidInjectedTx, err.Error())
+	}
+
+	if tx.Version != InjectedTxVersion {
+		return tx, errorsmod.Wrapf(ErrInvalidInjectedTx, "unsupported version %d", tx.Version)
+	}
+
+	if _, ok := InjectedTxType_name[int32(tx.Type)]; !ok || tx.Type == InjectedTxTypeUnspecified {
+		return tx, errorsmod.Wrapf(ErrInvalidInjectedTx, "unknown type %d", tx.Type)
+	}
+
+	return tx, nil
+}
@@ -23,7 +23,7 @@ const (
 	RouterKey = ModuleName
 
 	// GovModuleName is the name of the gov module
-	GovModuleName = "gov"
+	GovModuleName = "symGov"
 
 	// PoolModuleName duplicates the Protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
 	// It should be synced with the distribution module's name if it is ever changed.
@@ -52,6 +52,19 @@ var (
 	ParamsKey         = collections.NewPrefix(81) // prefix for parameters for module x/symStaking
 
 	CachedBlockHashKey = collections.NewPrefix(90) // prefix for finalized blockhash
+	SymbioticSyncsKey  = collections.NewPrefix(91) // prefix for the Symbiotic sync records
+
+	SymbioticSyncCheckpointKey      = collections.NewPrefix(92) // key for the last applied Symbiotic sync checkpoint
+	SymbioticPendingPowerChangesKey = collections.NewPrefix(93) // prefix for the Symbiotic power changes not applied yet
+	OperatorBindingsKey             = collections.NewPrefix(94) // prefix for the Symbiotic operators the validators are bound to
+	VoterStakeRequestsKey           = collections.NewPrefix(98) // prefix for the Symbiotic voter stake requests
+	VoterStakeRequestsByHeightKey   = collections.NewPrefix(99) // prefix for an index of the Symbiotic voter stake requests by height
+
+	PendingConsPubKeyRotationsKey         = collections.NewPrefix(95)  // prefix for the consensus pubkey rotations not reported by the middleware yet
+	ConsPubKeyRotationUpdatesKey          = collections.NewPrefix(96)  // prefix for the consensus pubkey rotations not sent to CometBFT yet
+	ValidatorConsPubKeyRotationHistoryKey = collections.NewPrefix(101) // prefix for the consensus pubkey rotation history by validator
+	ConsAddrToValidatorIdentifierMapKey   = collections.NewPrefix(105) // prefix for rotated cons address to the initial cons address
+	OldToNewConsAddrMapKey                = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
 
 )
 
@@ -0,0 +1,439 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Consensus key types a middleware can report.
+const (
+	// KeyTypeConsAddress is a 20 bytes consensus address, left aligned in a
+	// bytes32 or given as an address.
+	KeyTypeConsAddress = "cons_address"
+	KeyTypeEd25519     = "ed25519"
+	KeyTypeSecp256k1   = "secp256k1"
+	KeyTypeBls12381    = "bls12_381"
+)
+
+// consPubKeySizes are the sizes of the supported consensus public keys.
+var consPubKeySizes = map[string]int{
+	KeyTypeEd25519:   32,
+	KeyTypeSecp256k1: 33,
+	KeyTypeBls12381:  48,
+}
+
+// SimpleMiddlewareABI is the ABI of the SimpleMiddleware methods the validator
+// set is read with by default.
+const SimpleMiddlewareABI = `[
+	{
+		"type": "function",
+		"name": "getCurrentEpoch",
+		"outputs": [
+			{
+				"name": "epoch",
+				"type": "uint48",
+				"internalType": "uint48"
+			}
+		],
+		"stateMutability": "view"
+	},
+	{
+		"type": "function",
+		"name": "getValidatorSet",
+		"inputs": [
+			{
+				"name": "epoch",
+				"type": "uint48",
+				"internalType": "uint48"
+			}
+		],
+		"outputs": [
+			{
+				"name": "validatorsData",
+				"type": "tuple[]",
+				"internalType": "struct SimpleMiddleware.ValidatorData[]",
+				"components": [
+					{
+						"name": "stake",
+						"type": "uint256",
+						"internalType": "uint256"
+					},
+					{
+						"name": "consAddr",
+						"type": "bytes32",
+						"internalType": "bytes32"
+					}
+				]
+			}
+		],
+		"stateMutability": "view"
+	}
+]`
+
+// DefaultMiddlewareABI returns the mapping of a SimpleMiddleware, used when
+// the middleware_abi param is not set.
+func DefaultMiddlewareABI() MiddlewareABI {
+	return MiddlewareABI{
+		Abi:                SimpleMiddlewareABI,
+		EpochMethod:        "getCurrentEpoch",
+		ValidatorSetMethod: "getValidatorSet",
+		StakeField:         "stake",
+		KeyField:           "consAddr",
+		KeyType:            KeyTypeConsAddress,
+	}
+}
+
+// IsEmpty reports whether the mapping is unset.
+func (m MiddlewareABI) IsEmpty() bool {
+	return m.Equal(MiddlewareABI{})
+}
+
+// Validate checks that the ABI holds the methods and fields of the mapping.
+// An empty mapping is valid.
+func (m MiddlewareABI) Validate() error {
+	if m.IsEmpty() {
+		return nil
+	}
+	_, err := NewMiddlewareAdapter(m)
+	return err
+}
+
+// MiddlewareCaller runs a read-only call with the given calldata on the
+// middleware, at the block the validator set is read at.
+type MiddlewareCaller func(data []byte) ([]byte, error)
+
+// MiddlewareAdapter reads the validator set of a middleware contract. The
+// default adapter is driven by the middleware_abi param, apps with a
+// middleware the param cannot describe register their own through depinject.
+type MiddlewareAdapter interface {
+	// ValidatorSet reads the validator set of the current epoch with call.
+	ValidatorSet(call MiddlewareCaller) ([]SymbioticValidator, error)
+}
+
+var _ MiddlewareAdapter = &abiMiddlewareAdapter{}
+
+// abiMiddlewareAdapter is the MiddlewareAdapter of a MiddlewareABI.
+type abiMiddlewareAdapter struct {
+	epochMethod        *abi.Method
+	validatorSetMethod abi.Method
+	stakePath          []string
+	keyPath            []string
+	keyType            string
+	operatorPath       []string
+}
+
+// NewMiddlewareAdapter returns the adapter of a mapping, the SimpleMiddleware
+// one if the mapping is empty.
+func NewMiddlewareAdapter(m MiddlewareABI) (MiddlewareAdapter, error) {
+	if m.IsEmpty() {
+		m = DefaultMiddlewareABI()
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(m.Abi))
+	if err != nil {
+		return nil, fmt.Errorf("invalid middleware abi: %w", err)
+	}
+
+	a := &abiMiddlewareAdapter{keyType: m.KeyType}
+
+	var ok bool
+	if a.validatorSetMethod, ok = contractABI.Methods[m.ValidatorSetMethod]; !ok {
+		return nil, fmt.Errorf("middleware abi has no validator set method %q", m.ValidatorSetMethod)
+	}
+	outputs := a.validatorSetMethod.Outputs
+	if len(outputs) == 0 || (outputs[0].Type.T != abi.SliceTy && outputs[0].Type.T != abi.ArrayTy) || outputs[0].Type.Elem.T != abi.TupleTy {
+		return nil, fmt.Errorf("middleware method %s must return an array of tuples first", m.ValidatorSetMethod)
+	}
+
+	if m.EpochMethod == "" {
+		if len(a.validatorSetMethod.Inputs) != 0 {
+			return nil, fmt.Errorf("middleware method %s takes arguments but no epoch method is set", m.ValidatorSetMethod)
+		}
+	} else {
+		epochMethod, ok := contractABI.Methods[m.EpochMethod]
+		if !ok {
+			return nil, fmt.Errorf("middleware abi has no epoch method %q", m.EpochMethod)
+		}
+		if len(epochMethod.Inputs) != 0 || len(epochMethod.Outputs) == 0 || epochMethod.Outputs[0].Type.T != abi.UintTy {
+			return nil, fmt.Errorf("middleware method %s must take no argument and return an unsigned integer first", m.EpochMethod)
+		}
+		inputs := a.validatorSetMethod.Inputs
+		if len(inputs) != 1 || inputs[0].Type.T != abi.UintTy {
+			return nil, fmt.Errorf("middleware method %s must take the epoch as its only argument", m.ValidatorSetMethod)
+		}
+		a.epochMethod = &epochMethod
+	}
+
+	entry := *outputs[0].Type.Elem
+	if a.stakePath, err = fieldPath(entry, m.StakeField, true, abi.UintTy); err != nil {
+		return nil, fmt.Errorf("invalid stake field: %w", err)
+	}
+
+	if m.KeyType != KeyTypeConsAddress && consPubKeySizes[m.KeyType] == 0 {
+		return nil, fmt.Errorf("unknown key type %q, expected one of %s, %s, %s or %s",
+			m.KeyType, KeyTypeConsAddress, KeyTypeEd25519, KeyTypeSecp256k1, KeyTypeBls12381)
+	}
+	if a.keyPath, err = fieldPath(entry, m.KeyField, false, abi.AddressTy, abi.FixedBytesTy, abi.BytesTy); err != nil {
+		return nil, fmt.Errorf("invalid key field: %w", err)
+	}
+
+	if m.OperatorField != "" {
+		if a.operatorPath, err = fieldPath(entry, m.OperatorField, false, abi.AddressTy, abi.FixedBytesTy, abi.BytesTy); err != nil {
+			return nil, fmt.Errorf("invalid operator field: %w", err)
+		}
+	}
+
+	return a, nil
+}
+
+// fieldPath splits a dotted field path and checks that it selects a field of
+// one of the given types in a tuple. Arrays along the path are only allowed
+// if repeated is set.
+func fieldPath(t abi.Type, field string, repeated bool, leafTypes ...byte) ([]string, error) {
+	if field == "" {
+		return nil, errors.New("field cannot be empty")
+	}
+
+	path := strings.Split(field, ".")
+	for i := 0; ; {
+		switch {
+		case t.T == abi.SliceTy || t.T == abi.ArrayTy:
+			if !repeated {
+				return nil, fmt.Errorf("field %s goes through an array", field)
+			}
+			t = *t.Elem
+			continue
+
+		case i == len(path):
+			for _, leaf := range leafTypes {
+				if t.T == leaf {
+					return path, nil
+				}
+			}
+			return nil, fmt.Errorf("field %s has unsupported type %s", field, t)
+
+		case t.T != abi.TupleTy:
+			return nil, fmt.Errorf("field %s: %s is not a tuple", field, strings.Join(path[:i], "."))
+		}
+
+		found := false
+		for j, name := range t.TupleRawNames {
+			if name == path[i] {
+				t, found = *t.TupleElems[j], true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("field %s: unknown component %q", field, path[i])
+		}
+		i++
+	}
+}
+
+// ValidatorSet implements MiddlewareAdapter.
+func (a *abiMiddlewareAdapter) ValidatorSet(call MiddlewareCaller) ([]SymbioticValidator, error) {
+	method := a.validatorSetMethod
+
+	var args []interface{}
+	if a.epochMethod != nil {
+		res, err := call(a.epochMethod.ID)
+		if err != nil {
+			return nil, err
+		}
+		out, err := a.epochMethod.Outputs.Unpack(res)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s result: %w", a.epochMethod.Name, err)
+		}
+		epoch, err := uintValue(reflect.ValueOf(out[0]))
+		if err != nil {
+			return nil, err
+		}
+		arg, err := uintArg(method.Inputs[0].Type, epoch)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	input, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := call(append(append([]byte(nil), method.ID...), input...))
+	if err != nil {
+		return nil, err
+	}
+	out, err := method.Outputs.Unpack(res)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s result: %w", method.Name, err)
+	}
+
+	entryType := *method.Outputs[0].Type.Elem
+	entries := reflect.ValueOf(out[0])
+	validators := make([]SymbioticValidator, 0, entries.Len())
+	for i := 0; i < entries.Len(); i++ {
+		v, err := a.decodeEntry(entryType, entries.Index(i))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %d: %w", method.Name, i, err)
+		}
+		validators = append(validators, v)
+	}
+
+	return validators, nil
+}
+
+func (a *abiMiddlewareAdapter) decodeEntry(t abi.Type, entry reflect.Value) (SymbioticValidator, error) {
+	var v SymbioticValidator
+
+	v.Stake = new(big.Int)
+	for _, value := range fieldValues(t, entry, a.stakePath) {
+		stake, err := uintValue(value)
+		if err != nil {
+			return v, err
+		}
+		v.Stake.Add(v.Stake, stake)
+	}
+
+	key := bytesValue(fieldValues(t, entry, a.keyPath)[0])
+	if a.keyType == KeyTypeConsAddress {
+		if len(key) != 20 && len(key) != 32 {
+			return v, fmt.Errorf("invalid consensus address length %d", len(key))
+		}
+		copy(v.ConsAddr[:], key)
+	} else {
+		if len(key) != consPubKeySizes[a.keyType] {
+			return v, fmt.Errorf("invalid %s public key length %d", a.keyType, len(key))
+		}
+		v.KeyType = a.keyType
+		v.ConsPubKey = key
+	}
+
+	if a.operatorPath != nil {
+		v.Operator = bytesValue(fieldValues(t, entry, a.operatorPath)[0])
+	}
+
+	return v, nil
+}
+
+// fieldValues returns the values a field path selects in a decoded value of
+// type t, one per element of the arrays along the path.
+func fieldValues(t abi.Type, v reflect.Value, path []string) []reflect.Value {
+	if t.T == abi.SliceTy || t.T == abi.ArrayTy {
+		var values []reflect.Value
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, fieldValues(*t.Elem, v.Index(i), path)...)
+		}
+		return values
+	}
+	if len(path) == 0 {
+		return []reflect.Value{v}
+	}
+
+	// the path was checked against the type by fieldPath
+	for i, name := range t.TupleRawNames {
+		if name == path[0] {
+			return fieldValues(*t.TupleElems[i], v.Field(i), path[1:])
+		}
+	}
+	return nil
+}
+
+// uintValue converts a decoded unsigned integer to a big.Int.
+func uintValue(v reflect.Value) (*big.Int, error) {
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), nil
+	}
+	if b, ok := v.Interface().(*big.Int); ok && b.Sign() >= 0 {
+		return new(big.Int).Set(b), nil
+	}
+	return nil, fmt.Errorf("invalid unsigned integer %v", v)
+}
+
+// uintArg converts n to the Go value the ABI packs for the unsigned integer
+// type t.
+func uintArg(t abi.Type, n *big.Int) (interface{}, error) {
+	if n.BitLen() > t.Size {
+		return nil, fmt.Errorf("%s overflows %s", n, t)
+	}
+	if typ := t.GetType(); typ != reflect.TypeOf(n) {
+		return reflect.ValueOf(n.Uint64()).Convert(typ).Interface(), nil
+	}
+	return n, nil
+}
+
+// bytesValue returns the bytes of a decoded address or byte string.
+func bytesValue(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return append([]byte(nil), v.Bytes()...)
+	}
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b
+}
+
+// MiddlewareVoterStakeABI is the ABI of the middleware method the stake a vault
+// depositor or curator backs an operator with through a vault is read with. It
+// weights the governance votes cast from Ethereum addresses.
+const MiddlewareVoterStakeABI = `[
+	{
+		"type": "function",
+		"name": "getVoterStake",
+		"inputs": [
+			{
+				"name": "vault",
+				"type": "address",
+				"internalType": "address"
+			},
+			{
+				"name": "operator",
+				"type": "address",
+				"internalType": "address"
+			},
+			{
+				"name": "voter",
+				"type": "address",
+				"internalType": "address"
+			}
+		],
+		"outputs": [
+			{
+				"name": "stake",
+				"type": "uint256",
+				"internalType": "uint256"
+			}
+		],
+		"stateMutability": "view"
+	}
+]`
+
+// VoterStake reads with call the stake voter backs operator with through vault.
+func VoterStake(call MiddlewareCaller, vault, operator, voter common.Address) (*big.Int, error) {
+	contractABI, err := abi.JSON(strings.NewReader(MiddlewareVoterStakeABI))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := contractABI.Pack("getVoterStake", vault, operator, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := call(data)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := contractABI.Unpack("getVoterStake", res)
+	if err != nil {
+		return nil, fmt.Errorf("invalid getVoterStake result: %w", err)
+	}
+
+	return uintValue(reflect.ValueOf(out[0]))
+}
@@ -0,0 +1,221 @@
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// fakeMiddleware answers the calls of an adapter with ABI encoded results.
+func fakeMiddleware(t *testing.T, abiJSON string, results map[string][]interface{}) types.MiddlewareCaller {
+	t.Helper()
+
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	require.NoError(t, err)
+
+	return func(data []byte) ([]byte, error) {
+		for name, method := range contractABI.Methods {
+			if bytes.HasPrefix(data, method.ID) {
+				return method.Outputs.Pack(results[name]...)
+			}
+		}
+		return nil, errors.New("unknown method")
+	}
+}
+
+func TestSimpleMiddlewareAdapter(t *testing.T) {
+	adapter, err := types.NewMiddlewareAdapter(types.MiddlewareABI{})
+	require.NoError(t, err)
+
+	consAddr := sdk.ConsAddress(bytes.Repeat([]byte{1}, 20))
+	var slot [32]byte
+	copy(slot[:], consAddr)
+
+	type validatorData struct {
+		Stake    *big.Int
+		ConsAddr [32]byte
+	}
+	calls := 0
+	caller := fakeMiddleware(t, types.SimpleMiddlewareABI, map[string][]interface{}{
+		"getCurrentEpoch": {big.NewInt(7)},
+		"getValidatorSet": {[]validatorData{{Stake: big.NewInt(100), ConsAddr: slot}}},
+	})
+	validators, err := adapter.ValidatorSet(func(data []byte) ([]byte, error) {
+		calls++
+		if calls == 2 {
+			// the epoch is passed to getValidatorSet
+			require.Equal(t, big.NewInt(7), new(big.Int).SetBytes(data[4:]))
+		}
+		return caller(data)
+	})
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, big.NewInt(100), validators[0].Stake)
+
+	got, err := validators[0].ConsAddress()
+	require.NoError(t, err)
+	require.Equal(t, consAddr, got)
+}
+
+const vaultMiddlewareABI = `[
+	{
+		"type": "function",
+		"name": "activeOperators",
+		"outputs": [
+			{
+				"name": "operators",
+				"type": "tuple[]",
+				"components": [
+					{"name": "operator", "type": "address"},
+					{"name": "key", "type": "bytes"},
+					{
+						"name": "vaults",
+						"type": "tuple[]",
+						"components": [
+							{"name": "vault", "type": "address"},
+							{"name": "stake", "type": "uint256"}
+						]
+					}
+				]
+			}
+		],
+		"stateMutability": "view"
+	}
+]`
+
+func TestMiddlewareAdapterPubKeysAndVaults(t *testing.T) {
+	m := types.MiddlewareABI{
+		Abi:                vaultMiddlewareABI,
+		ValidatorSetMethod: "activeOperators",
+		StakeField:         "vaults.stake",
+		KeyField:           "key",
+		KeyType:            types.KeyTypeEd25519,
+		OperatorField:      "operator",
+	}
+	require.NoError(t, m.Validate())
+	adapter, err := types.NewMiddlewareAdapter(m)
+	require.NoError(t, err)
+
+	type vault struct {
+		Vault common.Address
+		Stake *big.Int
+	}
+	type operator struct {
+		Operator common.Address
+		Key      []byte
+		Vaults   []vault
+	}
+	pubKey := ed25519.GenPrivKey().PubKey()
+	caller := fakeMiddleware(t, vaultMiddlewareABI, map[string][]interface{}{
+		"activeOperators": {[]operator{{
+			Operator: common.HexToAddress("0xaa"),
+			Key:      pubKey.Bytes(),
+			Vaults:   []vault{{common.HexToAddress("0x01"), big.NewInt(30)}, {common.HexToAddress("0x02"), big.NewInt(12)}},
+		}}},
+	})
+
+	validators, err := adapter.ValidatorSet(caller)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	v := validators[0]
+	require.Equal(t, big.NewInt(42), v.Stake)
+	require.Equal(t, types.KeyTypeEd25519, v.KeyType)
+	require.Equal(t, pubKey.Bytes(), v.ConsPubKey)
+	require.Equal(t, common.HexToAddress("0xaa").Bytes(), v.Operator)
+
+	consAddr, err := v.ConsAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.ConsAddress(pubKey.Address()), consAddr)
+
+	// a key of the wrong size fails the whole set
+	caller = fakeMiddleware(t, vaultMiddlewareABI, map[string][]interface{}{
+		"activeOperators": {[]operator{{Key: []byte{1, 2, 3}}}},
+	})
+	_, err = adapter.ValidatorSet(caller)
+	require.ErrorContains(t, err, "invalid ed25519 public key length")
+}
+
+func TestMiddlewareABIValidate(t *testing.T) {
+	require.NoError(t, types.MiddlewareABI{}.Validate())
+	require.NoError(t, types.DefaultMiddlewareABI().Validate())
+
+	valid := types.MiddlewareABI{
+		Abi:                vaultMiddlewareABI,
+		ValidatorSetMethod: "activeOperators",
+		StakeField:         "vaults.stake",
+		KeyField:           "key",
+		KeyType:            types.KeyTypeEd25519,
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := map[string]func(m *types.MiddlewareABI){
+		"invalid abi":              func(m *types.MiddlewareABI) { m.Abi = "{" },
+		"unknown method":           func(m *types.MiddlewareABI) { m.ValidatorSetMethod = "getValidatorSet" },
+		"unknown epoch method":     func(m *types.MiddlewareABI) { m.EpochMethod = "getCurrentEpoch" },
+		"unknown stake field":      func(m *types.MiddlewareABI) { m.StakeField = "stake" },
+		"stake field not a uint":   func(m *types.MiddlewareABI) { m.StakeField = "vaults.vault" },
+		"key field in an array":    func(m *types.MiddlewareABI) { m.KeyField = "vaults.vault" },
+		"unknown key type":         func(m *types.MiddlewareABI) { m.KeyType = "sr25519" },
+		"operator field not key":   func(m *types.MiddlewareABI) { m.OperatorField = "vaults" },
+		"empty key field":          func(m *types.MiddlewareABI) { m.KeyField = "" },
+		"path through a non tuple": func(m *types.MiddlewareABI) { m.KeyField = "key.inner" },
+	}
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := valid
+			malleate(&m)
+			require.Error(t, m.Validate())
+		})
+	}
+}
+
+func TestValidatorSetDigest(t *testing.T) {
+	a := types.SymbioticValidator{Stake: big.NewInt(1), KeyType: types.KeyTypeEd25519, ConsPubKey: bytes.Repeat([]byte{1}, 32)}
+	b := a
+	b.Operator = []byte{2}
+	require.NotEqual(t, types.ValidatorSetDigest([]types.SymbioticValidator{a}), types.ValidatorSetDigest([]types.SymbioticValidator{b}))
+
+	// fields are length prefixed, bytes cannot move between them
+	c := a
+	c.KeyType = ""
+	c.ConsPubKey = append([]byte(types.KeyTypeEd25519), a.ConsPubKey...)
+	require.NotEqual(t, types.ValidatorSetDigest([]types.SymbioticValidator{a}), types.ValidatorSetDigest([]types.SymbioticValidator{c}))
+}
+
+func TestVoterStake(t *testing.T) {
+	var (
+		vault    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+		operator = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+		voter    = common.HexToAddress("0x00000000000000000000000000000000000000c3")
+	)
+
+	contractABI, err := abi.JSON(strings.NewReader(types.MiddlewareVoterStakeABI))
+	require.NoError(t, err)
+	method := contractABI.Methods["getVoterStake"]
+
+	stake, err := types.VoterStake(func(data []byte) ([]byte, error) {
+		require.True(t, bytes.HasPrefix(data, method.ID))
+		args, err := method.Inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{vault, operator, voter}, args)
+
+		return method.Outputs.Pack(big.NewInt(1234))
+	}, vault, operator, voter)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1234), stake)
+
+	_, err = types.VoterStake(func([]byte) ([]byte, error) { return []byte{1}, nil }, vault, operator, voter)
+	require.ErrorContains(t, err, "invalid getVoterStake result")
+}
@@ -8,7 +8,9 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
 
@@ -16,6 +18,11 @@ var (
 	_ coretransaction.Msg                  = &MsgCreateValidator{}
 	_ gogoprotoany.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
 	_ coretransaction.Msg                  = &MsgEditValidator{}
+	_ coretransaction.Msg                  = &MsgUpdateOperatorBinding{}
+	_ coretransaction.Msg                  = &MsgRotateConsPubKey{}
+	_ gogoprotoany.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ coretransaction.Msg                  = &MsgOperatorExec{}
+	_ gogoprotoany.UnpackInterfacesMessage = (*MsgOperatorExec)(nil)
 	_ coretransaction.Msg                  = &MsgUpdateParams{}
 )
 
@@ -64,6 +71,12 @@ func (msg MsgCreateValidator) Validate(ac address.Codec) error {
 		return err
 	}
 
+	if msg.OperatorBinding != nil {
+		if err := msg.OperatorBinding.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidOperatorBinding, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -73,6 +86,60 @@ func (msg MsgCreateValidator) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker
 	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
 }
 
+// NewMsgUpdateOperatorBinding creates a new MsgUpdateOperatorBinding instance
+func NewMsgUpdateOperatorBinding(valAddr string, proof OperatorBindingProof) *MsgUpdateOperatorBinding {
+	return &MsgUpdateOperatorBinding{
+		ValidatorAddress: valAddr,
+		OperatorBinding:  proof,
+	}
+}
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance
+func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
+
+// NewMsgOperatorExec creates a new MsgOperatorExec instance, the signature of
+// the operator being set once the messages are packed, see
+// OperatorExecMsgsHash.
+func NewMsgOperatorExec(submitter, valAddr string, msgs []sdk.Msg) (*MsgOperatorExec, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgOperatorExec{
+		Submitter:        submitter,
+		ValidatorAddress: valAddr,
+		Msgs:             anys,
+	}, nil
+}
+
+// GetMessages returns the cached messages of the MsgOperatorExec.
+func (msg MsgOperatorExec) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgOperatorExec")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgOperatorExec) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
 // NewMsgEditValidator creates a new MsgEditValidator instance
 func NewMsgEditValidator(valAddr string, newRate *math.LegacyDec, description Description) *MsgEditValidator {
 	return &MsgEditValidator{
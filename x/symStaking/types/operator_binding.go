@@ -0,0 +1,216 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// EIP-712 domain of the operator bindings.
+const (
+	OperatorBindingDomainName    = "Symbiotic Staking"
+	OperatorBindingDomainVersion = "1"
+)
+
+var (
+	eip712DomainTypeHash    = crypto.Keccak256([]byte("EIP712Domain(string name,string version)"))
+	operatorBindingTypeHash = crypto.Keccak256([]byte("OperatorBinding(string chainId,string validator,bytes consPubKey,address operator,uint64 nonce)"))
+	operatorExecTypeHash    = crypto.Keccak256([]byte("OperatorExec(string chainId,string validator,bytes32 msgsHash,uint64 nonce)"))
+)
+
+// OperatorBindingText returns the text an operator signs with personal_sign
+// (EIP-191) to bind a validator of the chain with the given id and consensus
+// public key.
+func OperatorBindingText(chainID, validator string, consPubKey []byte, operator common.Address, nonce uint64) string {
+	return fmt.Sprintf("Bind Symbiotic operator %s to validator %s with consensus key 0x%x on chain %s with nonce %d", operator.Hex(), validator, consPubKey, chainID, nonce)
+}
+
+// OperatorBindingSignBytes returns the digest an operator signs to bind a
+// validator of the chain with the given id and consensus public key, so that
+// the binding cannot be used with another key. An EIP-191 signature signs
+// OperatorBindingText, an EIP-712 one the typed data:
+//
+//	OperatorBinding(string chainId,string validator,bytes consPubKey,address operator,uint64 nonce)
+//
+// in the domain EIP712Domain(string name,string version) of
+// OperatorBindingDomainName and OperatorBindingDomainVersion.
+func OperatorBindingSignBytes(signatureType OperatorSignatureType, chainID, validator string, consPubKey []byte, operator common.Address, nonce uint64) ([]byte, error) {
+	switch signatureType {
+	case OperatorSignatureTypeEIP191:
+		return accounts.TextHash([]byte(OperatorBindingText(chainID, validator, consPubKey, operator, nonce))), nil
+
+	case OperatorSignatureTypeEIP712:
+		structHash := crypto.Keccak256(
+			operatorBindingTypeHash,
+			crypto.Keccak256([]byte(chainID)),
+			crypto.Keccak256([]byte(validator)),
+			crypto.Keccak256(consPubKey),
+			common.LeftPadBytes(operator.Bytes(), 32),
+			nonceWord(nonce),
+		)
+
+		return eip712Hash(structHash), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported operator signature type %s", signatureType)
+	}
+}
+
+// OperatorExecMsgsHash returns the hash an operator signs for the messages of
+// a MsgOperatorExec: the keccak256 of the concatenated keccak256 hashes of
+// their type URL and of their value, as encoded in the message.
+func OperatorExecMsgsHash(msgs []*codectypes.Any) common.Hash {
+	hashes := make([][]byte, 0, 2*len(msgs))
+	for _, msg := range msgs {
+		hashes = append(hashes, crypto.Keccak256([]byte(msg.TypeUrl)), crypto.Keccak256(msg.Value))
+	}
+	return crypto.Keccak256Hash(hashes...)
+}
+
+// OperatorExecText returns the text an operator signs with personal_sign
+// (EIP-191) to execute messages on behalf of a validator of the chain with the
+// given id.
+func OperatorExecText(chainID, validator string, msgsHash common.Hash, nonce uint64) string {
+	return fmt.Sprintf("Execute messages %s as validator %s on chain %s with nonce %d", msgsHash.Hex(), validator, chainID, nonce)
+}
+
+// OperatorExecSignBytes returns the digest an operator signs to execute the
+// messages of msgsHash, see OperatorExecMsgsHash, on behalf of a validator of
+// the chain with the given id. An EIP-191 signature signs OperatorExecText, an
+// EIP-712 one the typed data:
+//
+//	OperatorExec(string chainId,string validator,bytes32 msgsHash,uint64 nonce)
+//
+// in the domain of the operator bindings.
+func OperatorExecSignBytes(signatureType OperatorSignatureType, chainID, validator string, msgsHash common.Hash, nonce uint64) ([]byte, error) {
+	switch signatureType {
+	case OperatorSignatureTypeEIP191:
+		return accounts.TextHash([]byte(OperatorExecText(chainID, validator, msgsHash, nonce))), nil
+
+	case OperatorSignatureTypeEIP712:
+		structHash := crypto.Keccak256(
+			operatorExecTypeHash,
+			crypto.Keccak256([]byte(chainID)),
+			crypto.Keccak256([]byte(validator)),
+			msgsHash.Bytes(),
+			nonceWord(nonce),
+		)
+
+		return eip712Hash(structHash), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported operator signature type %s", signatureType)
+	}
+}
+
+// eip712Hash returns the EIP-712 digest of a struct hash in the domain
+// EIP712Domain(string name,string version) of OperatorBindingDomainName and
+// OperatorBindingDomainVersion.
+func eip712Hash(structHash []byte) []byte {
+	domainSeparator := crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(OperatorBindingDomainName)),
+		crypto.Keccak256([]byte(OperatorBindingDomainVersion)),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// nonceWord returns the ABI encoding of a uint64 nonce.
+func nonceWord(nonce uint64) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], nonce)
+	return word
+}
+
+// Validate checks that the proof names an operator address and carries a
+// signature of a supported type.
+func (p OperatorBindingProof) Validate() error {
+	if !common.IsHexAddress(p.Operator) {
+		return fmt.Errorf("invalid operator address: %s", p.Operator)
+	}
+
+	if p.SignatureType != OperatorSignatureTypeEIP191 && p.SignatureType != OperatorSignatureTypeEIP712 {
+		return fmt.Errorf("unsupported operator signature type %s", p.SignatureType)
+	}
+
+	if len(p.Signature) != crypto.SignatureLength {
+		return fmt.Errorf("invalid signature length %d, expected %d", len(p.Signature), crypto.SignatureLength)
+	}
+
+	return nil
+}
+
+// Verify checks that the operator of the proof signed the binding of
+// validator and its consensus public key with nonce on the chain with the
+// given id.
+func (p OperatorBindingProof) Verify(chainID, validator string, consPubKey []byte, nonce uint64) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	operator := common.HexToAddress(p.Operator)
+	digest, err := OperatorBindingSignBytes(p.SignatureType, chainID, validator, consPubKey, operator, nonce)
+	if err != nil {
+		return err
+	}
+
+	signer, err := RecoverOperatorSigner(digest, p.Signature)
+	if err != nil {
+		return err
+	}
+	if signer != operator {
+		return fmt.Errorf("signed by %s, expected %s", signer, operator)
+	}
+
+	return nil
+}
+
+// Verify checks that operator signed the messages of the MsgOperatorExec with
+// nonce on the chain with the given id.
+func (msg MsgOperatorExec) Verify(chainID string, operator common.Address, nonce uint64) error {
+	if len(msg.Signature) != crypto.SignatureLength {
+		return fmt.Errorf("invalid signature length %d, expected %d", len(msg.Signature), crypto.SignatureLength)
+	}
+
+	digest, err := OperatorExecSignBytes(msg.SignatureType, chainID, msg.ValidatorAddress, OperatorExecMsgsHash(msg.Msgs), nonce)
+	if err != nil {
+		return err
+	}
+
+	signer, err := RecoverOperatorSigner(digest, msg.Signature)
+	if err != nil {
+		return err
+	}
+	if signer != operator {
+		return fmt.Errorf("signed by %s, expected %s", signer, operator)
+	}
+
+	return nil
+}
+
+// RecoverOperatorSigner returns the Ethereum address that produced the
+// 65 bytes signature of digest. The recovery id is accepted as 0/1 or 27/28.
+func RecoverOperatorSigner(digest, signature []byte) (common.Address, error) {
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, errors.New("invalid signature recovery id")
+	}
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
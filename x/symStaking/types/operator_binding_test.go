@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/symStaking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+func TestOperatorBindingProof(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	operator := crypto.PubkeyToAddress(key.PublicKey)
+
+	const (
+		chainID   = "symbiotic-test"
+		validator = "cosmosvaloper1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	)
+	consPubKey := ed25519.GenPrivKey().PubKey().Bytes()
+
+	for _, sigType := range []types.OperatorSignatureType{types.OperatorSignatureTypeEIP191, types.OperatorSignatureTypeEIP712} {
+		t.Run(sigType.String(), func(t *testing.T) {
+			digest, err := types.OperatorBindingSignBytes(sigType, chainID, validator, consPubKey, operator, 3)
+			require.NoError(t, err)
+			signature, err := crypto.Sign(digest, key)
+			require.NoError(t, err)
+			proof := types.OperatorBindingProof{Operator: operator.Hex(), SignatureType: sigType, Signature: signature}
+
+			require.NoError(t, proof.Verify(chainID, validator, consPubKey, 3))
+			require.Error(t, proof.Verify(chainID, validator, consPubKey, 2))
+			require.Error(t, proof.Verify("other-chain", validator, consPubKey, 3))
+			require.Error(t, proof.Verify(chainID, "cosmosvaloper1other", consPubKey, 3))
+			// the binding does not carry over to another consensus key
+			require.Error(t, proof.Verify(chainID, validator, ed25519.GenPrivKey().PubKey().Bytes(), 3))
+
+			// wallets return the recovery id as 27 or 28
+			proof.Signature[crypto.RecoveryIDOffset] += 27
+			require.NoError(t, proof.Verify(chainID, validator, consPubKey, 3))
+		})
+	}
+
+	// the two signature types sign different digests
+	eip191, err := types.OperatorBindingSignBytes(types.OperatorSignatureTypeEIP191, chainID, validator, consPubKey, operator, 0)
+	require.NoError(t, err)
+	eip712, err := types.OperatorBindingSignBytes(types.OperatorSignatureTypeEIP712, chainID, validator, consPubKey, operator, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, eip191, eip712)
+
+	require.Error(t, types.OperatorBindingProof{Operator: "0x01", SignatureType: types.OperatorSignatureTypeEIP191, Signature: make([]byte, 65)}.Validate())
+	require.Error(t, types.OperatorBindingProof{Operator: operator.Hex(), Signature: make([]byte, 65)}.Validate())
+	require.Error(t, types.OperatorBindingProof{Operator: operator.Hex(), SignatureType: types.OperatorSignatureTypeEIP712, Signature: make([]byte, 64)}.Validate())
+}
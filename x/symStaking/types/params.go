@@ -29,38 +29,115 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultSymbioticSyncPeriod is the default number of blocks between two
+	// validator power syncs from the Symbiotic middleware.
+	DefaultSymbioticSyncPeriod int64 = 10
+
+	// DefaultEthereumNetwork is the default Ethereum network of the middleware.
+	DefaultEthereumNetwork = ChainHolesky
+
+	// DefaultSlotsInEpoch, DefaultBeaconGenesisTimestamp and DefaultSlotDuration
+	// describe the Holesky beacon chain.
+	DefaultSlotsInEpoch           int64 = 32
+	DefaultBeaconGenesisTimestamp int64 = 1695902400
+	DefaultSlotDuration           int64 = 12
+
+	// DefaultSymbioticSyncHistoryEntries is the default number of Symbiotic
+	// sync records to persist.
+	DefaultSymbioticSyncHistoryEntries uint32 = 100
+
+	// DefaultMaxStakeStaleness is the default age the Symbiotic stake may get
+	// before the stale stake action is taken.
+	DefaultMaxStakeStaleness time.Duration = time.Hour * 24
+
+	// DefaultStaleStakeAction freezes the validator set while the Symbiotic
+	// stake is stale.
+	DefaultStaleStakeAction = StaleStakeActionHaltValidatorUpdates
 )
 
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultMaxPowerChangeRate is set to 30%, below the 1/3 of the voting
+	// power CometBFT light clients trust a validator set change with.
+	DefaultMaxPowerChangeRate = math.LegacyNewDecWithPrec(3, 1)
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries, historicalEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
+	middlewareAddress string, symbioticSyncPeriod, slotsInEpoch,
+	beaconGenesisTimestamp, slotDuration int64,
 ) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		HistoricalEntries:      historicalEntries,
+		BondDenom:              bondDenom,
+		MinCommissionRate:      minCommissionRate,
+		MiddlewareAddress:      middlewareAddress,
+		SymbioticSyncPeriod:    symbioticSyncPeriod,
+		SlotsInEpoch:           slotsInEpoch,
+		BeaconGenesisTimestamp: beaconGenesisTimestamp,
+		SlotDuration:           slotDuration,
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultUnbondingTime,
 		DefaultMaxValidators,
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		"",
+		DefaultSymbioticSyncPeriod,
+		DefaultSlotsInEpoch,
+		DefaultBeaconGenesisTimestamp,
+		DefaultSlotDuration,
 	)
+	params.SymbioticSyncHistoryEntries = DefaultSymbioticSyncHistoryEntries
+	params.MaxStakeStaleness = DefaultMaxStakeStaleness
+	params.StaleStakeAction = DefaultStaleStakeAction
+	params.EthereumNetwork = DefaultEthereumNetwork
+	params.MaxPowerChangeRate = DefaultMaxPowerChangeRate
+	return params
+}
+
+// SetEthereumNetwork sets the Ethereum network of the middleware together with
+// the beacon chain params of its profile. The beacon chain params of a devnet
+// are left as they are.
+func (p *Params) SetEthereumNetwork(network string) error {
+	if network == ChainDevnet {
+		p.EthereumNetwork = network
+		return nil
+	}
+
+	spec, ok := GetBeaconChainSpec(network)
+	if !ok {
+		return errUnknownEthereumNetwork(network)
+	}
+
+	p.EthereumNetwork = network
+	p.BeaconGenesisTimestamp = spec.GenesisTimestamp
+	p.SlotDuration = spec.SlotDuration
+	p.SlotsInEpoch = spec.SlotsInEpoch
+	return nil
+}
+
+// BeaconEpoch returns the beacon chain epoch of an execution block timestamp.
+func (p Params) BeaconEpoch(timestamp uint64) uint64 {
+	genesis := p.BeaconGenesisTimestamp
+	if p.SlotDuration <= 0 || p.SlotsInEpoch <= 0 || int64(timestamp) < genesis {
+		return 0
+	}
+	return uint64((int64(timestamp) - genesis) / p.SlotDuration / p.SlotsInEpoch)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -109,6 +186,50 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMiddlewareAddress(p.MiddlewareAddress); err != nil {
+		return err
+	}
+
+	if err := validateSymbioticSyncPeriod(p.SymbioticSyncPeriod); err != nil {
+		return err
+	}
+
+	if err := validateSlotsInEpoch(p.SlotsInEpoch); err != nil {
+		return err
+	}
+
+	if err := validateBeaconGenesisTimestamp(p.BeaconGenesisTimestamp); err != nil {
+		return err
+	}
+
+	if err := validateSlotDuration(p.SlotDuration); err != nil {
+		return err
+	}
+
+	if err := validateEthereumNetwork(p); err != nil {
+		return err
+	}
+
+	if err := validateMaxStakeStaleness(p.MaxStakeStaleness); err != nil {
+		return err
+	}
+
+	if err := validateStaleStakeAction(p.StaleStakeAction); err != nil {
+		return err
+	}
+
+	if err := validateMaxPowerChangeRate(p.MaxPowerChangeRate); err != nil {
+		return err
+	}
+
+	if p.MaxStakeStaleness > 0 && p.StaleStakeAction == StaleStakeActionUnspecified {
+		return errors.New("stale stake action must be set when max stake staleness is positive")
+	}
+
+	if err := p.MiddlewareAbi.Validate(); err != nil {
+		return fmt.Errorf("invalid middleware abi param: %w", err)
+	}
+
 	return nil
 }
 
@@ -208,3 +329,145 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxPowerChangeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil rate is left by params set before the limit existed, it disables
+	// the limit like a zero rate
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max power change rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max power change rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMiddlewareAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset middleware address is valid in genesis, nodes refuse to start
+	// until it matches their local config
+	if v == "" {
+		return nil
+	}
+
+	return ValidateMiddlewareAddress(v)
+}
+
+func validateSymbioticSyncPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("symbiotic sync period must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlotsInEpoch(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("slots in epoch must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBeaconGenesisTimestamp(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("beacon genesis timestamp must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlotDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("slot duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxStakeStaleness(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max stake staleness must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateStaleStakeAction(i interface{}) error {
+	v, ok := i.(StaleStakeAction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := StaleStakeAction_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid stale stake action: %d", v)
+	}
+
+	return nil
+}
+
+// validateEthereumNetwork checks that the beacon chain params of a public
+// network match its profile.
+func validateEthereumNetwork(p Params) error {
+	if p.EthereumNetwork == "" || p.EthereumNetwork == ChainDevnet {
+		return nil
+	}
+
+	spec, ok := GetBeaconChainSpec(p.EthereumNetwork)
+	if !ok {
+		return errUnknownEthereumNetwork(p.EthereumNetwork)
+	}
+
+	if p.BeaconGenesisTimestamp != spec.GenesisTimestamp || p.SlotDuration != spec.SlotDuration || p.SlotsInEpoch != spec.SlotsInEpoch {
+		return fmt.Errorf(
+			"beacon chain params do not match the %s network: genesis timestamp %d, slot duration %d, slots in epoch %d, expected %d, %d, %d",
+			p.EthereumNetwork, p.BeaconGenesisTimestamp, p.SlotDuration, p.SlotsInEpoch,
+			spec.GenesisTimestamp, spec.SlotDuration, spec.SlotsInEpoch,
+		)
+	}
+
+	return nil
+}
+
+func errUnknownEthereumNetwork(network string) error {
+	return fmt.Errorf("unknown ethereum network %q, expected one of %s, %s, %s or %s",
+		network, ChainMainnet, ChainSepolia, ChainHolesky, ChainDevnet)
+}
@@ -71,6 +71,42 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9ea901dc076fbe21, []int{0}
 }
 
+// StaleStakeAction is the safety mode entered while the Symbiotic stake is
+// staler than the max_stake_staleness param.
+type StaleStakeAction int32
+
+const (
+	// STALE_STAKE_ACTION_UNSPECIFIED defines no safety mode.
+	StaleStakeActionUnspecified StaleStakeAction = 0
+	// STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES freezes the validator set sent to
+	// CometBFT until a sync is applied again.
+	StaleStakeActionHaltValidatorUpdates StaleStakeAction = 1
+	// STALE_STAKE_ACTION_HALT_CHAIN rejects every tx through the circuit breaker
+	// until a sync is applied again. Blocks keep being produced so that syncs can
+	// resume.
+	StaleStakeActionHaltChain StaleStakeAction = 2
+)
+
+var StaleStakeAction_name = map[int32]string{
+	0: "STALE_STAKE_ACTION_UNSPECIFIED",
+	1: "STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES",
+	2: "STALE_STAKE_ACTION_HALT_CHAIN",
+}
+
+var StaleStakeAction_value = map[string]int32{
+	"STALE_STAKE_ACTION_UNSPECIFIED":            0,
+	"STALE_STAKE_ACTION_HALT_VALIDATOR_UPDATES": 1,
+	"STALE_STAKE_ACTION_HALT_CHAIN":             2,
+}
+
+func (x StaleStakeAction) String() string {
+	return proto.EnumName(StaleStakeAction_name, int32(x))
+}
+
+func (StaleStakeAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{1}
+}
+
 // Infraction indicates the infraction a validator committed.
 type Infraction int32
 
@@ -100,7 +136,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9ea901dc076fbe21, []int{1}
+	return fileDescriptor_9ea901dc076fbe21, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -508,6 +544,56 @@ func (m *ValAddresses) GetAddresses() []string {
 	return nil
 }
 
+// ConsPubKeyRotation is a rotation of the consensus public key of a validator.
+// It is pending until a Symbiotic sync reports the new key.
+type ConsPubKeyRotation struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key replaced by the rotation.
+	OldConsPubkey *any.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the
+	// rotation.
+	NewConsPubkey *any.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height the rotation was requested at.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// rotated_height is the height of the Symbiotic sync that applied the
+	// rotation, zero while it is pending.
+	RotatedHeight int64 `protobuf:"varint,5,opt,name=rotated_height,json=rotatedHeight,proto3" json:"rotated_height,omitempty"`
+}
+
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{7}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
 // Params defines the parameters for the x/symStaking module.
 type Params struct {
 	// unbonding_time is the time duration of unbonding.
@@ -522,13 +608,58 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// middleware_address is the address of the Symbiotic network middleware the
+	// validator set is read from. Nodes refuse to start if their local config
+	// points to a different middleware.
+	MiddlewareAddress string `protobuf:"bytes,7,opt,name=middleware_address,json=middlewareAddress,proto3" json:"middleware_address,omitempty"`
+	// symbiotic_sync_period is the number of blocks between two validator power
+	// syncs from the middleware.
+	SymbioticSyncPeriod int64 `protobuf:"varint,8,opt,name=symbiotic_sync_period,json=symbioticSyncPeriod,proto3" json:"symbiotic_sync_period,omitempty"`
+	// slots_in_epoch is the number of beacon chain slots in an epoch.
+	SlotsInEpoch int64 `protobuf:"varint,9,opt,name=slots_in_epoch,json=slotsInEpoch,proto3" json:"slots_in_epoch,omitempty"`
+	// beacon_genesis_timestamp is the unix time of the beacon chain genesis.
+	BeaconGenesisTimestamp int64 `protobuf:"varint,10,opt,name=beacon_genesis_timestamp,json=beaconGenesisTimestamp,proto3" json:"beacon_genesis_timestamp,omitempty"`
+	// slot_duration is the duration of a beacon chain slot, in seconds.
+	SlotDuration int64 `protobuf:"varint,11,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	// middleware_abi describes how the validator set is read from the middleware.
+	// The zero value reads a SimpleMiddleware.
+	MiddlewareAbi MiddlewareABI `protobuf:"bytes,12,opt,name=middleware_abi,json=middlewareAbi,proto3" json:"middleware_abi"`
+	// auto_register_validators makes the middleware validator set drive the
+	// validators: operators reported with a consensus public key get a validator
+	// created without MsgCreateValidator, and validators missing from the set
+	// lose their tokens and start unbonding.
+	AutoRegisterValidators bool `protobuf:"varint,13,opt,name=auto_register_validators,json=autoRegisterValidators,proto3" json:"auto_register_validators,omitempty"`
+	// symbiotic_sync_history_entries is the number of Symbiotic sync records to
+	// persist. The last sync is always kept.
+	SymbioticSyncHistoryEntries uint32 `protobuf:"varint,14,opt,name=symbiotic_sync_history_entries,json=symbioticSyncHistoryEntries,proto3" json:"symbiotic_sync_history_entries,omitempty"`
+	// max_stake_staleness is how old the stake of the last applied Symbiotic
+	// sync may get before stale_stake_action is taken. Zero disables the check.
+	MaxStakeStaleness time.Duration `protobuf:"bytes,15,opt,name=max_stake_staleness,json=maxStakeStaleness,proto3,stdduration" json:"max_stake_staleness"`
+	// stale_stake_action is the safety mode entered while the stake is staler
+	// than max_stake_staleness.
+	StaleStakeAction StaleStakeAction `protobuf:"varint,16,opt,name=stale_stake_action,json=staleStakeAction,proto3,enum=cosmos.symStaking.v1beta1.StaleStakeAction" json:"stale_stake_action,omitempty"`
+	// ethereum_network is the Ethereum network the middleware is deployed on:
+	// mainnet, sepolia, holesky or devnet. The beacon chain params of a public
+	// network must match its profile, a devnet sets its own. Nodes refuse to
+	// start if their local config points to another network. Empty skips both
+	// checks.
+	EthereumNetwork string `protobuf:"bytes,17,opt,name=ethereum_network,json=ethereumNetwork,proto3" json:"ethereum_network,omitempty"`
+	// max_power_change_rate is the fraction of the bonded power that a single
+	// Symbiotic sync may change, counting both increases and decreases. Changes
+	// beyond it are kept pending and applied at the next syncs. Zero disables
+	// the limit.
+	MaxPowerChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=max_power_change_rate,json=maxPowerChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_power_change_rate"`
+	// require_operator_binding requires validators to be bound to their
+	// Symbiotic operator, through MsgCreateValidator or MsgUpdateOperatorBinding,
+	// for the middleware stake to be applied to them.
+	RequireOperatorBinding bool `protobuf:"varint,19,opt,name=require_operator_binding,json=requireOperatorBinding,proto3" json:"require_operator_binding,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ea901dc076fbe21, []int{7}
+	return fileDescriptor_9ea901dc076fbe21, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,6 +723,197 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMiddlewareAddress() string {
+	if m != nil {
+		return m.MiddlewareAddress
+	}
+	return ""
+}
+
+func (m *Params) GetSymbioticSyncPeriod() int64 {
+	if m != nil {
+		return m.SymbioticSyncPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSlotsInEpoch() int64 {
+	if m != nil {
+		return m.SlotsInEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetBeaconGenesisTimestamp() int64 {
+	if m != nil {
+		return m.BeaconGenesisTimestamp
+	}
+	return 0
+}
+
+func (m *Params) GetSlotDuration() int64 {
+	if m != nil {
+		return m.SlotDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMiddlewareAbi() MiddlewareABI {
+	if m != nil {
+		return m.MiddlewareAbi
+	}
+	return MiddlewareABI{}
+}
+
+func (m *Params) GetAutoRegisterValidators() bool {
+	if m != nil {
+		return m.AutoRegisterValidators
+	}
+	return false
+}
+
+func (m *Params) GetSymbioticSyncHistoryEntries() uint32 {
+	if m != nil {
+		return m.SymbioticSyncHistoryEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakeStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStakeStaleness
+	}
+	return 0
+}
+
+func (m *Params) GetStaleStakeAction() StaleStakeAction {
+	if m != nil {
+		return m.StaleStakeAction
+	}
+	return StaleStakeActionUnspecified
+}
+
+func (m *Params) GetEthereumNetwork() string {
+	if m != nil {
+		return m.EthereumNetwork
+	}
+	return ""
+}
+
+func (m *Params) GetRequireOperatorBinding() bool {
+	if m != nil {
+		return m.RequireOperatorBinding
+	}
+	return false
+}
+
+// MiddlewareABI maps the methods and return values of a middleware contract to
+// the Symbiotic validator set.
+type MiddlewareABI struct {
+	// abi is the JSON ABI of the middleware, it must contain the methods below.
+	Abi string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	// epoch_method is the method returning the current epoch, passed as the only
+	// argument of validator_set_method. Empty if validator_set_method takes no
+	// argument.
+	EpochMethod string `protobuf:"bytes,2,opt,name=epoch_method,json=epochMethod,proto3" json:"epoch_method,omitempty"`
+	// validator_set_method is the method whose first output is the array of
+	// validator set entries.
+	ValidatorSetMethod string `protobuf:"bytes,3,opt,name=validator_set_method,json=validatorSetMethod,proto3" json:"validator_set_method,omitempty"`
+	// stake_field is the path of the stake in an entry. Dots select tuple
+	// components and arrays along the path are summed, e.g. "vaults.stake" sums
+	// a vault breakdown.
+	StakeField string `protobuf:"bytes,4,opt,name=stake_field,json=stakeField,proto3" json:"stake_field,omitempty"`
+	// key_field is the path of the consensus key in an entry.
+	KeyField string `protobuf:"bytes,5,opt,name=key_field,json=keyField,proto3" json:"key_field,omitempty"`
+	// key_type is the type of the consensus key: "cons_address", "ed25519",
+	// "secp256k1" or "bls12_381".
+	KeyType string `protobuf:"bytes,6,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// operator_field is the path of the operator address in an entry, optional.
+	OperatorField string `protobuf:"bytes,7,opt,name=operator_field,json=operatorField,proto3" json:"operator_field,omitempty"`
+}
+
+func (m *MiddlewareABI) Reset()         { *m = MiddlewareABI{} }
+func (m *MiddlewareABI) String() string { return proto.CompactTextString(m) }
+func (*MiddlewareABI) ProtoMessage()    {}
+func (*MiddlewareABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ea901dc076fbe21, []int{9}
+}
+func (m *MiddlewareABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiddlewareABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MiddlewareABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MiddlewareABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiddlewareABI.Merge(m, src)
+}
+func (m *MiddlewareABI) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiddlewareABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiddlewareABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiddlewareABI proto.InternalMessageInfo
+
+func (m *MiddlewareABI) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetEpochMethod() string {
+	if m != nil {
+		return m.EpochMethod
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetValidatorSetMethod() string {
+	if m != nil {
+		return m.ValidatorSetMethod
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetStakeField() string {
+	if m != nil {
+		return m.StakeField
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetKeyField() string {
+	if m != nil {
+		return m.KeyField
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+func (m *MiddlewareABI) GetOperatorField() string {
+	if m != nil {
+		return m.OperatorField
+	}
+	return ""
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 //
@@ -604,7 +926,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ea901dc076fbe21, []int{8}
+	return fileDescriptor_9ea901dc076fbe21, []int{10}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,6 +964,7 @@ func (m *ValidatorUpdates) GetUpdates() []v11.ValidatorUpdate {
 
 func init() {
 	proto.RegisterEnum("cosmos.symStaking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.symStaking.v1beta1.StaleStakeAction", StaleStakeAction_name, StaleStakeAction_value)
 	proto.RegisterEnum("cosmos.symStaking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.symStaking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.symStaking.v1beta1.HistoricalRecord")
@@ -650,7 +973,9 @@ func init() {
 	proto.RegisterType((*Description)(nil), "cosmos.symStaking.v1beta1.Description")
 	proto.RegisterType((*Validator)(nil), "cosmos.symStaking.v1beta1.Validator")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.symStaking.v1beta1.ValAddresses")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.symStaking.v1beta1.ConsPubKeyRotation")
 	proto.RegisterType((*Params)(nil), "cosmos.symStaking.v1beta1.Params")
+	proto.RegisterType((*MiddlewareABI)(nil), "cosmos.symStaking.v1beta1.MiddlewareABI")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.symStaking.v1beta1.ValidatorUpdates")
 }
 
@@ -659,91 +984,130 @@ func init() {
 }
 
 var fileDescriptor_9ea901dc076fbe21 = []byte{
-	// 1329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x1b, 0xf7, 0xda, 0xae, 0x13, 0x8f, 0x93, 0xd8, 0x9d, 0xa6, 0xed, 0xd6, 0xef, 0x5b, 0xdb, 0xaf,
-	0xdf, 0xb7, 0x6d, 0xde, 0x40, 0xd6, 0x4a, 0x40, 0x95, 0x88, 0xe0, 0x10, 0xdb, 0x49, 0x63, 0x28,
-	0x4e, 0x58, 0xc7, 0x45, 0x20, 0xd1, 0xd5, 0x78, 0x77, 0x62, 0x0f, 0xf1, 0xee, 0x5a, 0x3b, 0xe3,
-	0x10, 0xdf, 0x39, 0x54, 0xe1, 0xd2, 0x13, 0x42, 0xa0, 0x88, 0x4a, 0x5c, 0x7a, 0xec, 0xa1, 0xe2,
-	0x13, 0x70, 0xa8, 0x38, 0x55, 0x3d, 0x21, 0x0e, 0x01, 0xb5, 0x87, 0xf6, 0xcc, 0x27, 0x40, 0x33,
-	0x3b, 0xeb, 0xb5, 0x9d, 0x36, 0x2a, 0xea, 0xc5, 0xf2, 0xf3, 0xef, 0xb7, 0xcf, 0x3c, 0xcf, 0xef,
-	0x79, 0x66, 0xc0, 0x35, 0xd3, 0xa5, 0xb6, 0x4b, 0x4b, 0x74, 0x60, 0x37, 0x18, 0xda, 0x23, 0x4e,
-	0xbb, 0xb4, 0xbf, 0xdc, 0xc2, 0x0c, 0x2d, 0x97, 0xa8, 0x2f, 0x6b, 0x3d, 0xcf, 0x65, 0x2e, 0xbc,
-	0xe4, 0x3b, 0x6a, 0xa1, 0xa3, 0x26, 0x1d, 0xb3, 0xf3, 0x6d, 0xb7, 0xed, 0x0a, 0xaf, 0x12, 0xff,
-	0xe7, 0x07, 0x64, 0x2f, 0xb5, 0x5d, 0xb7, 0xdd, 0xc5, 0x25, 0x21, 0xb5, 0xfa, 0xbb, 0x25, 0xe4,
-	0x0c, 0xa4, 0x29, 0x37, 0x69, 0xb2, 0xfa, 0x1e, 0x62, 0xc4, 0x75, 0xa4, 0x3d, 0x3f, 0x69, 0x67,
-	0xc4, 0xc6, 0x94, 0x21, 0xbb, 0x17, 0x60, 0xfb, 0xc9, 0x18, 0xfe, 0x47, 0x65, 0x66, 0x12, 0x5b,
-	0x1e, 0xa8, 0x85, 0x28, 0x1e, 0x1e, 0xc5, 0x74, 0x49, 0x80, 0x7d, 0x16, 0xd9, 0xc4, 0x71, 0x4b,
-	0xe2, 0x57, 0xaa, 0x2e, 0x9b, 0xae, 0x8d, 0x59, 0x6b, 0x97, 0x95, 0xd8, 0xa0, 0x87, 0x69, 0x69,
-	0x7f, 0xd9, 0xff, 0x23, 0xcd, 0xff, 0x1e, 0x9a, 0x51, 0xcb, 0x24, 0x13, 0xd6, 0xe2, 0x0f, 0x0a,
-	0x98, 0xdb, 0x24, 0x94, 0xb9, 0x1e, 0x31, 0x51, 0xb7, 0xe6, 0xec, 0xba, 0xf0, 0x7d, 0x90, 0xe8,
-	0x60, 0x64, 0x61, 0x4f, 0x55, 0x0a, 0xca, 0x42, 0x6a, 0xe5, 0x92, 0x16, 0x20, 0x68, 0x7e, 0xe4,
-	0xfe, 0xb2, 0xb6, 0x29, 0x1c, 0xca, 0xc9, 0x47, 0xc7, 0xf9, 0xc8, 0xfd, 0xe7, 0x0f, 0x16, 0x15,
-	0x5d, 0xc6, 0xc0, 0x1b, 0x20, 0xb1, 0x8f, 0xba, 0x14, 0x33, 0x35, 0x5a, 0x88, 0x2d, 0xa4, 0x56,
-	0xfe, 0xa7, 0xbd, 0xb2, 0xf2, 0xda, 0x2d, 0xd4, 0x25, 0x16, 0x62, 0xee, 0x38, 0x90, 0x1f, 0xbe,
-	0x1a, 0x55, 0x95, 0xe2, 0x37, 0x0a, 0xc8, 0x84, 0xd9, 0xe9, 0xd8, 0x74, 0x3d, 0x0b, 0xaa, 0x60,
-	0x0a, 0xf5, 0x7a, 0x1d, 0x44, 0x3b, 0x22, 0xc1, 0x19, 0x3d, 0x10, 0xe1, 0xbb, 0x20, 0xce, 0x4b,
-	0xad, 0x46, 0x45, 0xde, 0x59, 0xcd, 0xef, 0x83, 0x16, 0xf4, 0x41, 0xdb, 0x09, 0xfa, 0x50, 0x8e,
-	0xdf, 0xfd, 0x23, 0xaf, 0xe8, 0xc2, 0x1b, 0x5e, 0x03, 0xe9, 0xfd, 0x20, 0x11, 0x6a, 0x08, 0xdc,
-	0x98, 0xc0, 0x9d, 0x0b, 0xd5, 0x9b, 0x88, 0x76, 0x8a, 0xdf, 0x46, 0x41, 0xba, 0xe2, 0xda, 0x36,
-	0xa1, 0x94, 0xb8, 0x8e, 0x8e, 0x18, 0xa6, 0xf0, 0x43, 0x10, 0xf7, 0x10, 0xc3, 0x22, 0x93, 0x64,
-	0xf9, 0x3a, 0x3f, 0xc6, 0xef, 0xc7, 0xf9, 0x7f, 0xf9, 0x67, 0xa6, 0xd6, 0x9e, 0x46, 0xdc, 0x92,
-	0x8d, 0x58, 0x47, 0xbb, 0x89, 0xdb, 0xc8, 0x1c, 0x54, 0xb1, 0xf9, 0xe4, 0xe1, 0x12, 0x90, 0x25,
-	0xa9, 0x62, 0xd3, 0x3f, 0xb3, 0xc0, 0x80, 0x9f, 0x80, 0x69, 0x1b, 0x1d, 0x18, 0x02, 0x2f, 0xfa,
-	0x46, 0x78, 0x53, 0x36, 0x3a, 0xe0, 0xf9, 0xc1, 0xdb, 0x20, 0xcd, 0x21, 0xcd, 0x0e, 0x72, 0xda,
-	0xd8, 0x47, 0x8e, 0xbd, 0x11, 0xf2, 0xac, 0x8d, 0x0e, 0x2a, 0x02, 0x8d, 0xe3, 0xaf, 0xc6, 0x5f,
-	0xdc, 0xcb, 0x2b, 0xc5, 0x5f, 0x14, 0x00, 0xc2, 0xc2, 0x40, 0x0b, 0x64, 0xcc, 0xa1, 0x24, 0x3e,
-	0x4a, 0x25, 0x95, 0x16, 0x4f, 0x21, 0xc3, 0x44, 0x65, 0xcb, 0xb3, 0x3c, 0xc3, 0xc7, 0xc7, 0x79,
-	0xc5, 0xff, 0x70, 0xda, 0x3c, 0x51, 0xf9, 0x54, 0xbf, 0x67, 0x21, 0x86, 0x8d, 0xd7, 0xec, 0xb9,
-	0x00, 0xe4, 0x7d, 0xf7, 0x01, 0x81, 0x1f, 0xcd, 0xed, 0xf2, 0x18, 0xf7, 0x15, 0x90, 0xaa, 0x62,
-	0x6a, 0x7a, 0xa4, 0xc7, 0xa7, 0x99, 0x13, 0xcd, 0x76, 0x1d, 0xb2, 0x27, 0x27, 0x21, 0xa9, 0x07,
-	0x22, 0xcc, 0x82, 0x69, 0x62, 0x61, 0x87, 0x11, 0x36, 0xf0, 0x3b, 0xa5, 0x0f, 0x65, 0x1e, 0xf5,
-	0x15, 0x6e, 0x51, 0x12, 0x94, 0x5a, 0x0f, 0x44, 0xf8, 0x7f, 0x90, 0xa1, 0xd8, 0xec, 0x7b, 0x84,
-	0x0d, 0x0c, 0xd3, 0x75, 0x18, 0x32, 0x99, 0x1a, 0x17, 0x2e, 0xe9, 0x40, 0x5f, 0xf1, 0xd5, 0x1c,
-	0xc4, 0xc2, 0x0c, 0x91, 0x2e, 0x55, 0xcf, 0xf8, 0x20, 0x52, 0x94, 0xa9, 0x7e, 0x7f, 0x06, 0x24,
-	0x87, 0xd3, 0x03, 0x2b, 0x20, 0xe3, 0xf6, 0xb0, 0xc7, 0xff, 0x1b, 0xc8, 0xb2, 0x3c, 0x4c, 0xa9,
-	0x24, 0xa4, 0xfa, 0xe4, 0xe1, 0xd2, 0xbc, 0xac, 0xf9, 0x9a, 0x6f, 0x69, 0x30, 0x8f, 0x38, 0x6d,
-	0x3d, 0x1d, 0x44, 0x48, 0x35, 0xfc, 0x8c, 0x77, 0xcd, 0xa1, 0xd8, 0xa1, 0x7d, 0x6a, 0xf4, 0xfa,
-	0xad, 0x3d, 0x3c, 0x90, 0x45, 0x9d, 0x3f, 0x51, 0xd4, 0x35, 0x67, 0x50, 0x56, 0x7f, 0x0d, 0xa1,
-	0x4d, 0x6f, 0xd0, 0x63, 0xae, 0xb6, 0xdd, 0x6f, 0x7d, 0x84, 0x07, 0xbc, 0x55, 0x12, 0x67, 0x5b,
-	0xc0, 0xc0, 0x0b, 0x20, 0xf1, 0x25, 0x22, 0x5d, 0x6c, 0x89, 0x8a, 0x4c, 0xeb, 0x52, 0x82, 0x1f,
-	0x80, 0x04, 0x65, 0x88, 0xf5, 0xa9, 0x28, 0xc3, 0xdc, 0xca, 0x95, 0x53, 0xe8, 0x51, 0x76, 0x1d,
-	0xab, 0x21, 0x9c, 0x75, 0x19, 0x04, 0x2b, 0x20, 0xc1, 0xdc, 0x3d, 0xec, 0xc8, 0x1a, 0x95, 0xdf,
-	0x92, 0x9c, 0x3e, 0x7f, 0x92, 0xd3, 0x35, 0x87, 0x8d, 0xb0, 0xb9, 0xe6, 0x30, 0x5d, 0x86, 0xc2,
-	0x06, 0x48, 0x59, 0x61, 0xcf, 0xd5, 0x84, 0x38, 0xf1, 0xd5, 0x53, 0x12, 0x19, 0x61, 0xc8, 0xe8,
-	0xda, 0x1a, 0x45, 0xe1, 0x9d, 0xee, 0x3b, 0x2d, 0xd7, 0xb1, 0x88, 0xd3, 0x36, 0x3a, 0x98, 0xb4,
-	0x3b, 0x4c, 0x9d, 0x2a, 0x28, 0x0b, 0x31, 0x3d, 0x3d, 0xd4, 0x6f, 0x0a, 0x35, 0xdc, 0x06, 0x73,
-	0xa1, 0xab, 0x60, 0xf2, 0xf4, 0x3f, 0x65, 0xf2, 0xec, 0x10, 0x80, 0xbb, 0xc0, 0x6d, 0x00, 0xc2,
-	0x59, 0x51, 0x93, 0x02, 0xed, 0xca, 0x6b, 0x0d, 0xde, 0xe8, 0x79, 0x46, 0x30, 0xe0, 0x7f, 0x41,
-	0xf8, 0x09, 0x83, 0x58, 0x54, 0x05, 0x85, 0xd8, 0x42, 0x5c, 0x9f, 0x19, 0x2a, 0x6b, 0x16, 0x5d,
-	0x9d, 0xbe, 0x73, 0x2f, 0x1f, 0x79, 0x71, 0x2f, 0x1f, 0x29, 0x6e, 0x80, 0x99, 0x5b, 0xa8, 0x2b,
-	0x79, 0x85, 0x29, 0xbc, 0x0e, 0x92, 0x28, 0x10, 0x54, 0xa5, 0x10, 0x3b, 0x95, 0x97, 0xa1, 0x6b,
-	0xf1, 0xc7, 0x18, 0x48, 0x6c, 0x23, 0x0f, 0xd9, 0x14, 0x6e, 0x9d, 0xa8, 0x52, 0x70, 0x37, 0x4d,
-	0x56, 0xa9, 0x2a, 0xef, 0x62, 0xbf, 0x48, 0xdf, 0xbd, 0xaa, 0x48, 0x57, 0xc0, 0x1c, 0x5f, 0x8c,
-	0xe1, 0x86, 0x17, 0x5c, 0x9f, 0x15, 0xfb, 0x6d, 0x38, 0x58, 0x14, 0xe6, 0x41, 0x8a, 0xbb, 0x61,
-	0x87, 0x79, 0x04, 0x53, 0x41, 0xdf, 0x59, 0x1d, 0xd8, 0xe8, 0x60, 0xdd, 0xd7, 0xc0, 0x25, 0x00,
-	0x3b, 0xc3, 0x0b, 0x6a, 0xe8, 0x17, 0x17, 0x7e, 0x67, 0x43, 0x4b, 0xe0, 0x7e, 0x19, 0x00, 0x9e,
-	0x85, 0x61, 0x61, 0xc7, 0xb5, 0xe5, 0x68, 0x27, 0xb9, 0xa6, 0xca, 0x15, 0xf0, 0x6b, 0x05, 0x9c,
-	0xb3, 0x89, 0x63, 0x4c, 0xac, 0x4f, 0xc1, 0xca, 0x64, 0x79, 0xe7, 0x35, 0x76, 0xf6, 0x5f, 0xc7,
-	0xf9, 0xec, 0x00, 0xd9, 0xdd, 0xd5, 0xe2, 0x4b, 0x70, 0x8a, 0x2f, 0xdb, 0xe8, 0x67, 0x6d, 0xe2,
-	0x8c, 0xef, 0xde, 0xd5, 0x6b, 0x7c, 0xc7, 0x1c, 0x3e, 0x7f, 0xb0, 0x28, 0x5f, 0x23, 0x4b, 0xd4,
-	0xda, 0x2b, 0x1d, 0x8c, 0x3e, 0xb2, 0xfc, 0xb6, 0x14, 0x6f, 0x83, 0xcc, 0xb0, 0x58, 0x4d, 0xb1,
-	0x4e, 0x29, 0xdc, 0x00, 0x53, 0xfe, 0x66, 0xf5, 0x7b, 0x9d, 0x5a, 0xf9, 0x4f, 0xf8, 0x7e, 0xe0,
-	0x2f, 0x10, 0xfe, 0x7c, 0x98, 0x08, 0x1a, 0xe5, 0x5d, 0x10, 0xcc, 0xef, 0xff, 0xc5, 0x9f, 0x15,
-	0x00, 0xc2, 0xc1, 0x87, 0x6f, 0x83, 0x8b, 0xe5, 0xad, 0x7a, 0xd5, 0x68, 0xec, 0xac, 0xed, 0x34,
-	0x1b, 0x46, 0xb3, 0xde, 0xd8, 0x5e, 0xaf, 0xd4, 0x36, 0x6a, 0xeb, 0xd5, 0x4c, 0x24, 0x9b, 0x3e,
-	0x3c, 0x2a, 0xa4, 0x9a, 0x0e, 0xed, 0x61, 0x93, 0xec, 0x12, 0x6c, 0xc1, 0xab, 0x60, 0x7e, 0xdc,
-	0x9b, 0x4b, 0xeb, 0xd5, 0x8c, 0x92, 0x9d, 0x39, 0x3c, 0x2a, 0x4c, 0x37, 0x05, 0x1f, 0xb0, 0x05,
-	0x17, 0xc0, 0xf9, 0x93, 0x7e, 0xb5, 0xfa, 0x8d, 0x4c, 0x34, 0x3b, 0x7b, 0x78, 0x54, 0x48, 0x36,
-	0x03, 0xe2, 0xc0, 0x22, 0x80, 0xa3, 0x9e, 0x12, 0x2f, 0x96, 0x05, 0x87, 0x47, 0x85, 0x44, 0x59,
-	0xa0, 0x65, 0xe3, 0x77, 0x7e, 0xca, 0x45, 0x16, 0xbf, 0x00, 0xa0, 0xe6, 0xec, 0x7a, 0xc8, 0x14,
-	0xeb, 0x20, 0x0b, 0x2e, 0xd4, 0xea, 0x1b, 0xfa, 0x5a, 0x65, 0xa7, 0xb6, 0x55, 0x1f, 0x4f, 0x7b,
-	0xc2, 0x56, 0xdd, 0x6a, 0x96, 0x6f, 0xae, 0x1b, 0x8d, 0xda, 0x8d, 0x7a, 0x46, 0x81, 0x17, 0xc1,
-	0xb9, 0x31, 0xdb, 0xa7, 0xf5, 0x9d, 0xda, 0xc7, 0xeb, 0x99, 0x68, 0xf9, 0xbd, 0x47, 0x4f, 0x73,
-	0xca, 0xe3, 0xa7, 0x39, 0xe5, 0xcf, 0xa7, 0x39, 0xe5, 0xee, 0xb3, 0x5c, 0xe4, 0xf1, 0xb3, 0x5c,
-	0xe4, 0xb7, 0x67, 0xb9, 0xc8, 0xe7, 0xf9, 0x31, 0x6e, 0x8c, 0xf5, 0x4c, 0x3c, 0xde, 0x5a, 0x09,
-	0x31, 0x29, 0xef, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x75, 0x99, 0x08, 0x0f, 0x3a, 0x0b, 0x00,
-	0x00,
+	// 1959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0xb2, 0x24, 0x0e, 0x45, 0x91, 0x1a, 0x7f, 0x64, 0x4d, 0xd7, 0x22, 0xcd, 0xda,
+	0xb1, 0xe2, 0xd4, 0x64, 0xed, 0x16, 0x41, 0x6b, 0xb4, 0x40, 0xf9, 0x21, 0x5b, 0x6c, 0x6c, 0x4a,
+	0x5d, 0x52, 0x4e, 0x13, 0xa0, 0x59, 0x0c, 0x77, 0x47, 0xe4, 0x94, 0xdc, 0x1d, 0x76, 0x67, 0x28,
+	0x69, 0xef, 0x3d, 0x04, 0xea, 0x25, 0xa7, 0xa2, 0x68, 0x21, 0xc0, 0x40, 0x2f, 0x39, 0xe6, 0x60,
+	0xf4, 0x2f, 0xe8, 0x21, 0xe8, 0xc9, 0xf0, 0xa9, 0x28, 0x50, 0xb7, 0xb0, 0x0f, 0xce, 0xb9, 0xa7,
+	0x1e, 0x7a, 0x28, 0xe6, 0x63, 0x97, 0x1f, 0xb2, 0x05, 0x27, 0xbe, 0x08, 0x9c, 0xf7, 0xde, 0xfc,
+	0x76, 0xde, 0x7b, 0xbf, 0x79, 0xef, 0x8d, 0xc0, 0x75, 0x87, 0x32, 0x8f, 0xb2, 0x32, 0x0b, 0xbd,
+	0x16, 0x47, 0x7d, 0xe2, 0x77, 0xcb, 0xfb, 0xb7, 0x3a, 0x98, 0xa3, 0x5b, 0x65, 0xa6, 0xd6, 0xa5,
+	0x61, 0x40, 0x39, 0x85, 0x17, 0x95, 0x61, 0x69, 0x6c, 0x58, 0xd2, 0x86, 0xb9, 0x73, 0x5d, 0xda,
+	0xa5, 0xd2, 0xaa, 0x2c, 0x7e, 0xa9, 0x0d, 0xb9, 0x8b, 0x5d, 0x4a, 0xbb, 0x03, 0x5c, 0x96, 0xab,
+	0xce, 0x68, 0xaf, 0x8c, 0xfc, 0x50, 0xab, 0xd6, 0x67, 0x55, 0xee, 0x28, 0x40, 0x9c, 0x50, 0x5f,
+	0xeb, 0xf3, 0xb3, 0x7a, 0x4e, 0x3c, 0xcc, 0x38, 0xf2, 0x86, 0x11, 0xb6, 0x3a, 0x8c, 0xad, 0x3e,
+	0xaa, 0x4f, 0xa6, 0xb1, 0xb5, 0x43, 0x1d, 0xc4, 0x70, 0xec, 0x8a, 0x43, 0x49, 0x84, 0xbd, 0x86,
+	0x3c, 0xe2, 0xd3, 0xb2, 0xfc, 0xab, 0x45, 0x97, 0x1d, 0xea, 0x61, 0xde, 0xd9, 0xe3, 0x65, 0x1e,
+	0x0e, 0x31, 0x2b, 0xef, 0xdf, 0x52, 0x3f, 0xb4, 0xfa, 0x3b, 0xb1, 0x1a, 0x75, 0x1c, 0x32, 0xa3,
+	0x2d, 0xfe, 0xc9, 0x00, 0xab, 0x5b, 0x84, 0x71, 0x1a, 0x10, 0x07, 0x0d, 0x1a, 0xfe, 0x1e, 0x85,
+	0x3f, 0x01, 0x8b, 0x3d, 0x8c, 0x5c, 0x1c, 0x98, 0x46, 0xc1, 0xd8, 0x48, 0xdd, 0xbe, 0x58, 0x8a,
+	0x10, 0x4a, 0x6a, 0xe7, 0xfe, 0xad, 0xd2, 0x96, 0x34, 0xa8, 0x26, 0xbf, 0x7a, 0x96, 0x9f, 0xfb,
+	0xe2, 0xe5, 0x97, 0x37, 0x0c, 0x4b, 0xef, 0x81, 0xf7, 0xc0, 0xe2, 0x3e, 0x1a, 0x30, 0xcc, 0xcd,
+	0xf9, 0x42, 0x62, 0x23, 0x75, 0xfb, 0x6a, 0xe9, 0xb5, 0x91, 0x2f, 0x3d, 0x44, 0x03, 0xe2, 0x22,
+	0x4e, 0xa7, 0x81, 0xd4, 0xf6, 0x3b, 0xf3, 0xa6, 0x51, 0xfc, 0x9d, 0x01, 0xb2, 0xe3, 0xd3, 0x59,
+	0xd8, 0xa1, 0x81, 0x0b, 0x4d, 0xb0, 0x84, 0x86, 0xc3, 0x1e, 0x62, 0x3d, 0x79, 0xc0, 0x15, 0x2b,
+	0x5a, 0xc2, 0x1f, 0x82, 0x05, 0x11, 0x6a, 0x73, 0x5e, 0x9e, 0x3b, 0x57, 0x52, 0x79, 0x28, 0x45,
+	0x79, 0x28, 0xb5, 0xa3, 0x3c, 0x54, 0x17, 0x3e, 0xff, 0x57, 0xde, 0xb0, 0xa4, 0x35, 0xbc, 0x0e,
+	0x32, 0xfb, 0xd1, 0x41, 0x98, 0x2d, 0x71, 0x13, 0x12, 0x77, 0x75, 0x2c, 0xde, 0x42, 0xac, 0x57,
+	0xfc, 0xfd, 0x3c, 0xc8, 0xd4, 0xa8, 0xe7, 0x11, 0xc6, 0x08, 0xf5, 0x2d, 0xc4, 0x31, 0x83, 0x3f,
+	0x07, 0x0b, 0x01, 0xe2, 0x58, 0x9e, 0x24, 0x59, 0xfd, 0x40, 0xb8, 0xf1, 0x8f, 0x67, 0xf9, 0x4b,
+	0xca, 0x67, 0xe6, 0xf6, 0x4b, 0x84, 0x96, 0x3d, 0xc4, 0x7b, 0xa5, 0xfb, 0xb8, 0x8b, 0x9c, 0xb0,
+	0x8e, 0x9d, 0xa7, 0x8f, 0x6f, 0x02, 0x1d, 0x92, 0x3a, 0x76, 0x94, 0xcf, 0x12, 0x03, 0xfe, 0x02,
+	0x2c, 0x7b, 0xe8, 0xd0, 0x96, 0x78, 0xf3, 0x6f, 0x85, 0xb7, 0xe4, 0xa1, 0x43, 0x71, 0x3e, 0xf8,
+	0x29, 0xc8, 0x08, 0x48, 0xa7, 0x87, 0xfc, 0x2e, 0x56, 0xc8, 0x89, 0xb7, 0x42, 0x4e, 0x7b, 0xe8,
+	0xb0, 0x26, 0xd1, 0x04, 0xfe, 0x9d, 0x85, 0xaf, 0x1f, 0xe5, 0x8d, 0xe2, 0x5f, 0x0d, 0x00, 0xc6,
+	0x81, 0x81, 0x2e, 0xc8, 0x3a, 0xf1, 0x4a, 0x7e, 0x94, 0x69, 0x2a, 0xdd, 0x38, 0x85, 0x0c, 0x33,
+	0x91, 0xad, 0xa6, 0xc5, 0x09, 0x9f, 0x3c, 0xcb, 0x1b, 0xea, 0xc3, 0x19, 0xe7, 0x44, 0xe4, 0x53,
+	0xa3, 0xa1, 0x8b, 0x38, 0xb6, 0xdf, 0x30, 0xe7, 0x12, 0x50, 0xe4, 0x5d, 0x01, 0x02, 0xb5, 0x5b,
+	0xe8, 0xb5, 0x1b, 0x5f, 0x18, 0x20, 0x55, 0xc7, 0xcc, 0x09, 0xc8, 0x50, 0xdc, 0x66, 0x41, 0x34,
+	0x8f, 0xfa, 0xa4, 0xaf, 0x6f, 0x42, 0xd2, 0x8a, 0x96, 0x30, 0x07, 0x96, 0x89, 0x8b, 0x7d, 0x4e,
+	0x78, 0xa8, 0x32, 0x65, 0xc5, 0x6b, 0xb1, 0xeb, 0x00, 0x77, 0x18, 0x89, 0x42, 0x6d, 0x45, 0x4b,
+	0xf8, 0x1e, 0xc8, 0x32, 0xec, 0x8c, 0x02, 0xc2, 0x43, 0xdb, 0xa1, 0x3e, 0x47, 0x0e, 0x37, 0x17,
+	0xa4, 0x49, 0x26, 0x92, 0xd7, 0x94, 0x58, 0x80, 0xb8, 0x98, 0x23, 0x32, 0x60, 0xe6, 0x19, 0x05,
+	0xa2, 0x97, 0xfa, 0xa8, 0x7f, 0x3c, 0x03, 0x92, 0xf1, 0xed, 0x81, 0x35, 0x90, 0xa5, 0x43, 0x1c,
+	0x88, 0xdf, 0x36, 0x72, 0xdd, 0x00, 0x33, 0xa6, 0x09, 0x69, 0x3e, 0x7d, 0x7c, 0xf3, 0x9c, 0x8e,
+	0x79, 0x45, 0x69, 0x5a, 0x3c, 0x20, 0x7e, 0xd7, 0xca, 0x44, 0x3b, 0xb4, 0x18, 0x7e, 0x2c, 0xb2,
+	0xe6, 0x33, 0xec, 0xb3, 0x11, 0xb3, 0x87, 0xa3, 0x4e, 0x1f, 0x87, 0x3a, 0xa8, 0xe7, 0x4e, 0x04,
+	0xb5, 0xe2, 0x87, 0x55, 0xf3, 0x6f, 0x63, 0x68, 0x27, 0x08, 0x87, 0x9c, 0x96, 0x76, 0x46, 0x9d,
+	0x0f, 0x71, 0x28, 0x52, 0xa5, 0x71, 0x76, 0x24, 0x0c, 0xbc, 0x00, 0x16, 0x7f, 0x8d, 0xc8, 0x00,
+	0xbb, 0x32, 0x22, 0xcb, 0x96, 0x5e, 0xc1, 0x9f, 0x82, 0x45, 0xc6, 0x11, 0x1f, 0x31, 0x19, 0x86,
+	0xd5, 0xdb, 0xd7, 0x4e, 0xa1, 0x47, 0x95, 0xfa, 0x6e, 0x4b, 0x1a, 0x5b, 0x7a, 0x13, 0xac, 0x81,
+	0x45, 0x4e, 0xfb, 0xd8, 0xd7, 0x31, 0xaa, 0xbe, 0xaf, 0x39, 0x7d, 0xfe, 0x24, 0xa7, 0x1b, 0x3e,
+	0x9f, 0x60, 0x73, 0xc3, 0xe7, 0x96, 0xde, 0x0a, 0x5b, 0x20, 0xe5, 0x8e, 0x73, 0x6e, 0x2e, 0x4a,
+	0x8f, 0xdf, 0x3d, 0xe5, 0x20, 0x13, 0x0c, 0x99, 0x2c, 0x5b, 0x93, 0x28, 0x22, 0xd3, 0x23, 0xbf,
+	0x43, 0x7d, 0x97, 0xf8, 0x5d, 0xbb, 0x87, 0x49, 0xb7, 0xc7, 0xcd, 0xa5, 0x82, 0xb1, 0x91, 0xb0,
+	0x32, 0xb1, 0x7c, 0x4b, 0x8a, 0xe1, 0x0e, 0x58, 0x1d, 0x9b, 0x4a, 0x26, 0x2f, 0x7f, 0x53, 0x26,
+	0xa7, 0x63, 0x00, 0x61, 0x02, 0x77, 0x00, 0x18, 0xdf, 0x15, 0x33, 0x29, 0xd1, 0xae, 0xbd, 0xd1,
+	0xc5, 0x9b, 0xf4, 0x67, 0x02, 0x03, 0x7e, 0x17, 0x8c, 0x3f, 0x61, 0x13, 0x97, 0x99, 0xa0, 0x90,
+	0xd8, 0x58, 0xb0, 0x56, 0x62, 0x61, 0xc3, 0x65, 0x77, 0x96, 0x3f, 0x7b, 0x94, 0x9f, 0xfb, 0xfa,
+	0x51, 0x7e, 0xae, 0x78, 0x17, 0xac, 0x3c, 0x44, 0x03, 0xcd, 0x2b, 0xcc, 0xe0, 0x07, 0x20, 0x89,
+	0xa2, 0x85, 0x69, 0x14, 0x12, 0xa7, 0xf2, 0x72, 0x6c, 0x5a, 0xfc, 0xe7, 0x3c, 0x80, 0x35, 0xea,
+	0x33, 0x4d, 0x2b, 0xca, 0x65, 0x93, 0x85, 0x4d, 0xb0, 0x16, 0x17, 0xe6, 0x19, 0xba, 0x5f, 0x79,
+	0xfa, 0xf8, 0xe6, 0x65, 0x0d, 0x1b, 0x5f, 0x8f, 0x69, 0xfc, 0xec, 0xfe, 0x8c, 0x1c, 0x3e, 0x04,
+	0x19, 0x3a, 0x70, 0xc5, 0x8d, 0x7c, 0x4b, 0xde, 0xa7, 0xe9, 0xc0, 0xd5, 0xe7, 0x15, 0xac, 0x7f,
+	0x08, 0x32, 0x3e, 0x3e, 0x98, 0xc2, 0x4d, 0x7c, 0x3b, 0x5c, 0x1f, 0x1f, 0x4c, 0xe0, 0x5e, 0x10,
+	0xfd, 0x59, 0x52, 0x6a, 0x41, 0x52, 0x4a, 0xaf, 0xe0, 0x35, 0xb0, 0x1a, 0x88, 0x18, 0x61, 0x37,
+	0xa2, 0xdc, 0x19, 0xa9, 0x4f, 0x6b, 0xa9, 0x22, 0xdc, 0x44, 0x9e, 0x5e, 0x26, 0xc1, 0xe2, 0x0e,
+	0x0a, 0x90, 0xc7, 0xe0, 0xf6, 0x09, 0x16, 0x46, 0xbd, 0x7f, 0xf6, 0xa8, 0x75, 0x3d, 0xeb, 0x28,
+	0x12, 0xfe, 0xe1, 0x75, 0x24, 0xbc, 0x06, 0x56, 0x45, 0xe3, 0x19, 0x77, 0x50, 0x19, 0xd3, 0xb4,
+	0xec, 0x1f, 0x71, 0x66, 0x18, 0xcc, 0x83, 0x94, 0x30, 0xc3, 0x3e, 0x0f, 0x08, 0x66, 0x32, 0x3e,
+	0x69, 0x0b, 0x78, 0xe8, 0x70, 0x53, 0x49, 0xe0, 0x4d, 0x00, 0x7b, 0xf1, 0x00, 0x10, 0xdb, 0x2d,
+	0x48, 0xbb, 0xb5, 0xb1, 0x26, 0x32, 0xbf, 0x0c, 0x80, 0x38, 0x85, 0xed, 0x62, 0x9f, 0x7a, 0xba,
+	0x74, 0x26, 0x85, 0xa4, 0x2e, 0x04, 0xf0, 0xb7, 0x06, 0x38, 0xeb, 0x11, 0xdf, 0x9e, 0x69, 0x4f,
+	0xf2, 0xd6, 0x27, 0xab, 0xed, 0x37, 0xe8, 0x89, 0xff, 0x79, 0x96, 0xcf, 0x85, 0xc8, 0x1b, 0xdc,
+	0x29, 0xbe, 0x02, 0xa7, 0xf8, 0xaa, 0x8e, 0xb9, 0xe6, 0x11, 0x7f, 0xba, 0xb7, 0x09, 0xa7, 0x3c,
+	0xe2, 0xba, 0x03, 0x7c, 0x80, 0x02, 0x1c, 0x53, 0x78, 0x49, 0x9e, 0x76, 0x6d, 0xac, 0x89, 0x08,
+	0x7a, 0x1b, 0x9c, 0x67, 0xa1, 0xd7, 0x21, 0x94, 0x13, 0xc7, 0x66, 0xa1, 0xef, 0xd8, 0x43, 0x1c,
+	0x10, 0xea, 0xca, 0x4a, 0x91, 0xb0, 0xce, 0xc6, 0xca, 0x56, 0xe8, 0x3b, 0x3b, 0x52, 0x05, 0xaf,
+	0x82, 0x55, 0x36, 0xa0, 0x9c, 0xd9, 0xc4, 0xb7, 0xf1, 0x90, 0x3a, 0x3d, 0x59, 0x08, 0x12, 0xd6,
+	0x8a, 0x94, 0x36, 0xfc, 0x4d, 0x21, 0x83, 0x3f, 0x02, 0x66, 0x07, 0x23, 0x87, 0xfa, 0x76, 0x17,
+	0xfb, 0x98, 0x11, 0x66, 0xc7, 0xa3, 0xaa, 0x09, 0xa4, 0xfd, 0x05, 0xa5, 0xbf, 0xa7, 0xd4, 0x71,
+	0x09, 0x12, 0x25, 0x41, 0x20, 0xd9, 0xd1, 0xe8, 0x6b, 0xa6, 0xc6, 0xf0, 0x11, 0x45, 0xe0, 0x27,
+	0x60, 0x75, 0xd2, 0xcf, 0x0e, 0x31, 0x57, 0x24, 0xab, 0x36, 0x4e, 0xa9, 0x46, 0x0f, 0xc6, 0xee,
+	0x57, 0x1b, 0x93, 0x05, 0x29, 0x3d, 0x11, 0x98, 0x0e, 0x11, 0x47, 0x47, 0x23, 0x4e, 0xed, 0x00,
+	0x77, 0x09, 0xe3, 0x38, 0x98, 0xa4, 0x5a, 0x5a, 0x76, 0x99, 0x0b, 0x42, 0x6f, 0x69, 0xf5, 0x04,
+	0xe7, 0x6a, 0x60, 0x7d, 0x26, 0x9c, 0x8a, 0x47, 0x61, 0x4c, 0xaf, 0x55, 0x49, 0xaf, 0x4b, 0x53,
+	0x71, 0x55, 0x63, 0x68, 0x18, 0x11, 0xed, 0x97, 0xe0, 0xac, 0x20, 0xae, 0x78, 0x64, 0x60, 0xf1,
+	0x77, 0x20, 0x02, 0xc4, 0xcc, 0xcc, 0x37, 0xbc, 0x35, 0x6b, 0x1e, 0x3a, 0x14, 0x11, 0xc0, 0xad,
+	0x08, 0x02, 0x7e, 0x0c, 0xa0, 0xc4, 0xd3, 0xd8, 0xc8, 0x91, 0xe1, 0xcd, 0xca, 0x06, 0xf9, 0xfe,
+	0x29, 0x81, 0x93, 0x08, 0x12, 0xab, 0x22, 0xb7, 0x58, 0x59, 0x36, 0x23, 0x11, 0x6d, 0x09, 0xf3,
+	0x1e, 0x0e, 0xf0, 0xc8, 0xb3, 0x7d, 0xcc, 0x0f, 0x68, 0xd0, 0x37, 0xd7, 0xd4, 0x00, 0x12, 0xc9,
+	0x9b, 0x4a, 0x0c, 0x09, 0x38, 0x2f, 0xfc, 0x1b, 0xd2, 0x03, 0x1c, 0x4c, 0x8d, 0x8f, 0xf0, 0xad,
+	0xc6, 0x47, 0xe8, 0xa1, 0xc3, 0x1d, 0x81, 0x39, 0x9e, 0x21, 0x45, 0x26, 0x03, 0xfc, 0x9b, 0x11,
+	0x09, 0xb0, 0x1d, 0x4f, 0x31, 0x1d, 0x22, 0x4b, 0x89, 0x79, 0x56, 0x65, 0x52, 0xeb, 0xb7, 0xb5,
+	0xba, 0xaa, 0xb4, 0x77, 0xae, 0x8b, 0x59, 0xe8, 0xe8, 0xe5, 0x97, 0x37, 0xf4, 0xab, 0xe9, 0x26,
+	0x73, 0xfb, 0xe5, 0xc3, 0xc9, 0xc7, 0xa0, 0x2a, 0x6f, 0xc5, 0xff, 0x19, 0x20, 0x3d, 0x45, 0x2c,
+	0x98, 0x05, 0x09, 0xc1, 0x47, 0x35, 0xd7, 0x89, 0x9f, 0xf0, 0x0a, 0x58, 0x91, 0x17, 0xc5, 0xf6,
+	0x30, 0xef, 0x51, 0x57, 0xcf, 0x75, 0x29, 0x29, 0x7b, 0x20, 0x45, 0xf0, 0xfb, 0xe0, 0xdc, 0xb8,
+	0xf3, 0x30, 0xcc, 0x23, 0x53, 0x35, 0xe7, 0xc1, 0x58, 0xd7, 0xc2, 0x5c, 0xef, 0xc8, 0x83, 0x94,
+	0x4a, 0xe3, 0x1e, 0xc1, 0x03, 0x57, 0x4f, 0x7b, 0x40, 0x8a, 0xee, 0x0a, 0x09, 0xbc, 0x04, 0x92,
+	0x7d, 0x1c, 0x6a, 0xb5, 0xaa, 0x57, 0xcb, 0x7d, 0x1c, 0x2a, 0xe5, 0x45, 0x20, 0x7e, 0xdb, 0xe2,
+	0xd5, 0xa5, 0x4a, 0x94, 0xb5, 0xd4, 0xc7, 0x61, 0x3b, 0x1c, 0xca, 0xfa, 0x1a, 0x07, 0x4b, 0x6d,
+	0x56, 0xe5, 0x23, 0x1d, 0x49, 0x25, 0x82, 0x9e, 0x16, 0x3f, 0x05, 0xd9, 0x98, 0xff, 0xbb, 0x72,
+	0xea, 0x65, 0xf0, 0x2e, 0x58, 0x52, 0x03, 0xb0, 0x6a, 0xc9, 0xa9, 0xdb, 0x57, 0xc6, 0xcf, 0x3c,
+	0xf1, 0x50, 0x14, 0xaf, 0xbc, 0x99, 0x4d, 0x93, 0xb7, 0x31, 0xda, 0x2c, 0x9e, 0x69, 0x37, 0xfe,
+	0x62, 0x00, 0x30, 0x9e, 0xcf, 0xe0, 0xf7, 0xc0, 0x3b, 0xd5, 0xed, 0x66, 0xdd, 0x6e, 0xb5, 0x2b,
+	0xed, 0xdd, 0x96, 0xbd, 0xdb, 0x6c, 0xed, 0x6c, 0xd6, 0x1a, 0x77, 0x1b, 0x9b, 0xf5, 0xec, 0x5c,
+	0x2e, 0x73, 0x74, 0x5c, 0x48, 0xed, 0xfa, 0x6c, 0x88, 0x1d, 0xb2, 0x47, 0xb0, 0x0b, 0xdf, 0x05,
+	0xe7, 0xa6, 0xad, 0xc5, 0x6a, 0xb3, 0x9e, 0x35, 0x72, 0x2b, 0x47, 0xc7, 0x85, 0xe5, 0x5d, 0xd9,
+	0x56, 0xb0, 0x0b, 0x37, 0xc0, 0xf9, 0x93, 0x76, 0x8d, 0xe6, 0xbd, 0xec, 0x7c, 0x2e, 0x7d, 0x74,
+	0x5c, 0x48, 0xee, 0x46, 0xfd, 0x07, 0x16, 0x01, 0x9c, 0xb4, 0xd4, 0x78, 0x89, 0x1c, 0x38, 0x3a,
+	0x2e, 0x2c, 0x56, 0x25, 0x5a, 0x6e, 0xe1, 0xb3, 0x3f, 0xaf, 0xcf, 0xdd, 0xf8, 0xaf, 0x01, 0xb2,
+	0xb3, 0xf7, 0x46, 0xd4, 0x87, 0x56, 0xbb, 0x72, 0x7f, 0x53, 0xec, 0xff, 0x70, 0xd3, 0xae, 0xd4,
+	0xda, 0x8d, 0xed, 0xe6, 0x8c, 0x17, 0xf9, 0xa3, 0xe3, 0xc2, 0xa5, 0xd9, 0x9d, 0x93, 0x5e, 0x7d,
+	0x04, 0xde, 0x7b, 0x05, 0xc8, 0x56, 0xe5, 0x7e, 0xdb, 0x7e, 0x58, 0xb9, 0xdf, 0xa8, 0x57, 0xda,
+	0xdb, 0x96, 0xbd, 0xbb, 0x53, 0xaf, 0xb4, 0x37, 0x5b, 0x59, 0x23, 0xb7, 0x71, 0x74, 0x5c, 0xb8,
+	0x3a, 0x8b, 0xb7, 0x85, 0x06, 0xfc, 0x44, 0xde, 0x7e, 0x06, 0x2e, 0xbf, 0x0e, 0xb8, 0xb6, 0x55,
+	0x69, 0x34, 0xb3, 0xf3, 0xb9, 0xcb, 0x47, 0xc7, 0x85, 0x8b, 0xaf, 0x02, 0xab, 0xf5, 0x10, 0xf1,
+	0xb5, 0xeb, 0xbf, 0x02, 0xa0, 0xe1, 0xef, 0x05, 0xaa, 0xbc, 0xc0, 0x1c, 0xb8, 0xd0, 0x68, 0xde,
+	0xb5, 0x5e, 0xe5, 0xeb, 0x8c, 0xae, 0xbe, 0xbd, 0x5b, 0x15, 0x5f, 0x6f, 0xdc, 0x6b, 0x66, 0x0d,
+	0xf8, 0x0e, 0x38, 0x3b, 0xa5, 0xfb, 0xa8, 0xd9, 0x6e, 0x3c, 0xd8, 0xcc, 0xce, 0x57, 0x7f, 0xfc,
+	0xd5, 0xf3, 0x75, 0xe3, 0xc9, 0xf3, 0x75, 0xe3, 0xdf, 0xcf, 0xd7, 0x8d, 0xcf, 0x5f, 0xac, 0xcf,
+	0x3d, 0x79, 0xb1, 0x3e, 0xf7, 0xf7, 0x17, 0xeb, 0x73, 0x9f, 0xe4, 0xa7, 0x4a, 0xc6, 0xd4, 0x6d,
+	0x95, 0xff, 0x5e, 0xe8, 0x2c, 0xca, 0xaa, 0xf9, 0x83, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe6,
+	0xd6, 0xde, 0x94, 0xdc, 0x11, 0x00, 0x00,
 }
 
 func (this *CommissionRates) Equal(that interface{}) bool {
@@ -876,6 +1240,87 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.MiddlewareAddress != that1.MiddlewareAddress {
+		return false
+	}
+	if this.SymbioticSyncPeriod != that1.SymbioticSyncPeriod {
+		return false
+	}
+	if this.SlotsInEpoch != that1.SlotsInEpoch {
+		return false
+	}
+	if this.BeaconGenesisTimestamp != that1.BeaconGenesisTimestamp {
+		return false
+	}
+	if this.SlotDuration != that1.SlotDuration {
+		return false
+	}
+	if !this.MiddlewareAbi.Equal(&that1.MiddlewareAbi) {
+		return false
+	}
+	if this.AutoRegisterValidators != that1.AutoRegisterValidators {
+		return false
+	}
+	if this.SymbioticSyncHistoryEntries != that1.SymbioticSyncHistoryEntries {
+		return false
+	}
+	if this.MaxStakeStaleness != that1.MaxStakeStaleness {
+		return false
+	}
+	if this.StaleStakeAction != that1.StaleStakeAction {
+		return false
+	}
+	if this.EthereumNetwork != that1.EthereumNetwork {
+		return false
+	}
+	if !this.MaxPowerChangeRate.Equal(that1.MaxPowerChangeRate) {
+		return false
+	}
+	if this.RequireOperatorBinding != that1.RequireOperatorBinding {
+		return false
+	}
+	return true
+}
+func (this *MiddlewareABI) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MiddlewareABI)
+	if !ok {
+		that2, ok := that.(MiddlewareABI)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Abi != that1.Abi {
+		return false
+	}
+	if this.EpochMethod != that1.EpochMethod {
+		return false
+	}
+	if this.ValidatorSetMethod != that1.ValidatorSetMethod {
+		return false
+	}
+	if this.StakeField != that1.StakeField {
+		return false
+	}
+	if this.KeyField != that1.KeyField {
+		return false
+	}
+	if this.KeyType != that1.KeyType {
+		return false
+	}
+	if this.OperatorField != that1.OperatorField {
+		return false
+	}
 	return true
 }
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
@@ -1274,7 +1719,7 @@ func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1284,56 +1729,292 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinCommissionRate.Size()
-		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+	if m.RotatedHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.RotatedHeight))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err11 != nil {
-		return 0, err11
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireOperatorBinding {
+		i--
+		if m.RequireOperatorBinding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.MaxPowerChangeRate.Size()
+		i -= size
+		if _, err := m.MaxPowerChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.EthereumNetwork) > 0 {
+		i -= len(m.EthereumNetwork)
+		copy(dAtA[i:], m.EthereumNetwork)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.EthereumNetwork)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.StaleStakeAction != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.StaleStakeAction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStakeStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStakeStaleness):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x7a
+	if m.SymbioticSyncHistoryEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SymbioticSyncHistoryEntries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.AutoRegisterValidators {
+		i--
+		if m.AutoRegisterValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.MiddlewareAbi.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SlotDuration != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlotDuration))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BeaconGenesisTimestamp != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.BeaconGenesisTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SlotsInEpoch != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlotsInEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SymbioticSyncPeriod != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SymbioticSyncPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MiddlewareAddress) > 0 {
+		i -= len(m.MiddlewareAddress)
+		copy(dAtA[i:], m.MiddlewareAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.MiddlewareAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MiddlewareABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiddlewareABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiddlewareABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorField) > 0 {
+		i -= len(m.OperatorField)
+		copy(dAtA[i:], m.OperatorField)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorField)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.KeyField) > 0 {
+		i -= len(m.KeyField)
+		copy(dAtA[i:], m.KeyField)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.KeyField)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakeField) > 0 {
+		i -= len(m.StakeField)
+		copy(dAtA[i:], m.StakeField)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.StakeField)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorSetMethod) > 0 {
+		i -= len(m.ValidatorSetMethod)
+		copy(dAtA[i:], m.ValidatorSetMethod)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSetMethod)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EpochMethod) > 0 {
+		i -= len(m.EpochMethod)
+		copy(dAtA[i:], m.EpochMethod)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.EpochMethod)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1536,6 +2217,33 @@ func (m *ValAddresses) Size() (n int) {
 	return n
 }
 
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.RotatedHeight != 0 {
+		n += 1 + sovStaking(uint64(m.RotatedHeight))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1559,6 +2267,81 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.MiddlewareAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.SymbioticSyncPeriod != 0 {
+		n += 1 + sovStaking(uint64(m.SymbioticSyncPeriod))
+	}
+	if m.SlotsInEpoch != 0 {
+		n += 1 + sovStaking(uint64(m.SlotsInEpoch))
+	}
+	if m.BeaconGenesisTimestamp != 0 {
+		n += 1 + sovStaking(uint64(m.BeaconGenesisTimestamp))
+	}
+	if m.SlotDuration != 0 {
+		n += 1 + sovStaking(uint64(m.SlotDuration))
+	}
+	l = m.MiddlewareAbi.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.AutoRegisterValidators {
+		n += 2
+	}
+	if m.SymbioticSyncHistoryEntries != 0 {
+		n += 1 + sovStaking(uint64(m.SymbioticSyncHistoryEntries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStakeStaleness)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.StaleStakeAction != 0 {
+		n += 2 + sovStaking(uint64(m.StaleStakeAction))
+	}
+	l = len(m.EthereumNetwork)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	l = m.MaxPowerChangeRate.Size()
+	n += 2 + l + sovStaking(uint64(l))
+	if m.RequireOperatorBinding {
+		n += 3
+	}
+	return n
+}
+
+func (m *MiddlewareABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.EpochMethod)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSetMethod)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.StakeField)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.KeyField)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.OperatorField)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -2799,7 +3582,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2822,17 +3605,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2842,30 +3625,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			m.MaxValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2875,16 +3657,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &any.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			m.MaxEntries = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -2894,13 +3693,189 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &any.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedHeight", wireType)
+			}
+			m.RotatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
 			m.HistoricalEntries = 0
@@ -2984,6 +3959,598 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiddlewareAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncPeriod", wireType)
+			}
+			m.SymbioticSyncPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticSyncPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsInEpoch", wireType)
+			}
+			m.SlotsInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsInEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconGenesisTimestamp", wireType)
+			}
+			m.BeaconGenesisTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeaconGenesisTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotDuration", wireType)
+			}
+			m.SlotDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiddlewareAbi", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MiddlewareAbi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterValidators = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbioticSyncHistoryEntries", wireType)
+			}
+			m.SymbioticSyncHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SymbioticSyncHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStakeStaleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleStakeAction", wireType)
+			}
+			m.StaleStakeAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleStakeAction |= StaleStakeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumNetwork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumNetwork = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireOperatorBinding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireOperatorBinding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiddlewareABI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiddlewareABI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiddlewareABI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -1,6 +1,265 @@
 package types
 
-type CachedBlockHash struct {
-	BlockHash string
-	Height    int64
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SymbioticValidator is a single entry of the validator set reported by the
+// Symbiotic middleware contract.
+type SymbioticValidator struct {
+	Stake *big.Int
+	// ConsAddr holds the consensus address in its first 20 bytes, when the
+	// middleware reports consensus addresses.
+	ConsAddr [32]byte
+	// KeyType is the type of ConsPubKey, empty when the middleware reports
+	// consensus addresses.
+	KeyType    string
+	ConsPubKey []byte
+	// Operator is the operator address, if the middleware reports it.
+	Operator []byte
+}
+
+// ConsAddress returns the consensus address of the validator, derived from
+// its public key if the middleware reports full keys.
+func (v SymbioticValidator) ConsAddress() (sdk.ConsAddress, error) {
+	if v.KeyType == "" {
+		if len(v.ConsPubKey) != 0 {
+			return nil, errors.New("consensus public key without key type")
+		}
+		return sdk.ConsAddress(v.ConsAddr[:20]), nil
+	}
+
+	if v.KeyType == KeyTypeBls12381 {
+		if err := v.validatePubKey(); err != nil {
+			return nil, err
+		}
+		// the CometBFT bls12_381 address, computed without decoding the key
+		return sdk.ConsAddress(tmhash.SumTruncated(v.ConsPubKey)), nil
+	}
+
+	pk, err := v.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ConsAddress(pk.Address()), nil
+}
+
+// PubKey returns the consensus public key of the validator. It fails if the
+// middleware reports consensus addresses only.
+func (v SymbioticValidator) PubKey() (cryptotypes.PubKey, error) {
+	if v.KeyType == "" {
+		return nil, errors.New("no consensus public key")
+	}
+	if err := v.validatePubKey(); err != nil {
+		return nil, err
+	}
+
+	switch v.KeyType {
+	case KeyTypeEd25519:
+		return &ed25519.PubKey{Key: v.ConsPubKey}, nil
+	case KeyTypeSecp256k1:
+		return &secp256k1.PubKey{Key: v.ConsPubKey}, nil
+	default:
+		return &bls12_381.PubKey{Key: v.ConsPubKey}, nil
+	}
+}
+
+// MiddlewareKey returns the key a middleware reporting keys of keyType
+// identifies the validator with consensus public key pk by: its consensus
+// address for KeyTypeConsAddress, its consensus public key otherwise.
+func MiddlewareKey(keyType string, pk cryptotypes.PubKey) ([]byte, error) {
+	if keyType == KeyTypeConsAddress {
+		return pk.Address(), nil
+	}
+
+	v := SymbioticValidator{KeyType: keyType, ConsPubKey: pk.Bytes()}
+	consAddr, err := v.ConsAddress()
+	if err != nil {
+		return nil, err
+	}
+	if !consAddr.Equals(sdk.ConsAddress(pk.Address())) {
+		return nil, fmt.Errorf("%s consensus public key is not of type %s", pk.Type(), keyType)
+	}
+
+	return v.ConsPubKey, nil
+}
+
+func (v SymbioticValidator) validatePubKey() error {
+	if size, ok := consPubKeySizes[v.KeyType]; !ok || len(v.ConsPubKey) != size {
+		return fmt.Errorf("invalid %s consensus public key of length %d", v.KeyType, len(v.ConsPubKey))
+	}
+	return nil
+}
+
+// SymbioticDataSource defines the Ethereum reads the Symbiotic sync path
+// depends on. The default implementation talks to beacon and execution RPC
+// endpoints, tests can plug in a deterministic in-memory backend instead.
+type SymbioticDataSource interface {
+	// GetBeaconBlockHash returns the execution block hash referenced by the
+	// beacon block at the given slot. It returns ErrSymbioticNotFound if the
+	// slot was omitted.
+	GetBeaconBlockHash(ctx context.Context, slot int64) (blockHash string, err error)
+	// GetFinalizedEpoch returns the epoch of the finalized checkpoint of the
+	// beacon chain head. The blocks of the slots up to the first one of that
+	// epoch are finalized.
+	GetFinalizedEpoch(ctx context.Context) (epoch int64, err error)
+	// GetBlockByHash returns the execution block with the given hash.
+	GetBlockByHash(ctx context.Context, blockHash string) (*ethtypes.Block, error)
+	// GetBlockByNumber returns the canonical execution block with the given number.
+	GetBlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error)
+	// GetValidatorSet returns the validator set read by the adapter from the
+	// middleware contract at the given execution block hash.
+	GetValidatorSet(ctx context.Context, middlewareAddress, blockHash string, adapter MiddlewareAdapter) ([]SymbioticValidator, error)
+	// GetVoterStake returns the stake voter backs operator with through vault,
+	// read from the middleware contract at the given execution block hash.
+	GetVoterStake(ctx context.Context, middlewareAddress, blockHash string, vault, operator, voter common.Address) (*big.Int, error)
+}
+
+// ValidatorSetDigest returns the digest of a middleware validator set, in the
+// order reported by the middleware. Each entry is hashed as its consensus
+// address slot, its 32 bytes big endian stake, then its key type, consensus
+// public key and operator, each prefixed with its 8 bytes big endian length.
+func ValidatorSetDigest(validators []SymbioticValidator) []byte {
+	h := sha256.New()
+	writeBytes := func(b []byte) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+	for _, v := range validators {
+		var stake [32]byte
+		if v.Stake != nil {
+			v.Stake.FillBytes(stake[:])
+		}
+		h.Write(v.ConsAddr[:])
+		h.Write(stake[:])
+		writeBytes([]byte(v.KeyType))
+		writeBytes(v.ConsPubKey)
+		writeBytes(v.Operator)
+	}
+	return h.Sum(nil)
+}
+
+// NewSymbioticValidatorStakes converts a middleware validator set to its
+// protobuf representation.
+func NewSymbioticValidatorStakes(validators []SymbioticValidator) []SymbioticValidatorStake {
+	stakes := make([]SymbioticValidatorStake, 0, len(validators))
+	for _, v := range validators {
+		stake := math.ZeroInt()
+		if v.Stake != nil {
+			stake = math.NewIntFromBigInt(v.Stake)
+		}
+		stakes = append(stakes, SymbioticValidatorStake{
+			ConsAddr:   append([]byte(nil), v.ConsAddr[:]...),
+			Stake:      stake,
+			KeyType:    v.KeyType,
+			ConsPubkey: v.ConsPubKey,
+			Operator:   v.Operator,
+		})
+	}
+	return stakes
+}
+
+// SymbioticValidators converts the validator set carried by the sync data back
+// to middleware validators. It returns false if a consensus address slot is
+// not 32 bytes long, a stake is negative or a consensus public key does not
+// match its type.
+func (d SymbioticSyncData) SymbioticValidators() ([]SymbioticValidator, bool) {
+	validators, ok := symbioticValidators(d.Validators)
+	if !ok {
+		return nil, false
+	}
+	for _, val := range validators {
+		if _, err := val.ConsAddress(); err != nil {
+			return nil, false
+		}
+	}
+	return validators, true
+}
+
+// SymbioticValidators converts the cached validator set back to middleware
+// validators. It returns false if a consensus address slot is not 32 bytes
+// long or a stake is negative, invalid consensus keys are reported when the
+// validator set is applied.
+func (c CachedBlockHash) SymbioticValidators() ([]SymbioticValidator, bool) {
+	return symbioticValidators(c.Validators)
+}
+
+func symbioticValidators(stakes []SymbioticValidatorStake) ([]SymbioticValidator, bool) {
+	validators := make([]SymbioticValidator, 0, len(stakes))
+	for _, v := range stakes {
+		if len(v.ConsAddr) != 32 || v.Stake.IsNil() || v.Stake.IsNegative() {
+			return nil, false
+		}
+		val := SymbioticValidator{
+			Stake:      v.Stake.BigInt(),
+			KeyType:    v.KeyType,
+			ConsPubKey: v.ConsPubkey,
+			Operator:   v.Operator,
+		}
+		copy(val.ConsAddr[:], v.ConsAddr)
+		validators = append(validators, val)
+	}
+	return validators, true
+}
+
+// MaxVoterStakesPerSync is the maximum number of voter stakes a vote
+// extension carries. The oldest pending requests are read first, the others
+// wait for the next syncs.
+const MaxVoterStakesPerSync = 32
+
+// VoterStakeRequestID returns the id of the request for the stake voter backs
+// operator with through vault at the given execution block hash, the
+// keccak256 hash of the block hash and the 20 bytes addresses.
+func VoterStakeRequestID(blockHash string, vault, operator, voter []byte) []byte {
+	return crypto.Keccak256([]byte(blockHash), vault, operator, voter)
+}
+
+// ID returns the id of the request.
+func (r SymbioticVoterStakeRequest) ID() []byte {
+	return VoterStakeRequestID(r.BlockHash, r.Vault, r.Operator, r.Voter)
+}
+
+// ValidateVoterStakes checks that voter stakes carry at most
+// MaxVoterStakesPerSync distinct 32 bytes request ids with non negative
+// stakes.
+func ValidateVoterStakes(voterStakes []SymbioticVoterStake) error {
+	if len(voterStakes) > MaxVoterStakesPerSync {
+		return fmt.Errorf("%d voter stakes, expected at most %d", len(voterStakes), MaxVoterStakesPerSync)
+	}
+
+	seen := make(map[string]struct{}, len(voterStakes))
+	for _, s := range voterStakes {
+		if len(s.RequestId) != common.HashLength {
+			return fmt.Errorf("malformed voter stake request id of %d bytes", len(s.RequestId))
+		}
+		if s.Stake.IsNil() || s.Stake.IsNegative() {
+			return fmt.Errorf("invalid voter stake %s", s.Stake)
+		}
+		if _, ok := seen[string(s.RequestId)]; ok {
+			return fmt.Errorf("duplicate voter stake request id %x", s.RequestId)
+		}
+		seen[string(s.RequestId)] = struct{}{}
+	}
+
+	return nil
 }
@@ -0,0 +1,4886 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/symStaking/v1beta1/symbiotic.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InjectedTxType tags the payload of an InjectedTx.
+type InjectedTxType int32
+
+const (
+	// INJECTED_TX_TYPE_UNSPECIFIED defines an invalid injected tx type.
+	InjectedTxTypeUnspecified InjectedTxType = 0
+	// INJECTED_TX_TYPE_SYMBIOTIC_SYNC tags a SymbioticSyncData payload.
+	InjectedTxTypeSymbioticSync InjectedTxType = 1
+)
+
+var InjectedTxType_name = map[int32]string{
+	0: "INJECTED_TX_TYPE_UNSPECIFIED",
+	1: "INJECTED_TX_TYPE_SYMBIOTIC_SYNC",
+}
+
+var InjectedTxType_value = map[string]int32{
+	"INJECTED_TX_TYPE_UNSPECIFIED":    0,
+	"INJECTED_TX_TYPE_SYMBIOTIC_SYNC": 1,
+}
+
+func (x InjectedTxType) String() string {
+	return proto.EnumName(InjectedTxType_name, int32(x))
+}
+
+func (InjectedTxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{0}
+}
+
+// OperatorSignatureType is the scheme of an operator binding signature.
+type OperatorSignatureType int32
+
+const (
+	// OPERATOR_SIGNATURE_TYPE_UNSPECIFIED defines an invalid signature type.
+	OperatorSignatureTypeUnspecified OperatorSignatureType = 0
+	// OPERATOR_SIGNATURE_TYPE_EIP191 is a personal_sign signature of the
+	// operator binding text.
+	OperatorSignatureTypeEIP191 OperatorSignatureType = 1
+	// OPERATOR_SIGNATURE_TYPE_EIP712 is a signature of the operator binding
+	// typed data.
+	OperatorSignatureTypeEIP712 OperatorSignatureType = 2
+)
+
+var OperatorSignatureType_name = map[int32]string{
+	0: "OPERATOR_SIGNATURE_TYPE_UNSPECIFIED",
+	1: "OPERATOR_SIGNATURE_TYPE_EIP191",
+	2: "OPERATOR_SIGNATURE_TYPE_EIP712",
+}
+
+var OperatorSignatureType_value = map[string]int32{
+	"OPERATOR_SIGNATURE_TYPE_UNSPECIFIED": 0,
+	"OPERATOR_SIGNATURE_TYPE_EIP191":      1,
+	"OPERATOR_SIGNATURE_TYPE_EIP712":      2,
+}
+
+func (x OperatorSignatureType) String() string {
+	return proto.EnumName(OperatorSignatureType_name, int32(x))
+}
+
+func (OperatorSignatureType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{1}
+}
+
+// SymbioticVoteExtension is the vote extension attested by validators on the
+// block preceding a Symbiotic sync height.
+type SymbioticVoteExtension struct {
+	// height is the sync height the attestation is made for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the finalized execution block hash observed by the
+	// validator, or "invalid" if none could be validated.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// validator_set_digest is the digest of the middleware validator set read at
+	// block_hash, empty if block_hash is "invalid".
+	ValidatorSetDigest []byte `protobuf:"bytes,3,opt,name=validator_set_digest,json=validatorSetDigest,proto3" json:"validator_set_digest,omitempty"`
+	// block_number is the number of the execution block, zero if block_hash is
+	// "invalid".
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix time of the execution block, zero if
+	// block_hash is "invalid".
+	BlockTimestamp uint64 `protobuf:"varint,5,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// voter_stakes are the stakes read for the oldest pending voter stake
+	// requests, whatever block_hash is.
+	VoterStakes []SymbioticVoterStake `protobuf:"bytes,6,rep,name=voter_stakes,json=voterStakes,proto3" json:"voter_stakes"`
+}
+
+func (m *SymbioticVoteExtension) Reset()         { *m = SymbioticVoteExtension{} }
+func (m *SymbioticVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*SymbioticVoteExtension) ProtoMessage()    {}
+func (*SymbioticVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{0}
+}
+func (m *SymbioticVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticVoteExtension.Merge(m, src)
+}
+func (m *SymbioticVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticVoteExtension proto.InternalMessageInfo
+
+func (m *SymbioticVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SymbioticVoteExtension) GetValidatorSetDigest() []byte {
+	if m != nil {
+		return m.ValidatorSetDigest
+	}
+	return nil
+}
+
+func (m *SymbioticVoteExtension) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *SymbioticVoteExtension) GetVoterStakes() []SymbioticVoterStake {
+	if m != nil {
+		return m.VoterStakes
+	}
+	return nil
+}
+
+// SymbioticVoterStake is the stake read for a SymbioticVoterStakeRequest.
+type SymbioticVoterStake struct {
+	// request_id is the id of the request, the keccak256 hash of its block hash,
+	// vault, operator and voter.
+	RequestId []byte `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// stake is the stake the voter backs the operator with through the vault.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+}
+
+func (m *SymbioticVoterStake) Reset()         { *m = SymbioticVoterStake{} }
+func (m *SymbioticVoterStake) String() string { return proto.CompactTextString(m) }
+func (*SymbioticVoterStake) ProtoMessage()    {}
+func (*SymbioticVoterStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{1}
+}
+func (m *SymbioticVoterStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticVoterStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticVoterStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticVoterStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticVoterStake.Merge(m, src)
+}
+func (m *SymbioticVoterStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticVoterStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticVoterStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticVoterStake proto.InternalMessageInfo
+
+func (m *SymbioticVoterStake) GetRequestId() []byte {
+	if m != nil {
+		return m.RequestId
+	}
+	return nil
+}
+
+// SymbioticVoterStakeRequest is the request of another module for the stake an
+// Ethereum voter backs an operator with through a vault at an execution block.
+// Validators read it in the vote extensions of the next Symbiotic sync, the
+// stake attested by more than 2/3 of the voting power is stored in the
+// request.
+type SymbioticVoterStakeRequest struct {
+	// block_hash is the execution block hash the stake is read at.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// vault is the address of the vault.
+	Vault []byte `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
+	// operator is the address of the operator.
+	Operator []byte `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// voter is the address of the voter.
+	Voter []byte `protobuf:"bytes,4,opt,name=voter,proto3" json:"voter,omitempty"`
+	// height is the height the request was made at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// resolved is set once the stake was agreed on.
+	Resolved bool `protobuf:"varint,6,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// stake is the agreed stake, zero until resolved.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+}
+
+func (m *SymbioticVoterStakeRequest) Reset()         { *m = SymbioticVoterStakeRequest{} }
+func (m *SymbioticVoterStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*SymbioticVoterStakeRequest) ProtoMessage()    {}
+func (*SymbioticVoterStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{2}
+}
+func (m *SymbioticVoterStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticVoterStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticVoterStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticVoterStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticVoterStakeRequest.Merge(m, src)
+}
+func (m *SymbioticVoterStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticVoterStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticVoterStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticVoterStakeRequest proto.InternalMessageInfo
+
+func (m *SymbioticVoterStakeRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SymbioticVoterStakeRequest) GetVault() []byte {
+	if m != nil {
+		return m.Vault
+	}
+	return nil
+}
+
+func (m *SymbioticVoterStakeRequest) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *SymbioticVoterStakeRequest) GetVoter() []byte {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+func (m *SymbioticVoterStakeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SymbioticVoterStakeRequest) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
+// SymbioticValidatorStake is a single entry of the middleware validator set.
+type SymbioticValidatorStake struct {
+	// cons_addr is the consensus address slot reported by the middleware.
+	ConsAddr []byte `protobuf:"bytes,1,opt,name=cons_addr,json=consAddr,proto3" json:"cons_addr,omitempty"`
+	// stake is the stake of the operator reported by the middleware.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	// key_type is the type of cons_pubkey, empty if the middleware reports
+	// consensus addresses.
+	KeyType string `protobuf:"bytes,3,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// cons_pubkey is the consensus public key reported by the middleware.
+	ConsPubkey []byte `protobuf:"bytes,4,opt,name=cons_pubkey,json=consPubkey,proto3" json:"cons_pubkey,omitempty"`
+	// operator is the operator address reported by the middleware, if any.
+	Operator []byte `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *SymbioticValidatorStake) Reset()         { *m = SymbioticValidatorStake{} }
+func (m *SymbioticValidatorStake) String() string { return proto.CompactTextString(m) }
+func (*SymbioticValidatorStake) ProtoMessage()    {}
+func (*SymbioticValidatorStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{3}
+}
+func (m *SymbioticValidatorStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticValidatorStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticValidatorStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticValidatorStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticValidatorStake.Merge(m, src)
+}
+func (m *SymbioticValidatorStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticValidatorStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticValidatorStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticValidatorStake proto.InternalMessageInfo
+
+func (m *SymbioticValidatorStake) GetConsAddr() []byte {
+	if m != nil {
+		return m.ConsAddr
+	}
+	return nil
+}
+
+func (m *SymbioticValidatorStake) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+func (m *SymbioticValidatorStake) GetConsPubkey() []byte {
+	if m != nil {
+		return m.ConsPubkey
+	}
+	return nil
+}
+
+func (m *SymbioticValidatorStake) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+// CachedBlockHash is the execution block hash the PreBlocker of a Symbiotic
+// sync height stores for the EndBlock of the same height to apply.
+type CachedBlockHash struct {
+	// block_hash is the execution block hash, "invalid" if the sync is skipped.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// height is the sync height the block hash was stored at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// attested is set when block_hash and validators were agreed on through
+	// vote extensions, in which case validators is applied as is.
+	Attested bool `protobuf:"varint,3,opt,name=attested,proto3" json:"attested,omitempty"`
+	// validators is the attested middleware validator set.
+	Validators []SymbioticValidatorStake `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+	// block_number is the attested number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the attested timestamp of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,6,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// voter_stakes are the voter stakes attested by more than 2/3 of the voting
+	// power, applied even if the sync is skipped.
+	VoterStakes []SymbioticVoterStake `protobuf:"bytes,7,rep,name=voter_stakes,json=voterStakes,proto3" json:"voter_stakes"`
+}
+
+func (m *CachedBlockHash) Reset()         { *m = CachedBlockHash{} }
+func (m *CachedBlockHash) String() string { return proto.CompactTextString(m) }
+func (*CachedBlockHash) ProtoMessage()    {}
+func (*CachedBlockHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{4}
+}
+func (m *CachedBlockHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CachedBlockHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CachedBlockHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CachedBlockHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachedBlockHash.Merge(m, src)
+}
+func (m *CachedBlockHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *CachedBlockHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachedBlockHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CachedBlockHash proto.InternalMessageInfo
+
+func (m *CachedBlockHash) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *CachedBlockHash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CachedBlockHash) GetAttested() bool {
+	if m != nil {
+		return m.Attested
+	}
+	return false
+}
+
+func (m *CachedBlockHash) GetValidators() []SymbioticValidatorStake {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *CachedBlockHash) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *CachedBlockHash) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *CachedBlockHash) GetVoterStakes() []SymbioticVoterStake {
+	if m != nil {
+		return m.VoterStakes
+	}
+	return nil
+}
+
+// InjectedTx is the envelope of the system data injected by the proposer as
+// the first tx of a block. It is encoded after the sdk InjectedTxPrefix so that
+// it is never mistaken for a regular tx.
+type InjectedTx struct {
+	// version is the envelope version.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// type tags the payload.
+	Type InjectedTxType `protobuf:"varint,2,opt,name=type,proto3,enum=cosmos.symStaking.v1beta1.InjectedTxType" json:"type,omitempty"`
+	// height is the height of the block the tx is injected in.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block_hash is the agreed finalized execution block hash, or "invalid" if
+	// no agreement was reached.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// payload is the encoded data of the given type.
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *InjectedTx) Reset()         { *m = InjectedTx{} }
+func (m *InjectedTx) String() string { return proto.CompactTextString(m) }
+func (*InjectedTx) ProtoMessage()    {}
+func (*InjectedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{5}
+}
+func (m *InjectedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectedTx.Merge(m, src)
+}
+func (m *InjectedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectedTx proto.InternalMessageInfo
+
+func (m *InjectedTx) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *InjectedTx) GetType() InjectedTxType {
+	if m != nil {
+		return m.Type
+	}
+	return InjectedTxTypeUnspecified
+}
+
+func (m *InjectedTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InjectedTx) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *InjectedTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// SymbioticSyncData is the payload injected by the proposer at a Symbiotic
+// sync height. It carries the vote extensions proving that more than 2/3 of
+// the voting power attested the envelope block hash and the digest of
+// validators.
+type SymbioticSyncData struct {
+	// extended_commit_info is the proposer local last commit.
+	ExtendedCommitInfo v1.ExtendedCommitInfo `protobuf:"bytes,1,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info"`
+	// validators is the middleware validator set read at the envelope block hash.
+	Validators []SymbioticValidatorStake `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// block_number is the attested number of the envelope block.
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the attested unix time of the envelope block.
+	BlockTimestamp uint64 `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// voter_stakes are the voter stakes attested by more than 2/3 of the voting
+	// power in extended_commit_info, sorted by request id. They are carried even
+	// if the envelope block hash is "invalid".
+	VoterStakes []SymbioticVoterStake `protobuf:"bytes,5,rep,name=voter_stakes,json=voterStakes,proto3" json:"voter_stakes"`
+}
+
+func (m *SymbioticSyncData) Reset()         { *m = SymbioticSyncData{} }
+func (m *SymbioticSyncData) String() string { return proto.CompactTextString(m) }
+func (*SymbioticSyncData) ProtoMessage()    {}
+func (*SymbioticSyncData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{6}
+}
+func (m *SymbioticSyncData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticSyncData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticSyncData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticSyncData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticSyncData.Merge(m, src)
+}
+func (m *SymbioticSyncData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticSyncData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticSyncData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticSyncData proto.InternalMessageInfo
+
+func (m *SymbioticSyncData) GetExtendedCommitInfo() v1.ExtendedCommitInfo {
+	if m != nil {
+		return m.ExtendedCommitInfo
+	}
+	return v1.ExtendedCommitInfo{}
+}
+
+func (m *SymbioticSyncData) GetValidators() []SymbioticValidatorStake {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *SymbioticSyncData) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *SymbioticSyncData) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *SymbioticSyncData) GetVoterStakes() []SymbioticVoterStake {
+	if m != nil {
+		return m.VoterStakes
+	}
+	return nil
+}
+
+// SymbioticSyncRecord is the record of a Symbiotic sync height.
+type SymbioticSyncRecord struct {
+	// height is the sync height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the sync block.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// block_hash is the execution block hash the validator set was read at, or
+	// "invalid" if the sync was skipped.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix time of the execution block.
+	BlockTimestamp uint64 `protobuf:"varint,5,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// epoch is the beacon chain epoch of the execution block.
+	Epoch uint64 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// stakes are the entries of the middleware validator set, in the order
+	// reported by the middleware.
+	Stakes []SymbioticSyncStake `protobuf:"bytes,7,rep,name=stakes,proto3" json:"stakes"`
+}
+
+func (m *SymbioticSyncRecord) Reset()         { *m = SymbioticSyncRecord{} }
+func (m *SymbioticSyncRecord) String() string { return proto.CompactTextString(m) }
+func (*SymbioticSyncRecord) ProtoMessage()    {}
+func (*SymbioticSyncRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{7}
+}
+func (m *SymbioticSyncRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticSyncRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticSyncRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticSyncRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticSyncRecord.Merge(m, src)
+}
+func (m *SymbioticSyncRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticSyncRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticSyncRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticSyncRecord proto.InternalMessageInfo
+
+func (m *SymbioticSyncRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SymbioticSyncRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SymbioticSyncRecord) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SymbioticSyncRecord) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *SymbioticSyncRecord) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *SymbioticSyncRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SymbioticSyncRecord) GetStakes() []SymbioticSyncStake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
+}
+
+// SymbioticSyncCheckpoint tracks the last applied Symbiotic sync to measure
+// how stale the stake of the validator set is.
+type SymbioticSyncCheckpoint struct {
+	// height is the height of the last applied sync, zero if none was applied
+	// yet.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the last applied sync block, or the time tracking
+	// started at if none was applied yet.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// block_hash is the execution block hash of the last applied sync.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the number of the execution block.
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_timestamp is the unix time of the execution block, the stake is as
+	// old as it.
+	BlockTimestamp uint64 `protobuf:"varint,5,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	// skipped_syncs is the number of syncs skipped since the last applied one.
+	SkippedSyncs uint64 `protobuf:"varint,6,opt,name=skipped_syncs,json=skippedSyncs,proto3" json:"skipped_syncs,omitempty"`
+	// safety_mode is the safety mode in force, unspecified if the stake is not
+	// stale.
+	SafetyMode StaleStakeAction `protobuf:"varint,7,opt,name=safety_mode,json=safetyMode,proto3,enum=cosmos.symStaking.v1beta1.StaleStakeAction" json:"safety_mode,omitempty"`
+}
+
+func (m *SymbioticSyncCheckpoint) Reset()         { *m = SymbioticSyncCheckpoint{} }
+func (m *SymbioticSyncCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SymbioticSyncCheckpoint) ProtoMessage()    {}
+func (*SymbioticSyncCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{8}
+}
+func (m *SymbioticSyncCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticSyncCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticSyncCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticSyncCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticSyncCheckpoint.Merge(m, src)
+}
+func (m *SymbioticSyncCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticSyncCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticSyncCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticSyncCheckpoint proto.InternalMessageInfo
+
+func (m *SymbioticSyncCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SymbioticSyncCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SymbioticSyncCheckpoint) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *SymbioticSyncCheckpoint) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *SymbioticSyncCheckpoint) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *SymbioticSyncCheckpoint) GetSkippedSyncs() uint64 {
+	if m != nil {
+		return m.SkippedSyncs
+	}
+	return 0
+}
+
+func (m *SymbioticSyncCheckpoint) GetSafetyMode() StaleStakeAction {
+	if m != nil {
+		return m.SafetyMode
+	}
+	return StaleStakeActionUnspecified
+}
+
+// SymbioticSyncStake is a middleware validator set entry of a sync.
+type SymbioticSyncStake struct {
+	// validator_address is the operator address of the validator the stake was
+	// applied to, empty if no validator matched the entry.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// cons_address is the consensus address of the entry, empty if its
+	// consensus key is invalid.
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// operator is the operator address reported by the middleware, if any.
+	Operator []byte `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// stake is the stake reported by the middleware.
+	Stake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+}
+
+func (m *SymbioticSyncStake) Reset()         { *m = SymbioticSyncStake{} }
+func (m *SymbioticSyncStake) String() string { return proto.CompactTextString(m) }
+func (*SymbioticSyncStake) ProtoMessage()    {}
+func (*SymbioticSyncStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{9}
+}
+func (m *SymbioticSyncStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticSyncStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticSyncStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticSyncStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticSyncStake.Merge(m, src)
+}
+func (m *SymbioticSyncStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticSyncStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticSyncStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticSyncStake proto.InternalMessageInfo
+
+func (m *SymbioticSyncStake) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SymbioticSyncStake) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *SymbioticSyncStake) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+// SymbioticPendingPowerChange is the stake of a validator read from the
+// middleware and not fully applied yet because of the max_power_change_rate
+// param.
+type SymbioticPendingPowerChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// target_tokens are the tokens the validator converges to.
+	TargetTokens cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=target_tokens,json=targetTokens,proto3,customtype=cosmossdk.io/math.Int" json:"target_tokens"`
+	// height is the height of the sync the target was read at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SymbioticPendingPowerChange) Reset()         { *m = SymbioticPendingPowerChange{} }
+func (m *SymbioticPendingPowerChange) String() string { return proto.CompactTextString(m) }
+func (*SymbioticPendingPowerChange) ProtoMessage()    {}
+func (*SymbioticPendingPowerChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{10}
+}
+func (m *SymbioticPendingPowerChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SymbioticPendingPowerChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SymbioticPendingPowerChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SymbioticPendingPowerChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbioticPendingPowerChange.Merge(m, src)
+}
+func (m *SymbioticPendingPowerChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SymbioticPendingPowerChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbioticPendingPowerChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbioticPendingPowerChange proto.InternalMessageInfo
+
+func (m *SymbioticPendingPowerChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SymbioticPendingPowerChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// OperatorBindingProof is the signature by which a Symbiotic operator binds a
+// validator to its Ethereum address.
+type OperatorBindingProof struct {
+	// operator is the hex encoded Ethereum address of the operator, as reported
+	// by the middleware.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// signature_type is the scheme of signature.
+	SignatureType OperatorSignatureType `protobuf:"varint,2,opt,name=signature_type,json=signatureType,proto3,enum=cosmos.symStaking.v1beta1.OperatorSignatureType" json:"signature_type,omitempty"`
+	// signature is the 65 bytes signature of the binding by the operator.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *OperatorBindingProof) Reset()         { *m = OperatorBindingProof{} }
+func (m *OperatorBindingProof) String() string { return proto.CompactTextString(m) }
+func (*OperatorBindingProof) ProtoMessage()    {}
+func (*OperatorBindingProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{11}
+}
+func (m *OperatorBindingProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorBindingProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorBindingProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorBindingProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorBindingProof.Merge(m, src)
+}
+func (m *OperatorBindingProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorBindingProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorBindingProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorBindingProof proto.InternalMessageInfo
+
+func (m *OperatorBindingProof) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *OperatorBindingProof) GetSignatureType() OperatorSignatureType {
+	if m != nil {
+		return m.SignatureType
+	}
+	return OperatorSignatureTypeUnspecified
+}
+
+func (m *OperatorBindingProof) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// OperatorBinding is the Symbiotic operator a validator is bound to.
+type OperatorBinding struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator is the hex encoded Ethereum address of the operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// nonce is the nonce the next binding of the validator must be signed with.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *OperatorBinding) Reset()         { *m = OperatorBinding{} }
+func (m *OperatorBinding) String() string { return proto.CompactTextString(m) }
+func (*OperatorBinding) ProtoMessage()    {}
+func (*OperatorBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2209fd967c7b24b2, []int{12}
+}
+func (m *OperatorBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorBinding.Merge(m, src)
+}
+func (m *OperatorBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorBinding proto.InternalMessageInfo
+
+func (m *OperatorBinding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OperatorBinding) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *OperatorBinding) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.symStaking.v1beta1.InjectedTxType", InjectedTxType_name, InjectedTxType_value)
+	proto.RegisterEnum("cosmos.symStaking.v1beta1.OperatorSignatureType", OperatorSignatureType_name, OperatorSignatureType_value)
+	proto.RegisterType((*SymbioticVoteExtension)(nil), "cosmos.symStaking.v1beta1.SymbioticVoteExtension")
+	proto.RegisterType((*SymbioticVoterStake)(nil), "cosmos.symStaking.v1beta1.SymbioticVoterStake")
+	proto.RegisterType((*SymbioticVoterStakeRequest)(nil), "cosmos.symStaking.v1beta1.SymbioticVoterStakeRequest")
+	proto.RegisterType((*SymbioticValidatorStake)(nil), "cosmos.symStaking.v1beta1.SymbioticValidatorStake")
+	proto.RegisterType((*CachedBlockHash)(nil), "cosmos.symStaking.v1beta1.CachedBlockHash")
+	proto.RegisterType((*InjectedTx)(nil), "cosmos.symStaking.v1beta1.InjectedTx")
+	proto.RegisterType((*SymbioticSyncData)(nil), "cosmos.symStaking.v1beta1.SymbioticSyncData")
+	proto.RegisterType((*SymbioticSyncRecord)(nil), "cosmos.symStaking.v1beta1.SymbioticSyncRecord")
+	proto.RegisterType((*SymbioticSyncCheckpoint)(nil), "cosmos.symStaking.v1beta1.SymbioticSyncCheckpoint")
+	proto.RegisterType((*SymbioticSyncStake)(nil), "cosmos.symStaking.v1beta1.SymbioticSyncStake")
+	proto.RegisterType((*SymbioticPendingPowerChange)(nil), "cosmos.symStaking.v1beta1.SymbioticPendingPowerChange")
+	proto.RegisterType((*OperatorBindingProof)(nil), "cosmos.symStaking.v1beta1.OperatorBindingProof")
+	proto.RegisterType((*OperatorBinding)(nil), "cosmos.symStaking.v1beta1.OperatorBinding")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/symStaking/v1beta1/symbiotic.proto", fileDescriptor_2209fd967c7b24b2)
+}
+
+var fileDescriptor_2209fd967c7b24b2 = []byte{
+	// 1389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x6f, 0x1a, 0x47,
+	0x14, 0xf6, 0x62, 0xb0, 0xcd, 0x80, 0x1d, 0x67, 0x4a, 0x52, 0x42, 0x12, 0x20, 0x24, 0x52, 0x9c,
+	0x44, 0x59, 0x02, 0x3d, 0x44, 0x39, 0x54, 0x95, 0xc1, 0xb4, 0xa5, 0x6a, 0x6c, 0xb4, 0xe0, 0x26,
+	0xa9, 0x52, 0xad, 0x96, 0xdd, 0x01, 0xb6, 0xc0, 0x0c, 0xdd, 0x19, 0x48, 0xf8, 0x07, 0x6d, 0x4e,
+	0x91, 0x7a, 0xf6, 0xa9, 0x52, 0xd5, 0xde, 0x7a, 0x88, 0x7a, 0xed, 0x35, 0xa7, 0x2a, 0xcd, 0xa9,
+	0xaa, 0xaa, 0xb4, 0x4a, 0x0e, 0x3d, 0x55, 0xea, 0xa9, 0x87, 0x9e, 0xaa, 0x99, 0x59, 0x16, 0x16,
+	0x63, 0xe2, 0x5a, 0x3e, 0xf5, 0x62, 0xf9, 0xbd, 0x79, 0xef, 0xcd, 0x7b, 0xdf, 0x7b, 0x6f, 0xbe,
+	0x05, 0x5c, 0x31, 0x09, 0xed, 0x12, 0x9a, 0xa5, 0xc3, 0x6e, 0x95, 0x19, 0x6d, 0x1b, 0x37, 0xb3,
+	0x83, 0x5c, 0x1d, 0x31, 0x23, 0xc7, 0x55, 0x75, 0x9b, 0x30, 0xdb, 0x54, 0x7b, 0x0e, 0x61, 0x04,
+	0x9e, 0x91, 0xa6, 0xea, 0xd8, 0x54, 0x75, 0x4d, 0x13, 0xb1, 0x26, 0x69, 0x12, 0x61, 0x95, 0xe5,
+	0xff, 0x49, 0x87, 0x84, 0xeb, 0xa0, 0xcb, 0x03, 0xd7, 0x5b, 0x1e, 0x9d, 0x33, 0x49, 0x17, 0xb1,
+	0x7a, 0x83, 0x65, 0x8d, 0xba, 0x69, 0x67, 0x07, 0xb9, 0x2c, 0x1b, 0xf6, 0xd0, 0xe8, 0x34, 0xd5,
+	0x24, 0xa4, 0xd9, 0x41, 0x59, 0x21, 0xd5, 0xfb, 0x8d, 0x2c, 0xb3, 0xbb, 0x88, 0x32, 0xa3, 0xdb,
+	0x73, 0x0d, 0x4e, 0x1a, 0x5d, 0x1b, 0x93, 0xac, 0xf8, 0xeb, 0xaa, 0x2e, 0xcf, 0x29, 0xc4, 0xcd,
+	0x56, 0x18, 0x66, 0xbe, 0x0e, 0x80, 0xd3, 0xd5, 0x51, 0x69, 0x1f, 0x11, 0x86, 0x4a, 0x0f, 0x19,
+	0xc2, 0xd4, 0x26, 0x18, 0x9e, 0x06, 0x4b, 0x2d, 0x64, 0x37, 0x5b, 0x2c, 0xae, 0xa4, 0x95, 0x8d,
+	0x45, 0xcd, 0x95, 0xe0, 0x79, 0x00, 0xea, 0x1d, 0x62, 0xb6, 0xf5, 0x96, 0x41, 0x5b, 0xf1, 0x40,
+	0x5a, 0xd9, 0x08, 0x6b, 0x61, 0xa1, 0x79, 0xdf, 0xa0, 0x2d, 0x78, 0x03, 0xc4, 0x06, 0x46, 0xc7,
+	0xb6, 0x0c, 0x46, 0x1c, 0x9d, 0x22, 0xa6, 0x5b, 0x76, 0x13, 0x51, 0x16, 0x5f, 0x4c, 0x2b, 0x1b,
+	0x51, 0x0d, 0x7a, 0x67, 0x55, 0xc4, 0xb6, 0xc4, 0x09, 0xbc, 0x00, 0xa2, 0x32, 0x20, 0xee, 0x77,
+	0xeb, 0xc8, 0x89, 0x07, 0xd3, 0xca, 0x46, 0x50, 0x8b, 0x08, 0xdd, 0xb6, 0x50, 0xc1, 0xcb, 0xe0,
+	0x84, 0x34, 0xf1, 0x6a, 0x8f, 0x87, 0x84, 0xd5, 0x9a, 0x50, 0xd7, 0x46, 0x5a, 0x78, 0x07, 0x44,
+	0x07, 0x84, 0x21, 0x47, 0xe7, 0x65, 0x22, 0x1a, 0x5f, 0x4a, 0x2f, 0x6e, 0x44, 0xf2, 0xaa, 0x7a,
+	0x60, 0xb7, 0x54, 0x5f, 0xf5, 0x0e, 0x3f, 0x45, 0x85, 0xe0, 0xd3, 0x17, 0xa9, 0x05, 0x2d, 0x32,
+	0xf0, 0x34, 0x34, 0xf3, 0x00, 0xbc, 0x31, 0xc3, 0x92, 0x83, 0xe1, 0xa0, 0xcf, 0xfa, 0x88, 0x32,
+	0xdd, 0xb6, 0x04, 0x50, 0x51, 0x2d, 0xec, 0x6a, 0xca, 0x16, 0xdc, 0x04, 0x21, 0x91, 0x88, 0x84,
+	0xa9, 0x70, 0x8d, 0xc7, 0xfd, 0xe5, 0x45, 0xea, 0x94, 0x4c, 0x87, 0x5a, 0x6d, 0xd5, 0x26, 0xd9,
+	0xae, 0xc1, 0x5a, 0x6a, 0x19, 0xb3, 0xe7, 0x4f, 0xae, 0x03, 0x37, 0xcf, 0x32, 0x66, 0x9a, 0xf4,
+	0xcc, 0xfc, 0xa3, 0x80, 0xc4, 0x8c, 0x9b, 0x35, 0x79, 0xc7, 0x54, 0x37, 0x94, 0xe9, 0x6e, 0xc4,
+	0x40, 0x68, 0x60, 0xf4, 0x3b, 0x4c, 0x24, 0x10, 0xd5, 0xa4, 0x00, 0x13, 0x60, 0x85, 0xf4, 0x90,
+	0xc3, 0xdb, 0xe0, 0xf6, 0xc5, 0x93, 0x85, 0x07, 0xbf, 0x45, 0xb4, 0x81, 0x7b, 0x70, 0x61, 0x62,
+	0x18, 0x42, 0xbe, 0x61, 0x48, 0x80, 0x15, 0x07, 0x51, 0xd2, 0x19, 0x20, 0x2b, 0xbe, 0x94, 0x56,
+	0x36, 0x56, 0x34, 0x4f, 0x1e, 0x17, 0xbf, 0x7c, 0xe4, 0xe2, 0x7f, 0x52, 0xc0, 0x9b, 0xe3, 0xe2,
+	0xbd, 0xd1, 0x11, 0xd0, 0x9f, 0x05, 0x61, 0x93, 0x60, 0xaa, 0x1b, 0x96, 0xe5, 0xb8, 0xc8, 0xaf,
+	0x70, 0xc5, 0xa6, 0x65, 0x39, 0xc7, 0x00, 0x3c, 0x3c, 0x03, 0x56, 0xda, 0x68, 0xa8, 0xf3, 0x55,
+	0x14, 0x20, 0x85, 0xb5, 0xe5, 0x36, 0x1a, 0xd6, 0x86, 0x3d, 0x04, 0x53, 0x20, 0x22, 0xae, 0xee,
+	0xf5, 0xeb, 0x6d, 0x34, 0x74, 0x91, 0x02, 0x5c, 0x55, 0x11, 0x1a, 0x1f, 0xc0, 0x21, 0x3f, 0xc0,
+	0x99, 0x3f, 0x03, 0xe0, 0x44, 0xd1, 0x30, 0x5b, 0xc8, 0x2a, 0x78, 0x6d, 0x7a, 0x4d, 0x17, 0xc7,
+	0xe8, 0x07, 0xa6, 0xd1, 0x37, 0x18, 0x43, 0x94, 0x21, 0x4b, 0xa4, 0xb8, 0xa2, 0x79, 0x32, 0xfc,
+	0x04, 0x00, 0x6f, 0xd7, 0x68, 0x3c, 0x28, 0xf6, 0x20, 0x7f, 0xa8, 0x3d, 0xf0, 0xc1, 0x5c, 0x08,
+	0x73, 0xe8, 0xbe, 0xf9, 0xe3, 0xbb, 0xab, 0x8a, 0x36, 0x11, 0x70, 0xdf, 0xd2, 0x86, 0x0e, 0xb5,
+	0xb4, 0x4b, 0x33, 0x97, 0xf6, 0xfe, 0xd4, 0xd2, 0x2e, 0x1f, 0x69, 0x69, 0x27, 0x12, 0xf5, 0x6d,
+	0xee, 0xf7, 0x0a, 0x00, 0x65, 0xfc, 0x29, 0x32, 0x19, 0xb2, 0x6a, 0x0f, 0x61, 0x1c, 0x2c, 0x0f,
+	0x90, 0xc3, 0x5f, 0x38, 0x81, 0xf3, 0xaa, 0x36, 0x12, 0xe1, 0xdb, 0x20, 0x28, 0x9a, 0xcd, 0x31,
+	0x5e, 0xcb, 0x5f, 0x99, 0x73, 0xfd, 0x38, 0x1c, 0x1f, 0x07, 0x4d, 0xb8, 0x4d, 0x34, 0x69, 0x71,
+	0xce, 0x7b, 0x19, 0x9c, 0xee, 0x6d, 0x1c, 0x2c, 0xf7, 0x8c, 0x61, 0x87, 0x18, 0x96, 0x3b, 0x29,
+	0x23, 0x31, 0xf3, 0x57, 0x00, 0x9c, 0xf4, 0x0a, 0xad, 0x0e, 0xb1, 0xb9, 0x65, 0x30, 0x03, 0xde,
+	0x07, 0x31, 0xc4, 0xdf, 0x68, 0x0b, 0x59, 0xba, 0x49, 0xba, 0x5d, 0x9b, 0xe9, 0x36, 0x6e, 0x10,
+	0x51, 0x4c, 0x24, 0x7f, 0x49, 0x1d, 0x71, 0x89, 0xca, 0xb9, 0x44, 0x1d, 0xe4, 0xd4, 0x92, 0x6b,
+	0x5d, 0x14, 0xc6, 0x65, 0xdc, 0x20, 0xee, 0xfb, 0x06, 0xd1, 0xbe, 0x13, 0x78, 0xd7, 0x37, 0x35,
+	0x81, 0x23, 0x4f, 0x8d, 0xbc, 0x61, 0xde, 0xc0, 0x2c, 0x1e, 0x6a, 0x60, 0x82, 0x87, 0x7a, 0xe5,
+	0x43, 0xc7, 0xf5, 0xca, 0xff, 0x10, 0x98, 0x78, 0xe6, 0x39, 0xe4, 0x1a, 0x32, 0x89, 0x63, 0x1d,
+	0xc8, 0x85, 0x7c, 0x64, 0xec, 0xae, 0x1c, 0x99, 0x48, 0x3e, 0xa1, 0x4a, 0xaa, 0x56, 0x47, 0x54,
+	0xad, 0x7a, 0x29, 0x17, 0x56, 0xf9, 0x65, 0x8f, 0x7f, 0x4b, 0x29, 0x72, 0x42, 0x85, 0xdb, 0xd4,
+	0x68, 0x2c, 0x4e, 0x8f, 0xc6, 0x71, 0x12, 0x63, 0x0c, 0x84, 0x50, 0x8f, 0x98, 0x2d, 0x77, 0x05,
+	0xa5, 0x00, 0x2b, 0x60, 0xc9, 0xb7, 0x73, 0xd7, 0x0f, 0x03, 0x21, 0xc7, 0x65, 0xdf, 0xca, 0xb9,
+	0x71, 0x32, 0x3f, 0x06, 0x26, 0x5e, 0x6c, 0x6e, 0x59, 0x6c, 0x21, 0xb3, 0xdd, 0x23, 0x36, 0x66,
+	0xff, 0x03, 0x14, 0x2f, 0x82, 0x55, 0xda, 0xb6, 0x7b, 0x3d, 0x64, 0xe9, 0x74, 0x88, 0x4d, 0xea,
+	0xa2, 0x19, 0x75, 0x95, 0xbc, 0x5e, 0x0a, 0x3f, 0x04, 0x11, 0x6a, 0x34, 0x10, 0x1b, 0xea, 0x5d,
+	0x62, 0x49, 0xf6, 0x5b, 0xcb, 0x5f, 0x9b, 0x87, 0x2c, 0x33, 0x3a, 0x48, 0x20, 0xba, 0x69, 0x32,
+	0x9b, 0x60, 0x0d, 0x48, 0xff, 0xdb, 0xc4, 0x42, 0x99, 0x2f, 0x02, 0x00, 0xee, 0x87, 0x1e, 0x6e,
+	0x83, 0x93, 0xe3, 0xcf, 0x2c, 0x4e, 0x81, 0x88, 0x52, 0x49, 0x1c, 0x85, 0x0b, 0xcf, 0x9f, 0x5c,
+	0x3f, 0xef, 0xde, 0xe6, 0xad, 0xe5, 0xa6, 0x34, 0xa9, 0x32, 0xc7, 0xc6, 0x4d, 0x6d, 0x7d, 0x30,
+	0xa5, 0x87, 0x5b, 0x20, 0xea, 0xb1, 0x29, 0x0f, 0x15, 0xd8, 0x17, 0xaa, 0x48, 0x30, 0x45, 0x98,
+	0xf6, 0xa9, 0x3f, 0x54, 0x64, 0xc4, 0xb9, 0x3c, 0xca, 0xbc, 0x0f, 0x0b, 0x8f, 0x92, 0x83, 0x47,
+	0xfe, 0x1c, 0xf8, 0x55, 0x01, 0x67, 0x3d, 0x2c, 0x2a, 0x08, 0x5b, 0x36, 0x6e, 0x56, 0xc8, 0x03,
+	0xe4, 0x14, 0x5b, 0x06, 0x6e, 0x1e, 0x3f, 0x28, 0xbb, 0x60, 0x95, 0x19, 0x4e, 0x13, 0x31, 0x9d,
+	0x91, 0x36, 0xc2, 0x23, 0x54, 0x6e, 0xfc, 0x87, 0xd4, 0xe5, 0xa0, 0x46, 0x65, 0x98, 0x9a, 0x88,
+	0x72, 0x10, 0x53, 0x64, 0xbe, 0x55, 0x40, 0x6c, 0xc7, 0x85, 0xab, 0x60, 0xcb, 0xea, 0x1c, 0x42,
+	0x1a, 0x3e, 0x58, 0xe5, 0xc7, 0xc1, 0x18, 0xd6, 0x3b, 0x60, 0x8d, 0xda, 0x4d, 0x6c, 0xb0, 0xbe,
+	0x83, 0xf4, 0x09, 0xfe, 0xba, 0x31, 0x67, 0xe0, 0x46, 0x97, 0x54, 0x47, 0x8e, 0x82, 0xc6, 0x56,
+	0xe9, 0xa4, 0x08, 0xcf, 0x81, 0xb0, 0xa7, 0x70, 0x9b, 0x39, 0x56, 0x64, 0xbe, 0x54, 0xc0, 0x89,
+	0xa9, 0x5c, 0x8f, 0x1d, 0xfe, 0xc9, 0xb2, 0x03, 0x53, 0x65, 0xc7, 0x40, 0x08, 0x13, 0x6c, 0x22,
+	0x97, 0x47, 0xa4, 0x70, 0x75, 0x4f, 0x01, 0x6b, 0x7e, 0x72, 0x86, 0xef, 0x80, 0x73, 0xe5, 0xed,
+	0x0f, 0x4a, 0xc5, 0x5a, 0x69, 0x4b, 0xaf, 0xdd, 0xd5, 0x6b, 0xf7, 0x2a, 0x25, 0x7d, 0x77, 0xbb,
+	0x5a, 0x29, 0x15, 0xcb, 0xef, 0x96, 0x4b, 0x5b, 0xeb, 0x0b, 0x89, 0xf3, 0x8f, 0xf6, 0xd2, 0x67,
+	0xfc, 0x5e, 0xbb, 0x98, 0xf6, 0x90, 0x69, 0x37, 0x6c, 0x64, 0xc1, 0x2d, 0x90, 0xda, 0x17, 0xa0,
+	0x7a, 0xef, 0x76, 0xa1, 0xbc, 0x53, 0x2b, 0x17, 0xf5, 0xea, 0xbd, 0xed, 0xe2, 0xba, 0x92, 0x48,
+	0x3d, 0xda, 0x4b, 0x9f, 0xf5, 0xc7, 0xf0, 0x2d, 0x6d, 0x22, 0xf8, 0xf9, 0x57, 0xc9, 0x85, 0xab,
+	0x7f, 0x2b, 0xe0, 0xd4, 0x4c, 0xf0, 0xe1, 0x6d, 0x70, 0x71, 0xa7, 0x52, 0xd2, 0x36, 0x6b, 0x3b,
+	0x9a, 0x5e, 0x2d, 0xbf, 0xb7, 0xbd, 0x59, 0xdb, 0xd5, 0x4a, 0xb3, 0xb2, 0xbd, 0xf4, 0x68, 0x2f,
+	0x9d, 0x9e, 0x19, 0x63, 0x32, 0xe9, 0x22, 0x48, 0x1e, 0x14, 0xae, 0x54, 0xae, 0xe4, 0x6e, 0xe5,
+	0x46, 0x39, 0xcf, 0x8c, 0x24, 0x4d, 0x5e, 0x13, 0xe4, 0x66, 0x2e, 0xbf, 0x1e, 0x98, 0x1f, 0xe4,
+	0x66, 0x2e, 0x2f, 0x0b, 0x2f, 0xdc, 0x7a, 0xfa, 0x32, 0xa9, 0x3c, 0x7b, 0x99, 0x54, 0x7e, 0x7f,
+	0x99, 0x54, 0x1e, 0xbf, 0x4a, 0x2e, 0x3c, 0x7b, 0x95, 0x5c, 0xf8, 0xf9, 0x55, 0x72, 0xe1, 0xe3,
+	0x94, 0x6f, 0x89, 0x1e, 0x4e, 0xfe, 0x64, 0x15, 0xbf, 0x82, 0xeb, 0x4b, 0x82, 0x07, 0xde, 0xfa,
+	0x37, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x46, 0x2b, 0xcf, 0x9d, 0x0f, 0x00, 0x00,
+}
+
+func (m *SymbioticVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoterStakes) > 0 {
+		for iNdEx := len(m.VoterStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorSetDigest) > 0 {
+		i -= len(m.ValidatorSetDigest)
+		copy(dAtA[i:], m.ValidatorSetDigest)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ValidatorSetDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticVoterStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticVoterStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticVoterStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticVoterStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticVoterStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticVoterStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Resolved {
+		i--
+		if m.Resolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Vault) > 0 {
+		i -= len(m.Vault)
+		copy(dAtA[i:], m.Vault)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Vault)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticValidatorStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticValidatorStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticValidatorStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsPubkey) > 0 {
+		i -= len(m.ConsPubkey)
+		copy(dAtA[i:], m.ConsPubkey)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ConsPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConsAddr) > 0 {
+		i -= len(m.ConsAddr)
+		copy(dAtA[i:], m.ConsAddr)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CachedBlockHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CachedBlockHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CachedBlockHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoterStakes) > 0 {
+		for iNdEx := len(m.VoterStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InjectedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticSyncData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticSyncData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticSyncData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoterStakes) > 0 {
+		for iNdEx := len(m.VoterStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ExtendedCommitInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticSyncRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticSyncRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticSyncRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSymbiotic(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticSyncCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticSyncCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticSyncCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SafetyMode != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.SafetyMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SkippedSyncs != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.SkippedSyncs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSymbiotic(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticSyncStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticSyncStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticSyncStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SymbioticPendingPowerChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SymbioticPendingPowerChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SymbioticPendingPowerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TargetTokens.Size()
+		i -= size
+		if _, err := m.TargetTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSymbiotic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorBindingProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorBindingProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorBindingProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SignatureType != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.SignatureType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintSymbiotic(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSymbiotic(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSymbiotic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSymbiotic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SymbioticVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.ValidatorSetDigest)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockTimestamp))
+	}
+	if len(m.VoterStakes) > 0 {
+		for _, e := range m.VoterStakes {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SymbioticVoterStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	return n
+}
+
+func (m *SymbioticVoterStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Vault)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	if m.Resolved {
+		n += 2
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	return n
+}
+
+func (m *SymbioticValidatorStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddr)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.ConsPubkey)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	return n
+}
+
+func (m *CachedBlockHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	if m.Attested {
+		n += 2
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockTimestamp))
+	}
+	if len(m.VoterStakes) > 0 {
+		for _, e := range m.VoterStakes {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InjectedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Version))
+	}
+	if m.Type != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	return n
+}
+
+func (m *SymbioticSyncData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExtendedCommitInfo.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockTimestamp))
+	}
+	if len(m.VoterStakes) > 0 {
+		for _, e := range m.VoterStakes {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SymbioticSyncRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSymbiotic(uint64(l))
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockTimestamp))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Epoch))
+	}
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovSymbiotic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SymbioticSyncCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSymbiotic(uint64(l))
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockNumber))
+	}
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovSymbiotic(uint64(m.BlockTimestamp))
+	}
+	if m.SkippedSyncs != 0 {
+		n += 1 + sovSymbiotic(uint64(m.SkippedSyncs))
+	}
+	if m.SafetyMode != 0 {
+		n += 1 + sovSymbiotic(uint64(m.SafetyMode))
+	}
+	return n
+}
+
+func (m *SymbioticSyncStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	return n
+}
+
+func (m *SymbioticPendingPowerChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = m.TargetTokens.Size()
+	n += 1 + l + sovSymbiotic(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *OperatorBindingProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.SignatureType != 0 {
+		n += 1 + sovSymbiotic(uint64(m.SignatureType))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSymbiotic(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovSymbiotic(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovSymbiotic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSymbiotic(x uint64) (n int) {
+	return sovSymbiotic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SymbioticVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetDigest = append(m.ValidatorSetDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetDigest == nil {
+				m.ValidatorSetDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterStakes = append(m.VoterStakes, SymbioticVoterStake{})
+			if err := m.VoterStakes[len(m.VoterStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticVoterStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticVoterStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticVoterStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = append(m.RequestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestId == nil {
+				m.RequestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticVoterStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticVoterStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticVoterStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vault = append(m.Vault[:0], dAtA[iNdEx:postIndex]...)
+			if m.Vault == nil {
+				m.Vault = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolved = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticValidatorStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticValidatorStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticValidatorStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddr = append(m.ConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsAddr == nil {
+				m.ConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkey = append(m.ConsPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsPubkey == nil {
+				m.ConsPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CachedBlockHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CachedBlockHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CachedBlockHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attested = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, SymbioticValidatorStake{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterStakes = append(m.VoterStakes, SymbioticVoterStake{})
+			if err := m.VoterStakes[len(m.VoterStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InjectedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= InjectedTxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticSyncData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticSyncData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticSyncData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtendedCommitInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, SymbioticValidatorStake{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterStakes = append(m.VoterStakes, SymbioticVoterStake{})
+			if err := m.VoterStakes[len(m.VoterStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticSyncRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticSyncRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticSyncRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, SymbioticSyncStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticSyncCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticSyncCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticSyncCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSyncs", wireType)
+			}
+			m.SkippedSyncs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedSyncs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyMode", wireType)
+			}
+			m.SafetyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafetyMode |= StaleStakeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticSyncStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticSyncStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticSyncStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SymbioticPendingPowerChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SymbioticPendingPowerChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SymbioticPendingPowerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorBindingProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorBindingProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorBindingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureType", wireType)
+			}
+			m.SignatureType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureType |= OperatorSignatureType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSymbiotic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSymbiotic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSymbiotic(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSymbiotic
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSymbiotic
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSymbiotic
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSymbiotic
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSymbiotic
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSymbiotic        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSymbiotic          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSymbiotic = fmt.Errorf("proto: unexpected end of group")
+)
@@ -41,6 +41,10 @@ type MsgCreateValidator struct {
 	Commission       CommissionRates `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission"`
 	ValidatorAddress string          `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Pubkey           *any.Any        `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// operator_binding is the proof that the Symbiotic operator of the
+	// validator binds it to validator_address. It is required by the
+	// require_operator_binding param.
+	OperatorBinding *OperatorBindingProof `protobuf:"bytes,5,opt,name=operator_binding,json=operatorBinding,proto3" json:"operator_binding,omitempty"`
 }
 
 func (m *MsgCreateValidator) Reset()         { *m = MsgCreateValidator{} }
@@ -194,6 +198,312 @@ func (m *MsgEditValidatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditValidatorResponse proto.InternalMessageInfo
 
+// MsgUpdateOperatorBinding defines a SDK message for binding an existing
+// validator to a Symbiotic operator.
+type MsgUpdateOperatorBinding struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// operator_binding is the proof that the Symbiotic operator binds the
+	// validator, signed with the nonce of the current binding.
+	OperatorBinding OperatorBindingProof `protobuf:"bytes,2,opt,name=operator_binding,json=operatorBinding,proto3" json:"operator_binding"`
+}
+
+func (m *MsgUpdateOperatorBinding) Reset()         { *m = MsgUpdateOperatorBinding{} }
+func (m *MsgUpdateOperatorBinding) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOperatorBinding) ProtoMessage()    {}
+func (*MsgUpdateOperatorBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{4}
+}
+func (m *MsgUpdateOperatorBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOperatorBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOperatorBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOperatorBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOperatorBinding.Merge(m, src)
+}
+func (m *MsgUpdateOperatorBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOperatorBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOperatorBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOperatorBinding proto.InternalMessageInfo
+
+func (m *MsgUpdateOperatorBinding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateOperatorBinding) GetOperatorBinding() OperatorBindingProof {
+	if m != nil {
+		return m.OperatorBinding
+	}
+	return OperatorBindingProof{}
+}
+
+// MsgUpdateOperatorBindingResponse defines the Msg/UpdateOperatorBinding
+// response type.
+type MsgUpdateOperatorBindingResponse struct {
+}
+
+func (m *MsgUpdateOperatorBindingResponse) Reset()         { *m = MsgUpdateOperatorBindingResponse{} }
+func (m *MsgUpdateOperatorBindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOperatorBindingResponse) ProtoMessage()    {}
+func (*MsgUpdateOperatorBindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{5}
+}
+func (m *MsgUpdateOperatorBindingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOperatorBindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOperatorBindingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOperatorBindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOperatorBindingResponse.Merge(m, src)
+}
+func (m *MsgUpdateOperatorBindingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOperatorBindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOperatorBindingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOperatorBindingResponse proto.InternalMessageInfo
+
+// MsgRotateConsPubKey defines a SDK message for rotating the consensus public
+// key of an existing validator.
+type MsgRotateConsPubKey struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// new_pubkey is the consensus public key the validator rotates to.
+	NewPubkey *any.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{6}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse defines the Msg/RotateConsPubKey response type.
+type MsgRotateConsPubKeyResponse struct {
+}
+
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{7}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
+// MsgOperatorExec defines a SDK message for executing messages on behalf of a
+// validator registered by a Symbiotic sync, whose operator address is the
+// Ethereum address of its operator and has no account key. The messages are
+// authorized by a signature of the operator key instead.
+type MsgOperatorExec struct {
+	// submitter is the account submitting the message and paying its fees.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// msgs are the messages to execute, each signed by the validator address.
+	Msgs []*any.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// signature_type is the scheme of signature.
+	SignatureType OperatorSignatureType `protobuf:"varint,4,opt,name=signature_type,json=signatureType,proto3,enum=cosmos.symStaking.v1beta1.OperatorSignatureType" json:"signature_type,omitempty"`
+	// signature is the 65 bytes signature of the messages by the operator, with
+	// the nonce of the validator binding.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgOperatorExec) Reset()         { *m = MsgOperatorExec{} }
+func (m *MsgOperatorExec) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorExec) ProtoMessage()    {}
+func (*MsgOperatorExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{8}
+}
+func (m *MsgOperatorExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOperatorExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorExec.Merge(m, src)
+}
+func (m *MsgOperatorExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOperatorExec proto.InternalMessageInfo
+
+func (m *MsgOperatorExec) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgOperatorExec) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgOperatorExec) GetMsgs() []*any.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *MsgOperatorExec) GetSignatureType() OperatorSignatureType {
+	if m != nil {
+		return m.SignatureType
+	}
+	return OperatorSignatureTypeUnspecified
+}
+
+func (m *MsgOperatorExec) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgOperatorExecResponse defines the Msg/OperatorExec response type.
+type MsgOperatorExecResponse struct {
+	// results are the encoded responses of the executed messages.
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgOperatorExecResponse) Reset()         { *m = MsgOperatorExecResponse{} }
+func (m *MsgOperatorExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOperatorExecResponse) ProtoMessage()    {}
+func (*MsgOperatorExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1decc4a4587222, []int{9}
+}
+func (m *MsgOperatorExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOperatorExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOperatorExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOperatorExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOperatorExecResponse.Merge(m, src)
+}
+func (m *MsgOperatorExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOperatorExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOperatorExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOperatorExecResponse proto.InternalMessageInfo
+
+func (m *MsgOperatorExecResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -208,7 +518,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf1decc4a4587222, []int{4}
+	return fileDescriptor_bf1decc4a4587222, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,7 +570,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf1decc4a4587222, []int{5}
+	return fileDescriptor_bf1decc4a4587222, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +604,12 @@ func init() {
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.symStaking.v1beta1.MsgCreateValidatorResponse")
 	proto.RegisterType((*MsgEditValidator)(nil), "cosmos.symStaking.v1beta1.MsgEditValidator")
 	proto.RegisterType((*MsgEditValidatorResponse)(nil), "cosmos.symStaking.v1beta1.MsgEditValidatorResponse")
+	proto.RegisterType((*MsgUpdateOperatorBinding)(nil), "cosmos.symStaking.v1beta1.MsgUpdateOperatorBinding")
+	proto.RegisterType((*MsgUpdateOperatorBindingResponse)(nil), "cosmos.symStaking.v1beta1.MsgUpdateOperatorBindingResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.symStaking.v1beta1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.symStaking.v1beta1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgOperatorExec)(nil), "cosmos.symStaking.v1beta1.MsgOperatorExec")
+	proto.RegisterType((*MsgOperatorExecResponse)(nil), "cosmos.symStaking.v1beta1.MsgOperatorExecResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.symStaking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.symStaking.v1beta1.MsgUpdateParamsResponse")
 }
@@ -303,52 +619,73 @@ func init() {
 }
 
 var fileDescriptor_bf1decc4a4587222 = []byte{
-	// 711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x96, 0xef, 0x97, 0xa4, 0x83, 0x5a, 0x58, 0x48, 0x28, 0x8b, 0x6e, 0xa1, 0x07, 0x21,
-	0x35, 0x3b, 0x2b, 0xe0, 0x8f, 0xd8, 0x83, 0x91, 0x52, 0xbd, 0x68, 0x0d, 0x29, 0x81, 0x83, 0x17,
-	0x32, 0xdd, 0x1d, 0x97, 0x4d, 0xd9, 0x9d, 0x75, 0x67, 0x8a, 0xec, 0xcd, 0xe8, 0xc5, 0x78, 0xf2,
-	0xec, 0x89, 0xa3, 0x47, 0x0e, 0xfd, 0x23, 0x08, 0x17, 0x49, 0x4f, 0xc6, 0x03, 0x31, 0x70, 0xc0,
-	0xbf, 0xc2, 0x98, 0xdd, 0x9d, 0x76, 0x77, 0x5b, 0xa8, 0x62, 0xe2, 0xa5, 0xdd, 0xbc, 0xf7, 0x99,
-	0xcf, 0x7b, 0xef, 0xf3, 0x79, 0x33, 0xa0, 0xa0, 0x11, 0x6a, 0x11, 0xaa, 0x52, 0xcf, 0x5a, 0x63,
-	0xa8, 0x61, 0xda, 0x86, 0xba, 0xb3, 0x50, 0xc7, 0x0c, 0x2d, 0xa8, 0x6c, 0x17, 0x3a, 0x2e, 0x61,
-	0x44, 0x9c, 0x0a, 0x31, 0x30, 0xc2, 0x40, 0x8e, 0x91, 0xa6, 0x0c, 0x42, 0x8c, 0x6d, 0xac, 0x06,
-	0xc0, 0x7a, 0xf3, 0xa5, 0x8a, 0x6c, 0x2f, 0x3c, 0x25, 0xe5, 0x7b, 0x53, 0xcc, 0xb4, 0x30, 0x65,
-	0xc8, 0x72, 0x38, 0x60, 0xc2, 0x20, 0x06, 0x09, 0x3e, 0x55, 0xff, 0x8b, 0x47, 0x79, 0xb1, 0xcd,
-	0x30, 0xc1, 0x2b, 0x87, 0x29, 0x99, 0xf7, 0x5a, 0x47, 0x14, 0x77, 0xbb, 0xd4, 0x88, 0x69, 0xf3,
-	0xfc, 0xdc, 0xc5, 0xb3, 0x50, 0xde, 0x77, 0x08, 0x9c, 0xe4, 0x40, 0x8b, 0xfa, 0x08, 0xff, 0x8f,
-	0x27, 0xc6, 0x90, 0x65, 0xda, 0x44, 0x0d, 0x7e, 0xc3, 0x50, 0xe1, 0xd3, 0x10, 0x10, 0xab, 0xd4,
-	0x58, 0x71, 0x31, 0x62, 0x78, 0x03, 0x6d, 0x9b, 0x3a, 0x62, 0xc4, 0x15, 0xd7, 0xc0, 0x88, 0x8e,
-	0xa9, 0xe6, 0x9a, 0x0e, 0x33, 0x89, 0x9d, 0x13, 0x66, 0x84, 0xf9, 0x91, 0xc5, 0x9b, 0xf0, 0x42,
-	0xa5, 0x60, 0x25, 0x42, 0x97, 0x33, 0x07, 0xc7, 0xf9, 0xd4, 0xe7, 0xb3, 0xfd, 0xa2, 0x50, 0x8b,
-	0xb3, 0x88, 0xeb, 0x00, 0x68, 0xc4, 0xb2, 0x4c, 0x4a, 0x7d, 0xce, 0x74, 0xc0, 0x59, 0x1c, 0xc0,
-	0xb9, 0xd2, 0x05, 0xd7, 0x10, 0xc3, 0x34, 0xce, 0x1b, 0x23, 0x12, 0x9f, 0x83, 0xb1, 0x9d, 0x4e,
-	0xe3, 0x9b, 0x48, 0xd7, 0x5d, 0x4c, 0x69, 0x6e, 0x68, 0x46, 0x98, 0xcf, 0x94, 0x67, 0xdb, 0x2d,
-	0xe5, 0x06, 0x2f, 0xd0, 0x1d, 0x6e, 0x39, 0x84, 0xac, 0x31, 0xd7, 0xb4, 0x8d, 0xda, 0xe8, 0x4e,
-	0x4f, 0x5c, 0x7c, 0x02, 0x86, 0x9d, 0x66, 0xbd, 0x81, 0xbd, 0xdc, 0x7f, 0x41, 0x8b, 0x13, 0x30,
-	0xb4, 0x1a, 0x76, 0xac, 0x86, 0xcb, 0xb6, 0x57, 0xce, 0x1d, 0xb6, 0x94, 0x09, 0x4e, 0xad, 0xb9,
-	0x9e, 0xc3, 0x08, 0x5c, 0x6d, 0xd6, 0x9f, 0x62, 0xaf, 0xc6, 0x4f, 0x97, 0x1e, 0xbd, 0xdf, 0xcb,
-	0xa7, 0x7e, 0xec, 0xe5, 0x53, 0x6f, 0xcf, 0xf6, 0x8b, 0xfd, 0x2d, 0x7e, 0x38, 0xdb, 0x2f, 0xf2,
-	0xde, 0x14, 0xaa, 0x37, 0xd4, 0x7e, 0x17, 0x0a, 0xd7, 0x81, 0xd4, 0x1f, 0xad, 0x61, 0xea, 0x10,
-	0x9b, 0xe2, 0xc2, 0x97, 0x34, 0x18, 0xad, 0x52, 0xe3, 0xb1, 0x6e, 0xb2, 0x7f, 0x6c, 0xdc, 0xb9,
-	0x0a, 0xa7, 0xff, 0x5e, 0xe1, 0x0d, 0x90, 0x8d, 0xfc, 0xdb, 0x74, 0x11, 0xc3, 0xdc, 0x2f, 0xe5,
-	0xdb, 0x71, 0x7e, 0x3a, 0x64, 0xa3, 0x7a, 0x03, 0x9a, 0x44, 0xb5, 0x10, 0xdb, 0x82, 0xcf, 0xb0,
-	0x81, 0x34, 0xaf, 0x82, 0xb5, 0x76, 0x4b, 0x01, 0xbc, 0x58, 0x05, 0x6b, 0xb5, 0x6b, 0x5a, 0x62,
-	0x43, 0x4a, 0x0f, 0x7f, 0xaf, 0xf8, 0x74, 0x52, 0xf1, 0x84, 0x78, 0x05, 0x09, 0xe4, 0x7a, 0x63,
-	0x5d, 0xb5, 0x8f, 0x05, 0x90, 0xad, 0x52, 0x63, 0xdd, 0xd1, 0x11, 0xc3, 0xab, 0xc8, 0x45, 0x16,
-	0x15, 0xef, 0x81, 0x0c, 0x6a, 0xb2, 0x2d, 0xe2, 0x9a, 0xcc, 0x0b, 0xa4, 0xce, 0x94, 0x73, 0xed,
-	0x68, 0x2d, 0x92, 0x32, 0x44, 0x50, 0xb1, 0x02, 0x86, 0x9d, 0x80, 0x81, 0x5f, 0x82, 0xd9, 0x01,
-	0xfe, 0x84, 0xa5, 0xe2, 0xd6, 0xf0, 0xb3, 0xa5, 0x6a, 0xbb, 0xa5, 0x64, 0xa3, 0x71, 0x66, 0x6e,
-	0xc3, 0x3b, 0xf7, 0xfd, 0xc1, 0xa3, 0x42, 0xfe, 0xc0, 0x73, 0xb1, 0x81, 0x77, 0xe3, 0x6f, 0x47,
-	0xcf, 0x30, 0x05, 0x08, 0x26, 0x7b, 0x42, 0x9d, 0xd9, 0x4b, 0xe3, 0xe7, 0x54, 0x5a, 0xfc, 0x99,
-	0x06, 0x43, 0x55, 0x6a, 0x88, 0xaf, 0x41, 0xb6, 0xf7, 0xf5, 0x50, 0x06, 0xcc, 0xd3, 0xbf, 0xd0,
-	0xd2, 0xdd, 0x4b, 0xc1, 0x3b, 0x5d, 0x89, 0xaf, 0xc0, 0xd5, 0xe4, 0xee, 0xdf, 0x1a, 0xcc, 0x93,
-	0x00, 0x4b, 0x4b, 0x97, 0x00, 0x77, 0x4b, 0xbe, 0x13, 0xc0, 0x95, 0xc4, 0x06, 0x14, 0x07, 0xb3,
-	0xc4, 0xb1, 0xd2, 0xe2, 0x9f, 0x63, 0xbb, 0x5b, 0x37, 0x7e, 0xd8, 0xaf, 0xbc, 0xf4, 0xff, 0x1b,
-	0x7f, 0x0f, 0xca, 0x0f, 0x0e, 0x4e, 0x64, 0xe1, 0xe8, 0x44, 0x16, 0xbe, 0x9f, 0xc8, 0xc2, 0xc7,
-	0x53, 0x39, 0x75, 0x74, 0x2a, 0xa7, 0xbe, 0x9e, 0xca, 0xa9, 0x17, 0xf9, 0xc4, 0x15, 0x4a, 0xb8,
-	0xce, 0x3c, 0x07, 0xd3, 0xfa, 0x70, 0xf0, 0x94, 0x2d, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xd0,
-	0xf4, 0xce, 0x44, 0x1f, 0x07, 0x00, 0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6f, 0xdb, 0x54,
+	0x18, 0x8f, 0x93, 0xb5, 0x28, 0x6f, 0x5d, 0xd3, 0xb9, 0x45, 0xcb, 0xdc, 0x2d, 0xe9, 0x8c, 0xc4,
+	0x4a, 0x50, 0xec, 0xad, 0x85, 0x21, 0x82, 0x34, 0xd1, 0xb4, 0xe5, 0x02, 0x81, 0xca, 0x65, 0x43,
+	0xda, 0x25, 0x7a, 0x71, 0xde, 0xbc, 0xa7, 0xd6, 0x7e, 0xc6, 0xef, 0xa5, 0xad, 0x39, 0x21, 0x90,
+	0x10, 0x70, 0x82, 0xff, 0x60, 0x47, 0x8e, 0x3d, 0xe4, 0x8f, 0x98, 0x7a, 0x61, 0xea, 0x09, 0x71,
+	0xa8, 0x50, 0x7b, 0x28, 0x7f, 0xc1, 0xb8, 0x22, 0xdb, 0x2f, 0x8e, 0xed, 0x38, 0x49, 0x1b, 0x6d,
+	0x97, 0xc4, 0xfe, 0xde, 0xef, 0xfb, 0xbe, 0xf7, 0xfd, 0xbe, 0xdf, 0xfb, 0x9e, 0x81, 0xac, 0x13,
+	0x6a, 0x12, 0xaa, 0x52, 0xd7, 0xdc, 0x66, 0x70, 0x07, 0x5b, 0x86, 0xba, 0x77, 0xbf, 0x85, 0x18,
+	0xbc, 0xaf, 0xb2, 0x03, 0xc5, 0x76, 0x08, 0x23, 0xe2, 0xcd, 0x00, 0xa3, 0xf4, 0x31, 0x0a, 0xc7,
+	0x48, 0x37, 0x0d, 0x42, 0x8c, 0x5d, 0xa4, 0xfa, 0xc0, 0x56, 0xe7, 0xa9, 0x0a, 0x2d, 0x37, 0xf0,
+	0x92, 0xca, 0xc9, 0x25, 0x86, 0x4d, 0x44, 0x19, 0x34, 0x6d, 0x0e, 0x58, 0x30, 0x88, 0x41, 0xfc,
+	0x47, 0xd5, 0x7b, 0xe2, 0x56, 0x9e, 0xac, 0x19, 0x2c, 0xf0, 0xcc, 0xc1, 0x52, 0x89, 0xef, 0xb5,
+	0x05, 0x29, 0x0a, 0x77, 0xa9, 0x13, 0x6c, 0xf1, 0xf5, 0xbb, 0xc3, 0x6b, 0xa1, 0x7c, 0xdf, 0x01,
+	0xf0, 0xbd, 0x11, 0x40, 0xd7, 0x6c, 0x61, 0xc2, 0xb0, 0xce, 0xa1, 0x37, 0x38, 0xd4, 0xa4, 0x1e,
+	0xc6, 0xfb, 0xe3, 0x0b, 0xd7, 0xa1, 0x89, 0x2d, 0xa2, 0xfa, 0xbf, 0x81, 0x49, 0x7e, 0x95, 0x03,
+	0x62, 0x83, 0x1a, 0xeb, 0x0e, 0x82, 0x0c, 0x3d, 0x86, 0xbb, 0xb8, 0x0d, 0x19, 0x71, 0xc4, 0x6d,
+	0x70, 0xb5, 0x8d, 0xa8, 0xee, 0x60, 0x9b, 0x61, 0x62, 0x15, 0x85, 0x25, 0x61, 0xf9, 0xea, 0xca,
+	0xbb, 0xca, 0x50, 0x52, 0x95, 0x8d, 0x3e, 0xba, 0x9e, 0x7f, 0x71, 0x52, 0xce, 0xfc, 0x71, 0x7e,
+	0x58, 0x11, 0xb4, 0x68, 0x14, 0xf1, 0x11, 0x00, 0x3a, 0x31, 0x4d, 0x4c, 0xa9, 0x17, 0x33, 0xeb,
+	0xc7, 0xac, 0x8c, 0x88, 0xb9, 0x1e, 0x82, 0x35, 0xc8, 0x10, 0x8d, 0xc6, 0x8d, 0x04, 0x12, 0xbf,
+	0x04, 0xd7, 0xf7, 0x7a, 0x1b, 0x6f, 0xc2, 0x76, 0xdb, 0x41, 0x94, 0x16, 0x73, 0x4b, 0xc2, 0x72,
+	0xbe, 0x7e, 0xe7, 0xb8, 0x5b, 0xbd, 0xcd, 0x13, 0x84, 0xc5, 0xad, 0x05, 0x90, 0x6d, 0xe6, 0x60,
+	0xcb, 0xd0, 0xe6, 0xf6, 0x12, 0x76, 0xf1, 0x33, 0x30, 0x6d, 0x77, 0x5a, 0x3b, 0xc8, 0x2d, 0x5e,
+	0xf1, 0xb7, 0xb8, 0xa0, 0x04, 0xaa, 0x50, 0x7a, 0xaa, 0x50, 0xd6, 0x2c, 0xb7, 0x5e, 0x3c, 0xea,
+	0x56, 0x17, 0x78, 0x68, 0xdd, 0x71, 0x6d, 0x46, 0x94, 0xad, 0x4e, 0xeb, 0x73, 0xe4, 0x6a, 0xdc,
+	0x5b, 0x7c, 0x02, 0xe6, 0x88, 0x8d, 0x1c, 0x7f, 0x5b, 0x2d, 0x6c, 0xb5, 0xb1, 0x65, 0x14, 0xa7,
+	0xfc, 0x88, 0xea, 0x88, 0xa2, 0xbf, 0xe2, 0x2e, 0xf5, 0xc0, 0x63, 0xcb, 0x21, 0xe4, 0xa9, 0x56,
+	0x20, 0x71, 0x6b, 0xed, 0xd3, 0x9f, 0x9f, 0x97, 0x33, 0xff, 0x3e, 0x2f, 0x67, 0x7e, 0x38, 0x3f,
+	0xac, 0x0c, 0x96, 0xff, 0xeb, 0xf9, 0x61, 0x85, 0xd7, 0x5d, 0xa5, 0xed, 0x1d, 0x75, 0xb0, 0xc3,
+	0xf2, 0x2d, 0x20, 0x0d, 0x5a, 0x35, 0x44, 0x6d, 0x62, 0x51, 0x24, 0xff, 0x99, 0x05, 0x73, 0x0d,
+	0x6a, 0x6c, 0xb6, 0x31, 0x7b, 0xc3, 0xa2, 0x48, 0xed, 0x5e, 0x76, 0xf2, 0xee, 0x3d, 0x06, 0x85,
+	0xbe, 0x36, 0x9a, 0x0e, 0x64, 0x88, 0x6b, 0xa1, 0xfa, 0xf7, 0x49, 0x79, 0x31, 0x88, 0x46, 0xdb,
+	0x3b, 0x0a, 0x26, 0xaa, 0x09, 0xd9, 0x33, 0xe5, 0x0b, 0x64, 0x40, 0xdd, 0xdd, 0x40, 0xfa, 0x71,
+	0xb7, 0x0a, 0x78, 0xb2, 0x0d, 0xa4, 0x6b, 0xb3, 0x7a, 0x4c, 0x7d, 0xb5, 0x87, 0xe3, 0x19, 0x5f,
+	0x8c, 0x33, 0x1e, 0x23, 0x4f, 0x96, 0x40, 0x31, 0x69, 0x0b, 0xd9, 0xfe, 0x3d, 0xeb, 0x2f, 0x3e,
+	0xb2, 0xdb, 0x90, 0xa1, 0x84, 0x00, 0xd2, 0x09, 0x12, 0x26, 0x27, 0x08, 0xa5, 0xc8, 0x32, 0x3b,
+	0x91, 0x2c, 0xa3, 0x3d, 0x1d, 0x50, 0xe8, 0xc3, 0xe1, 0x3c, 0xbd, 0x13, 0xe7, 0x29, 0xb5, 0x6c,
+	0x59, 0x06, 0x4b, 0xc3, 0xd6, 0x42, 0xde, 0xfe, 0x13, 0xc0, 0x7c, 0x83, 0x1a, 0x1a, 0x61, 0x90,
+	0xa1, 0x75, 0x62, 0xd1, 0xe0, 0x04, 0xbe, 0x76, 0xca, 0x1a, 0x00, 0x58, 0x68, 0xbf, 0xc9, 0xa7,
+	0x42, 0x76, 0xa2, 0xa9, 0x90, 0xb7, 0xd0, 0xfe, 0x96, 0x1f, 0xa0, 0xb6, 0x36, 0x5e, 0x4a, 0xa5,
+	0x38, 0x45, 0xc9, 0x0a, 0xe5, 0xdb, 0x60, 0x31, 0xc5, 0x1c, 0x12, 0xf3, 0x53, 0x0e, 0x14, 0x1a,
+	0xd4, 0xe8, 0xf1, 0xb6, 0x79, 0x80, 0x74, 0xf1, 0x01, 0xc8, 0xd3, 0x4e, 0xcb, 0xc4, 0x8c, 0x21,
+	0x87, 0x93, 0x51, 0x3c, 0xee, 0xef, 0x36, 0xce, 0x41, 0x1f, 0xfa, 0xda, 0x0f, 0xe8, 0x26, 0xb8,
+	0x62, 0x52, 0xc3, 0x9b, 0xd0, 0xb9, 0xa1, 0x34, 0x2e, 0x1e, 0x75, 0xab, 0xfc, 0x16, 0x53, 0xbc,
+	0x9b, 0x33, 0x94, 0xa1, 0x57, 0xb4, 0xef, 0x2e, 0x7e, 0x03, 0x66, 0x29, 0x36, 0x2c, 0xc8, 0x3a,
+	0x0e, 0x6a, 0x32, 0xd7, 0x46, 0xfe, 0xb4, 0x9e, 0x5d, 0xb9, 0x77, 0x01, 0x11, 0x6f, 0xf7, 0x1c,
+	0xbf, 0x76, 0x6d, 0xa4, 0x5d, 0xa3, 0xd1, 0x57, 0xf1, 0x16, 0xc8, 0x87, 0x06, 0x7f, 0x5e, 0xcf,
+	0x68, 0x7d, 0x43, 0xad, 0xea, 0xf5, 0xac, 0xcf, 0x8e, 0xd7, 0x2b, 0x29, 0xde, 0xab, 0x28, 0xe9,
+	0xf2, 0x2a, 0xb8, 0x91, 0x30, 0xf5, 0x7a, 0x24, 0x16, 0xc1, 0x5b, 0x0e, 0xa2, 0x9d, 0x5d, 0xe6,
+	0x49, 0x33, 0xb7, 0x3c, 0xa3, 0xf5, 0x5e, 0xe5, 0x13, 0xc1, 0xef, 0x5e, 0xa0, 0xfd, 0x2d, 0xe8,
+	0x40, 0x93, 0x7a, 0xdd, 0x83, 0x1d, 0xf6, 0x8c, 0x38, 0x98, 0xb9, 0xe3, 0xbb, 0x17, 0x42, 0xc5,
+	0x0d, 0x30, 0x6d, 0xfb, 0x11, 0xb8, 0x6c, 0xef, 0x8c, 0xa0, 0x27, 0x48, 0x15, 0x3d, 0xd5, 0xdc,
+	0xb7, 0xd6, 0x38, 0xee, 0x56, 0x0b, 0xfd, 0x32, 0x97, 0xee, 0x29, 0x1f, 0x7c, 0xe4, 0x13, 0x11,
+	0x26, 0xf2, 0x88, 0xb8, 0x1b, 0x21, 0xe2, 0x20, 0xfa, 0xa1, 0x92, 0x28, 0x46, 0x56, 0x7c, 0x56,
+	0xa2, 0xa6, 0x1e, 0x2b, 0xb5, 0xf9, 0x94, 0x4c, 0x2b, 0xaf, 0xa6, 0x40, 0xae, 0x41, 0x0d, 0x71,
+	0x1f, 0x14, 0x92, 0x1f, 0x2a, 0xd5, 0x11, 0xf5, 0x0c, 0xde, 0x6f, 0xd2, 0x87, 0x97, 0x82, 0x87,
+	0xbd, 0xfa, 0x16, 0x5c, 0x8b, 0x5f, 0x85, 0xef, 0x8f, 0x8e, 0x13, 0x03, 0x4b, 0xab, 0x97, 0x00,
+	0x87, 0x29, 0x7f, 0x11, 0xc0, 0xdb, 0xe9, 0x17, 0xc2, 0x98, 0x70, 0xa9, 0x4e, 0xd2, 0x27, 0x13,
+	0x38, 0x85, 0x7b, 0xf9, 0x0e, 0xcc, 0x0d, 0xcc, 0x58, 0x65, 0x74, 0xc0, 0x24, 0x5e, 0x7a, 0x70,
+	0x39, 0x7c, 0x98, 0xdb, 0x02, 0x33, 0xb1, 0x31, 0x56, 0x19, 0x1d, 0x27, 0x8a, 0x95, 0x56, 0x2e,
+	0x8e, 0x0d, 0xf3, 0xfd, 0x28, 0x80, 0x99, 0xd8, 0xc9, 0xab, 0x5c, 0x84, 0xb9, 0x00, 0x3b, 0x2e,
+	0x61, 0x9a, 0xe2, 0xe5, 0xf9, 0xa3, 0x41, 0xc5, 0x4b, 0x53, 0xdf, 0x7b, 0xe7, 0xaf, 0xfe, 0xf1,
+	0x8b, 0xd3, 0x92, 0xf0, 0xf2, 0xb4, 0x24, 0xfc, 0x73, 0x5a, 0x12, 0x7e, 0x3b, 0x2b, 0x65, 0x5e,
+	0x9e, 0x95, 0x32, 0x7f, 0x9d, 0x95, 0x32, 0x4f, 0xca, 0xb1, 0x2f, 0x99, 0xd8, 0x69, 0xf3, 0x86,
+	0x21, 0x6d, 0x4d, 0xfb, 0x03, 0x75, 0xf5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xc0, 0x46,
+	0x0e, 0x2d, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +704,16 @@ type MsgClient interface {
 	CreateValidator(ctx context.Context, in *MsgCreateValidator, opts ...grpc.CallOption) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(ctx context.Context, in *MsgEditValidator, opts ...grpc.CallOption) (*MsgEditValidatorResponse, error)
+	// UpdateOperatorBinding binds an existing validator to another Symbiotic
+	// operator, or binds it again to rotate the signature.
+	UpdateOperatorBinding(ctx context.Context, in *MsgUpdateOperatorBinding, opts ...grpc.CallOption) (*MsgUpdateOperatorBindingResponse, error)
+	// RotateConsPubKey requests the rotation of the consensus public key of an
+	// existing validator. The new key is used once the Symbiotic middleware
+	// reports it.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// OperatorExec executes messages on behalf of a validator registered by a
+	// Symbiotic sync, authorized by a signature of its operator key.
+	OperatorExec(ctx context.Context, in *MsgOperatorExec, opts ...grpc.CallOption) (*MsgOperatorExecResponse, error)
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -398,6 +745,33 @@ func (c *msgClient) EditValidator(ctx context.Context, in *MsgEditValidator, opt
 	return out, nil
 }
 
+func (c *msgClient) UpdateOperatorBinding(ctx context.Context, in *MsgUpdateOperatorBinding, opts ...grpc.CallOption) (*MsgUpdateOperatorBindingResponse, error) {
+	out := new(MsgUpdateOperatorBindingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/UpdateOperatorBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OperatorExec(ctx context.Context, in *MsgOperatorExec, opts ...grpc.CallOption) (*MsgOperatorExecResponse, error) {
+	out := new(MsgOperatorExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/OperatorExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.symStaking.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -413,6 +787,16 @@ type MsgServer interface {
 	CreateValidator(context.Context, *MsgCreateValidator) (*MsgCreateValidatorResponse, error)
 	// EditValidator defines a method for editing an existing validator.
 	EditValidator(context.Context, *MsgEditValidator) (*MsgEditValidatorResponse, error)
+	// UpdateOperatorBinding binds an existing validator to another Symbiotic
+	// operator, or binds it again to rotate the signature.
+	UpdateOperatorBinding(context.Context, *MsgUpdateOperatorBinding) (*MsgUpdateOperatorBindingResponse, error)
+	// RotateConsPubKey requests the rotation of the consensus public key of an
+	// existing validator. The new key is used once the Symbiotic middleware
+	// reports it.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// OperatorExec executes messages on behalf of a validator registered by a
+	// Symbiotic sync, authorized by a signature of its operator key.
+	OperatorExec(context.Context, *MsgOperatorExec) (*MsgOperatorExecResponse, error)
 	// UpdateParams defines an operation for updating the x/symStaking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -428,6 +812,15 @@ func (*UnimplementedMsgServer) CreateValidator(ctx context.Context, req *MsgCrea
 func (*UnimplementedMsgServer) EditValidator(ctx context.Context, req *MsgEditValidator) (*MsgEditValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidator not implemented")
 }
+func (*UnimplementedMsgServer) UpdateOperatorBinding(ctx context.Context, req *MsgUpdateOperatorBinding) (*MsgUpdateOperatorBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperatorBinding not implemented")
+}
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
+func (*UnimplementedMsgServer) OperatorExec(ctx context.Context, req *MsgOperatorExec) (*MsgOperatorExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorExec not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -472,6 +865,60 @@ func _Msg_EditValidator_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateOperatorBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperatorBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperatorBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/UpdateOperatorBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperatorBinding(ctx, req.(*MsgUpdateOperatorBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OperatorExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOperatorExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OperatorExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.symStaking.v1beta1.Msg/OperatorExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OperatorExec(ctx, req.(*MsgOperatorExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -502,6 +949,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditValidator",
 			Handler:    _Msg_EditValidator_Handler,
 		},
+		{
+			MethodName: "UpdateOperatorBinding",
+			Handler:    _Msg_UpdateOperatorBinding_Handler,
+		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
+		{
+			MethodName: "OperatorExec",
+			Handler:    _Msg_OperatorExec_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -531,6 +990,18 @@ func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OperatorBinding != nil {
+		{
+			size, err := m.OperatorBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Pubkey != nil {
 		{
 			size, err := m.Pubkey.MarshalToSizedBuffer(dAtA[:i])
@@ -671,7 +1142,7 @@ func (m *MsgEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateOperatorBinding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -681,18 +1152,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperatorBinding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperatorBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.OperatorBinding.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -701,17 +1172,17 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateOperatorBindingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -721,12 +1192,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperatorBindingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperatorBindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -734,10 +1205,233 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubkey != nil {
+		{
+			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SignatureType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignatureType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOperatorExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOperatorExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOperatorExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -763,6 +1457,10 @@ func (m *MsgCreateValidator) Size() (n int) {
 		l = m.Pubkey.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.OperatorBinding != nil {
+		l = m.OperatorBinding.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -803,37 +1501,603 @@ func (m *MsgEditValidatorResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgUpdateOperatorBinding) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OperatorBinding.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateOperatorBindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOperatorExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SignatureType != 0 {
+		n += 1 + sovTx(uint64(m.SignatureType))
+	}
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *MsgOperatorExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &any.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperatorBinding == nil {
+				m.OperatorBinding = &OperatorBindingProof{}
+			}
+			if err := m.OperatorBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperatorBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -856,17 +2120,17 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperatorBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperatorBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -876,28 +2140,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorBinding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,11 +2187,111 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OperatorBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOperatorBindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOperatorBindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOperatorBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -960,9 +2323,9 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -989,10 +2352,10 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &any.Any{}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &any.Any{}
 			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1017,7 +2380,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1040,10 +2403,10 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1067,7 +2430,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1090,17 +2453,17 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1110,24 +2473,23 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1163,9 +2525,9 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1175,28 +2537,79 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureType", wireType)
+			}
+			m.SignatureType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureType |= OperatorSignatureType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1218,7 +2631,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgOperatorExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1241,12 +2654,44 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOperatorExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOperatorExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])